@@ -0,0 +1,715 @@
+package application
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/card"
+	"flamingo.me/form/domain/markdown"
+	"flamingo.me/form/domain/pagination"
+	"flamingo.me/form/domain/validators"
+)
+
+type (
+	// bindingPlan as precompiled description of struct form data type.
+	// It contains everything handler needs to know about form data type, so no tags or field names have to be looked up
+	// while handling requests. Binding plans are shared between handlers and must not be modified.
+	bindingPlan struct {
+		// typeOf struct type which plan is compiled for
+		typeOf reflect.Type
+		// validationRules validation rules of all form fields
+		validationRules map[string][]domain.ValidationRule
+		// fieldDefaults names of all form fields, mapped to default values defined by `default:"value"` tag
+		fieldDefaults map[string]string
+		// confirmBindings all fields tagged with `confirmfield:"OtherField"`
+		confirmBindings []confirmBinding
+		// confirmErr error of invalid confirmfield tag, returned when confirmation fields are processed
+		confirmErr error
+		// ruleErr error of comparison rule with parameter invalid for type of the field, of invalid pattern rule,
+		// of invalid required_if parameter or of invalid required_when condition, returned before validation
+		ruleErr error
+		// requiredBindings all fields tagged with `required_when:"ctx:flag"`
+		requiredBindings []requiredBinding
+		// formFields bindings of all form fields by their form names, used for applying rule profiles
+		formFields map[string]formField
+		// sensitiveFields form names of all sensitive form fields, which values must not be retained or logged,
+		// nil if there are none
+		sensitiveFields map[string]bool
+		// encryptBindings all fields tagged with `encrypt:"true"`
+		encryptBindings []encryptBinding
+		// cardBindings all payment card sub forms
+		cardBindings []cardBinding
+		// markdownBindings all markdown fields
+		markdownBindings []markdownBinding
+	}
+
+	// confirmBinding as precompiled binding of confirmation field and its paired field
+	confirmBinding struct {
+		// index path of confirmation field, which may cross pointers to sub structs
+		index []int
+		// confirmedIndex path of paired field
+		confirmedIndex []int
+		// fieldName name of field used for field errors
+		fieldName string
+		// label go name of confirmation field used for default label of field errors
+		label string
+	}
+
+	// encryptBinding as precompiled binding of encrypted field
+	encryptBinding struct {
+		// index path of encrypted field, which may cross pointers to sub structs
+		index []int
+		// pointer flag if field is *string instead of string
+		pointer bool
+		// name go name of encrypted field
+		name string
+	}
+
+	// cardBinding as precompiled binding of payment card sub form
+	cardBinding struct {
+		// index path of card field, which may cross pointers to sub structs
+		index []int
+		// pointer flag if field is *card.Card instead of card.Card
+		pointer bool
+	}
+
+	// markdownBinding as precompiled binding of markdown field
+	markdownBinding struct {
+		// index path of markdown field, which may cross pointers to sub structs
+		index []int
+		// fieldName name of field used for markdown previews
+		fieldName string
+	}
+)
+
+var (
+	// bindingPlans contains binding plans of already compiled form data types
+	bindingPlans = domain.NewCache("bindingPlans")
+
+	// emptyBindingPlan is used for all form data which is not struct
+	emptyBindingPlan = &bindingPlan{
+		validationRules: map[string][]domain.ValidationRule{},
+	}
+
+	// cardType is type of payment card sub form
+	cardType = reflect.TypeOf(card.Card{})
+
+	// markdownType is type of markdown fields
+	markdownType = reflect.TypeOf(markdown.Text(""))
+
+	// sortType is type of sorting sub form
+	sortType = reflect.TypeOf(pagination.Sort{})
+
+	// timeType is type of time fields, which are validated as single value instead of sub struct
+	timeType = reflect.TypeOf(time.Time{})
+
+	// ruleParamReplacer restores commas and pipes of rule parameters, which are escaped in validation tags
+	ruleParamReplacer = strings.NewReplacer("0x2C", ",", "0x7C", "|")
+
+	// comparisonRules names of validation rules which compare length or value of the field with their parameter
+	comparisonRules = map[string]bool{
+		"min": true,
+		"max": true,
+		"len": true,
+		"gt":  true,
+		"gte": true,
+		"lt":  true,
+		"lte": true,
+	}
+
+	// fieldReferenceRules names of validation rules which reference other field of the same struct as parameter,
+	// by its struct field name or by path of struct field names for cross struct rules (like "Address.Zip")
+	fieldReferenceRules = map[string]bool{
+		"eqfield":       true,
+		"nefield":       true,
+		"gtfield":       true,
+		"gtefield":      true,
+		"ltfield":       true,
+		"ltefield":      true,
+		"eqcsfield":     true,
+		"necsfield":     true,
+		"gtcsfield":     true,
+		"gtecsfield":    true,
+		"ltcsfield":     true,
+		"ltecsfield":    true,
+		"fieldcontains": true,
+		"fieldexcludes": true,
+	}
+
+	// fieldListRules names of validation rules which reference space separated list of other fields
+	// of the same struct as parameter
+	fieldListRules = map[string]bool{
+		"required_with":        true,
+		"required_with_all":    true,
+		"required_without":     true,
+		"required_without_all": true,
+	}
+)
+
+// requiredIfRule name of validation rule which references space separated pairs of other fields of the same struct
+// and their values as parameter (like "Country DE")
+const requiredIfRule = validators.RequiredIfValidatorName
+
+// loadBindingPlan returns binding plan of form data type, by compiling it if it's not compiled yet.
+func loadBindingPlan(typeOf reflect.Type) *bindingPlan {
+	if typeOf == nil {
+		return emptyBindingPlan
+	}
+
+	if typeOf.Kind() == reflect.Ptr {
+		typeOf = typeOf.Elem()
+	}
+
+	if typeOf.Kind() != reflect.Struct {
+		return emptyBindingPlan
+	}
+
+	if plan, ok := bindingPlans.Load(typeOf); ok {
+		return plan.(*bindingPlan)
+	}
+
+	plan, _ := bindingPlans.LoadOrStore(typeOf, compileBindingPlan(typeOf))
+
+	return plan.(*bindingPlan)
+}
+
+// compileBindingPlan compiles binding plan of struct type
+func compileBindingPlan(typeOf reflect.Type) *bindingPlan {
+	plan := &bindingPlan{
+		typeOf:        typeOf,
+		fieldDefaults: compileFieldDefaults(typeOf),
+	}
+
+	plan.validationRules, plan.ruleErr = compileValidationRules(typeOf, map[reflect.Type]bool{typeOf: true})
+
+	plan.compileFields(typeOf, nil, "", map[reflect.Type]bool{typeOf: true})
+
+	plan.formFields = map[string]formField{}
+	compileFormFields(plan.formFields, typeOf, nil, "", "", nil, map[reflect.Type]bool{typeOf: true})
+
+	for name, field := range plan.formFields {
+		if field.sensitive {
+			if plan.sensitiveFields == nil {
+				plan.sensitiveFields = map[string]bool{}
+			}
+			plan.sensitiveFields[name] = true
+		}
+	}
+
+	return plan
+}
+
+// compileValidationRules as function for extracting validation rules of all fields of struct type, including sub structs
+// and structs of slice elements, which are validated by "dive". Structs which are already part of the current path
+// are skipped, so recursive types (like trees) don't cause endless compilation.
+// It returns error of first comparison rule with parameter invalid for type of the field.
+func compileValidationRules(typeOf reflect.Type, path map[reflect.Type]bool) (map[string][]domain.ValidationRule, error) {
+	validationRules := map[string][]domain.ValidationRule{}
+	var ruleErr error
+
+	for i := 0; i < typeOf.NumField(); i++ {
+		fieldType := typeOf.Field(i)
+
+		fieldTypeOf := fieldType.Type
+		if fieldTypeOf.Kind() == reflect.Ptr && fieldTypeOf.Elem().Kind() == reflect.Struct {
+			fieldTypeOf = fieldTypeOf.Elem()
+		}
+
+		name := fieldType.Tag.Get("form")
+		if name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name = fieldType.Name
+		}
+
+		if fieldTypeOf.Kind() == reflect.Struct && fieldTypeOf != timeType {
+			if path[fieldTypeOf] {
+				continue
+			}
+
+			path[fieldTypeOf] = true
+			subRules, err := compileValidationRules(fieldTypeOf, path)
+			delete(path, fieldTypeOf)
+			if ruleErr == nil {
+				ruleErr = err
+			}
+			for k, v := range subRules {
+				key := fmt.Sprintf("%s.%s", name, k)
+				validationRules[key] = v
+			}
+
+			// whitelist of sortable fields is defined by tag of embedding field, so it's exported as rule of sort field
+			if fieldTypeOf == sortType {
+				if fields := pagination.SortFields(fieldType); len(fields) > 0 {
+					key := fmt.Sprintf("%s.%s", name, formFieldName(sortType, "Field"))
+					validationRules[key] = append(validationRules[key], domain.ValidationRule{
+						Name:  "oneof",
+						Value: strings.Join(fields, " "),
+					})
+				}
+			}
+
+			continue
+		}
+
+		if confirmed := fieldType.Tag.Get("confirmfield"); confirmed != "" {
+			validationRules[name] = append(validationRules[name], domain.ValidationRule{
+				Name:  "confirmfield",
+				Value: formFieldName(typeOf, confirmed),
+			})
+		}
+
+		if condition := fieldType.Tag.Get(requiredWhenTag); condition != "" {
+			if _, err := parseRequiredCondition(name, condition); err != nil && ruleErr == nil {
+				ruleErr = err
+			}
+			validationRules[name] = append(validationRules[name], domain.ValidationRule{
+				Name:  requiredWhenTag,
+				Value: condition,
+			})
+		}
+
+		validationTag := fieldType.Tag.Get("validate")
+		if validationTag == "" {
+			continue
+		}
+
+		// rules of element structs are exported under name of the slice with "[]" suffix (like "items[].name"),
+		// while rules of the slice itself (like "min" before "dive") are exported under its name
+		if elemTypeOf := diveStructTypeOf(fieldType.Type, validationTag); elemTypeOf != nil && !path[elemTypeOf] {
+			path[elemTypeOf] = true
+			elemRules, err := compileValidationRules(elemTypeOf, path)
+			delete(path, elemTypeOf)
+			if ruleErr == nil {
+				ruleErr = err
+			}
+			for k, v := range elemRules {
+				key := fmt.Sprintf("%s[].%s", name, k)
+				validationRules[key] = v
+			}
+		}
+
+		rules, err := parseValidationRules(name, validationTag, fieldType.Type, typeOf)
+		if err != nil && ruleErr == nil {
+			ruleErr = err
+		}
+		if len(rules) > 0 {
+			validationRules[name] = append(validationRules[name], rules...)
+		}
+	}
+
+	return validationRules, ruleErr
+}
+
+// parseValidationRules as function for extracting validation rules of single field from validation tag.
+// Fields referenced by cross-field rules are resolved by struct type, which contains the field.
+// It returns error of first comparison rule with parameter invalid for type of the field, or of invalid pattern rule.
+func parseValidationRules(name string, validationTag string, fieldTypeOf reflect.Type, structTypeOf reflect.Type) ([]domain.ValidationRule, error) {
+	var validationRules []domain.ValidationRule
+	var ruleErr error
+
+	// rules after "dive" are applied to elements of slices and maps, so their parameters are typed by elements
+	valueTypeOf := fieldTypeOf
+
+	tags := strings.Split(validationTag, ",")
+	for _, tag := range tags {
+		// parameters may contain separators, same as in validator itself (like patterns with "=")
+		values := strings.SplitN(tag, "=", 2)
+		if len(values) == 0 {
+			continue
+		}
+		if values[0] == "omitempty" || values[0] == "" {
+			continue
+		}
+		if values[0] == "dive" {
+			valueTypeOf = elemTypeOf(valueTypeOf)
+		}
+
+		validationRule := domain.ValidationRule{
+			Name: values[0],
+		}
+		if len(values) > 1 {
+			validationRule.Value = ruleParamReplacer.Replace(values[1])
+		}
+		validationRule.Value = referencedFormFields(validationRule, structTypeOf)
+		validationRule.ValueType = ruleValueType(validationRule.Name, validationRule.Value, valueTypeOf)
+		validationRule.Conditions = ruleConditions(validationRule)
+
+		if err := checkRuleValue(name, validationRule); err != nil && ruleErr == nil {
+			ruleErr = err
+		}
+
+		if validationRule.Name == validators.PatternValidatorName {
+			meta, err := patternRuleMeta(name, validationRule.Value)
+			if err != nil && ruleErr == nil {
+				ruleErr = err
+			}
+			validationRule.Meta = meta
+		}
+
+		validationRules = append(validationRules, validationRule)
+	}
+
+	return validationRules, ruleErr
+}
+
+// referencedFormFields returns parameter of cross-field rule with struct field names of referenced fields replaced
+// by their form names, so templates can render client side hints for matching inputs. Parameters of other rules,
+// and names of unknown fields are returned as they are.
+func referencedFormFields(rule domain.ValidationRule, structTypeOf reflect.Type) string {
+	if structTypeOf == nil {
+		return rule.Value
+	}
+
+	switch {
+	case fieldReferenceRules[rule.Name]:
+		return formFieldPath(structTypeOf, rule.Value)
+	case fieldListRules[rule.Name]:
+		fields := strings.Fields(rule.Value)
+		for i := range fields {
+			fields[i] = formFieldPath(structTypeOf, fields[i])
+		}
+		return strings.Join(fields, " ")
+	case rule.Name == requiredIfRule:
+		params := strings.Fields(rule.Value)
+		for i := 0; i < len(params); i += 2 {
+			params[i] = formFieldPath(structTypeOf, params[i])
+		}
+		return strings.Join(params, " ")
+	}
+
+	return rule.Value
+}
+
+// ruleConditions returns referenced fields of conditional rule, with their values for "required_if" rule.
+// It returns nil for other rules.
+func ruleConditions(rule domain.ValidationRule) []domain.RuleCondition {
+	var conditions []domain.RuleCondition
+
+	switch {
+	case fieldListRules[rule.Name]:
+		for _, field := range strings.Fields(rule.Value) {
+			conditions = append(conditions, domain.RuleCondition{Field: field})
+		}
+	case rule.Name == requiredIfRule:
+		params := strings.Fields(rule.Value)
+		for i := 0; i+1 < len(params); i += 2 {
+			conditions = append(conditions, domain.RuleCondition{Field: params[i], Value: params[i+1]})
+		}
+	}
+
+	return conditions
+}
+
+// formFieldPath returns path of form field names for path of struct field names separated by ".", starting in struct
+// type. Names which can't be resolved are kept as they are.
+func formFieldPath(typeOf reflect.Type, path string) string {
+	names := strings.Split(path, ".")
+
+	for i, name := range names {
+		for typeOf.Kind() == reflect.Ptr {
+			typeOf = typeOf.Elem()
+		}
+
+		if typeOf.Kind() != reflect.Struct {
+			break
+		}
+
+		fieldType, ok := typeOf.FieldByName(name)
+		if !ok {
+			break
+		}
+
+		names[i] = formFieldName(typeOf, name)
+		typeOf = fieldType.Type
+	}
+
+	return strings.Join(names, ".")
+}
+
+// ruleValueType returns type of parameter of comparison rule, depending on type of the field, or "unsupported" for
+// comparisons of times which can't be exported. For other rules and unsupported types of fields, it returns empty string.
+func ruleValueType(name string, value string, typeOf reflect.Type) string {
+	if !comparisonRules[name] || typeOf == nil {
+		return ""
+	}
+
+	for typeOf.Kind() == reflect.Ptr {
+		typeOf = typeOf.Elem()
+	}
+
+	if typeOf == timeType {
+		// only gt, gte, lt and lte without parameter can be described as comparison with current time
+		if name == "min" || name == "max" || name == "len" || value != "" {
+			return domain.RuleValueTypeUnsupported
+		}
+
+		return domain.RuleValueTypeTime
+	}
+
+	switch typeOf.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		return domain.RuleValueTypeLength
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return domain.RuleValueTypeNumber
+	}
+
+	return ""
+}
+
+// checkRuleValue as function for checking that parameter of comparison rule fits type of the field, and that
+// parameter of required_if rule consists of pairs of fields and values
+func checkRuleValue(fieldName string, rule domain.ValidationRule) error {
+	if rule.Name == requiredIfRule {
+		if params := strings.Fields(rule.Value); len(params) == 0 || len(params)%2 != 0 {
+			return domain.NewFormErrorf("rule %q of field %q expects pairs of fields and values, got %q", rule.Name, fieldName, rule.Value)
+		}
+	}
+
+	switch rule.ValueType {
+	case domain.RuleValueTypeLength:
+		if _, err := strconv.ParseUint(rule.Value, 10, 64); err != nil {
+			return domain.NewFormErrorf("rule %q of field %q expects length, got %q", rule.Name, fieldName, rule.Value)
+		}
+	case domain.RuleValueTypeNumber:
+		if _, err := strconv.ParseFloat(rule.Value, 64); err != nil {
+			return domain.NewFormErrorf("rule %q of field %q expects number, got %q", rule.Name, fieldName, rule.Value)
+		}
+	}
+
+	return nil
+}
+
+// patternRuleMeta as function for checking pattern of pattern rule, and converting it into JavaScript compatible form
+func patternRuleMeta(fieldName string, pattern string) (map[string]string, error) {
+	if _, err := validators.CompilePattern(pattern); err != nil {
+		return nil, domain.NewFormErrorf("pattern %q of field %q is invalid: %s", pattern, fieldName, err)
+	}
+
+	jsPattern, err := validators.JSPattern(pattern)
+	if err != nil {
+		return nil, domain.NewFormErrorf("pattern %q of field %q can't be shared with clients: %s", pattern, fieldName, err)
+	}
+
+	return map[string]string{
+		"jsPattern": jsPattern,
+		"jsFlags":   validators.JSPatternFlags,
+	}, nil
+}
+
+// elemTypeOf returns type of elements of slices, arrays and maps, or nil for other types
+func elemTypeOf(typeOf reflect.Type) reflect.Type {
+	if typeOf == nil {
+		return nil
+	}
+
+	for typeOf.Kind() == reflect.Ptr {
+		typeOf = typeOf.Elem()
+	}
+
+	switch typeOf.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return typeOf.Elem()
+	}
+
+	return nil
+}
+
+// diveStructTypeOf returns struct type of elements of slice or array, if validation tag dives into its elements,
+// same as validator which validates element structs only by "dive". It returns nil for other types and tags.
+func diveStructTypeOf(typeOf reflect.Type, validationTag string) reflect.Type {
+	if typeOf.Kind() != reflect.Slice && typeOf.Kind() != reflect.Array {
+		return nil
+	}
+
+	elemTypeOf := typeOf.Elem()
+	if elemTypeOf.Kind() == reflect.Ptr {
+		elemTypeOf = elemTypeOf.Elem()
+	}
+
+	if elemTypeOf.Kind() != reflect.Struct || elemTypeOf == timeType {
+		return nil
+	}
+
+	for _, tag := range strings.Split(validationTag, ",") {
+		if tag == "dive" {
+			return elemTypeOf
+		}
+	}
+
+	return nil
+}
+
+// compileFieldDefaults as function for extracting names of all form fields of struct type, including sub structs,
+// with their default values
+func compileFieldDefaults(typeOf reflect.Type) map[string]string {
+	fieldDefaults := map[string]string{}
+
+	for i := 0; i < typeOf.NumField(); i++ {
+		fieldType := typeOf.Field(i)
+		if fieldType.PkgPath != "" {
+			continue
+		}
+
+		fieldTypeOf := fieldType.Type
+		if fieldTypeOf.Kind() == reflect.Ptr && fieldTypeOf.Elem().Kind() == reflect.Struct {
+			fieldTypeOf = fieldTypeOf.Elem()
+		}
+
+		name := fieldType.Tag.Get("form")
+		if name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name = fieldType.Name
+		}
+
+		// structs without exported fields (like time.Time) are decoded from single value, same as other fields
+		if subDefaults := compileStructDefaults(fieldTypeOf); len(subDefaults) > 0 {
+			for k, v := range subDefaults {
+				fieldDefaults[fmt.Sprintf("%s.%s", name, k)] = v
+			}
+
+			continue
+		}
+
+		fieldDefaults[name] = fieldType.Tag.Get("default")
+	}
+
+	return fieldDefaults
+}
+
+// compileStructDefaults as function for extracting field defaults of sub struct, it returns nothing for other types
+func compileStructDefaults(typeOf reflect.Type) map[string]string {
+	if typeOf.Kind() != reflect.Struct {
+		return nil
+	}
+
+	return compileFieldDefaults(typeOf)
+}
+
+// compileFields collects confirmation, encryption, card and markdown bindings of all exported fields of struct type, including sub structs.
+// Sub structs which are already part of the current path are skipped, so recursive types don't cause endless compilation.
+func (p *bindingPlan) compileFields(typeOf reflect.Type, index []int, namespace string, path map[reflect.Type]bool) {
+	for i := 0; i < typeOf.NumField(); i++ {
+		fieldType := typeOf.Field(i)
+		if fieldType.PkgPath != "" {
+			continue
+		}
+
+		fieldIndex := append(append([]int{}, index...), i)
+		fieldName := namespace + strings.ToLower(fieldType.Name[0:1]) + fieldType.Name[1:]
+
+		fieldTypeOf := fieldType.Type
+		if fieldTypeOf.Kind() == reflect.Ptr && fieldTypeOf.Elem().Kind() == reflect.Struct {
+			fieldTypeOf = fieldTypeOf.Elem()
+		}
+
+		if fieldTypeOf == cardType {
+			p.cardBindings = append(p.cardBindings, cardBinding{
+				index:   fieldIndex,
+				pointer: fieldType.Type.Kind() == reflect.Ptr,
+			})
+			continue
+		}
+
+		if fieldTypeOf.Kind() == reflect.Struct {
+			if !path[fieldTypeOf] {
+				path[fieldTypeOf] = true
+				p.compileFields(fieldTypeOf, fieldIndex, fieldName+".", path)
+				delete(path, fieldTypeOf)
+			}
+			continue
+		}
+
+		if fieldType.Type == markdownType {
+			p.markdownBindings = append(p.markdownBindings, markdownBinding{
+				index:     fieldIndex,
+				fieldName: fieldName,
+			})
+		}
+
+		if confirmed := fieldType.Tag.Get("confirmfield"); confirmed != "" {
+			confirmedType, ok := typeOf.FieldByName(confirmed)
+			if !ok {
+				if p.confirmErr == nil {
+					p.confirmErr = domain.NewFormErrorf("there is no field %q confirmed by field %q", confirmed, fieldType.Name)
+				}
+				continue
+			}
+
+			p.confirmBindings = append(p.confirmBindings, confirmBinding{
+				index:          fieldIndex,
+				confirmedIndex: append(append([]int{}, index...), confirmedType.Index...),
+				fieldName:      fieldName,
+				label:          fieldType.Name,
+			})
+		}
+
+		if condition, err := parseRequiredCondition(fieldName, fieldType.Tag.Get(requiredWhenTag)); err == nil {
+			p.requiredBindings = append(p.requiredBindings, requiredBinding{
+				index:     fieldIndex,
+				fieldName: fieldName,
+				label:     fieldType.Name,
+				condition: condition,
+			})
+		}
+
+		if fieldType.Tag.Get("encrypt") != "true" {
+			continue
+		}
+
+		switch {
+		case fieldType.Type.Kind() == reflect.String:
+			p.encryptBindings = append(p.encryptBindings, encryptBinding{
+				index: fieldIndex,
+				name:  fieldType.Name,
+			})
+		case fieldType.Type.Kind() == reflect.Ptr && fieldType.Type.Elem().Kind() == reflect.String:
+			p.encryptBindings = append(p.encryptBindings, encryptBinding{
+				index:   fieldIndex,
+				pointer: true,
+				name:    fieldType.Name,
+			})
+		}
+	}
+}
+
+// formFieldName as function for resolving name of form field for struct field, by using "form" tag
+func formFieldName(typeOf reflect.Type, fieldName string) string {
+	fieldType, ok := typeOf.FieldByName(fieldName)
+	if !ok {
+		return fieldName
+	}
+
+	if name := fieldType.Tag.Get("form"); name != "" && name != "-" {
+		return name
+	}
+
+	return fieldType.Name
+}
+
+// fieldByIndex returns field of struct value by its index path.
+// It returns false in case when path crosses nil pointer to sub struct.
+func fieldByIndex(valueOf reflect.Value, index []int) (reflect.Value, bool) {
+	for _, i := range index {
+		if valueOf.Kind() == reflect.Ptr {
+			if valueOf.IsNil() {
+				return reflect.Value{}, false
+			}
+			valueOf = valueOf.Elem()
+		}
+		valueOf = valueOf.Field(i)
+	}
+
+	return valueOf, true
+}
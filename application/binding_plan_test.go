@@ -0,0 +1,464 @@
+package application
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/card"
+	"flamingo.me/form/domain/markdown"
+	"flamingo.me/form/domain/pagination"
+)
+
+type (
+	BindingPlanTestSuite struct {
+		suite.Suite
+	}
+
+	bindingPlanTestAccount struct {
+		Password             string
+		PasswordConfirmation string  `confirmfield:"Password"`
+		IBAN                 *string `encrypt:"true"`
+	}
+
+	bindingPlanTestData struct {
+		Email             string `form:"email" validate:"required"`
+		EmailConfirmation string `form:"emailConfirmation" confirmfield:"Email"`
+		Secret            string `encrypt:"true"`
+		Account           *bindingPlanTestAccount
+		Parent            *bindingPlanTestData `form:"-"`
+		internal          string
+	}
+)
+
+func TestBindingPlanTestSuite(t *testing.T) {
+	suite.Run(t, &BindingPlanTestSuite{})
+}
+
+func (t *BindingPlanTestSuite) TestLoadBindingPlan_Empty() {
+	t.Exactly(emptyBindingPlan, loadBindingPlan(nil))
+	t.Exactly(emptyBindingPlan, loadBindingPlan(reflect.TypeOf("string")))
+	t.Exactly(emptyBindingPlan, loadBindingPlan(reflect.TypeOf(map[string]string{})))
+}
+
+func (t *BindingPlanTestSuite) TestLoadBindingPlan() {
+	plan := loadBindingPlan(reflect.TypeOf(bindingPlanTestData{}))
+
+	t.Equal(reflect.TypeOf(bindingPlanTestData{}), plan.typeOf)
+	t.Equal(map[string][]domain.ValidationRule{
+		"email": {
+			{
+				Name: "required",
+			},
+		},
+		"emailConfirmation": {
+			{
+				Name:  "confirmfield",
+				Value: "email",
+			},
+		},
+		"Account.PasswordConfirmation": {
+			{
+				Name:  "confirmfield",
+				Value: "Password",
+			},
+		},
+	}, plan.validationRules)
+	t.Equal([]confirmBinding{
+		{
+			index:          []int{1},
+			confirmedIndex: []int{0},
+			fieldName:      "emailConfirmation",
+			label:          "EmailConfirmation",
+		},
+		{
+			index:          []int{3, 1},
+			confirmedIndex: []int{3, 0},
+			fieldName:      "account.passwordConfirmation",
+			label:          "PasswordConfirmation",
+		},
+	}, plan.confirmBindings)
+	t.Equal([]encryptBinding{
+		{
+			index: []int{2},
+			name:  "Secret",
+		},
+		{
+			index:   []int{3, 2},
+			pointer: true,
+			name:    "IBAN",
+		},
+	}, plan.encryptBindings)
+	t.Equal(map[string]bool{
+		"Secret":                       true,
+		"Account.Password":             true,
+		"Account.PasswordConfirmation": true,
+		"Account.IBAN":                 true,
+	}, plan.sensitiveFields)
+	t.NoError(plan.confirmErr)
+
+	t.Exactly(plan, loadBindingPlan(reflect.TypeOf(&bindingPlanTestData{})))
+}
+
+func (t *BindingPlanTestSuite) TestLoadBindingPlan_Cards() {
+	plan := loadBindingPlan(reflect.TypeOf(struct {
+		Payment card.Card `form:"payment"`
+		Backup  *card.Card
+	}{}))
+
+	t.Equal([]cardBinding{
+		{
+			index: []int{0},
+		},
+		{
+			index:   []int{1},
+			pointer: true,
+		},
+	}, plan.cardBindings)
+	t.Equal([]domain.ValidationRule{
+		{
+			Name: "required",
+		},
+		{
+			Name: "luhn",
+		},
+	}, plan.validationRules["payment.number"])
+}
+
+func (t *BindingPlanTestSuite) TestLoadBindingPlan_Markdown() {
+	plan := loadBindingPlan(reflect.TypeOf(struct {
+		Title   string
+		Comment markdown.Text `form:"comment" validate:"markdownmax=2000"`
+		Review  *struct {
+			Body markdown.Text
+		}
+	}{}))
+
+	t.Equal([]markdownBinding{
+		{
+			index:     []int{1},
+			fieldName: "comment",
+		},
+		{
+			index:     []int{2, 0},
+			fieldName: "review.body",
+		},
+	}, plan.markdownBindings)
+}
+
+func (t *BindingPlanTestSuite) TestLoadBindingPlan_Sort() {
+	plan := loadBindingPlan(reflect.TypeOf(struct {
+		Sort       pagination.Sort `form:"sort" sort:"name,price"`
+		Unsorted   *pagination.Sort
+		Pagination pagination.Pagination `form:"pagination"`
+	}{}))
+
+	t.Equal([]domain.ValidationRule{
+		{
+			Name:  "oneof",
+			Value: "name price",
+		},
+	}, plan.validationRules["sort.field"])
+	t.Equal([]domain.ValidationRule{
+		{
+			Name:  "oneof",
+			Value: "asc desc",
+		},
+	}, plan.validationRules["sort.direction"])
+	t.Nil(plan.validationRules["Unsorted.field"])
+	t.Equal([]domain.ValidationRule{
+		{
+			Name:      "min",
+			Value:     "1",
+			ValueType: domain.RuleValueTypeNumber,
+		},
+		{
+			Name:      "max",
+			Value:     "100",
+			ValueType: domain.RuleValueTypeNumber,
+		},
+	}, plan.validationRules["pagination.pageSize"])
+}
+
+func (t *BindingPlanTestSuite) TestLoadBindingPlan_RuleValueTypes() {
+	plan := loadBindingPlan(reflect.TypeOf(struct {
+		Name     string            `form:"name" validate:"required,min=3,max=20"`
+		Age      *int              `form:"age" validate:"omitempty,gte=18"`
+		Price    float64           `form:"price" validate:"gt=0.5"`
+		Tags     []string          `form:"tags" validate:"max=5,dive,min=2"`
+		Labels   map[string]string `form:"labels" validate:"len=2"`
+		Birthday time.Time         `form:"birthday" validate:"required,lt"`
+		Accepted bool              `form:"accepted" validate:"eq=true"`
+	}{}))
+
+	t.NoError(plan.ruleErr)
+	t.Equal(map[string][]domain.ValidationRule{
+		"name": {
+			{Name: "required"},
+			{Name: "min", Value: "3", ValueType: domain.RuleValueTypeLength},
+			{Name: "max", Value: "20", ValueType: domain.RuleValueTypeLength},
+		},
+		"age": {
+			{Name: "gte", Value: "18", ValueType: domain.RuleValueTypeNumber},
+		},
+		"price": {
+			{Name: "gt", Value: "0.5", ValueType: domain.RuleValueTypeNumber},
+		},
+		"tags": {
+			{Name: "max", Value: "5", ValueType: domain.RuleValueTypeLength},
+			{Name: "dive"},
+			{Name: "min", Value: "2", ValueType: domain.RuleValueTypeLength},
+		},
+		"labels": {
+			{Name: "len", Value: "2", ValueType: domain.RuleValueTypeLength},
+		},
+		"birthday": {
+			{Name: "required"},
+			{Name: "lt", ValueType: domain.RuleValueTypeTime},
+		},
+		"accepted": {
+			{Name: "eq", Value: "true"},
+		},
+	}, plan.validationRules)
+}
+
+func (t *BindingPlanTestSuite) TestLoadBindingPlan_UnsupportedTimeRules() {
+	plan := loadBindingPlan(reflect.TypeOf(struct {
+		Birthday  time.Time  `form:"birthday" validate:"min=1"`
+		StartDate *time.Time `form:"startDate" validate:"gt=2020-01-01,lte"`
+	}{}))
+
+	t.NoError(plan.ruleErr)
+	t.Equal(map[string][]domain.ValidationRule{
+		"birthday": {
+			{Name: "min", Value: "1", ValueType: domain.RuleValueTypeUnsupported},
+		},
+		"startDate": {
+			{Name: "gt", Value: "2020-01-01", ValueType: domain.RuleValueTypeUnsupported},
+			{Name: "lte", ValueType: domain.RuleValueTypeTime},
+		},
+	}, plan.validationRules)
+}
+
+func (t *BindingPlanTestSuite) TestLoadBindingPlan_CrossFieldRules() {
+	type address struct {
+		Zip string `form:"zip"`
+	}
+
+	plan := loadBindingPlan(reflect.TypeOf(struct {
+		Password             string   `form:"password"`
+		PasswordConfirmation string   `form:"passwordConfirmation" validate:"eqfield=Password"`
+		Start                int      `form:"start"`
+		End                  int      `form:"end" validate:"gtefield=Start"`
+		Phone                string   `form:"phone" validate:"required_without=Email Mobile"`
+		Email                string   `form:"email"`
+		Mobile               string   `form:"mobile"`
+		Billing              *address `form:"billing"`
+		State                string   `form:"state" validate:"required_if=Billing.Zip 10115 Phone 030"`
+		ShippingZip          string   `form:"shippingZip" validate:"necsfield=Billing.Zip"`
+		Nickname             string   `form:"nickname" validate:"nefield=Unknown"`
+	}{}))
+
+	t.NoError(plan.ruleErr)
+	t.Equal([]domain.ValidationRule{
+		{Name: "eqfield", Value: "password"},
+	}, plan.validationRules["passwordConfirmation"])
+	t.Equal([]domain.ValidationRule{
+		{Name: "gtefield", Value: "start"},
+	}, plan.validationRules["end"])
+	t.Equal([]domain.ValidationRule{
+		{Name: "required_without", Value: "email mobile", Conditions: []domain.RuleCondition{
+			{Field: "email"},
+			{Field: "mobile"},
+		}},
+	}, plan.validationRules["phone"])
+	t.Equal([]domain.ValidationRule{
+		{Name: "required_if", Value: "billing.zip 10115 phone 030", Conditions: []domain.RuleCondition{
+			{Field: "billing.zip", Value: "10115"},
+			{Field: "phone", Value: "030"},
+		}},
+	}, plan.validationRules["state"])
+	t.Equal([]domain.ValidationRule{
+		{Name: "necsfield", Value: "billing.zip"},
+	}, plan.validationRules["shippingZip"])
+	t.Equal([]domain.ValidationRule{
+		{Name: "nefield", Value: "Unknown"},
+	}, plan.validationRules["nickname"])
+}
+
+func (t *BindingPlanTestSuite) TestLoadBindingPlan_SliceRules() {
+	type item struct {
+		Name     string `form:"name" validate:"required"`
+		Quantity int    `form:"quantity" validate:"gte=1"`
+	}
+
+	type category struct {
+		Title    string     `form:"title" validate:"required"`
+		Children []category `form:"children" validate:"dive"`
+	}
+
+	plan := loadBindingPlan(reflect.TypeOf(struct {
+		Items      []item     `form:"items" validate:"min=1,max=10,dive,required"`
+		Optional   []*item    `form:"optional" validate:"omitempty,dive"`
+		Fixed      [2]item    `form:"fixed" validate:"dive"`
+		Unchecked  []item     `form:"unchecked" validate:"max=3"`
+		Categories []category `form:"categories" validate:"dive"`
+	}{}))
+
+	t.NoError(plan.ruleErr)
+	t.Equal(map[string][]domain.ValidationRule{
+		"items": {
+			{Name: "min", Value: "1", ValueType: domain.RuleValueTypeLength},
+			{Name: "max", Value: "10", ValueType: domain.RuleValueTypeLength},
+			{Name: "dive"},
+			{Name: "required"},
+		},
+		"items[].name": {
+			{Name: "required"},
+		},
+		"items[].quantity": {
+			{Name: "gte", Value: "1", ValueType: domain.RuleValueTypeNumber},
+		},
+		"optional": {
+			{Name: "dive"},
+		},
+		"optional[].name": {
+			{Name: "required"},
+		},
+		"optional[].quantity": {
+			{Name: "gte", Value: "1", ValueType: domain.RuleValueTypeNumber},
+		},
+		"fixed": {
+			{Name: "dive"},
+		},
+		"fixed[].name": {
+			{Name: "required"},
+		},
+		"fixed[].quantity": {
+			{Name: "gte", Value: "1", ValueType: domain.RuleValueTypeNumber},
+		},
+		"unchecked": {
+			{Name: "max", Value: "3", ValueType: domain.RuleValueTypeLength},
+		},
+		"categories": {
+			{Name: "dive"},
+		},
+		"categories[].title": {
+			{Name: "required"},
+		},
+		"categories[].children": {
+			{Name: "dive"},
+		},
+	}, plan.validationRules)
+}
+
+func (t *BindingPlanTestSuite) TestLoadBindingPlan_RuleError() {
+	testCases := []interface{}{
+		struct {
+			Name string `validate:"min=three"`
+		}{},
+		struct {
+			Age int `validate:"max=old"`
+		}{},
+		struct {
+			Sub struct {
+				Tags []int `validate:"dive,max=x"`
+			}
+		}{},
+		struct {
+			Items []struct {
+				Quantity int `validate:"min=few"`
+			} `validate:"dive"`
+		}{},
+		struct {
+			Country string
+			State   string `validate:"required_if=Country"`
+		}{},
+	}
+
+	for _, testCase := range testCases {
+		plan := loadBindingPlan(reflect.TypeOf(testCase))
+		t.Error(plan.ruleErr)
+		t.NotEmpty(plan.validationRules)
+	}
+}
+
+func (t *BindingPlanTestSuite) TestLoadBindingPlan_Pattern() {
+	plan := loadBindingPlan(reflect.TypeOf(struct {
+		PostCode string `form:"postCode" validate:"required,pattern=^[0-9]{5}$"`
+		Code     string `form:"code" validate:"pattern=^(?i)[a-z]{10x2C3}0x7C[0-9]+$"`
+	}{}))
+
+	t.NoError(plan.ruleErr)
+	t.Equal([]domain.ValidationRule{
+		{
+			Name: "required",
+		},
+		{
+			Name:  "pattern",
+			Value: "^[0-9]{5}$",
+			Meta: map[string]string{
+				"jsPattern": "^[0-9]{5}$",
+				"jsFlags":   "u",
+			},
+		},
+	}, plan.validationRules["postCode"])
+	t.Equal([]domain.ValidationRule{
+		{
+			Name:  "pattern",
+			Value: "^(?i)[a-z]{1,3}|[0-9]+$",
+			Meta: map[string]string{
+				"jsPattern": "^[A-Za-z\\u{17f}\\u{212a}]{1,3}|[0-9]+$",
+				"jsFlags":   "u",
+			},
+		},
+	}, plan.validationRules["code"])
+}
+
+func (t *BindingPlanTestSuite) TestLoadBindingPlan_PatternError() {
+	plan := loadBindingPlan(reflect.TypeOf(struct {
+		PostCode string `form:"postCode" validate:"pattern=^[0-9$"`
+	}{}))
+
+	t.Error(plan.ruleErr)
+	t.Equal([]domain.ValidationRule{
+		{
+			Name:  "pattern",
+			Value: "^[0-9$",
+		},
+	}, plan.validationRules["postCode"])
+}
+
+func (t *BindingPlanTestSuite) TestLoadBindingPlan_ConfirmError() {
+	plan := loadBindingPlan(reflect.TypeOf(struct {
+		EmailConfirmation string `confirmfield:"Email"`
+	}{}))
+
+	t.Error(plan.confirmErr)
+	t.Empty(plan.confirmBindings)
+}
+
+func (t *BindingPlanTestSuite) TestFieldByIndex() {
+	data := bindingPlanTestData{
+		Email: "user@example.com",
+	}
+	valueOf := reflect.ValueOf(&data).Elem()
+
+	field, ok := fieldByIndex(valueOf, []int{0})
+	t.True(ok)
+	t.Equal("user@example.com", field.String())
+
+	_, ok = fieldByIndex(valueOf, []int{3, 0})
+	t.False(ok)
+
+	data.Account = &bindingPlanTestAccount{
+		Password: "secret",
+	}
+
+	field, ok = fieldByIndex(valueOf, []int{3, 0})
+	t.True(ok)
+	t.Equal("secret", field.String())
+}
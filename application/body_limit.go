@@ -0,0 +1,82 @@
+package application
+
+import (
+	"io"
+	"net/http"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/formdata"
+)
+
+type (
+	// limitedBody wraps request body limited by http.MaxBytesReader, and tracks if the limit is exceeded,
+	// as decoders of request body (like multipart reader) don't keep original errors of the body
+	limitedBody struct {
+		body     io.ReadCloser
+		limit    int64
+		read     int64
+		exceeded bool
+	}
+)
+
+// Read reads from limited body, and marks body as exceeded if it fails after the limit is reached
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.body.Read(p)
+	b.read += int64(n)
+	if err != nil && err != io.EOF && b.read >= b.limit {
+		b.exceeded = true
+	}
+
+	return n, err
+}
+
+// Close closes limited body
+func (b *limitedBody) Close() error {
+	return b.body.Close()
+}
+
+// limitError returns ErrRequestBodyTooLarge instead of decoding error, if decoding failed because body exceeded
+// the limit
+func (b *limitedBody) limitError(err error) error {
+	if b != nil && b.exceeded {
+		return domain.ErrRequestBodyTooLarge
+	}
+
+	return err
+}
+
+// limitBody as method for limiting size of request body by maximal body size of the handler, before it's decoded.
+// It returns ErrRequestBodyTooLarge if declared content length already exceeds the limit, and nil body if there is
+// no limit.
+func (h *formHandlerImpl) limitBody(r *web.Request) (*limitedBody, error) {
+	request := r.Request()
+	if h.maxBodySize <= 0 || request.Body == nil {
+		return nil, nil
+	}
+
+	if request.ContentLength > h.maxBodySize {
+		return nil, domain.ErrRequestBodyTooLarge
+	}
+
+	if body, ok := request.Body.(*limitedBody); ok {
+		return body, nil
+	}
+
+	body := &limitedBody{
+		body:  http.MaxBytesReader(nil, request.Body, h.maxBodySize),
+		limit: h.maxBodySize,
+	}
+	request.Body = body
+
+	return body, nil
+}
+
+// multipartMaxMemory as method for returning maximal number of bytes of multipart body kept in memory
+func (h *formHandlerImpl) multipartMaxMemory() int64 {
+	if h.multipartMemory > 0 {
+		return h.multipartMemory
+	}
+
+	return formdata.MultipartMaxMemory
+}
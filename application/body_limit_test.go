@@ -0,0 +1,84 @@
+package application
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/formdata"
+)
+
+type (
+	BodyLimitTestSuite struct {
+		suite.Suite
+
+		handler *formHandlerImpl
+		context context.Context
+	}
+)
+
+func TestBodyLimitTestSuite(t *testing.T) {
+	suite.Run(t, &BodyLimitTestSuite{})
+}
+
+func (t *BodyLimitTestSuite) SetupTest() {
+	t.handler = &formHandlerImpl{maxBodySize: 16}
+	t.context = context.Background()
+}
+
+func (t *BodyLimitTestSuite) request(contentType string, body string, contentLength int64) *web.Request {
+	return web.CreateRequest(&http.Request{
+		Method:        http.MethodPost,
+		URL:           &url.URL{},
+		Header:        http.Header{"Content-Type": []string{contentType}},
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: contentLength,
+	}, nil)
+}
+
+func (t *BodyLimitTestSuite) TestGetURLValues_WithinLimit() {
+	values, err := t.handler.getURLValues(t.context, t.request("application/x-www-form-urlencoded", "name=john", -1), http.MethodPost)
+	t.NoError(err)
+	t.Equal(&url.Values{"name": []string{"john"}}, values)
+}
+
+func (t *BodyLimitTestSuite) TestGetURLValues_TooLarge() {
+	_, err := t.handler.getURLValues(t.context, t.request("application/x-www-form-urlencoded", "name="+strings.Repeat("x", 16), -1), http.MethodPost)
+	t.True(domain.IsRequestBodyTooLarge(err))
+
+	_, err = t.handler.getURLValues(t.context, t.request("application/json", `{"name":"`+strings.Repeat("x", 16)+`"}`, -1), http.MethodPost)
+	t.True(domain.IsRequestBodyTooLarge(err))
+}
+
+func (t *BodyLimitTestSuite) TestGetURLValues_ContentLengthTooLarge() {
+	_, err := t.handler.getURLValues(t.context, t.request("application/x-www-form-urlencoded", "", 17), http.MethodPost)
+	t.Equal(domain.ErrRequestBodyTooLarge, err)
+}
+
+func (t *BodyLimitTestSuite) TestGetURLValues_InvalidBody() {
+	_, err := t.handler.getURLValues(t.context, t.request("application/json", `[]`, -1), http.MethodPost)
+	t.Error(err)
+	t.False(domain.IsRequestBodyTooLarge(err))
+}
+
+func (t *BodyLimitTestSuite) TestGetURLValues_Unlimited() {
+	t.handler.maxBodySize = 0
+
+	values, err := t.handler.getURLValues(t.context, t.request("application/x-www-form-urlencoded", "name="+strings.Repeat("x", 16), -1), http.MethodPost)
+	t.NoError(err)
+	t.Equal(&url.Values{"name": []string{strings.Repeat("x", 16)}}, values)
+}
+
+func (t *BodyLimitTestSuite) TestMultipartMaxMemory() {
+	t.Equal(int64(formdata.MultipartMaxMemory), t.handler.multipartMaxMemory())
+
+	t.handler.multipartMemory = 1024
+	t.Equal(int64(1024), t.handler.multipartMaxMemory())
+}
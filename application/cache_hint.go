@@ -0,0 +1,47 @@
+package application
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+// cacheHint returns cache metadata of unsubmitted form. Form is cacheable, unless form data provider or any enabled
+// form extension provides user specific form data, or form is restored from failed submission, contains debug info
+// or is degraded, since degradations of one request must not be served to others.
+func (h *formHandlerImpl) cacheHint(ctx context.Context, req *web.Request, form *domain.Form) *domain.CacheHint {
+	var reasons []string
+	if isUserSpecific(h.formDataProvider) {
+		reasons = append(reasons, "formDataProvider")
+	}
+
+	for _, name := range h.enabledExtensionNames(ctx, req) {
+		if isUserSpecific(h.formExtensions[name]) {
+			reasons = append(reasons, name)
+		}
+	}
+
+	if form.IsSubmitted() {
+		reasons = append(reasons, "postRedirectGet")
+	}
+	if form.DebugInfo != nil {
+		reasons = append(reasons, "debug")
+	}
+	if form.IsDegraded() {
+		reasons = append(reasons, "degradations")
+	}
+
+	return &domain.CacheHint{
+		Cacheable: len(reasons) == 0,
+		Reasons:   reasons,
+	}
+}
+
+// isUserSpecific checks if form service or form extension implements domain.UserSpecificFormDataProvider
+// and provides user specific form data
+func isUserSpecific(service interface{}) bool {
+	userSpecific, ok := service.(domain.UserSpecificFormDataProvider)
+
+	return ok && userSpecific.IsUserSpecific()
+}
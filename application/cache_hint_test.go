@@ -0,0 +1,121 @@
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	CacheHintTestSuite struct {
+		suite.Suite
+
+		handler *formHandlerImpl
+
+		context context.Context
+		request *web.Request
+	}
+
+	cacheHintTestProvider struct {
+		userSpecific bool
+	}
+)
+
+var _ domain.UserSpecificFormDataProvider = &cacheHintTestProvider{}
+
+func TestCacheHintTestSuite(t *testing.T) {
+	suite.Run(t, &CacheHintTestSuite{})
+}
+
+func (p *cacheHintTestProvider) GetFormData(context.Context, *web.Request) (interface{}, error) {
+	return nil, nil
+}
+
+func (p *cacheHintTestProvider) IsUserSpecific() bool {
+	return p.userSpecific
+}
+
+func (t *CacheHintTestSuite) SetupTest() {
+	t.handler = &formHandlerImpl{
+		formDataProvider: &cacheHintTestProvider{},
+		formExtensions: map[string]domain.FormExtension{
+			"formExtension.honeypot": &cacheHintTestProvider{},
+			"formExtension.captcha":  struct{}{},
+		},
+	}
+
+	t.context = context.Background()
+	t.request = web.CreateRequest(&http.Request{}, nil)
+}
+
+func (t *CacheHintTestSuite) TestCacheHint_Cacheable() {
+	form := domain.NewForm(false, nil)
+
+	t.Equal(&domain.CacheHint{Cacheable: true}, t.handler.cacheHint(t.context, t.request, &form))
+}
+
+func (t *CacheHintTestSuite) TestCacheHint_UserSpecific() {
+	t.handler.formDataProvider = &cacheHintTestProvider{userSpecific: true}
+	t.handler.formExtensions["formExtension.csrfToken"] = &cacheHintTestProvider{userSpecific: true}
+	t.handler.formExtensions["formExtension.minFillTime"] = &prefixedFormExtension{
+		prefix:        "contact",
+		formExtension: &cacheHintTestProvider{userSpecific: true},
+	}
+
+	form := domain.NewForm(false, nil)
+
+	t.Equal(&domain.CacheHint{
+		Reasons: []string{"formDataProvider", "formExtension.csrfToken", "formExtension.minFillTime"},
+	}, t.handler.cacheHint(t.context, t.request, &form))
+}
+
+func (t *CacheHintTestSuite) TestCacheHint_DisabledExtension() {
+	featureFlagProvider := &mocks.FeatureFlagProvider{}
+	featureFlagProvider.On("IsEnabled", t.context, t.request, "csrf").Return(false).Once()
+
+	t.handler.formExtensions["formExtension.csrfToken"] = &cacheHintTestProvider{userSpecific: true}
+	t.handler.featureToggles = newFeatureToggles(featureFlagProvider, []domain.FeatureToggle{
+		{
+			Feature:    "csrf",
+			Extensions: []string{"formExtension.csrfToken"},
+		},
+	})
+
+	form := domain.NewForm(false, nil)
+
+	t.Equal(&domain.CacheHint{Cacheable: true}, t.handler.cacheHint(t.context, t.request, &form))
+
+	featureFlagProvider.AssertExpectations(t.T())
+}
+
+func (t *CacheHintTestSuite) TestCacheHint_Restored() {
+	form := domain.NewForm(true, nil)
+	form.DebugInfo = &domain.DebugInfo{}
+	form.Degradations = []domain.Degradation{
+		{
+			Subsystem: "formExtension.captcha",
+			Reason:    "captcha service unavailable",
+		},
+	}
+
+	t.Equal(&domain.CacheHint{
+		Reasons: []string{"postRedirectGet", "debug", "degradations"},
+	}, t.handler.cacheHint(t.context, t.request, &form))
+}
+
+func (t *CacheHintTestSuite) TestHandleUnsubmittedForm_CacheHint() {
+	t.handler.formDataProvider = &cacheHintTestProvider{userSpecific: true}
+	delete(t.handler.formExtensions, "formExtension.captcha")
+
+	form, err := t.handler.HandleUnsubmittedForm(t.context, t.request)
+	t.NoError(err)
+	t.Equal(&domain.CacheHint{
+		Reasons: []string{"formDataProvider"},
+	}, form.CacheHint)
+}
@@ -0,0 +1,42 @@
+package application
+
+import (
+	"context"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"flamingo.me/flamingo/v3/framework/opencensus"
+	"flamingo.me/form/domain"
+)
+
+var (
+	// cacheSize records count of entries of internal caches after entries are stored
+	cacheSize = stats.Int64("flamingo-form/cache/size", "Count of entries of internal caches", stats.UnitDimensionless)
+	// cacheEvictions counts entries evicted from internal caches because of their limits
+	cacheEvictions = stats.Int64("flamingo-form/cache/evictions", "Count of entries evicted from internal caches", stats.UnitDimensionless)
+	// cacheKey tag key with name of the cache
+	cacheKey = tag.MustNewKey("flamingo-form.cache")
+)
+
+func init() {
+	if err := opencensus.View("flamingo-form/cache/size", cacheSize, view.LastValue(), cacheKey); err != nil {
+		panic(err)
+	}
+	if err := opencensus.View("flamingo-form/cache/evictions", cacheEvictions, view.Sum(), cacheKey); err != nil {
+		panic(err)
+	}
+
+	domain.ObserveCaches(recordCacheStats)
+}
+
+// recordCacheStats records size of the cache and count of evicted entries by metrics
+func recordCacheStats(cacheStats domain.CacheStats, evicted int) {
+	metricCtx, _ := tag.New(context.Background(), tag.Upsert(cacheKey, cacheStats.Name))
+	stats.Record(metricCtx, cacheSize.M(int64(cacheStats.Size)))
+
+	if evicted > 0 {
+		stats.Record(metricCtx, cacheEvictions.M(int64(evicted)))
+	}
+}
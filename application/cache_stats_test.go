@@ -0,0 +1,45 @@
+package application
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	CacheStatsTestSuite struct {
+		suite.Suite
+	}
+
+	cacheStatsTestData struct {
+		Name string `form:"name"`
+	}
+)
+
+func TestCacheStatsTestSuite(t *testing.T) {
+	suite.Run(t, &CacheStatsTestSuite{})
+}
+
+func (t *CacheStatsTestSuite) TestBindingPlansStats() {
+	loadBindingPlan(reflect.TypeOf(cacheStatsTestData{}))
+
+	var found bool
+	for _, cacheStats := range domain.AllCacheStats() {
+		if cacheStats.Name == "bindingPlans" {
+			found = true
+			t.NotZero(cacheStats.Size)
+		}
+	}
+
+	t.True(found)
+}
+
+func (t *CacheStatsTestSuite) TestRecordCacheStats() {
+	t.NotPanics(func() {
+		recordCacheStats(domain.CacheStats{Name: "bindingPlans", Size: 2, Limit: 1, Evictions: 1}, 1)
+		recordCacheStats(domain.CacheStats{Name: "bindingPlans", Size: 1}, 0)
+	})
+}
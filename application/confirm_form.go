@@ -0,0 +1,268 @@
+package application
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/gob"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// confirmation defines encryption of snapshots of reviewed submissions, which are carried from review
+	// to confirmation step by hidden form field. Each snapshot can be confirmed only once, within the web session
+	// which reviewed it.
+	confirmation struct {
+		fieldName   string
+		maxAge      time.Duration
+		aead        cipher.AEAD
+		tokenSource domain.TokenSource
+	}
+
+	// confirmationSnapshot defines encrypted content of snapshot token
+	confirmationSnapshot struct {
+		// ID random identifier of the snapshot, which is pending in web session until snapshot is confirmed
+		ID string `json:"id"`
+		// Values submitted values of reviewed submission, without snapshot token
+		Values url.Values `json:"values"`
+		// ReviewedAt unix time of the review
+		ReviewedAt int64 `json:"reviewedAt"`
+	}
+
+	// pendingConfirmations defines identifiers of snapshots stored in web session, which are not confirmed yet,
+	// mapped to unix time of their review
+	pendingConfirmations map[string]int64
+)
+
+const (
+	confirmationIDLength = 16
+
+	// pendingConfirmationsKey key of web session, under which identifiers of pending snapshots are stored
+	pendingConfirmationsKey = "form.confirmation.pending"
+)
+
+func init() {
+	// web sessions are encoded via gob, so stored type must be registered
+	gob.Register(pendingConfirmations{})
+}
+
+// newConfirmation returns encryption of snapshots with key derived from the secret, or with random key of token
+// source if there is no secret configured, so snapshots are only valid for the same instance. It panics if maximal
+// age is not valid duration.
+func newConfirmation(fieldName string, maxAge string, secret string, tokenSource domain.TokenSource) *confirmation {
+	age, err := time.ParseDuration(maxAge)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	key := sha256.Sum256([]byte(secret))
+	if secret == "" {
+		if err := domain.ReadToken(tokenSource, key[:]); err != nil {
+			panic(err.Error())
+		}
+	}
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		panic(err.Error())
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return &confirmation{
+		fieldName:   fieldName,
+		maxAge:      age,
+		aead:        aead,
+		tokenSource: tokenSource,
+	}
+}
+
+// HandleConfirmForm as method for returning ConfirmForm instance. Submission without snapshot token is handled
+// as dry run, and contains encrypted snapshot of submitted values if it's valid. Submission with snapshot token is
+// validated again and executed with values of the snapshot, so they can't be changed after review.
+func (h *formHandlerImpl) HandleConfirmForm(ctx context.Context, req *web.Request) (*domain.ConfirmForm, error) {
+	if h.confirmation == nil {
+		return nil, domain.NewFormError("there is no confirmation configured for confirm form handler")
+	}
+
+	if !h.isSubmission(req) {
+		form, err := h.HandleUnsubmittedForm(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return &domain.ConfirmForm{Form: *form, FieldName: h.confirmation.fieldName}, nil
+	}
+
+	h.startHandling(ctx, req)
+	form, err := h.buildForm(ctx, req, true)
+	if err != nil {
+		return nil, err
+	}
+
+	submittedValues, err := h.getURLValues(domain.ContextWithFormData(ctx, form.Data), req, h.submissionMethod(req))
+	if err != nil {
+		h.logError(req, "postValueProcessing", err)
+		return nil, domain.NewFormErrorWithParent(err)
+	}
+	defer h.closeStreamedFiles(req)
+
+	if token := submittedValues.Get(h.confirmation.fieldName); token != "" {
+		return h.handleConfirmation(ctx, req, form, token, *submittedValues)
+	}
+
+	form.DryRun = true
+	form, err = h.handleSubmittedValues(ctx, req, form, *submittedValues)
+	if err != nil {
+		return nil, err
+	}
+
+	confirmForm := &domain.ConfirmForm{Form: *form, FieldName: h.confirmation.fieldName}
+	if !form.IsValid() {
+		return confirmForm, nil
+	}
+
+	confirmForm.Token, err = h.confirmation.seal(req, formDataTypeName(form.Data), *submittedValues, domain.CurrentTime(h.clock))
+	if err != nil {
+		h.logError(req, "confirmation", err)
+		return nil, domain.NewFormErrorWithParent(err)
+	}
+
+	return confirmForm, nil
+}
+
+// handleConfirmation as method for handling confirmation step. Form data is decoded from values of the snapshot,
+// while form extensions (like CSRF token or captcha) decode values of the current request, which override values of
+// the snapshot. Forged, expired, foreign or already confirmed snapshot makes form invalid, without validating and
+// executing the submission.
+func (h *formHandlerImpl) handleConfirmation(ctx context.Context, req *web.Request, form *domain.Form, token string, submittedValues url.Values) (*domain.ConfirmForm, error) {
+	values, ok := h.confirmation.open(req, token, formDataTypeName(form.Data), domain.CurrentTime(h.clock))
+	if !ok {
+		form.ValidationInfo.AddGeneralError("formError.confirmation.invalid", "Confirmation is expired, please review your input again")
+		form.Degradations = h.collectDegradations(ctx, req)
+
+		return &domain.ConfirmForm{Form: *form, FieldName: h.confirmation.fieldName, Confirmed: true}, nil
+	}
+
+	extensionValues := make(url.Values, len(values)+len(submittedValues))
+	for key, list := range values {
+		extensionValues[key] = list
+	}
+	for key, list := range submittedValues {
+		if key != h.confirmation.fieldName {
+			extensionValues[key] = list
+		}
+	}
+
+	form, err := h.handleSubmittedValuesWithExtensionValues(ctx, req, form, values, extensionValues)
+	if err != nil {
+		return nil, err
+	}
+
+	h.persistSubmission(req, values, form)
+
+	return &domain.ConfirmForm{Form: *form, FieldName: h.confirmation.fieldName, Confirmed: true}, nil
+}
+
+// seal creates encrypted snapshot token from submitted values, without the snapshot token itself, and stores
+// its identifier as pending in web session. Form data type is authenticated as additional data, so snapshot
+// of one form can't be confirmed by another one.
+func (c *confirmation) seal(req *web.Request, typeName string, values url.Values, reviewedAt time.Time) (string, error) {
+	if req.Session() == nil {
+		return "", domain.NewFormError("confirmation of submissions requires web session")
+	}
+
+	snapshotValues := make(url.Values, len(values))
+	for key, list := range values {
+		if key != c.fieldName {
+			snapshotValues[key] = list
+		}
+	}
+
+	id := make([]byte, confirmationIDLength)
+	if err := domain.ReadToken(c.tokenSource, id); err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(confirmationSnapshot{
+		ID:         base64.RawURLEncoding.EncodeToString(id),
+		Values:     snapshotValues,
+		ReviewedAt: reviewedAt.Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, c.aead.NonceSize())
+	if err := domain.ReadToken(c.tokenSource, nonce); err != nil {
+		return "", err
+	}
+
+	pending := c.pending(req, reviewedAt)
+	pending[base64.RawURLEncoding.EncodeToString(id)] = reviewedAt.Unix()
+	req.Session().Store(pendingConfirmationsKey, pending)
+
+	return base64.RawURLEncoding.EncodeToString(c.aead.Seal(nonce, nonce, payload, []byte(typeName))), nil
+}
+
+// open decrypts snapshot token, checks its form data type and age, consumes it from pending snapshots of web session,
+// and returns submitted values of the snapshot
+func (c *confirmation) open(req *web.Request, token string, typeName string, now time.Time) (url.Values, bool) {
+	sealed, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(sealed) < c.aead.NonceSize() || req.Session() == nil {
+		return nil, false
+	}
+
+	nonceSize := c.aead.NonceSize()
+	payload, err := c.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], []byte(typeName))
+	if err != nil {
+		return nil, false
+	}
+
+	var snapshot confirmationSnapshot
+	if err := json.Unmarshal(payload, &snapshot); err != nil {
+		return nil, false
+	}
+
+	if now.Sub(time.Unix(snapshot.ReviewedAt, 0)) > c.maxAge {
+		return nil, false
+	}
+
+	pending := c.pending(req, now)
+	if _, ok := pending[snapshot.ID]; !ok {
+		return nil, false
+	}
+	delete(pending, snapshot.ID)
+	req.Session().Store(pendingConfirmationsKey, pending)
+
+	if snapshot.Values == nil {
+		snapshot.Values = url.Values{}
+	}
+
+	return snapshot.Values, true
+}
+
+// pending returns copy of pending snapshots stored in web session, without expired ones
+func (c *confirmation) pending(req *web.Request, now time.Time) pendingConfirmations {
+	pending := pendingConfirmations{}
+
+	stored, _ := req.Session().Load(pendingConfirmationsKey)
+	known, _ := stored.(pendingConfirmations)
+	for id, reviewedAt := range known {
+		if now.Sub(time.Unix(reviewedAt, 0)) <= c.maxAge {
+			pending[id] = reviewedAt
+		}
+	}
+
+	return pending
+}
@@ -0,0 +1,299 @@
+package application
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/formtest"
+)
+
+type (
+	ConfirmFormTestSuite struct {
+		suite.Suite
+
+		handler *formHandlerImpl
+		step    *confirmFormTestStep
+		now     time.Time
+
+		context context.Context
+		session *web.Session
+	}
+
+	confirmFormTestService struct{}
+
+	// confirmFormTestExtension accepts only submissions with current token of the request
+	confirmFormTestExtension struct{}
+
+	confirmFormTestStep struct {
+		executed []*domain.Form
+	}
+
+	confirmFormTestData struct {
+		Email string
+	}
+)
+
+var (
+	_ domain.CompleteFormService = &confirmFormTestService{}
+	_ domain.CompleteFormService = &confirmFormTestExtension{}
+	_ domain.SuccessStep         = &confirmFormTestStep{}
+)
+
+func TestConfirmFormTestSuite(t *testing.T) {
+	suite.Run(t, &ConfirmFormTestSuite{})
+}
+
+func (s *confirmFormTestService) GetFormData(context.Context, *web.Request) (interface{}, error) {
+	return confirmFormTestData{}, nil
+}
+
+func (s *confirmFormTestService) Decode(_ context.Context, _ *web.Request, values url.Values, _ interface{}) (interface{}, error) {
+	return confirmFormTestData{Email: values.Get("email")}, nil
+}
+
+func (s *confirmFormTestService) Validate(_ context.Context, _ *web.Request, _ domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	validationInfo := &domain.ValidationInfo{}
+	if formData.(confirmFormTestData).Email == "" {
+		validationInfo.AddFieldError("email", "formError.email.required", "email is required")
+	}
+
+	return validationInfo, nil
+}
+
+func (e *confirmFormTestExtension) GetFormData(context.Context, *web.Request) (interface{}, error) {
+	return "", nil
+}
+
+func (e *confirmFormTestExtension) Decode(_ context.Context, _ *web.Request, values url.Values, _ interface{}) (interface{}, error) {
+	return values.Get("csrfToken"), nil
+}
+
+func (e *confirmFormTestExtension) Validate(_ context.Context, _ *web.Request, _ domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	validationInfo := &domain.ValidationInfo{}
+	if formData != "current" {
+		validationInfo.AddGeneralError("formError.csrfToken.invalid", "csrf token is invalid")
+	}
+
+	return validationInfo, nil
+}
+
+func (s *confirmFormTestStep) Execute(_ context.Context, _ *web.Request, form *domain.Form) error {
+	s.executed = append(s.executed, form)
+	return nil
+}
+
+func (s *confirmFormTestStep) Compensate(context.Context, *web.Request, *domain.Form) error {
+	return nil
+}
+
+func (t *ConfirmFormTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *ConfirmFormTestSuite) SetupTest() {
+	service := &confirmFormTestService{}
+	t.step = &confirmFormTestStep{}
+	t.now = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.handler = &formHandlerImpl{
+		formDataProvider:  service,
+		formDataDecoder:   service,
+		formDataValidator: service,
+		successSteps:      []domain.SuccessStep{t.step},
+		logger:            &flamingo.NullLogger{},
+		confirmation:      newConfirmation("confirmationToken", "1h", "secret", nil),
+		clock:             formtest.NewFakeClock(t.now),
+	}
+	t.session = web.EmptySession()
+}
+
+func (t *ConfirmFormTestSuite) request(values url.Values) *web.Request {
+	return web.CreateRequest(&http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{Path: "/order"},
+		Header: http.Header{"Content-Type": []string{"application/x-www-form-urlencoded"}},
+		Body:   http.NoBody,
+		Form:   values,
+	}, t.session)
+}
+
+func (t *ConfirmFormTestSuite) TestHandleConfirmForm_Review() {
+	form, err := t.handler.HandleConfirmForm(t.context, t.request(url.Values{"email": []string{"user@example.com"}}))
+	t.NoError(err)
+	t.True(form.DryRun)
+	t.True(form.IsAwaitingConfirmation())
+	t.False(form.IsConfirmed())
+	t.Equal("confirmationToken", form.FieldName)
+	t.Equal(confirmFormTestData{Email: "user@example.com"}, form.Data)
+	t.Empty(t.step.executed)
+}
+
+func (t *ConfirmFormTestSuite) TestHandleConfirmForm_ReviewInvalid() {
+	form, err := t.handler.HandleConfirmForm(t.context, t.request(url.Values{}))
+	t.NoError(err)
+	t.False(form.IsValid())
+	t.False(form.IsAwaitingConfirmation())
+	t.Empty(t.step.executed)
+}
+
+func (t *ConfirmFormTestSuite) TestHandleConfirmForm_Confirm() {
+	review, err := t.handler.HandleConfirmForm(t.context, t.request(url.Values{"email": []string{"user@example.com"}}))
+	t.NoError(err)
+	t.True(review.IsAwaitingConfirmation())
+	t.NotContains(review.Token, "user@example.com")
+
+	// values changed after review are ignored, as submission is executed with values of the snapshot
+	form, err := t.handler.HandleConfirmForm(t.context, t.request(url.Values{
+		"email":             []string{"changed@example.com"},
+		"confirmationToken": []string{review.Token},
+	}))
+	t.NoError(err)
+	t.False(form.DryRun)
+	t.True(form.IsConfirmed())
+	t.False(form.IsAwaitingConfirmation())
+	t.Equal(confirmFormTestData{Email: "user@example.com"}, form.Data)
+	t.Len(t.step.executed, 1)
+}
+
+func (t *ConfirmFormTestSuite) TestHandleConfirmForm_ConfirmTwice() {
+	review, err := t.handler.HandleConfirmForm(t.context, t.request(url.Values{"email": []string{"user@example.com"}}))
+	t.NoError(err)
+
+	form, err := t.handler.HandleConfirmForm(t.context, t.request(url.Values{"confirmationToken": []string{review.Token}}))
+	t.NoError(err)
+	t.True(form.IsConfirmed())
+
+	// snapshot is consumed by confirmation, so it can't be replayed
+	form, err = t.handler.HandleConfirmForm(t.context, t.request(url.Values{"confirmationToken": []string{review.Token}}))
+	t.NoError(err)
+	t.False(form.IsConfirmed())
+	t.Len(t.step.executed, 1)
+}
+
+func (t *ConfirmFormTestSuite) TestHandleConfirmForm_ConfirmOtherSession() {
+	review, err := t.handler.HandleConfirmForm(t.context, t.request(url.Values{"email": []string{"user@example.com"}}))
+	t.NoError(err)
+
+	t.session = web.EmptySession()
+
+	form, err := t.handler.HandleConfirmForm(t.context, t.request(url.Values{"confirmationToken": []string{review.Token}}))
+	t.NoError(err)
+	t.False(form.IsConfirmed())
+	t.Empty(t.step.executed)
+}
+
+func (t *ConfirmFormTestSuite) TestHandleConfirmForm_ConfirmExtensionValues() {
+	t.handler.formExtensions = map[string]domain.FormExtension{"csrf": &confirmFormTestExtension{}}
+
+	review, err := t.handler.HandleConfirmForm(t.context, t.request(url.Values{
+		"email":     []string{"user@example.com"},
+		"csrfToken": []string{"reviewed"},
+	}))
+	t.NoError(err)
+	t.True(review.IsAwaitingConfirmation())
+
+	// form extensions decode values of the current request, instead of stale values of the snapshot
+	form, err := t.handler.HandleConfirmForm(t.context, t.request(url.Values{
+		"csrfToken":         []string{"current"},
+		"confirmationToken": []string{review.Token},
+	}))
+	t.NoError(err)
+	t.True(form.IsConfirmed())
+	t.Equal(confirmFormTestData{Email: "user@example.com"}, form.Data)
+	t.Len(t.step.executed, 1)
+}
+
+func (t *ConfirmFormTestSuite) TestHandleConfirmForm_ConfirmInvalidToken() {
+	form, err := t.handler.HandleConfirmForm(t.context, t.request(url.Values{
+		"email":             []string{"user@example.com"},
+		"confirmationToken": []string{"forged"},
+	}))
+	t.NoError(err)
+	t.False(form.IsConfirmed())
+	t.Equal([]domain.Error{
+		{
+			MessageKey:   "formError.confirmation.invalid",
+			DefaultLabel: "Confirmation is expired, please review your input again",
+		},
+	}, form.ValidationInfo.GetGeneralErrors())
+	t.Empty(t.step.executed)
+}
+
+func (t *ConfirmFormTestSuite) TestHandleConfirmForm_NotConfigured() {
+	t.handler.confirmation = nil
+
+	form, err := t.handler.HandleConfirmForm(t.context, t.request(url.Values{}))
+	t.Error(err)
+	t.Nil(form)
+}
+
+func (t *ConfirmFormTestSuite) TestSealAndOpen() {
+	request := t.request(url.Values{})
+	token, err := t.handler.confirmation.seal(request, "order", url.Values{
+		"email":             []string{"user@example.com"},
+		"confirmationToken": []string{"previous"},
+	}, t.now)
+	t.NoError(err)
+	t.NotContains(token, "user@example.com")
+
+	_, ok := t.handler.confirmation.open(request, token, "order", t.now.Add(time.Hour+time.Second))
+	t.False(ok, "expired snapshot")
+
+	_, ok = t.handler.confirmation.open(request, token, "registration", t.now)
+	t.False(ok, "snapshot of another form")
+
+	_, ok = t.handler.confirmation.open(request, token[:len(token)-2]+"xx", "order", t.now)
+	t.False(ok, "forged snapshot")
+
+	_, ok = t.handler.confirmation.open(request, "", "order", t.now)
+	t.False(ok, "missing snapshot")
+
+	values, ok := t.handler.confirmation.open(request, token, "order", t.now.Add(time.Hour))
+	t.True(ok)
+	t.Equal(url.Values{"email": []string{"user@example.com"}}, values)
+
+	_, ok = t.handler.confirmation.open(request, token, "order", t.now.Add(time.Hour))
+	t.False(ok, "confirmed snapshot")
+}
+
+func (t *ConfirmFormTestSuite) TestPending() {
+	request := t.request(url.Values{})
+	t.session.Store(pendingConfirmationsKey, pendingConfirmations{
+		"current": t.now.Unix(),
+		"expired": t.now.Add(-time.Hour - time.Second).Unix(),
+	})
+
+	t.Equal(pendingConfirmations{"current": t.now.Unix()}, t.handler.confirmation.pending(request, t.now))
+}
+
+func (t *ConfirmFormTestSuite) TestNewConfirmation() {
+	configured := newConfirmation("confirmationToken", "1h", "secret", nil)
+	t.Equal("confirmationToken", configured.fieldName)
+	t.Equal(time.Hour, configured.maxAge)
+
+	// snapshots are valid for all confirmations with the same secret
+	request := t.request(url.Values{})
+	token, err := configured.seal(request, "order", url.Values{}, t.now)
+	t.NoError(err)
+	_, ok := newConfirmation("confirmationToken", "1h", "secret", nil).open(request, token, "order", t.now)
+	t.True(ok)
+
+	token, err = newConfirmation("confirmationToken", "1h", "", formtest.NewFakeTokenSource(1)).seal(request, "order", url.Values{}, t.now)
+	t.NoError(err)
+	_, ok = newConfirmation("confirmationToken", "1h", "", formtest.NewFakeTokenSource(2)).open(request, token, "order", t.now)
+	t.False(ok, "snapshot sealed with another random key")
+	_, ok = newConfirmation("confirmationToken", "1h", "", formtest.NewFakeTokenSource(1)).open(request, token, "order", t.now)
+	t.True(ok)
+
+	t.Panics(func() {
+		newConfirmation("confirmationToken", "hour", "secret", nil)
+	})
+}
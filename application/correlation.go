@@ -0,0 +1,25 @@
+package application
+
+import (
+	"context"
+
+	"go.opencensus.io/trace"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+// startHandling as method for preparing the request for handling of single form. It starts collecting
+// of degradations, and assigns correlation ID to the submission, which is added to the current trace span.
+func (h *formHandlerImpl) startHandling(ctx context.Context, req *web.Request) {
+	domain.StartDegradations(req)
+
+	correlationID := domain.StartCorrelation(req, h.tokenSource)
+	if correlationID == "" {
+		return
+	}
+
+	if span := trace.FromContext(ctx); span != nil {
+		span.AddAttributes(trace.StringAttribute("flamingo-form.correlationId", correlationID))
+	}
+}
@@ -0,0 +1,48 @@
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	CorrelationTestSuite struct {
+		suite.Suite
+
+		handler *formHandlerImpl
+
+		context context.Context
+		request *web.Request
+	}
+)
+
+func TestCorrelationTestSuite(t *testing.T) {
+	suite.Run(t, &CorrelationTestSuite{})
+}
+
+func (t *CorrelationTestSuite) SetupTest() {
+	t.handler = &formHandlerImpl{
+		logger: &flamingo.NullLogger{},
+	}
+
+	t.context = context.Background()
+	t.request = web.CreateRequest(&http.Request{Header: http.Header{
+		http.CanonicalHeaderKey(domain.CorrelationIDHeader): []string{"gateway-4711"},
+	}}, nil)
+}
+
+func (t *CorrelationTestSuite) TestStartHandling() {
+	domain.ReportDegradation(t.request, "formExtension.captcha", "captcha service unavailable")
+
+	t.handler.startHandling(t.context, t.request)
+
+	t.Equal("gateway-4711", domain.CorrelationIDFromRequest(t.request))
+	t.Nil(domain.DegradationsFromRequest(t.request))
+}
@@ -0,0 +1,71 @@
+package application
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+// enqueueSubmission as method for pushing final form data of valid submission to domain.SubmissionQueue.
+// It returns acknowledgment token of queued submission.
+func (h *formHandlerImpl) enqueueSubmission(ctx context.Context, req *web.Request, form *domain.Form) (string, error) {
+	if h.submissionQueue == nil {
+		return "", domain.NewFormError("there is no SubmissionQueue defined for deferred form handler")
+	}
+
+	token, err := h.generateSubmissionToken()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(form.Data)
+	if err != nil {
+		return "", err
+	}
+
+	submission := domain.QueuedSubmission{
+		Token:         token,
+		CorrelationID: form.CorrelationID,
+		Type:          formDataTypeName(form.Data),
+		Timestamp:     domain.CurrentTime(h.clock),
+		Data:          data,
+	}
+
+	if req != nil && req.Request().URL != nil {
+		submission.Path = req.Request().URL.Path
+	}
+
+	if err := h.submissionQueue.Enqueue(ctx, submission); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// generateSubmissionToken generates random acknowledgment token of queued submission from token source
+func (h *formHandlerImpl) generateSubmissionToken() (string, error) {
+	token := make([]byte, 16)
+	if err := domain.ReadToken(h.tokenSource, token); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(token), nil
+}
+
+// formDataTypeName returns package qualified name of form data type, with pointers dereferenced
+func formDataTypeName(formData interface{}) string {
+	typeOf := reflect.TypeOf(formData)
+	for typeOf != nil && typeOf.Kind() == reflect.Ptr {
+		typeOf = typeOf.Elem()
+	}
+
+	if typeOf == nil {
+		return ""
+	}
+
+	return typeOf.String()
+}
@@ -0,0 +1,112 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/formtest"
+)
+
+type (
+	DeferredFormTestSuite struct {
+		suite.Suite
+
+		handler *formHandlerImpl
+		queue   *deferredFormTestQueue
+
+		context context.Context
+		request *web.Request
+	}
+
+	deferredFormTestQueue struct {
+		submissions []domain.QueuedSubmission
+		err         error
+	}
+
+	deferredFormTestData struct {
+		Email string `json:"email"`
+	}
+)
+
+func (q *deferredFormTestQueue) Enqueue(_ context.Context, submission domain.QueuedSubmission) error {
+	q.submissions = append(q.submissions, submission)
+	return q.err
+}
+
+func TestDeferredFormTestSuite(t *testing.T) {
+	suite.Run(t, &DeferredFormTestSuite{})
+}
+
+func (t *DeferredFormTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *DeferredFormTestSuite) SetupTest() {
+	t.queue = &deferredFormTestQueue{}
+	t.handler = &formHandlerImpl{
+		logger:          &flamingo.NullLogger{},
+		submissionQueue: t.queue,
+		clock:           formtest.NewFakeClock(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)),
+		tokenSource:     formtest.NewFakeTokenSource(0xab),
+	}
+	t.request = web.CreateRequest(&http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{Path: "/register"},
+	}, nil)
+}
+
+func (t *DeferredFormTestSuite) TestEnqueueSubmission() {
+	form := domain.NewForm(true, nil)
+	form.Data = &deferredFormTestData{Email: "user@example.com"}
+	form.CorrelationID = "correlation"
+
+	token, err := t.handler.enqueueSubmission(t.context, t.request, &form)
+	t.NoError(err)
+	t.Equal("abababababababababababababababab", token)
+
+	t.Equal([]domain.QueuedSubmission{
+		{
+			Token:         token,
+			CorrelationID: "correlation",
+			Type:          "application.deferredFormTestData",
+			Timestamp:     time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+			Path:          "/register",
+			Data:          json.RawMessage(`{"email":"user@example.com"}`),
+		},
+	}, t.queue.submissions)
+}
+
+func (t *DeferredFormTestSuite) TestEnqueueSubmission_Error() {
+	t.queue.err = errors.New("error")
+
+	form := domain.NewForm(true, nil)
+	token, err := t.handler.enqueueSubmission(t.context, t.request, &form)
+	t.Equal(errors.New("error"), err)
+	t.Empty(token)
+}
+
+func (t *DeferredFormTestSuite) TestEnqueueSubmission_NoQueue() {
+	t.handler.submissionQueue = nil
+
+	form := domain.NewForm(true, nil)
+	token, err := t.handler.enqueueSubmission(t.context, t.request, &form)
+	t.Error(err)
+	t.Empty(token)
+}
+
+func (t *DeferredFormTestSuite) TestFormDataTypeName() {
+	t.Equal("", formDataTypeName(nil))
+	t.Equal("application.deferredFormTestData", formDataTypeName(deferredFormTestData{}))
+	t.Equal("application.deferredFormTestData", formDataTypeName(&deferredFormTestData{}))
+	t.Equal("map[string]string", formDataTypeName(map[string]string{}))
+}
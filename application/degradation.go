@@ -0,0 +1,41 @@
+package application
+
+import (
+	"context"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"flamingo.me/flamingo/v3/framework/opencensus"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+var (
+	// degradations counts optional subsystems skipped during form handling
+	degradations = stats.Int64("flamingo-form/degradations", "Count of optional subsystems skipped during form handling", stats.UnitDimensionless)
+	// degradationKey tag key with name of skipped subsystem
+	degradationKey = tag.MustNewKey("flamingo-form.degradation")
+)
+
+func init() {
+	if err := opencensus.View("flamingo-form/degradations", degradations, view.Count(), degradationKey); err != nil {
+		panic(err)
+	}
+}
+
+// collectDegradations as method for returning degradations reported during form handling.
+// Each degradation is logged and counted by metric, so monitoring can distinguish clean results from degraded ones.
+func (h *formHandlerImpl) collectDegradations(ctx context.Context, req *web.Request) []domain.Degradation {
+	collected := domain.DegradationsFromRequest(req)
+
+	for _, degradation := range collected {
+		h.getLogger(req, "degradation").WithField("degradation", degradation.Subsystem).Warn("degraded form handling: " + degradation.Reason)
+
+		metricCtx, _ := tag.New(ctx, tag.Upsert(degradationKey, degradation.Subsystem))
+		stats.Record(metricCtx, degradations.M(1))
+	}
+
+	return collected
+}
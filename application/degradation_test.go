@@ -0,0 +1,60 @@
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	DegradationTestSuite struct {
+		suite.Suite
+
+		handler *formHandlerImpl
+
+		context context.Context
+		request *web.Request
+	}
+)
+
+func TestDegradationTestSuite(t *testing.T) {
+	suite.Run(t, &DegradationTestSuite{})
+}
+
+func (t *DegradationTestSuite) SetupTest() {
+	t.handler = &formHandlerImpl{
+		logger: &flamingo.NullLogger{},
+	}
+
+	t.context = context.Background()
+	t.request = web.CreateRequest(&http.Request{}, nil)
+}
+
+func (t *DegradationTestSuite) TestCollectDegradations() {
+	domain.StartDegradations(t.request)
+	domain.ReportDegradation(t.request, "formExtension.captcha", "captcha verification skipped: timeout")
+	domain.ReportDegradation(t.request, "formEnrichment", "geo-coding failed")
+
+	t.Equal([]domain.Degradation{
+		{
+			Subsystem: "formExtension.captcha",
+			Reason:    "captcha verification skipped: timeout",
+		},
+		{
+			Subsystem: "formEnrichment",
+			Reason:    "geo-coding failed",
+		},
+	}, t.handler.collectDegradations(t.context, t.request))
+}
+
+func (t *DegradationTestSuite) TestCollectDegradations_Clean() {
+	domain.StartDegradations(t.request)
+
+	t.Nil(t.handler.collectDegradations(t.context, t.request))
+}
@@ -0,0 +1,81 @@
+package application
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// DependencyStatusImpl as health check of all form extensions and validators which depend on external services.
+	// It collects all injected form extensions, field validators, struct validators and submission queue which implement
+	// domain.DependencyStatus, and reports form as unavailable if any of them is unavailable.
+	DependencyStatusImpl struct {
+		dependencies map[string]domain.DependencyStatus
+	}
+)
+
+var _ domain.DependencyStatus = &DependencyStatusImpl{}
+
+// Inject is method used to set all dependencies as local variables
+func (s *DependencyStatusImpl) Inject(
+	formExtensions map[string]domain.FormExtension,
+	fieldValidators []domain.FieldValidator,
+	structValidators []domain.StructValidator,
+	submissionQueue domain.SubmissionQueue,
+) {
+	s.dependencies = map[string]domain.DependencyStatus{}
+
+	for name, formExtension := range formExtensions {
+		s.addDependency(name, formExtension)
+	}
+
+	for _, fieldValidator := range fieldValidators {
+		s.addDependency("fieldValidator."+fieldValidator.ValidatorName(), fieldValidator)
+	}
+
+	for _, structValidator := range structValidators {
+		s.addDependency("structValidator."+reflect.TypeOf(structValidator.StructType()).String(), structValidator)
+	}
+
+	s.addDependency("submissionQueue", submissionQueue)
+}
+
+// Status as method for checking availability of all form dependencies.
+// Details contain status of each dependency, ordered by their names.
+func (s *DependencyStatusImpl) Status() (bool, string) {
+	names := make([]string, 0, len(s.dependencies))
+	for name := range s.dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	alive := true
+	details := make([]string, 0, len(names))
+
+	for _, name := range names {
+		dependencyAlive, dependencyDetails := s.dependencies[name].Status()
+		if !dependencyAlive {
+			alive = false
+			if dependencyDetails == "" {
+				dependencyDetails = "unavailable"
+			}
+			details = append(details, fmt.Sprintf("%s: %s", name, dependencyDetails))
+			continue
+		}
+
+		details = append(details, fmt.Sprintf("%s: ok", name))
+	}
+
+	return alive, strings.Join(details, ", ")
+}
+
+// addDependency adds service as dependency, in case when it implements domain.DependencyStatus
+func (s *DependencyStatusImpl) addDependency(name string, service interface{}) {
+	if dependency, ok := service.(domain.DependencyStatus); ok {
+		s.dependencies[name] = dependency
+	}
+}
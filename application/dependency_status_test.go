@@ -0,0 +1,112 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	DependencyStatusImplTestSuite struct {
+		suite.Suite
+
+		status *DependencyStatusImpl
+
+		captchaExtension   *dependencyStatusTestExtension
+		addressExtension   *dependencyStatusTestExtension
+		otherExtension     *mocks.FormDataValidator
+		vatNumberValidator *dependencyStatusTestValidator
+		otherValidator     *mocks.FieldValidator
+		submissionQueue    *dependencyStatusTestQueue
+	}
+
+	dependencyStatusTestExtension struct {
+		mocks.FormDataValidator
+		mocks.DependencyStatus
+	}
+
+	dependencyStatusTestValidator struct {
+		mocks.FieldValidator
+		mocks.DependencyStatus
+	}
+
+	dependencyStatusTestQueue struct {
+		mocks.SubmissionQueue
+		mocks.DependencyStatus
+	}
+)
+
+func TestDependencyStatusImplTestSuite(t *testing.T) {
+	suite.Run(t, &DependencyStatusImplTestSuite{})
+}
+
+func (t *DependencyStatusImplTestSuite) SetupTest() {
+	t.captchaExtension = &dependencyStatusTestExtension{}
+	t.addressExtension = &dependencyStatusTestExtension{}
+	t.otherExtension = &mocks.FormDataValidator{}
+	t.vatNumberValidator = &dependencyStatusTestValidator{}
+	t.vatNumberValidator.FieldValidator.On("ValidatorName").Return("vatnumber")
+	t.otherValidator = &mocks.FieldValidator{}
+	t.otherValidator.On("ValidatorName").Return("other")
+	t.submissionQueue = &dependencyStatusTestQueue{}
+
+	t.status = &DependencyStatusImpl{}
+	t.status.Inject(map[string]domain.FormExtension{
+		"formExtension.captcha": t.captchaExtension,
+		"formExtension.address": t.addressExtension,
+		"formExtension.other":   t.otherExtension,
+	}, []domain.FieldValidator{
+		t.vatNumberValidator,
+		t.otherValidator,
+	}, nil, t.submissionQueue)
+}
+
+func (t *DependencyStatusImplTestSuite) TearDownTest() {
+	t.captchaExtension.DependencyStatus.AssertExpectations(t.T())
+	t.addressExtension.DependencyStatus.AssertExpectations(t.T())
+	t.vatNumberValidator.DependencyStatus.AssertExpectations(t.T())
+	t.submissionQueue.DependencyStatus.AssertExpectations(t.T())
+}
+
+func (t *DependencyStatusImplTestSuite) TestInject() {
+	t.Equal(map[string]domain.DependencyStatus{
+		"formExtension.captcha":    t.captchaExtension,
+		"formExtension.address":    t.addressExtension,
+		"fieldValidator.vatnumber": t.vatNumberValidator,
+		"submissionQueue":          t.submissionQueue,
+	}, t.status.dependencies)
+}
+
+func (t *DependencyStatusImplTestSuite) TestStatus_Alive() {
+	t.captchaExtension.DependencyStatus.On("Status").Return(true, "")
+	t.addressExtension.DependencyStatus.On("Status").Return(true, "")
+	t.vatNumberValidator.DependencyStatus.On("Status").Return(true, "")
+	t.submissionQueue.DependencyStatus.On("Status").Return(true, "")
+
+	alive, details := t.status.Status()
+	t.True(alive)
+	t.Equal("fieldValidator.vatnumber: ok, formExtension.address: ok, formExtension.captcha: ok, submissionQueue: ok", details)
+}
+
+func (t *DependencyStatusImplTestSuite) TestStatus_Unavailable() {
+	t.captchaExtension.DependencyStatus.On("Status").Return(false, "timeout")
+	t.addressExtension.DependencyStatus.On("Status").Return(true, "")
+	t.vatNumberValidator.DependencyStatus.On("Status").Return(false, "")
+	t.submissionQueue.DependencyStatus.On("Status").Return(false, "amqp: connection refused")
+
+	alive, details := t.status.Status()
+	t.False(alive)
+	t.Equal("fieldValidator.vatnumber: unavailable, formExtension.address: ok, formExtension.captcha: timeout, submissionQueue: amqp: connection refused", details)
+}
+
+func (t *DependencyStatusImplTestSuite) TestStatus_Empty() {
+	status := &DependencyStatusImpl{}
+	status.Inject(nil, nil, nil, nil)
+
+	alive, details := status.Status()
+	t.True(alive)
+	t.Equal("", details)
+}
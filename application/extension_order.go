@@ -0,0 +1,72 @@
+package application
+
+import (
+	"context"
+	"sort"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+// orderExtensions returns names of form extensions in processing order: by descending priority of form extensions,
+// which implement domain.PrioritizedFormExtension, and by names for form extensions with the same priority
+func orderExtensions(formExtensions map[string]domain.FormExtension) []string {
+	names := make([]string, 0, len(formExtensions))
+	priorities := make(map[string]int, len(formExtensions))
+	for name, formExtension := range formExtensions {
+		names = append(names, name)
+		priorities[name] = extensionPriority(formExtension)
+	}
+
+	sort.SliceStable(names, func(i, j int) bool {
+		if priorities[names[i]] != priorities[names[j]] {
+			return priorities[names[i]] > priorities[names[j]]
+		}
+
+		return names[i] < names[j]
+	})
+
+	return names
+}
+
+// extensionPriority returns priority of form extension, or 0 if it doesn't implement domain.PrioritizedFormExtension
+func extensionPriority(formExtension interface{}) int {
+	if prioritized, ok := formExtension.(domain.PrioritizedFormExtension); ok {
+		return prioritized.Priority()
+	}
+
+	return 0
+}
+
+// extensionNames returns names of form extensions in processing order, which is compiled when handler is built,
+// or compiled on every usage for handlers without compiled order
+func (h *formHandlerImpl) extensionNames() []string {
+	if h.extensionOrder != nil {
+		return h.extensionOrder
+	}
+
+	return orderExtensions(h.formExtensions)
+}
+
+// enabledExtensionNames returns names of form extensions in processing order, which are processed for the request.
+// Form extensions skipped for the request, or disabled by feature flags and not enabled for the request, are left out.
+func (h *formHandlerImpl) enabledExtensionNames(ctx context.Context, req *web.Request) []string {
+	disabled := h.featureToggles.disabled(ctx, req)
+	names := h.extensionNames()
+
+	enabled := make([]string, 0, len(names))
+	for _, name := range names {
+		if isEnabled, ok := domain.FormExtensionOverride(req, name); ok {
+			if isEnabled {
+				enabled = append(enabled, name)
+			}
+			continue
+		}
+
+		if !disabled.isExtension(name) {
+			enabled = append(enabled, name)
+		}
+	}
+
+	return enabled
+}
@@ -0,0 +1,90 @@
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	ExtensionOrderTestSuite struct {
+		suite.Suite
+	}
+
+	extensionOrderTestExtension struct {
+		priority int
+	}
+)
+
+var _ domain.PrioritizedFormExtension = &extensionOrderTestExtension{}
+
+func TestExtensionOrderTestSuite(t *testing.T) {
+	suite.Run(t, &ExtensionOrderTestSuite{})
+}
+
+func (e *extensionOrderTestExtension) Priority() int {
+	return e.priority
+}
+
+func (t *ExtensionOrderTestSuite) TestOrderExtensions() {
+	t.Equal([]string{"captcha", "csrf", "honeypot", "newsletter", "rateLimiter"}, orderExtensions(map[string]domain.FormExtension{
+		"rateLimiter": &extensionOrderTestExtension{priority: -10},
+		"newsletter":  &mocks.FormDataProvider{},
+		"captcha":     &extensionOrderTestExtension{priority: 100},
+		"honeypot":    &extensionOrderTestExtension{priority: 0},
+		"csrf":        &extensionOrderTestExtension{priority: 100},
+	}))
+}
+
+func (t *ExtensionOrderTestSuite) TestOrderExtensions_Empty() {
+	t.Empty(orderExtensions(nil))
+}
+
+func (t *ExtensionOrderTestSuite) TestExtensionNames() {
+	handler := &formHandlerImpl{
+		formExtensions: map[string]domain.FormExtension{
+			"rateLimiter": &extensionOrderTestExtension{priority: -10},
+			"captcha":     &extensionOrderTestExtension{priority: 100},
+		},
+	}
+	t.Equal([]string{"captcha", "rateLimiter"}, handler.extensionNames())
+
+	handler.extensionOrder = []string{"rateLimiter"}
+	t.Equal([]string{"rateLimiter"}, handler.extensionNames())
+}
+
+func (t *ExtensionOrderTestSuite) TestEnabledExtensionNames() {
+	ctx := context.Background()
+	req := web.CreateRequest(&http.Request{}, nil)
+
+	featureFlagProvider := &mocks.FeatureFlagProvider{}
+	featureFlagProvider.On("IsEnabled", ctx, req, "newsletter").Return(false).Once()
+
+	handler := &formHandlerImpl{
+		formExtensions: map[string]domain.FormExtension{
+			"captcha":    &extensionOrderTestExtension{priority: 100},
+			"csrf":       &extensionOrderTestExtension{},
+			"newsletter": &extensionOrderTestExtension{},
+			"survey":     &extensionOrderTestExtension{},
+		},
+		featureToggles: newFeatureToggles(featureFlagProvider, []domain.FeatureToggle{
+			{
+				Feature:    "newsletter",
+				Extensions: []string{"newsletter", "survey"},
+			},
+		}),
+	}
+
+	domain.SkipFormExtensions(req, "captcha")
+	domain.EnableFormExtensions(req, "survey", "unknown")
+
+	t.Equal([]string{"csrf", "survey"}, handler.enabledExtensionNames(ctx, req))
+
+	featureFlagProvider.AssertExpectations(t.T())
+}
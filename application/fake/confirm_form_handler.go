@@ -0,0 +1,48 @@
+package fake
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	// confirmFormHandlerImpl defines faked implementation of domain.ConfirmFormHandler interface used for unit testing
+	confirmFormHandlerImpl struct {
+		*mocks.FormHandler
+	}
+)
+
+const (
+	// ConfirmFieldName defines name of the hidden form field used by faked domain.ConfirmFormHandler
+	ConfirmFieldName = "confirmationToken"
+	// ConfirmToken defines snapshot token returned by faked domain.ConfirmFormHandler for valid reviewed submissions
+	ConfirmToken = "fake-confirmation"
+)
+
+var _ domain.ConfirmFormHandler = &confirmFormHandlerImpl{}
+
+// HandleConfirmForm returns result of mocked HandleForm method. Submission with ConfirmToken is handled
+// as confirmation step, and other valid submissions contain ConfirmToken.
+func (h *confirmFormHandlerImpl) HandleConfirmForm(ctx context.Context, req *web.Request) (*domain.ConfirmForm, error) {
+	form, err := h.HandleForm(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	confirm := &domain.ConfirmForm{
+		Form:      *form,
+		FieldName: ConfirmFieldName,
+	}
+
+	switch {
+	case req.Request().FormValue(ConfirmFieldName) == ConfirmToken:
+		confirm.Confirmed = form.IsSubmitted()
+	case form.IsValidAndSubmitted():
+		confirm.Token = ConfirmToken
+	}
+
+	return confirm, nil
+}
@@ -0,0 +1,39 @@
+package fake
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	// deferredFormHandlerImpl defines faked implementation of domain.DeferredFormHandler interface used for unit testing
+	deferredFormHandlerImpl struct {
+		*mocks.FormHandler
+	}
+)
+
+// DeferredToken defines acknowledgment token returned by faked domain.DeferredFormHandler for valid submissions
+const DeferredToken = "fake-token"
+
+var _ domain.DeferredFormHandler = &deferredFormHandlerImpl{}
+
+// HandleDeferredForm returns result of mocked HandleForm method, with DeferredToken if form is valid and submitted
+func (h *deferredFormHandlerImpl) HandleDeferredForm(ctx context.Context, req *web.Request) (*domain.DeferredForm, error) {
+	form, err := h.HandleForm(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	deferred := &domain.DeferredForm{
+		Form: *form,
+	}
+
+	if form.IsValidAndSubmitted() {
+		deferred.Token = DeferredToken
+	}
+
+	return deferred, nil
+}
@@ -60,11 +60,96 @@ func (b *formHandlerBuilderImpl) AddNamedFormExtension(name string) error {
 	return nil
 }
 
+// AddFormDataValidator fakes storing of form data validator into mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) AddFormDataValidator(formDataValidator domain.FormDataValidator) application.FormHandlerBuilder {
+	return b
+}
+
 // AddFormExtension fakes storing of form extension into mocked instance of domain.FormHandler.
 func (b *formHandlerBuilderImpl) AddFormExtension(formExtension domain.FormExtension) error {
 	return nil
 }
 
+// AddSubForm fakes storing of sub form into mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) AddSubForm(prefix string, subForm domain.SubForm) error {
+	return nil
+}
+
+// AddNamedSubForm fakes storing of named sub form into mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) AddNamedSubForm(prefix string, name string) error {
+	return nil
+}
+
+// AddFormDataEnricher fakes storing of form data enricher into mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) AddFormDataEnricher(formDataEnricher domain.FormDataEnricher) application.FormHandlerBuilder {
+	return b
+}
+
+// AddSuccessStep fakes storing of success step into mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) AddSuccessStep(successStep domain.SuccessStep) application.FormHandlerBuilder {
+	return b
+}
+
+// AddFeatureToggle fakes storing of feature toggle into mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) AddFeatureToggle(toggle domain.FeatureToggle) application.FormHandlerBuilder {
+	return b
+}
+
+// SetDebugMode fakes storing of debug mode into mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) SetDebugMode(debug bool) application.FormHandlerBuilder {
+	return b
+}
+
+// SetFormDataType fakes storing of form data type into mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) SetFormDataType(formData interface{}) application.FormHandlerBuilder {
+	return b
+}
+
+// SetReportOnlyRules fakes storing of report-only validation rules into mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) SetReportOnlyRules(rules ...string) application.FormHandlerBuilder {
+	return b
+}
+
+// SetReportOnlyExtensions fakes storing of report-only form extensions into mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) SetReportOnlyExtensions(names ...string) application.FormHandlerBuilder {
+	return b
+}
+
+// SetRuleProfile fakes storing of default rule profile into mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) SetRuleProfile(name string) error {
+	return nil
+}
+
+// SetValidationGroup fakes storing of validation group into mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) SetValidationGroup(group string) application.FormHandlerBuilder {
+	return b
+}
+
+// SetSubmissionQueue fakes storing of submission queue into mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) SetSubmissionQueue(submissionQueue domain.SubmissionQueue) application.FormHandlerBuilder {
+	return b
+}
+
+// SetPostRedirectGet fakes storing of Post/Redirect/Get session key into mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) SetPostRedirectGet(sessionKey string) application.FormHandlerBuilder {
+	return b
+}
+
+// SetSubmissionMethods fakes storing of submission methods into mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) SetSubmissionMethods(methods ...string) application.FormHandlerBuilder {
+	return b
+}
+
+// SetStreamedUploads fakes storing of streaming of uploaded files into mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) SetStreamedUploads(streamed bool) application.FormHandlerBuilder {
+	return b
+}
+
+// SetRequestBodyLimits fakes storing of request body limits into mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) SetRequestBodyLimits(int64, int64) application.FormHandlerBuilder {
+	return b
+}
+
 // Must fakes storing wrapping of methods that can returns error message.
 func (b *formHandlerBuilderImpl) Must(error) application.FormHandlerBuilder {
 	return b
@@ -74,3 +159,24 @@ func (b *formHandlerBuilderImpl) Must(error) application.FormHandlerBuilder {
 func (b *formHandlerBuilderImpl) Build() domain.FormHandler {
 	return b.formHandler
 }
+
+// BuildSearchFormHandler returns faked instance of domain.SearchFormHandler, which delegates to mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) BuildSearchFormHandler() domain.SearchFormHandler {
+	return &searchFormHandlerImpl{
+		FormHandler: b.formHandler,
+	}
+}
+
+// BuildDeferredFormHandler returns faked instance of domain.DeferredFormHandler, which delegates to mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) BuildDeferredFormHandler() domain.DeferredFormHandler {
+	return &deferredFormHandlerImpl{
+		FormHandler: b.formHandler,
+	}
+}
+
+// BuildConfirmFormHandler returns faked instance of domain.ConfirmFormHandler, which delegates to mocked instance of domain.FormHandler.
+func (b *formHandlerBuilderImpl) BuildConfirmFormHandler() domain.ConfirmFormHandler {
+	return &confirmFormHandlerImpl{
+		FormHandler: b.formHandler,
+	}
+}
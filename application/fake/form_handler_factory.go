@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"flamingo.me/form/application"
 	"flamingo.me/form/domain"
 	"flamingo.me/form/domain/mocks"
@@ -41,3 +43,8 @@ func (f *FormHandlerFactoryImpl) GetFormHandlerBuilder() application.FormHandler
 		formHandler: f.formHandler,
 	}
 }
+
+// Warmup does nothing, since mocked instance of domain.FormHandler doesn't need any preparation
+func (f *FormHandlerFactoryImpl) Warmup(context.Context) error {
+	return nil
+}
@@ -0,0 +1,31 @@
+package fake
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	// searchFormHandlerImpl defines faked implementation of domain.SearchFormHandler interface used for unit testing
+	searchFormHandlerImpl struct {
+		*mocks.FormHandler
+	}
+)
+
+var _ domain.SearchFormHandler = &searchFormHandlerImpl{}
+
+// HandleSearchForm returns result of mocked HandleSubmittedGETForm method, with query of the request as canonical query
+func (h *searchFormHandlerImpl) HandleSearchForm(ctx context.Context, req *web.Request) (*domain.SearchForm, error) {
+	form, err := h.HandleSubmittedGETForm(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.SearchForm{
+		Form:            *form,
+		CanonicalValues: req.Request().URL.Query(),
+	}, nil
+}
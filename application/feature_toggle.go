@@ -0,0 +1,193 @@
+package application
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// featureToggles as definition of form parts which are enabled or disabled per request by feature flags
+	featureToggles struct {
+		provider domain.FeatureFlagProvider
+		toggles  []domain.FeatureToggle
+	}
+
+	// disabledFeatures as form parts disabled for single request
+	disabledFeatures struct {
+		fields     []string
+		rules      map[string]bool
+		extensions map[string]bool
+	}
+
+	// disabledFeaturesKey as key of request values, under which disabled form parts are stored,
+	// so feature flags are consulted only once per request and handler
+	disabledFeaturesKey struct {
+		toggles *featureToggles
+	}
+)
+
+// newFeatureToggles creates definition of feature toggles, if there is any toggle and feature flag provider
+func newFeatureToggles(provider domain.FeatureFlagProvider, toggles []domain.FeatureToggle) *featureToggles {
+	if provider == nil || len(toggles) == 0 {
+		return nil
+	}
+
+	return &featureToggles{
+		provider: provider,
+		toggles:  toggles,
+	}
+}
+
+// disabled returns form parts disabled for request, or nil if all parts are enabled
+func (t *featureToggles) disabled(ctx context.Context, req *web.Request) *disabledFeatures {
+	if t == nil {
+		return nil
+	}
+
+	if req == nil {
+		return t.evaluate(ctx, req)
+	}
+
+	key := disabledFeaturesKey{toggles: t}
+	if disabled, ok := req.Values.Load(key); ok {
+		return disabled.(*disabledFeatures)
+	}
+
+	disabled := t.evaluate(ctx, req)
+	req.Values.Store(key, disabled)
+
+	return disabled
+}
+
+// evaluate consults feature flag provider for all toggles
+func (t *featureToggles) evaluate(ctx context.Context, req *web.Request) *disabledFeatures {
+	var disabled *disabledFeatures
+
+	for _, toggle := range t.toggles {
+		if t.provider.IsEnabled(ctx, req, toggle.Feature) {
+			continue
+		}
+
+		if disabled == nil {
+			disabled = &disabledFeatures{
+				rules:      map[string]bool{},
+				extensions: map[string]bool{},
+			}
+		}
+
+		disabled.fields = append(disabled.fields, toggle.Fields...)
+		for _, rule := range toggle.Rules {
+			disabled.rules[rule] = true
+		}
+		for _, extension := range toggle.Extensions {
+			disabled.extensions[extension] = true
+		}
+	}
+
+	return disabled
+}
+
+// isField checks if field, or any of its parent fields, is disabled
+func (d *disabledFeatures) isField(name string) bool {
+	if d == nil {
+		return false
+	}
+
+	for _, field := range d.fields {
+		if name == field || strings.HasPrefix(name, field+".") || strings.HasPrefix(name, field+"[") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isRule checks if validation rule is disabled
+func (d *disabledFeatures) isRule(name string) bool {
+	return d != nil && d.rules[name]
+}
+
+// isExtension checks if form extension is disabled
+func (d *disabledFeatures) isExtension(name string) bool {
+	return d != nil && d.extensions[name]
+}
+
+// filterValidationRules returns copy of validation rules without rules of disabled fields and disabled rules
+func (d *disabledFeatures) filterValidationRules(validationRules map[string][]domain.ValidationRule) map[string][]domain.ValidationRule {
+	if d == nil {
+		return validationRules
+	}
+
+	filtered := make(map[string][]domain.ValidationRule, len(validationRules))
+	for fieldName, rules := range validationRules {
+		if d.isField(fieldName) {
+			continue
+		}
+
+		var fieldRules []domain.ValidationRule
+		for _, rule := range rules {
+			if !d.isRule(rule.Name) {
+				fieldRules = append(fieldRules, rule)
+			}
+		}
+
+		if len(fieldRules) > 0 {
+			filtered[fieldName] = fieldRules
+		}
+	}
+
+	return filtered
+}
+
+// filterValues returns copy of submitted values without values of disabled fields.
+// Values are not copied if there is no disabled field.
+func (d *disabledFeatures) filterValues(values url.Values) url.Values {
+	if d == nil || len(d.fields) == 0 {
+		return values
+	}
+
+	filtered := make(url.Values, len(values))
+	for name, value := range values {
+		if !d.isField(name) {
+			filtered[name] = value
+		}
+	}
+
+	return filtered
+}
+
+// filterValidationInfo returns validation info without errors of disabled fields and disabled rules
+func (d *disabledFeatures) filterValidationInfo(validationInfo *domain.ValidationInfo) *domain.ValidationInfo {
+	if d == nil || validationInfo == nil || validationInfo.IsValid() {
+		return validationInfo
+	}
+
+	result := &domain.ValidationInfo{}
+
+	var generalErrors []domain.Error
+	for _, err := range validationInfo.GetGeneralErrors() {
+		if !d.isRule(errorRule(err)) {
+			generalErrors = append(generalErrors, err)
+		}
+	}
+	result.AppendGeneralErrors(generalErrors)
+
+	fieldErrors := map[string][]domain.Error{}
+	for fieldName, errs := range validationInfo.GetErrorsForAllFields() {
+		if d.isField(fieldName) {
+			continue
+		}
+		for _, err := range errs {
+			if !d.isRule(errorRule(err)) {
+				fieldErrors[fieldName] = append(fieldErrors[fieldName], err)
+			}
+		}
+	}
+	result.AppendFieldErrors(fieldErrors)
+
+	return result
+}
@@ -0,0 +1,163 @@
+package application
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	FeatureTogglesTestSuite struct {
+		suite.Suite
+
+		provider *mocks.FeatureFlagProvider
+		toggles  *featureToggles
+
+		context context.Context
+		request *web.Request
+	}
+)
+
+func TestFeatureTogglesTestSuite(t *testing.T) {
+	suite.Run(t, &FeatureTogglesTestSuite{})
+}
+
+func (t *FeatureTogglesTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *FeatureTogglesTestSuite) SetupTest() {
+	t.provider = &mocks.FeatureFlagProvider{}
+	t.toggles = newFeatureToggles(t.provider, []domain.FeatureToggle{
+		{
+			Feature:    "address",
+			Fields:     []string{"address"},
+			Extensions: []string{"formExtension.lockout"},
+		},
+		{
+			Feature: "strictAge",
+			Rules:   []string{"maximumage"},
+		},
+	})
+
+	t.request = web.CreateRequest(&http.Request{}, nil)
+}
+
+func (t *FeatureTogglesTestSuite) TearDownTest() {
+	t.provider.AssertExpectations(t.T())
+}
+
+func (t *FeatureTogglesTestSuite) TestNewFeatureToggles() {
+	t.Nil(newFeatureToggles(nil, []domain.FeatureToggle{{Feature: "address"}}))
+	t.Nil(newFeatureToggles(t.provider, nil))
+
+	var empty *featureToggles
+	t.Nil(empty.disabled(t.context, t.request))
+}
+
+func (t *FeatureTogglesTestSuite) TestDisabled_AllEnabled() {
+	t.provider.On("IsEnabled", t.context, t.request, "address").Return(true).Once()
+	t.provider.On("IsEnabled", t.context, t.request, "strictAge").Return(true).Once()
+
+	t.Nil(t.toggles.disabled(t.context, t.request))
+	// feature flags are consulted only once per request
+	t.Nil(t.toggles.disabled(t.context, t.request))
+}
+
+func (t *FeatureTogglesTestSuite) TestDisabled() {
+	t.provider.On("IsEnabled", t.context, t.request, "address").Return(false).Once()
+	t.provider.On("IsEnabled", t.context, t.request, "strictAge").Return(true).Once()
+
+	disabled := t.toggles.disabled(t.context, t.request)
+	t.Equal(&disabledFeatures{
+		fields:     []string{"address"},
+		rules:      map[string]bool{},
+		extensions: map[string]bool{"formExtension.lockout": true},
+	}, disabled)
+	t.Exactly(disabled, t.toggles.disabled(t.context, t.request))
+
+	t.True(disabled.isField("address"))
+	t.True(disabled.isField("address.street"))
+	t.True(disabled.isField("address[0]"))
+	t.False(disabled.isField("addressee"))
+	t.True(disabled.isExtension("formExtension.lockout"))
+	t.False(disabled.isRule("maximumage"))
+}
+
+func (t *FeatureTogglesTestSuite) TestFilterValidationRules() {
+	disabled := &disabledFeatures{
+		fields: []string{"address"},
+		rules:  map[string]bool{"maximumage": true},
+	}
+
+	validationRules := map[string][]domain.ValidationRule{
+		"address.street": {{Name: "required"}},
+		"birthday":       {{Name: "required"}, {Name: "maximumage", Value: "150"}},
+		"age":            {{Name: "maximumage", Value: "150"}},
+	}
+
+	t.Equal(map[string][]domain.ValidationRule{
+		"birthday": {{Name: "required"}},
+	}, disabled.filterValidationRules(validationRules))
+	t.Len(validationRules, 3)
+	t.Len(validationRules["birthday"], 2)
+
+	var empty *disabledFeatures
+	t.Equal(validationRules, empty.filterValidationRules(validationRules))
+}
+
+func (t *FeatureTogglesTestSuite) TestFilterValues() {
+	disabled := &disabledFeatures{
+		fields: []string{"address"},
+	}
+
+	values := url.Values{
+		"address.street": {"Main Street"},
+		"name":           {"Name"},
+	}
+
+	t.Equal(url.Values{
+		"name": {"Name"},
+	}, disabled.filterValues(values))
+	t.Len(values, 2)
+
+	var empty *disabledFeatures
+	t.Equal(values, empty.filterValues(values))
+}
+
+func (t *FeatureTogglesTestSuite) TestFilterValidationInfo() {
+	disabled := &disabledFeatures{
+		fields: []string{"address"},
+		rules:  map[string]bool{"maximumage": true},
+	}
+
+	validationInfo := &domain.ValidationInfo{}
+	validationInfo.AddGeneralError("formError.maximumage", "maximumage")
+	validationInfo.AddGeneralError("formError.general", "general")
+	validationInfo.AddFieldError("address.street", "formError.address.street.required", "required")
+	validationInfo.AddFieldError("birthday", "formError.birthday.maximumage", "maximumage")
+	validationInfo.AddFieldError("birthday", "formError.birthday.required", "required")
+
+	result := disabled.filterValidationInfo(validationInfo)
+	t.Equal([]domain.Error{
+		{
+			MessageKey:   "formError.general",
+			DefaultLabel: "general",
+		},
+	}, result.GetGeneralErrors())
+	t.Equal(map[string][]domain.Error{
+		"birthday": {
+			{
+				MessageKey:   "formError.birthday.required",
+				DefaultLabel: "required",
+			},
+		},
+	}, result.GetErrorsForAllFields())
+}
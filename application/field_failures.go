@@ -0,0 +1,53 @@
+package application
+
+import (
+	"context"
+	"regexp"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"flamingo.me/flamingo/v3/framework/opencensus"
+	"flamingo.me/form/domain"
+)
+
+var (
+	// fieldFailures counts field errors of submitted forms
+	fieldFailures = stats.Int64("flamingo-form/field_failures", "Count of validation failures of form fields", stats.UnitDimensionless)
+	// fieldFailureFormKey tag key with package qualified name of form data type
+	fieldFailureFormKey = tag.MustNewKey("flamingo-form.form")
+	// fieldFailureFieldKey tag key with name of failed field, without indexes of slices
+	fieldFailureFieldKey = tag.MustNewKey("flamingo-form.field")
+	// fieldFailureRuleKey tag key with name of failed validation rule
+	fieldFailureRuleKey = tag.MustNewKey("flamingo-form.rule")
+
+	// fieldIndexRegex defines indexes of slice fields and keys of map fields (like "[2]" in "items[2].name",
+	// or "[de]" in "labels[de]"), so metric doesn't get tag value per submitted index or key
+	fieldIndexRegex = regexp.MustCompile(`\[[^\]]*\]`)
+)
+
+func init() {
+	if err := opencensus.View("flamingo-form/field_failures", fieldFailures, view.Count(), fieldFailureFormKey, fieldFailureFieldKey, fieldFailureRuleKey); err != nil {
+		panic(err)
+	}
+}
+
+// recordFieldFailures counts field errors of submitted form by metric, tagged by form, field and validation rule,
+// so dashboards can show which fields users struggle with most. Indexes of slice fields and keys of map fields
+// are stripped (like "items[].name"), so every element of the slice or map is counted as the same field.
+func recordFieldFailures(ctx context.Context, form *domain.Form) {
+	formName := formDataTypeName(form.Data)
+
+	for fieldName, errs := range form.ValidationInfo.GetErrorsForAllFields() {
+		field := fieldIndexRegex.ReplaceAllString(fieldName, "[]")
+		for _, err := range errs {
+			metricCtx, _ := tag.New(ctx,
+				tag.Upsert(fieldFailureFormKey, formName),
+				tag.Upsert(fieldFailureFieldKey, field),
+				tag.Upsert(fieldFailureRuleKey, errorRule(err)),
+			)
+			stats.Record(metricCtx, fieldFailures.M(1))
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	FieldFailuresTestSuite struct {
+		suite.Suite
+	}
+
+	fieldFailuresTestData struct {
+		Email string
+	}
+)
+
+func TestFieldFailuresTestSuite(t *testing.T) {
+	suite.Run(t, &FieldFailuresTestSuite{})
+}
+
+func (t *FieldFailuresTestSuite) TestRecordFieldFailures() {
+	form := domain.NewForm(true, nil)
+	form.Data = &fieldFailuresTestData{}
+	form.ValidationInfo.AddFieldError("email", "formError.email.required", "email is required")
+	form.ValidationInfo.AddFieldError("items[0].name", "formError.items[0].name.required", "name is required")
+	form.ValidationInfo.AddGeneralError("formError.general", "general")
+
+	t.NotPanics(func() {
+		recordFieldFailures(context.Background(), &form)
+	})
+}
+
+func (t *FieldFailuresTestSuite) TestFieldIndexRegex() {
+	t.Equal("items[].tags[].name", fieldIndexRegex.ReplaceAllString("items[0].tags[12].name", "[]"))
+	t.Equal("address.street", fieldIndexRegex.ReplaceAllString("address.street", "[]"))
+	t.Equal("labels[].text", fieldIndexRegex.ReplaceAllString("labels[de].text", "[]"))
+	t.Equal("attributes[][]", fieldIndexRegex.ReplaceAllString("attributes[color-1][ ]", "[]"))
+	t.Equal("items[]", fieldIndexRegex.ReplaceAllString("items[]", "[]"))
+}
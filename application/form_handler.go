@@ -2,15 +2,18 @@ package application
 
 import (
 	"context"
-	"fmt"
+	"html/template"
 	"net/http"
 	"net/url"
 	"reflect"
-	"strings"
+	"time"
 
 	"flamingo.me/flamingo/v3/framework/flamingo"
 	"flamingo.me/flamingo/v3/framework/web"
 	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/card"
+	"flamingo.me/form/domain/formdata"
+	"flamingo.me/form/domain/markdown"
 )
 
 type (
@@ -19,20 +22,52 @@ type (
 		formDataProvider         domain.FormDataProvider
 		formDataDecoder          domain.FormDataDecoder
 		formDataValidator        domain.FormDataValidator
+		formDataValidators       []domain.FormDataValidator
 		defaultFormDataProvider  domain.DefaultFormDataProvider
 		defaultFormDataDecoder   domain.DefaultFormDataDecoder
 		defaultFormDataValidator domain.DefaultFormDataValidator
 		formExtensions           map[string]domain.FormExtension
+		extensionOrder           []string
+		formDataEnrichers        []domain.FormDataEnricher
+		successSteps             []domain.SuccessStep
+		submissionQueue          domain.SubmissionQueue
 		validatorProvider        domain.ValidatorProvider
+		fieldEncryptor           domain.FieldEncryptor
+		cardTokenizer            card.Tokenizer
+		markdownRenderer         markdown.Renderer
 		logger                   flamingo.Logger
+		debug                    bool
+		bindingPlan              *bindingPlan
+		logPolicy                *logPolicy
+		reportOnly               *reportOnly
+		featureToggles           *featureToggles
+		ruleProfiles             ruleProfiles
+		ruleProfile              string
+		validationGroup          string
+		postRedirectGetKey       string
+		submissionMethods        []string
+		streamedUploads          bool
+		requestBodyDecoders      map[string]domain.RequestBodyDecoder
+		maxBodySize              int64
+		multipartMemory          int64
+		clock                    domain.Clock
+		tokenSource              domain.TokenSource
+		confirmation             *confirmation
 	}
 )
 
-var _ domain.FormHandler = &formHandlerImpl{}
+var (
+	_ domain.FormHandler         = &formHandlerImpl{}
+	_ domain.SearchFormHandler   = &formHandlerImpl{}
+	_ domain.DeferredFormHandler = &formHandlerImpl{}
+	_ domain.ConfirmFormHandler  = &formHandlerImpl{}
+)
 
-// HandleForm as method for returning Form instance with state depending on fact if there was form submission or not, via POST request
+// HandleForm as method for returning Form instance with state depending on fact if there was form submission or not,
+// via POST request or request with another submission method of the handler
 func (h *formHandlerImpl) HandleForm(ctx context.Context, req *web.Request) (*domain.Form, error) {
-	submitted := req.Request().Method == http.MethodPost
+	h.startHandling(ctx, req)
+	submitted := h.isSubmission(req)
 
 	form, err := h.buildForm(ctx, req, submitted)
 	if err != nil {
@@ -40,14 +75,28 @@ func (h *formHandlerImpl) HandleForm(ctx context.Context, req *web.Request) (*do
 	}
 
 	if submitted {
-		return h.handleSubmittedForm(ctx, req, form, http.MethodPost)
+		return h.handleSubmittedForm(ctx, req, form, h.submissionMethod(req))
+	}
+
+	form, err = h.restoreSubmission(ctx, req, form)
+	if err != nil {
+		h.logError(req, "postRedirectGet", err)
+		return nil, domain.NewFormErrorWithParent(err)
 	}
+	form.Degradations = h.collectDegradations(ctx, req)
+	form.CacheHint = h.cacheHint(ctx, req, form)
 
 	return form, nil
 }
 
+// HandleFormResult as method for returning FormResult with explicit state, otherwise it behaves like HandleForm
+func (h *formHandlerImpl) HandleFormResult(ctx context.Context, req *web.Request) domain.FormResult {
+	return domain.NewFormResult(h.HandleForm(ctx, req))
+}
+
 // HandleUnsubmittedForm as method for returning Form instance which is not submitted
 func (h *formHandlerImpl) HandleUnsubmittedForm(ctx context.Context, req *web.Request) (*domain.Form, error) {
+	h.startHandling(ctx, req)
 	form, err := h.buildForm(ctx, req, false)
 	if err != nil {
 		return nil, err
@@ -55,25 +104,49 @@ func (h *formHandlerImpl) HandleUnsubmittedForm(ctx context.Context, req *web.Re
 
 	err = h.processExtensions(ctx, req, url.Values{}, form)
 	if err != nil {
-		h.getLogger("formExtensions").Error(err.Error())
+		h.logError(req, "formExtensions", err)
 		return nil, domain.NewFormErrorWithParent(err)
 	}
 
+	form, err = h.restoreSubmission(ctx, req, form)
+	if err != nil {
+		h.logError(req, "postRedirectGet", err)
+		return nil, domain.NewFormErrorWithParent(err)
+	}
+	form.Degradations = h.collectDegradations(ctx, req)
+	form.CacheHint = h.cacheHint(ctx, req, form)
+
 	return form, nil
 }
 
-// HandleSubmittedForm as method for returning Form instance which is submitted via POST request
+// HandleSubmittedForm as method for returning Form instance which is submitted via POST request, or via request
+// with another submission method of the handler
 func (h *formHandlerImpl) HandleSubmittedForm(ctx context.Context, req *web.Request) (*domain.Form, error) {
+	h.startHandling(ctx, req)
 	form, err := h.buildForm(ctx, req, true)
 	if err != nil {
 		return nil, err
 	}
 
-	return h.handleSubmittedForm(ctx, req, form, http.MethodPost)
+	return h.handleSubmittedForm(ctx, req, form, h.submissionMethod(req))
+}
+
+// HandleSubmittedFormDryRun as method for returning Form instance which is submitted via POST request, decoded
+// and validated without side effects (like for "review your input" pages before final submission)
+func (h *formHandlerImpl) HandleSubmittedFormDryRun(ctx context.Context, req *web.Request) (*domain.Form, error) {
+	h.startHandling(ctx, req)
+	form, err := h.buildForm(ctx, req, true)
+	if err != nil {
+		return nil, err
+	}
+	form.DryRun = true
+
+	return h.handleSubmittedForm(ctx, req, form, h.submissionMethod(req))
 }
 
 // HandleSubmittedGETForm as method for returning Form instance which is submitted via GET request
 func (h *formHandlerImpl) HandleSubmittedGETForm(ctx context.Context, req *web.Request) (*domain.Form, error) {
+	h.startHandling(ctx, req)
 	form, err := h.buildForm(ctx, req, true)
 	if err != nil {
 		return nil, err
@@ -82,34 +155,117 @@ func (h *formHandlerImpl) HandleSubmittedGETForm(ctx context.Context, req *web.R
 	return h.handleSubmittedForm(ctx, req, form, http.MethodGet)
 }
 
+// HandleSearchForm as method for returning SearchForm instance with query parameters of the request bound as form
+// data, defaults applied to missing parameters, and canonical query without defaults and invalid parameters.
+// Search is handled as dry run, so only validation and read-only form extensions run.
+func (h *formHandlerImpl) HandleSearchForm(ctx context.Context, req *web.Request) (*domain.SearchForm, error) {
+	h.startHandling(ctx, req)
+	form, err := h.buildForm(ctx, req, true)
+	if err != nil {
+		return nil, err
+	}
+
+	query := req.Request().URL.Query()
+	plan := h.bindingPlanOf(form.Data)
+
+	// searches only read, so they're handled without side effects, like success pipeline, form result observers
+	// and form extensions which aren't read-only
+	form.DryRun = true
+	form, err = h.handleSubmittedValues(ctx, req, form, plan.applyDefaults(query))
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.SearchForm{
+		Form:            *form,
+		CanonicalValues: plan.canonicalValues(query, form),
+	}, nil
+}
+
+// HandleDeferredForm as method for returning DeferredForm instance, which behaves like HandleForm,
+// and contains acknowledgment token if valid submission is pushed to SubmissionQueue
+func (h *formHandlerImpl) HandleDeferredForm(ctx context.Context, req *web.Request) (*domain.DeferredForm, error) {
+	form, err := h.HandleForm(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	deferred := &domain.DeferredForm{
+		Form: *form,
+	}
+
+	if !form.IsValidAndSubmitted() {
+		return deferred, nil
+	}
+
+	deferred.Token, err = h.enqueueSubmission(ctx, req, form)
+	if err != nil {
+		h.logError(req, "submissionQueue", err)
+		return nil, domain.NewFormErrorWithParent(err)
+	}
+
+	return deferred, nil
+}
+
 // buildForm as method for creating new instance of Form domain
 func (h *formHandlerImpl) buildForm(ctx context.Context, req *web.Request, submitted bool) (*domain.Form, error) {
 	validationRules, err := h.collectFormExtensionValidationRules(ctx, req)
 	if err != nil {
-		h.getLogger("formExtensions").Error(err.Error())
+		h.logError(req, "formExtensions", err)
 		return nil, err
 	}
 
 	formData, err := h.getFormData(ctx, req, h.formDataProvider)
 	if err != nil {
-		h.getLogger("formBuilding").Error(err.Error())
+		h.logError(req, "formBuilding", err)
 		return nil, domain.NewFormErrorWithParent(err)
 	}
 
 	mainValidationRules := h.extractValidationRules(formData)
 	validationRules = h.mergeValidationRules(validationRules, mainValidationRules)
+	validationRules = h.selectedRuleProfile(ctx).validationRules(h.bindingPlanOf(formData), validationRules)
+	validationRules = h.featureToggles.disabled(ctx, req).filterValidationRules(validationRules)
+	validationRules = h.groupExclusion(formData).filterValidationRules(validationRules)
+	validationRules = resolveRequiredRules(ctx, validationRules)
+	validationRules = h.resolveValidationRules(ctx, req, formData, validationRules)
 	form := domain.NewForm(submitted, validationRules)
 	form.Data = formData
+	form.SensitiveFields = h.bindingPlanOf(formData).sensitiveFields
+	form.CorrelationID = domain.CorrelationIDFromRequest(req)
+
+	// previews of submitted forms are rendered from decoded form data
+	if !submitted {
+		form.MarkdownPreviews, err = h.renderMarkdown(ctx, formData)
+		if err != nil {
+			h.logError(req, "markdownRendering", err)
+			return nil, domain.NewFormErrorWithParent(err)
+		}
+	}
+
+	if h.debug {
+		form.DebugInfo = domain.NewDebugInfo(validationRules)
+	}
 
 	return &form, nil
 }
 
+// resolveValidationRules as method for adding request dependent metadata to exported validation rules,
+// if validator provider supports it
+func (h *formHandlerImpl) resolveValidationRules(ctx context.Context, req *web.Request, formData interface{}, validationRules map[string][]domain.ValidationRule) map[string][]domain.ValidationRule {
+	resolver, ok := h.validatorProvider.(validationRulesResolver)
+	if !ok {
+		return validationRules
+	}
+
+	return resolver.ResolveValidationRules(ctx, req, formData, validationRules)
+}
+
 // collectFormExtensionValidationRules collects validation rules from all form extensions defined for handler and delivers them as a single map
 func (h *formHandlerImpl) collectFormExtensionValidationRules(ctx context.Context, req *web.Request) (map[string][]domain.ValidationRule, error) {
 	validationRules := map[string][]domain.ValidationRule{}
-	for _, formExtension := range h.formExtensions {
+	for _, name := range h.enabledExtensionNames(ctx, req) {
 		var formDataProvider domain.FormDataProvider
-		if provider, ok := formExtension.(domain.FormDataProvider); ok {
+		if provider, ok := h.formExtensions[name].(domain.FormDataProvider); ok {
 			formDataProvider = provider
 		}
 		extensionFormData, err := h.getFormData(ctx, req, formDataProvider)
@@ -124,34 +280,153 @@ func (h *formHandlerImpl) collectFormExtensionValidationRules(ctx context.Contex
 
 // handleSubmittedForm as method for processing
 func (h *formHandlerImpl) handleSubmittedForm(ctx context.Context, req *web.Request, form *domain.Form, method string) (*domain.Form, error) {
-	values, err := h.getURLValues(req, method)
+	submittedValues, err := h.getURLValues(domain.ContextWithFormData(ctx, form.Data), req, method)
 	if err != nil {
-		h.getLogger("postValueProcessing").Error(err.Error())
+		h.logError(req, "postValueProcessing", err)
 		return nil, domain.NewFormErrorWithParent(err)
 	}
+	defer h.closeStreamedFiles(req)
 
-	formData, err := h.decode(ctx, req, *values, form.Data, h.formDataDecoder)
+	form, err = h.handleSubmittedValues(ctx, req, form, *submittedValues)
 	if err != nil {
-		h.getLogger("formDecoding").Error(err.Error())
+		return nil, err
+	}
+
+	// only failed submissions with body are followed by redirect, GET submissions keep their values in URL,
+	// and dry-run submissions are rendered as review of the input
+	if method != http.MethodGet && !form.DryRun {
+		h.persistSubmission(req, *submittedValues, form)
+	}
+
+	return form, nil
+}
+
+// handleSubmittedValues as method for decoding and validating submitted values into form
+func (h *formHandlerImpl) handleSubmittedValues(ctx context.Context, req *web.Request, form *domain.Form, submittedValues url.Values) (*domain.Form, error) {
+	return h.handleSubmittedValuesWithExtensionValues(ctx, req, form, submittedValues, submittedValues)
+}
+
+// handleSubmittedValuesWithExtensionValues as method for decoding and validating submitted values into form, while
+// form extensions, validity gates and form result observers get their own values (like values of the current request
+// for confirmation of reviewed snapshot)
+func (h *formHandlerImpl) handleSubmittedValuesWithExtensionValues(ctx context.Context, req *web.Request, form *domain.Form, submittedValues url.Values, submittedExtensionValues url.Values) (*domain.Form, error) {
+	// values of disabled fields are ignored, so they are never decoded into form data
+	disabled := h.featureToggles.disabled(ctx, req)
+	values := disabled.filterValues(submittedValues)
+	extensionValues := disabled.filterValues(submittedExtensionValues)
+
+	formData, err := h.decode(ctx, req, values, form.Data, h.formDataDecoder)
+	if err != nil {
+		h.logError(req, "formDecoding", err)
 		return nil, domain.NewFormErrorWithParent(err)
 	}
-	form.Data = formData
 
-	validationInfo, err := h.validate(ctx, req, h.validatorProvider, formData, h.formDataValidator)
+	if form.DebugInfo != nil {
+		form.DebugInfo.RawValues = domain.RedactValues(values, form, nil)
+		form.DebugInfo.DecodedData = domain.DebugSnapshot(formData)
+	}
+
+	// comparison rules with invalid parameters would make validator panic, so they are reported as error instead
+	if err := h.bindingPlanOf(formData).ruleErr; err != nil {
+		h.logError(req, "formValidation", err)
+		return nil, domain.NewFormErrorWithParent(err)
+	}
+
+	validationInfo, err := h.validateFormData(ctx, req, formData)
 	if err != nil {
-		h.getLogger("formValidation").Error(err.Error())
+		h.logError(req, "formValidation", err)
+		return nil, domain.NewFormErrorWithParent(err)
+	}
+
+	if err := h.applyRuleProfile(ctx, req, formData, validationInfo); err != nil {
+		h.logError(req, "formValidation", err)
+		return nil, domain.NewFormErrorWithParent(err)
+	}
+
+	formData, err = h.confirmFields(formData, validationInfo)
+	if err != nil {
+		h.logError(req, "fieldConfirmation", err)
 		return nil, domain.NewFormErrorWithParent(err)
-	} else if validationInfo == nil {
-		validationInfo = &domain.ValidationInfo{}
 	}
+	h.requireFields(ctx, formData, validationInfo)
+	validationInfo = disabled.filterValidationInfo(validationInfo)
+	// fields of other validation groups are validated as well, but their errors are dropped
+	validationInfo = h.groupExclusion(formData).filterValidationInfo(validationInfo)
+	validationInfo = h.reportRules(ctx, req, validationInfo)
+
 	form.ValidationInfo = *validationInfo
 
-	err = h.processExtensions(ctx, req, *values, form)
+	// previews are rendered before encryption, so they still contain plaintext values
+	form.MarkdownPreviews, err = h.renderMarkdown(ctx, formData)
+	if err != nil {
+		h.logError(req, "markdownRendering", err)
+		return nil, domain.NewFormErrorWithParent(err)
+	}
+
+	if form.DebugInfo != nil {
+		form.DebugInfo.ValidationInfo = domain.DebugValidationInfo(*validationInfo)
+	}
+
+	form.Data = formData
+
+	err = h.processExtensions(ctx, req, extensionValues, form)
 	if err != nil {
-		h.getLogger("formExtensions").Error(err.Error())
+		h.logError(req, "formExtensions", err)
 		return nil, domain.NewFormErrorWithParent(err)
 	}
 
+	err = h.gateFormValidity(ctx, req, extensionValues, form)
+	if err != nil {
+		h.logError(req, "formValidityGates", err)
+		return nil, domain.NewFormErrorWithParent(err)
+	}
+
+	// form data is enriched only after extensions and gates accepted the submission, so rejected submissions
+	// don't reach external services of enrichers, and before encryption, so enrichers operate on plaintext values
+	form.Data, err = h.enrichFormData(ctx, req, form.Data, &form.ValidationInfo)
+	if err != nil {
+		h.logError(req, "formEnrichment", err)
+		return nil, domain.NewFormErrorWithParent(err)
+	}
+
+	// fields are encrypted after validation, so validators and form extensions still operate on plaintext values
+	form.Data, err = h.encryptFields(ctx, form.Data)
+	if err != nil {
+		h.logError(req, "fieldEncryption", err)
+		return nil, domain.NewFormErrorWithParent(err)
+	}
+
+	if form.DryRun {
+		form.Degradations = h.collectDegradations(ctx, req)
+		return form, nil
+	}
+
+	// failures are counted for final submissions only, so reviewed and confirmed submission is not counted twice
+	recordFieldFailures(ctx, form)
+
+	// cards are exchanged for tokens by external service only after extensions and gates accepted the submission,
+	// so rejected submissions (like by CSRF check, rate limit or lockout) can't be used for testing card numbers
+	if form.IsValid() {
+		form.Data, err = h.tokenizeCards(ctx, form.Data)
+		if err != nil {
+			h.logError(req, "cardTokenization", err)
+			return nil, domain.NewFormErrorWithParent(err)
+		}
+	}
+
+	err = h.runSuccessPipeline(ctx, req, form)
+	if err != nil {
+		h.logError(req, "successPipeline", err)
+		return nil, domain.NewFormErrorWithParent(err)
+	}
+
+	err = h.observeFormResult(ctx, req, extensionValues, form)
+	if err != nil {
+		h.logError(req, "formResultObservers", err)
+		return nil, domain.NewFormErrorWithParent(err)
+	}
+	form.Degradations = h.collectDegradations(ctx, req)
+
 	return form, nil
 }
 
@@ -163,96 +438,344 @@ func (h *formHandlerImpl) mergeValidationRules(first map[string][]domain.Validat
 	return first
 }
 
-// extractValidationRules as method for extracting form fields validation rules
+// extractValidationRules as method for extracting form fields validation rules.
+// Validation rules are part of binding plan of form data type, so returned map is shared and must not be modified.
 func (h *formHandlerImpl) extractValidationRules(formData interface{}) map[string][]domain.ValidationRule {
-	validationRules := map[string][]domain.ValidationRule{}
+	return h.bindingPlanOf(formData).validationRules
+}
 
-	if formData == nil {
-		return validationRules
+// bindingPlanOf as method for returning binding plan of form data, which is compiled at handler construction
+// if form data type is known, or compiled once on first usage otherwise.
+func (h *formHandlerImpl) bindingPlanOf(formData interface{}) *bindingPlan {
+	typeOf := reflect.TypeOf(formData)
+	if typeOf != nil && typeOf.Kind() == reflect.Ptr {
+		typeOf = typeOf.Elem()
 	}
 
-	valueOf := reflect.Indirect(reflect.ValueOf(formData))
-	typeOf := valueOf.Type()
+	if h.bindingPlan != nil && h.bindingPlan.typeOf == typeOf {
+		return h.bindingPlan
+	}
 
-	if typeOf.Kind() != reflect.Struct {
-		return validationRules
+	return loadBindingPlan(typeOf)
+}
+
+// encryptFields as method for encrypting all string fields of form data which are tagged with `encrypt:"true"`.
+func (h *formHandlerImpl) encryptFields(ctx context.Context, formData interface{}) (interface{}, error) {
+	plan := h.bindingPlanOf(formData)
+	if len(plan.encryptBindings) == 0 {
+		return formData, nil
 	}
 
-	for i := 0; i < typeOf.NumField(); i++ {
-		fieldValue := valueOf.Field(i)
-		if fieldValue.Type().Kind() == reflect.Ptr && fieldValue.Type().Elem().Kind() == reflect.Struct {
-			fieldValue = reflect.Zero(fieldValue.Type().Elem())
+	return h.modifyStructFormData(formData, func(valueOf reflect.Value) error {
+		return h.encryptStructFields(ctx, valueOf, plan)
+	})
+}
+
+// confirmFields as method for validating fields tagged with `confirmfield:"OtherField"` against their paired fields.
+// Confirmation fields are stripped (set to zero value) from final form data.
+func (h *formHandlerImpl) confirmFields(formData interface{}, validationInfo *domain.ValidationInfo) (interface{}, error) {
+	plan := h.bindingPlanOf(formData)
+	if plan.confirmErr != nil {
+		return nil, plan.confirmErr
+	}
+
+	if len(plan.confirmBindings) == 0 {
+		return formData, nil
+	}
+
+	return h.modifyStructFormData(formData, func(valueOf reflect.Value) error {
+		h.confirmStructFields(valueOf, plan, validationInfo)
+		return nil
+	})
+}
+
+// validateFormData as method for validating form data by form data validator, followed by all additional form data
+// validators in order they are added. Remaining validators are skipped as soon as one of them stops validation.
+func (h *formHandlerImpl) validateFormData(ctx context.Context, req *web.Request, formData interface{}) (*domain.ValidationInfo, error) {
+	validationInfo, err := h.validate(ctx, req, h.validatorProvider, formData, h.formDataValidator)
+	if err != nil {
+		return nil, err
+	} else if validationInfo == nil {
+		validationInfo = &domain.ValidationInfo{}
+	}
+
+	for _, validator := range h.formDataValidators {
+		if validationInfo.IsValidationStopped() {
+			break
 		}
 
-		fieldType := typeOf.Field(i)
+		additionalInfo, err := validator.Validate(ctx, req, h.validatorProvider, formData)
+		if err != nil {
+			return nil, err
+		} else if additionalInfo == nil {
+			continue
+		}
+
+		validationInfo.AppendGeneralErrors(additionalInfo.GetGeneralErrors())
+		validationInfo.AppendFieldErrors(additionalInfo.GetErrorsForAllFields())
+		if additionalInfo.IsValidationStopped() {
+			validationInfo.StopValidation()
+		}
+	}
+
+	return validationInfo, nil
+}
+
+// enrichFormData as method for augmenting valid form data by all form data enrichers, in order they are added.
+// Errors reported by enricher are attached to validation info, and remaining enrichers are skipped.
+func (h *formHandlerImpl) enrichFormData(ctx context.Context, req *web.Request, formData interface{}, validationInfo *domain.ValidationInfo) (interface{}, error) {
+	for _, enricher := range h.formDataEnrichers {
+		if !validationInfo.IsValid() {
+			break
+		}
 
-		name := fieldType.Tag.Get("form")
-		if name == "-" {
+		enriched, enrichmentInfo, err := enricher.Enrich(ctx, req, formData)
+		if err != nil {
+			return nil, err
+		}
+		formData = enriched
+
+		if enrichmentInfo != nil {
+			validationInfo.AppendGeneralErrors(enrichmentInfo.GetGeneralErrors())
+			validationInfo.AppendFieldErrors(enrichmentInfo.GetErrorsForAllFields())
+		}
+	}
+
+	return formData, nil
+}
+
+// tokenizeCards as method for swapping numbers of all payment card sub forms for tokens provided by card.Tokenizer.
+// Number and CVC of tokenized card are stripped from final form data.
+func (h *formHandlerImpl) tokenizeCards(ctx context.Context, formData interface{}) (interface{}, error) {
+	plan := h.bindingPlanOf(formData)
+	if h.cardTokenizer == nil || len(plan.cardBindings) == 0 {
+		return formData, nil
+	}
+
+	return h.modifyStructFormData(formData, func(valueOf reflect.Value) error {
+		return h.tokenizeStructCards(ctx, valueOf, plan)
+	})
+}
+
+// renderMarkdown as method for rendering previews of all non-empty markdown fields by markdown.Renderer
+func (h *formHandlerImpl) renderMarkdown(ctx context.Context, formData interface{}) (map[string]template.HTML, error) {
+	plan := h.bindingPlanOf(formData)
+	if h.markdownRenderer == nil || len(plan.markdownBindings) == 0 || formData == nil {
+		return nil, nil
+	}
+
+	valueOf := reflect.ValueOf(formData)
+	if valueOf.Kind() == reflect.Ptr {
+		if valueOf.IsNil() {
+			return nil, nil
+		}
+		valueOf = valueOf.Elem()
+	}
+
+	if valueOf.Kind() != reflect.Struct {
+		return nil, nil
+	}
+
+	previews := map[string]template.HTML{}
+	for _, binding := range plan.markdownBindings {
+		fieldValue, ok := fieldByIndex(valueOf, binding.index)
+		if !ok {
+			continue
+		}
+
+		text := fieldValue.Interface().(markdown.Text)
+		if text == "" {
 			continue
 		}
 
-		if name == "" {
-			name = fieldType.Name
+		preview, err := h.markdownRenderer.Render(ctx, text)
+		if err != nil {
+			return nil, err
 		}
+		previews[binding.fieldName] = preview
+	}
 
-		if fieldValue.Kind() == reflect.Struct {
-			subRules := h.extractValidationRules(fieldValue.Interface())
-			for k, v := range subRules {
-				key := fmt.Sprintf("%s.%s", name, k)
-				validationRules[key] = v
-			}
+	return previews, nil
+}
+
+// modifyStructFormData as method for applying modification on struct form data.
+// Form data passed as value is copied, while form data passed as pointer is modified in place.
+func (h *formHandlerImpl) modifyStructFormData(formData interface{}, modify func(valueOf reflect.Value) error) (interface{}, error) {
+	if formData == nil {
+		return formData, nil
+	}
 
+	valueOf := reflect.ValueOf(formData)
+	if valueOf.Kind() == reflect.Ptr {
+		if valueOf.IsNil() || valueOf.Elem().Kind() != reflect.Struct {
+			return formData, nil
+		}
+		return formData, modify(valueOf.Elem())
+	}
+
+	if valueOf.Kind() != reflect.Struct {
+		return formData, nil
+	}
+
+	copied := reflect.New(valueOf.Type()).Elem()
+	copied.Set(valueOf)
+
+	err := modify(copied)
+	if err != nil {
+		return nil, err
+	}
+
+	return copied.Interface(), nil
+}
+
+// confirmStructFields as method for validating and stripping confirmation fields of addressable struct value, by using its binding plan.
+// Field errors are named in the same way as validation errors of default validator.
+func (h *formHandlerImpl) confirmStructFields(valueOf reflect.Value, plan *bindingPlan, validationInfo *domain.ValidationInfo) {
+	for _, binding := range plan.confirmBindings {
+		fieldValue, ok := fieldByIndex(valueOf, binding.index)
+		if !ok {
 			continue
 		}
 
-		validationTag := fieldType.Tag.Get("validate")
-		if validationTag == "" {
+		confirmedValue, _ := fieldByIndex(valueOf, binding.confirmedIndex)
+		if !reflect.DeepEqual(fieldValue.Interface(), confirmedValue.Interface()) {
+			validationInfo.AddFieldErrorWithParams(binding.fieldName, "formError."+binding.fieldName+".confirmfield", binding.label+" confirmfield",
+				domain.NewErrorParams(binding.label, "confirmfield", "", ""))
+		}
+
+		fieldValue.Set(reflect.Zero(fieldValue.Type()))
+	}
+}
+
+// encryptStructFields as method for encrypting tagged fields of addressable struct value, by using its binding plan
+func (h *formHandlerImpl) encryptStructFields(ctx context.Context, valueOf reflect.Value, plan *bindingPlan) error {
+	for _, binding := range plan.encryptBindings {
+		fieldValue, ok := fieldByIndex(valueOf, binding.index)
+		if !ok {
 			continue
 		}
 
-		tags := strings.Split(validationTag, ",")
-		for _, tag := range tags {
-			values := strings.Split(tag, "=")
-			if len(values) == 0 {
-				continue
-			}
-			if values[0] == "omitempty" || values[0] == "" {
+		var plaintext string
+		if binding.pointer {
+			if fieldValue.IsNil() {
 				continue
 			}
+			plaintext = fieldValue.Elem().String()
+		} else {
+			plaintext = fieldValue.String()
+		}
 
-			validationRule := domain.ValidationRule{
-				Name: values[0],
-			}
-			if len(values) > 1 {
-				validationRule.Value = values[1]
-			}
+		if plaintext == "" {
+			continue
+		}
+
+		if h.fieldEncryptor == nil {
+			return domain.NewFormErrorf("there is no FieldEncryptor for encrypting field %q", binding.name)
+		}
+
+		ciphertext, err := h.fieldEncryptor.Encrypt(ctx, plaintext)
+		if err != nil {
+			return err
+		}
+
+		if !binding.pointer {
+			fieldValue.SetString(ciphertext)
+			continue
+		}
+
+		// new pointer is set, so original value which may be shared is not changed
+		encrypted := reflect.New(fieldValue.Type().Elem())
+		encrypted.Elem().SetString(ciphertext)
+		fieldValue.Set(encrypted)
+	}
+
+	return nil
+}
+
+// tokenizeStructCards as method for tokenizing payment card sub forms of addressable struct value, by using its binding plan
+func (h *formHandlerImpl) tokenizeStructCards(ctx context.Context, valueOf reflect.Value, plan *bindingPlan) error {
+	for _, binding := range plan.cardBindings {
+		fieldValue, ok := fieldByIndex(valueOf, binding.index)
+		if !ok || (binding.pointer && fieldValue.IsNil()) {
+			continue
+		}
+
+		var paymentCard card.Card
+		if binding.pointer {
+			paymentCard = *fieldValue.Interface().(*card.Card)
+		} else {
+			paymentCard = fieldValue.Interface().(card.Card)
+		}
+
+		if paymentCard.Number == "" {
+			continue
+		}
 
-			validationRules[name] = append(validationRules[name], validationRule)
+		token, err := h.cardTokenizer.Tokenize(ctx, paymentCard)
+		if err != nil {
+			return err
+		}
+
+		if token == "" {
+			continue
+		}
+
+		paymentCard.Token = token
+		paymentCard.Number = ""
+		paymentCard.CVC = ""
+
+		if !binding.pointer {
+			fieldValue.Set(reflect.ValueOf(paymentCard))
+			continue
 		}
+
+		// new pointer is set, so original card which may be shared is not changed
+		fieldValue.Set(reflect.ValueOf(&paymentCard))
 	}
 
-	return validationRules
+	return nil
 }
 
-// getPostValues as method for extracting http request body
-func (h *formHandlerImpl) getURLValues(r *web.Request, method string) (*url.Values, error) {
+// getPostValues as method for extracting http request body.
+// Body is transformed into values by decoder of its content type, and values of body take precedence over query
+// parameters.
+func (h *formHandlerImpl) getURLValues(ctx context.Context, r *web.Request, method string) (*url.Values, error) {
 	if method == http.MethodGet {
 		values := r.Request().URL.Query()
 		return &values, nil
 	}
 
-	err := r.Request().ParseForm()
+	body, err := h.limitBody(r)
 	if err != nil {
 		return nil, err
 	}
 
-	return &r.Request().Form, nil
+	contentType := r.Request().Header.Get("Content-Type")
+
+	// streamed multipart body is read part by part, so its files are not buffered
+	if h.streamedUploads && formdata.IsMultipartContentType(contentType) {
+		streamedValues, err := h.getStreamedValues(r)
+		if err != nil {
+			return nil, body.limitError(err)
+		}
+
+		return streamedValues, nil
+	}
+
+	values, err := h.requestBodyDecoder(contentType).DecodeBody(ctx, r)
+	if err != nil {
+		return nil, body.limitError(err)
+	}
+
+	return &values, nil
 }
 
-// processExtensions as method for processing list of form extensions
+// processExtensions as method for processing list of form extensions in their processing order
 func (h *formHandlerImpl) processExtensions(ctx context.Context, req *web.Request, values url.Values, form *domain.Form) error {
-	for name, formExtension := range h.formExtensions {
-		err := h.processExtension(ctx, req, values, name, formExtension, form)
+	for _, name := range h.enabledExtensionNames(ctx, req) {
+		start := time.Now()
+		err := h.processExtension(ctx, req, values, name, h.formExtensions[name], form)
+		recordExtensionLatency(ctx, form, name, latencyStageProcessing, start)
 		if err != nil {
 			return err
 		}
@@ -284,7 +807,12 @@ func (h *formHandlerImpl) processExtension(ctx context.Context, req *web.Request
 	// at this point decoded data is added to map of form extension data
 	form.FormExtensionsData[name] = formData
 
-	if !form.IsSubmitted() {
+	h.debugExtension(form, name, func(debugInfo *domain.ExtensionDebugInfo) {
+		debugInfo.ProvidedData = domain.DebugSnapshot(formData)
+	})
+
+	// form extensions which aren't read-only only provide their form data for dry-run submissions
+	if !form.IsSubmitted() || (form.DryRun && !isReadOnly(formExtension)) {
 		return nil
 	}
 
@@ -302,6 +830,10 @@ func (h *formHandlerImpl) processExtension(ctx context.Context, req *web.Request
 	// at this point decoded data is added to map of form extension data
 	form.FormExtensionsData[name] = formData
 
+	h.debugExtension(form, name, func(debugInfo *domain.ExtensionDebugInfo) {
+		debugInfo.DecodedData = domain.DebugSnapshot(formData)
+	})
+
 	// checks if form extension is defined as form data validator
 	// if it's not, it passes nil, which means that default form data validator will be used
 	var formDataValidator domain.FormDataValidator
@@ -313,16 +845,108 @@ func (h *formHandlerImpl) processExtension(ctx context.Context, req *web.Request
 		return err
 	}
 
-	// form validation errors from form extension is attached
-	form.ValidationInfo.AppendGeneralErrors(validationInfo.GetGeneralErrors())
-	form.ValidationInfo.AppendFieldErrors(validationInfo.GetErrorsForAllFields())
+	validationInfo = h.featureToggles.disabled(ctx, req).filterValidationInfo(validationInfo)
+	validationInfo = h.reportRules(ctx, req, validationInfo)
+	if h.reportOnly.isExtension(name) {
+		// errors of form extension in report-only mode are only reported
+		h.reportExtension(ctx, req, name, validationInfo)
+	} else {
+		// form validation errors from form extension is attached
+		form.ValidationInfo.AppendGeneralErrors(validationInfo.GetGeneralErrors())
+		form.ValidationInfo.AppendFieldErrors(validationInfo.GetErrorsForAllFields())
+	}
+
+	h.debugExtension(form, name, func(debugInfo *domain.ExtensionDebugInfo) {
+		debugInfo.ValidationInfo = domain.DebugValidationInfo(*validationInfo)
+	})
 
 	return nil
 }
 
-// formHandlerImpl returns flamingo logger instance with defined fields for error logging
-func (h *formHandlerImpl) getLogger(value string) flamingo.Logger {
-	return h.logger.WithField("FormHandler", value)
+// debugExtension as method for recording contribution of form extension, if debug mode is enabled
+func (h *formHandlerImpl) debugExtension(form *domain.Form, name string, record func(debugInfo *domain.ExtensionDebugInfo)) {
+	if form.DebugInfo == nil {
+		return
+	}
+
+	debugInfo := form.DebugInfo.Extensions[name]
+	record(&debugInfo)
+	form.DebugInfo.Extensions[name] = debugInfo
+}
+
+// gateFormValidity as method for asking form extensions, which implement domain.FormValidityGate, if valid submitted form
+// can be treated as valid. Gates are asked in processing order of form extensions, until first of them vetoes form validity.
+func (h *formHandlerImpl) gateFormValidity(ctx context.Context, req *web.Request, values url.Values, form *domain.Form) error {
+	if !form.IsValidAndSubmitted() {
+		return nil
+	}
+
+	for _, name := range h.enabledExtensionNames(ctx, req) {
+		gate, ok := h.formExtensions[name].(domain.FormValidityGate)
+		if !ok || (form.DryRun && !isReadOnly(gate)) {
+			continue
+		}
+
+		start := time.Now()
+		veto, err := gate.GateFormValidity(ctx, req, values, form)
+		recordExtensionLatency(ctx, form, name, latencyStageGate, start)
+		if err != nil {
+			return err
+		}
+		if veto == nil {
+			continue
+		}
+
+		if h.reportOnly.isExtension(name) {
+			// vetoes of form extension in report-only mode are only reported
+			h.report(ctx, req, name, "", *veto)
+			continue
+		}
+
+		form.ValidationInfo.AddGeneralError(veto.MessageKey, veto.DefaultLabel)
+		return nil
+	}
+
+	return nil
+}
+
+// observeFormResult as method for notifying form extensions, which implement domain.FormResultObserver, about final state of submitted form
+func (h *formHandlerImpl) observeFormResult(ctx context.Context, req *web.Request, values url.Values, form *domain.Form) error {
+	for _, name := range h.enabledExtensionNames(ctx, req) {
+		if observer, ok := h.formExtensions[name].(domain.FormResultObserver); ok {
+			start := time.Now()
+			err := observer.ObserveFormResult(ctx, req, values, form)
+			recordExtensionLatency(ctx, form, name, latencyStageObservation, start)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// isReadOnly returns true if form extension implements domain.ReadOnlyFormExtension and causes no side effects
+func isReadOnly(formExtension interface{}) bool {
+	readOnly, ok := formExtension.(domain.ReadOnlyFormExtension)
+
+	return ok && readOnly.IsReadOnly()
+}
+
+// formHandlerImpl returns flamingo logger instance with defined fields for error logging, including correlation ID
+// of the handled submission
+func (h *formHandlerImpl) getLogger(req *web.Request, value string) flamingo.Logger {
+	logger := h.logger.WithField("FormHandler", value)
+	if correlationID := domain.CorrelationIDFromRequest(req); correlationID != "" {
+		logger = logger.WithField("correlationId", correlationID)
+	}
+
+	return logger
+}
+
+// logError as method for logging error of form processing stage, with log level and sampling defined for that stage
+func (h *formHandlerImpl) logError(req *web.Request, value string, err error) {
+	h.logPolicy.log(h.getLogger(req, value), value, err)
 }
 
 // getFormData calls GetFormData from instance of domain.FormDataProvider if it's defined, otherwise it calls it from default domain.FormDataProvider
@@ -0,0 +1,258 @@
+package application
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/formdata"
+)
+
+type (
+	benchmarkFormService struct {
+		formData interface{}
+	}
+
+	benchmarkSmallFormData struct {
+		Email    string `form:"email" validate:"required,email" conform:"trim,lower"`
+		Password string `form:"password" validate:"required,min=6"`
+		Remember bool   `form:"remember"`
+	}
+
+	benchmarkMediumFormData struct {
+		FirstName  string `form:"firstName" validate:"required,max=64" conform:"trim"`
+		LastName   string `form:"lastName" validate:"required,max=64" conform:"trim"`
+		Email      string `form:"email" validate:"required,email" conform:"trim,lower"`
+		Phone      string `form:"phone" validate:"omitempty,numeric" conform:"num"`
+		Birthday   string `form:"birthday" validate:"required"`
+		Newsletter bool   `form:"newsletter"`
+		Address    struct {
+			Street  string `form:"street" validate:"required" conform:"trim"`
+			Number  string `form:"number" validate:"required" conform:"trim"`
+			ZipCode string `form:"zipCode" validate:"required,len=5" conform:"trim"`
+			City    string `form:"city" validate:"required" conform:"trim"`
+			Country string `form:"country" validate:"required,len=2" conform:"upper"`
+		} `form:"address"`
+		Comment string `form:"comment" validate:"max=512" conform:"trim"`
+	}
+
+	benchmarkLargeFormData struct {
+		OrderID string `form:"orderId" validate:"required"`
+		Items   []struct {
+			SKU      string  `form:"sku" validate:"required" conform:"trim"`
+			Quantity int     `form:"quantity" validate:"min=1"`
+			Price    float64 `form:"price" validate:"gt=0"`
+			Comment  string  `form:"comment" validate:"max=128" conform:"trim"`
+		} `form:"items" validate:"required,dive"`
+	}
+)
+
+var _ domain.FormDataProvider = &benchmarkFormService{}
+
+func (s *benchmarkFormService) GetFormData(context.Context, *web.Request) (interface{}, error) {
+	return s.formData, nil
+}
+
+func benchmarkSmallValues() url.Values {
+	return url.Values{
+		"email":    []string{" User@Example.com "},
+		"password": []string{"secret"},
+		"remember": []string{"true"},
+	}
+}
+
+func benchmarkMediumValues() url.Values {
+	return url.Values{
+		"firstName":       []string{" John "},
+		"lastName":        []string{" Doe "},
+		"email":           []string{" John.Doe@Example.com "},
+		"phone":           []string{"+49 123 456"},
+		"birthday":        []string{"1990-01-01"},
+		"newsletter":      []string{"true"},
+		"address.street":  []string{" Main Street "},
+		"address.number":  []string{" 1a "},
+		"address.zipCode": []string{" 12345 "},
+		"address.city":    []string{" Berlin "},
+		"address.country": []string{"de"},
+		"comment":         []string{" please ring twice "},
+	}
+}
+
+func benchmarkLargeValues() url.Values {
+	values := url.Values{
+		"orderId": []string{"order"},
+	}
+
+	for i := 0; i < 50; i++ {
+		prefix := "items[" + strconv.Itoa(i) + "]."
+		values[prefix+"sku"] = []string{" sku-" + strconv.Itoa(i) + " "}
+		values[prefix+"quantity"] = []string{strconv.Itoa(i + 1)}
+		values[prefix+"price"] = []string{"9.99"}
+		values[prefix+"comment"] = []string{" comment "}
+	}
+
+	return values
+}
+
+func benchmarkFormHandler(formData interface{}) *formHandlerImpl {
+	validatorProvider := &ValidatorProviderImpl{}
+	validatorProvider.Inject(nil, nil)
+
+	return &formHandlerImpl{
+		formDataProvider:         &benchmarkFormService{formData: formData},
+		defaultFormDataProvider:  &formdata.DefaultFormDataProviderImpl{},
+		defaultFormDataDecoder:   &formdata.DefaultFormDataDecoderImpl{},
+		defaultFormDataValidator: &formdata.DefaultFormDataValidatorImpl{},
+		validatorProvider:        validatorProvider,
+		logger:                   &flamingo.NullLogger{},
+	}
+}
+
+func benchmarkRequest(values url.Values) *web.Request {
+	// form values are already parsed, so parsing of request body is not part of the benchmark
+	return web.CreateRequest(&http.Request{
+		Method:   http.MethodPost,
+		URL:      &url.URL{Path: "/form"},
+		Form:     values,
+		PostForm: values,
+	}, nil)
+}
+
+func benchmarkCases() []struct {
+	name     string
+	values   url.Values
+	formData interface{}
+} {
+	return []struct {
+		name     string
+		values   url.Values
+		formData interface{}
+	}{
+		{name: "StringMap", values: benchmarkMediumValues(), formData: map[string]string{}},
+		{name: "Small", values: benchmarkSmallValues(), formData: benchmarkSmallFormData{}},
+		{name: "Medium", values: benchmarkMediumValues(), formData: benchmarkMediumFormData{}},
+		{name: "Large", values: benchmarkLargeValues(), formData: benchmarkLargeFormData{}},
+	}
+}
+
+func BenchmarkFormHandlerImpl_HandleForm(b *testing.B) {
+	for _, benchmark := range benchmarkCases() {
+		benchmark := benchmark
+		b.Run(benchmark.name, func(b *testing.B) {
+			handler := benchmarkFormHandler(benchmark.formData)
+			req := benchmarkRequest(benchmark.values)
+			ctx := context.Background()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := handler.HandleForm(ctx, req); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkFormHandlerImpl_Decode(b *testing.B) {
+	for _, benchmark := range benchmarkCases() {
+		benchmark := benchmark
+		b.Run(benchmark.name, func(b *testing.B) {
+			handler := benchmarkFormHandler(benchmark.formData)
+			req := benchmarkRequest(benchmark.values)
+			ctx := context.Background()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := handler.decode(ctx, req, benchmark.values, benchmark.formData, nil); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkFormHandlerImpl_Validate(b *testing.B) {
+	for _, benchmark := range benchmarkCases() {
+		benchmark := benchmark
+		b.Run(benchmark.name, func(b *testing.B) {
+			handler := benchmarkFormHandler(benchmark.formData)
+			req := benchmarkRequest(benchmark.values)
+			ctx := context.Background()
+
+			formData, err := handler.decode(ctx, req, benchmark.values, benchmark.formData, nil)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := handler.validate(ctx, req, handler.validatorProvider, formData, nil); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkFormHandlerImpl_ExtractValidationRules(b *testing.B) {
+	for _, benchmark := range benchmarkCases() {
+		benchmark := benchmark
+		b.Run(benchmark.name, func(b *testing.B) {
+			handler := benchmarkFormHandler(benchmark.formData)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				handler.extractValidationRules(benchmark.formData)
+			}
+		})
+	}
+}
+
+func BenchmarkFormHandlerImpl_ExtractValidationRulesParallel(b *testing.B) {
+	for _, benchmark := range benchmarkCases() {
+		benchmark := benchmark
+		b.Run(benchmark.name, func(b *testing.B) {
+			handler := benchmarkFormHandler(benchmark.formData)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					handler.extractValidationRules(benchmark.formData)
+				}
+			})
+		})
+	}
+}
+
+// BenchmarkCompileValidationRules measures extraction of validation rules without cached binding plans,
+// as baseline of BenchmarkFormHandlerImpl_ExtractValidationRules
+func BenchmarkCompileValidationRules(b *testing.B) {
+	for _, benchmark := range benchmarkCases() {
+		benchmark := benchmark
+		typeOf := reflect.TypeOf(benchmark.formData)
+		if typeOf.Kind() != reflect.Struct {
+			continue
+		}
+
+		b.Run(benchmark.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := compileValidationRules(typeOf, map[reflect.Type]bool{typeOf: true}); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
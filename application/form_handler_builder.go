@@ -5,6 +5,9 @@ import (
 
 	"flamingo.me/flamingo/v3/framework/flamingo"
 	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/card"
+	"flamingo.me/form/domain/formdata"
+	"flamingo.me/form/domain/markdown"
 )
 
 type (
@@ -36,16 +39,80 @@ type (
 		// It returns error if there is no injected form data validator with that name.
 		// It sets form data validator instance and overrides default one.
 		SetNamedFormDataValidator(name string) error
+		// AddFormDataValidator adds form data validator, which runs after form data validator set for the handler.
+		// Validators run in order they are added, until one of them stops validation via domain.ValidationInfo.
+		AddFormDataValidator(formDataValidator domain.FormDataValidator) FormHandlerBuilder
 		// AddFormExtension adds form extension to the list of form extensions.
 		AddFormExtension(formExtension domain.FormExtension) error
 		// AddNamedFormExtension adds form extension by searching named extension via dingo injector.
 		// It returns error if there is no injected form extension with that name.
 		AddNamedFormExtension(name string) error
+		// AddSubForm embeds sub form into the form, as field of form data named by the prefix (like "billing").
+		// Form extensions of sub form are added with prefixed names (like "billing.formExtension.name").
+		// It returns error if sub form doesn't implement any of FormDataProvider, FormDataDecoder or FormDataValidator
+		// interfaces, or if one of its form extensions can't be added.
+		AddSubForm(prefix string, subForm domain.SubForm) error
+		// AddNamedSubForm embeds sub form by searching named sub form provided via dingo injector.
+		// It returns error if there is no injected sub form with that name, or if it can't be embedded.
+		AddNamedSubForm(prefix string, name string) error
+		// AddFormDataEnricher adds form data enricher, which augments form data after successful validation.
+		// Enrichers run in order they are added, until one of them reports validation errors.
+		AddFormDataEnricher(formDataEnricher domain.FormDataEnricher) FormHandlerBuilder
+		// AddSuccessStep adds step of success pipeline, which runs after valid form submission.
+		// If one of steps fails, already executed steps are compensated in reverse order.
+		AddSuccessStep(successStep domain.SuccessStep) FormHandlerBuilder
+		// AddFeatureToggle adds toggle of form fields, validation rules and form extensions, which are enabled only if
+		// feature flag is enabled for the request, as decided by domain.FeatureFlagProvider.
+		AddFeatureToggle(toggle domain.FeatureToggle) FormHandlerBuilder
+		// SetDebugMode enables or disables recording of inputs and outputs of each form processing stage into domain.Form.
+		SetDebugMode(debug bool) FormHandlerBuilder
+		// SetFormDataType sets type of form data by example instance, so its binding plan and decode plan are compiled
+		// at handler construction. Plans of other form data types are compiled on first usage. Build panics if type has
+		// invalid validation rules.
+		SetFormDataType(formData interface{}) FormHandlerBuilder
+		// SetReportOnlyRules sets validation rules which run in report-only mode: their violations are logged and counted
+		// by metric, but not added to validation info. It overrides report-only rules defined by configuration.
+		SetReportOnlyRules(rules ...string) FormHandlerBuilder
+		// SetReportOnlyExtensions sets names of form extensions which run in report-only mode: their violations are logged
+		// and counted by metric, but not added to validation info. It overrides report-only extensions defined by configuration.
+		SetReportOnlyExtensions(names ...string) FormHandlerBuilder
+		// SetRuleProfile sets rule profile which is applied if there is no rule profile selected for the request
+		// via domain.ContextWithRuleProfile. It returns error if there is no rule profile with that name in configuration.
+		SetRuleProfile(name string) error
+		// SetValidationGroup sets validation group, so only form fields which belong to it are validated (like "create"
+		// or "update"). Fields belong to groups listed by their `validate-groups` tag, or to all groups if there is no tag.
+		// Empty group validates all fields.
+		SetValidationGroup(group string) FormHandlerBuilder
+		// SetSubmissionQueue sets message queue of valid submissions handled by deferred form handler,
+		// and overrides default one.
+		SetSubmissionQueue(submissionQueue domain.SubmissionQueue) FormHandlerBuilder
+		// SetPostRedirectGet enables Post/Redirect/Get: failed POST submission is stored in web session under the key,
+		// and restored by next unsubmitted form. Empty key disables it.
+		SetPostRedirectGet(sessionKey string) FormHandlerBuilder
+		// SetSubmissionMethods sets methods of requests which are treated as submissions by HandleForm (like PUT, PATCH
+		// and DELETE for REST-style controllers), and whose bodies are read by HandleSubmittedForm. By default, only POST
+		// requests are treated as submissions.
+		SetSubmissionMethods(methods ...string) FormHandlerBuilder
+		// SetStreamedUploads enables or disables streaming of uploaded files: multipart body is read until the first file,
+		// which is read directly from the body while form is handled, instead of buffering it in memory or temporary file.
+		SetStreamedUploads(streamed bool) FormHandlerBuilder
+		// SetRequestBodyLimits sets maximal size of request body and maximal number of bytes of multipart body kept
+		// in memory, overriding configured limits for this form handler. Limits which are not positive keep configured
+		// ones. Form handler returns domain.ErrRequestBodyTooLarge for larger bodies.
+		SetRequestBodyLimits(maxSize int64, multipartMemory int64) FormHandlerBuilder
 		// Must wraps builder method execution and returns instance of builder if there is no error.
 		// It panics if there is an error.
 		Must(err error) FormHandlerBuilder
-		// Build creates new instance of FormHandler interface
+		// Build creates new instance of FormHandler interface, wrapped by decorators of form handlers bound via dingo injector
 		Build() domain.FormHandler
+		// BuildSearchFormHandler creates new instance of SearchFormHandler interface, for search/filter forms
+		BuildSearchFormHandler() domain.SearchFormHandler
+		// BuildDeferredFormHandler creates new instance of DeferredFormHandler interface, for forms which valid
+		// submissions are pushed to message queue and processed later
+		BuildDeferredFormHandler() domain.DeferredFormHandler
+		// BuildConfirmFormHandler creates new instance of ConfirmFormHandler interface, for forms which valid
+		// submissions are reviewed by the user, and executed after confirmation
+		BuildConfirmFormHandler() domain.ConfirmFormHandler
 	}
 
 	// formHandlerBuilderImpl as actual implementation of FormHandlerBuilder interface
@@ -55,16 +122,46 @@ type (
 		namedFormDataDecoders    map[string]domain.FormDataDecoder
 		namedFormDataValidators  map[string]domain.FormDataValidator
 		namedFormExtensions      map[string]domain.FormExtension
+		namedSubForms            map[string]domain.SubForm
 		defaultFormDataProvider  domain.DefaultFormDataProvider
 		defaultFormDataDecoder   domain.DefaultFormDataDecoder
 		defaultFormDataValidator domain.DefaultFormDataValidator
 		validatorProvider        domain.ValidatorProvider
+		fieldEncryptor           domain.FieldEncryptor
+		cardTokenizer            card.Tokenizer
+		markdownRenderer         markdown.Renderer
+		submissionQueue          domain.SubmissionQueue
 		logger                   flamingo.Logger
-
-		formDataProvider  domain.FormDataProvider
-		formDataDecoder   domain.FormDataDecoder
-		formDataValidator domain.FormDataValidator
-		formExtensions    map[string]domain.FormExtension
+		debug                    bool
+		formDataType             reflect.Type
+		logPolicy                *logPolicy
+		reportOnlyRules          []string
+		reportOnlyExtensions     []string
+		featureFlagProvider      domain.FeatureFlagProvider
+		featureToggles           []domain.FeatureToggle
+		ruleProfiles             ruleProfiles
+		ruleProfile              string
+		validationGroup          string
+		formHandlerDecorators    []domain.FormHandlerDecorator
+		postRedirectGetKey       string
+		submissionMethods        []string
+		streamedUploads          bool
+		requestBodyDecoders      map[string]domain.RequestBodyDecoder
+		maxBodySize              int64
+		multipartMemory          int64
+		clock                    domain.Clock
+		tokenSource              domain.TokenSource
+		confirmation             *confirmation
+		warmupRegistry           *warmupRegistry
+
+		formDataProvider   domain.FormDataProvider
+		formDataDecoder    domain.FormDataDecoder
+		formDataValidator  domain.FormDataValidator
+		formDataValidators []domain.FormDataValidator
+		formExtensions     map[string]domain.FormExtension
+		subForms           []subFormBinding
+		formDataEnrichers  []domain.FormDataEnricher
+		successSteps       []domain.SuccessStep
 	}
 )
 
@@ -101,6 +198,10 @@ func (b *formHandlerBuilderImpl) SetFormService(formService domain.FormService)
 	if !set {
 		return domain.NewFormError("FormService doesn't implement any of FormDataProvider, FormDataDecoder or FormDataValidator interfaces")
 	}
+
+	if enricher, ok := formService.(domain.FormDataEnricher); ok {
+		b.AddFormDataEnricher(enricher)
+	}
 	return nil
 }
 
@@ -161,6 +262,15 @@ func (b *formHandlerBuilderImpl) SetFormDataValidator(formDataValidator domain.F
 	return b
 }
 
+// AddFormDataValidator adds form data validator, which runs after form data validator set for the handler.
+// Validators run in order they are added, until one of them stops validation via domain.ValidationInfo.
+func (b *formHandlerBuilderImpl) AddFormDataValidator(formDataValidator domain.FormDataValidator) FormHandlerBuilder {
+	if formDataValidator != nil {
+		b.formDataValidators = append(b.formDataValidators, formDataValidator)
+	}
+	return b
+}
+
 // AddNamedFormExtension adds form extension by searching named extension via dingo injector.
 // It returns error if there is no injected form extension with that name.
 func (b *formHandlerBuilderImpl) AddNamedFormExtension(name string) error {
@@ -182,6 +292,183 @@ func (b *formHandlerBuilderImpl) AddFormExtension(formExtension domain.FormExten
 	return b.addFormExtension(valueOf.Type().Name(), formExtension)
 }
 
+// AddNamedSubForm embeds sub form by searching named sub form provided via dingo injector.
+// It returns error if there is no injected sub form with that name, or if it can't be embedded.
+func (b *formHandlerBuilderImpl) AddNamedSubForm(prefix string, name string) error {
+	if subForm, ok := b.namedSubForms[name]; ok {
+		return b.AddSubForm(prefix, subForm)
+	}
+
+	return domain.NewFormErrorf(`there is no SubForm with name "%q"`, name)
+}
+
+// AddSubForm embeds sub form into the form, as field of form data named by the prefix (like "billing").
+// Form extensions of sub form are added with prefixed names (like "billing.formExtension.name").
+// It returns error if sub form doesn't implement any of FormDataProvider, FormDataDecoder or FormDataValidator
+// interfaces, or if one of its form extensions can't be added.
+func (b *formHandlerBuilderImpl) AddSubForm(prefix string, subForm domain.SubForm) error {
+	if subForm == nil {
+		return domain.NewFormErrorf(`nil passed as SubForm for prefix "%q"`, prefix)
+	}
+
+	_, isProvider := subForm.(domain.FormDataProvider)
+	_, isDecoder := subForm.(domain.FormDataDecoder)
+	validator, isValidator := subForm.(domain.FormDataValidator)
+	if !isProvider && !isDecoder && !isValidator {
+		return domain.NewFormError("SubForm doesn't implement any of FormDataProvider, FormDataDecoder or FormDataValidator interfaces")
+	}
+
+	for _, name := range subForm.SubFormExtensions() {
+		formExtension, ok := b.namedFormExtensions[name]
+		if !ok {
+			return domain.NewFormErrorf(`there is no FormExtension with name "%q"`, name)
+		}
+
+		if err := b.addFormExtension(prefix+"."+name, b.prefixFormExtension(prefix, formExtension)); err != nil {
+			return err
+		}
+	}
+
+	b.subForms = append(b.subForms, subFormBinding{
+		prefix:  prefix,
+		subForm: subForm,
+	})
+
+	if isValidator {
+		b.AddFormDataValidator(&subFormDataValidator{
+			prefix:            prefix,
+			formDataValidator: validator,
+		})
+	}
+
+	return nil
+}
+
+// AddFormDataEnricher adds form data enricher, which augments form data after successful validation.
+// Enrichers run in order they are added, until one of them reports validation errors.
+func (b *formHandlerBuilderImpl) AddFormDataEnricher(formDataEnricher domain.FormDataEnricher) FormHandlerBuilder {
+	if formDataEnricher != nil {
+		b.formDataEnrichers = append(b.formDataEnrichers, formDataEnricher)
+	}
+	return b
+}
+
+// AddSuccessStep adds step of success pipeline, which runs after valid form submission.
+// If one of steps fails, already executed steps are compensated in reverse order.
+func (b *formHandlerBuilderImpl) AddSuccessStep(successStep domain.SuccessStep) FormHandlerBuilder {
+	if successStep != nil {
+		b.successSteps = append(b.successSteps, successStep)
+	}
+	return b
+}
+
+// AddFeatureToggle adds toggle of form fields, validation rules and form extensions, which are enabled only if
+// feature flag is enabled for the request, as decided by domain.FeatureFlagProvider.
+func (b *formHandlerBuilderImpl) AddFeatureToggle(toggle domain.FeatureToggle) FormHandlerBuilder {
+	b.featureToggles = append(b.featureToggles, toggle)
+
+	return b
+}
+
+// SetDebugMode enables or disables recording of inputs and outputs of each form processing stage into domain.Form.
+func (b *formHandlerBuilderImpl) SetDebugMode(debug bool) FormHandlerBuilder {
+	b.debug = debug
+
+	return b
+}
+
+// SetFormDataType sets type of form data by example instance, so its binding plan and decode plan are compiled
+// at handler construction. Plans of other form data types are compiled on first usage. Build panics if type has
+// invalid validation rules.
+func (b *formHandlerBuilderImpl) SetFormDataType(formData interface{}) FormHandlerBuilder {
+	b.formDataType = reflect.TypeOf(formData)
+
+	return b
+}
+
+// SetReportOnlyRules sets validation rules which run in report-only mode: their violations are logged and counted
+// by metric, but not added to validation info. It overrides report-only rules defined by configuration.
+func (b *formHandlerBuilderImpl) SetReportOnlyRules(rules ...string) FormHandlerBuilder {
+	b.reportOnlyRules = rules
+
+	return b
+}
+
+// SetReportOnlyExtensions sets names of form extensions which run in report-only mode: their violations are logged
+// and counted by metric, but not added to validation info. It overrides report-only extensions defined by configuration.
+func (b *formHandlerBuilderImpl) SetReportOnlyExtensions(names ...string) FormHandlerBuilder {
+	b.reportOnlyExtensions = names
+
+	return b
+}
+
+// SetRuleProfile sets rule profile which is applied if there is no rule profile selected for the request
+// via domain.ContextWithRuleProfile. It returns error if there is no rule profile with that name in configuration.
+func (b *formHandlerBuilderImpl) SetRuleProfile(name string) error {
+	if _, ok := b.ruleProfiles[name]; !ok {
+		return domain.NewFormErrorf(`there is no rule profile with name "%q"`, name)
+	}
+
+	b.ruleProfile = name
+
+	return nil
+}
+
+// SetValidationGroup sets validation group, so only form fields which belong to it are validated (like "create"
+// or "update"). Fields belong to groups listed by their `validate-groups` tag, or to all groups if there is no tag.
+// Empty group validates all fields.
+func (b *formHandlerBuilderImpl) SetValidationGroup(group string) FormHandlerBuilder {
+	b.validationGroup = group
+
+	return b
+}
+
+// SetSubmissionQueue sets message queue of valid submissions handled by deferred form handler,
+// and overrides default one.
+func (b *formHandlerBuilderImpl) SetSubmissionQueue(submissionQueue domain.SubmissionQueue) FormHandlerBuilder {
+	b.submissionQueue = submissionQueue
+	return b
+}
+
+// SetPostRedirectGet enables Post/Redirect/Get: failed POST submission is stored in web session under the key,
+// and restored by next unsubmitted form. Empty key disables it.
+func (b *formHandlerBuilderImpl) SetPostRedirectGet(sessionKey string) FormHandlerBuilder {
+	b.postRedirectGetKey = sessionKey
+
+	return b
+}
+
+// SetSubmissionMethods sets methods of requests which are treated as submissions by HandleForm (like PUT, PATCH
+// and DELETE for REST-style controllers), and whose bodies are read by HandleSubmittedForm. By default, only POST
+// requests are treated as submissions.
+func (b *formHandlerBuilderImpl) SetSubmissionMethods(methods ...string) FormHandlerBuilder {
+	b.submissionMethods = normalizeSubmissionMethods(methods)
+
+	return b
+}
+
+// SetStreamedUploads enables or disables streaming of uploaded files: multipart body is read until the first file,
+// which is read directly from the body while form is handled, instead of buffering it in memory or temporary file.
+func (b *formHandlerBuilderImpl) SetStreamedUploads(streamed bool) FormHandlerBuilder {
+	b.streamedUploads = streamed
+
+	return b
+}
+
+// SetRequestBodyLimits sets maximal size of request body and maximal number of bytes of multipart body kept
+// in memory, overriding configured limits for this form handler. Limits which are not positive keep configured
+// ones. Form handler returns domain.ErrRequestBodyTooLarge for larger bodies.
+func (b *formHandlerBuilderImpl) SetRequestBodyLimits(maxSize int64, multipartMemory int64) FormHandlerBuilder {
+	if maxSize > 0 {
+		b.maxBodySize = maxSize
+	}
+	if multipartMemory > 0 {
+		b.multipartMemory = multipartMemory
+	}
+
+	return b
+}
+
 // Must wraps builder method execution and returns instance of builder if there is no error.
 // It panics if there is an error.
 func (b *formHandlerBuilderImpl) Must(err error) FormHandlerBuilder {
@@ -192,8 +479,35 @@ func (b *formHandlerBuilderImpl) Must(err error) FormHandlerBuilder {
 	return b
 }
 
-// Build creates new instance of FormHandler interface
+// Build creates new instance of FormHandler interface, wrapped by decorators of form handlers in order of their bindings
 func (b *formHandlerBuilderImpl) Build() domain.FormHandler {
+	var formHandler domain.FormHandler = b.build()
+	for _, decorator := range b.formHandlerDecorators {
+		formHandler = decorator.Decorate(formHandler)
+	}
+
+	return formHandler
+}
+
+// BuildSearchFormHandler creates new instance of SearchFormHandler interface, for search/filter forms
+func (b *formHandlerBuilderImpl) BuildSearchFormHandler() domain.SearchFormHandler {
+	return b.build()
+}
+
+// BuildDeferredFormHandler creates new instance of DeferredFormHandler interface, for forms which valid
+// submissions are pushed to message queue and processed later
+func (b *formHandlerBuilderImpl) BuildDeferredFormHandler() domain.DeferredFormHandler {
+	return b.build()
+}
+
+// BuildConfirmFormHandler creates new instance of ConfirmFormHandler interface, for forms which valid
+// submissions are reviewed by the user, and executed after confirmation
+func (b *formHandlerBuilderImpl) BuildConfirmFormHandler() domain.ConfirmFormHandler {
+	return b.build()
+}
+
+// build creates new instance of form handler
+func (b *formHandlerBuilderImpl) build() *formHandlerImpl {
 	formDataProvider := b.formDataProvider
 	if formDataProvider == nil {
 		formDataProvider = b.defaultFormDataProvider
@@ -209,17 +523,91 @@ func (b *formHandlerBuilderImpl) Build() domain.FormHandler {
 		formDataValidator = b.defaultFormDataValidator
 	}
 
-	return &formHandlerImpl{
+	var plan *bindingPlan
+	if b.formDataType != nil {
+		plan = loadBindingPlan(b.formDataType)
+		// known form data type is checked at handler construction, so invalid rules are found at startup
+		if plan.ruleErr != nil {
+			panic(plan.ruleErr.Error())
+		}
+		formdata.CompileDecodePlan(b.formDataType)
+	}
+
+	handler := &formHandlerImpl{
 		defaultFormDataProvider:  b.defaultFormDataProvider,
 		defaultFormDataDecoder:   b.defaultFormDataDecoder,
 		defaultFormDataValidator: b.defaultFormDataValidator,
 		formDataProvider:         b.formDataProvider,
 		formDataDecoder:          b.formDataDecoder,
 		formDataValidator:        b.formDataValidator,
+		formDataValidators:       b.formDataValidators,
 		formExtensions:           b.formExtensions,
+		extensionOrder:           orderExtensions(b.formExtensions),
+		formDataEnrichers:        b.formDataEnrichers,
+		successSteps:             b.successSteps,
 		validatorProvider:        b.validatorProvider,
+		fieldEncryptor:           b.fieldEncryptor,
+		cardTokenizer:            b.cardTokenizer,
+		markdownRenderer:         b.markdownRenderer,
+		submissionQueue:          b.submissionQueue,
 		logger:                   b.logger,
+		debug:                    b.debug,
+		bindingPlan:              plan,
+		logPolicy:                b.logPolicy,
+		reportOnly:               newReportOnly(b.reportOnlyRules, b.reportOnlyExtensions),
+		featureToggles:           newFeatureToggles(b.featureFlagProvider, b.featureToggles),
+		ruleProfiles:             b.ruleProfiles,
+		ruleProfile:              b.ruleProfile,
+		validationGroup:          b.validationGroup,
+		postRedirectGetKey:       b.postRedirectGetKey,
+		submissionMethods:        b.submissionMethods,
+		streamedUploads:          b.streamedUploads,
+		requestBodyDecoders:      requestBodyDecoders(b.requestBodyDecoders, b.multipartMemory),
+		maxBodySize:              b.maxBodySize,
+		multipartMemory:          b.multipartMemory,
+		clock:                    b.clock,
+		tokenSource:              b.tokenSource,
+		confirmation:             b.confirmation,
 	}
+
+	// sub forms wrap provider and decoder of the form, so they operate on already provided and decoded form data
+	if len(b.subForms) > 0 {
+		handler.formDataProvider = &subFormDataProvider{
+			formDataProvider: formDataProvider,
+			subForms:         b.subForms,
+		}
+		handler.formDataDecoder = &subFormDataDecoder{
+			formDataDecoder: formDataDecoder,
+			subForms:        b.subForms,
+		}
+	}
+
+	b.warmupRegistry.register(handler)
+
+	return handler
+}
+
+// prefixFormExtension wraps form extension of sub form, so it operates on values of the prefix only
+func (b *formHandlerBuilderImpl) prefixFormExtension(prefix string, formExtension domain.FormExtension) *prefixedFormExtension {
+	prefixed := &prefixedFormExtension{
+		prefix:            prefix,
+		formExtension:     formExtension,
+		formDataProvider:  b.defaultFormDataProvider,
+		formDataDecoder:   b.defaultFormDataDecoder,
+		formDataValidator: b.defaultFormDataValidator,
+	}
+
+	if provider, ok := formExtension.(domain.FormDataProvider); ok {
+		prefixed.formDataProvider = provider
+	}
+	if decoder, ok := formExtension.(domain.FormDataDecoder); ok {
+		prefixed.formDataDecoder = decoder
+	}
+	if validator, ok := formExtension.(domain.FormDataValidator); ok {
+		prefixed.formDataValidator = validator
+	}
+
+	return prefixed
 }
 
 func (b *formHandlerBuilderImpl) addFormExtension(name string, formExtension domain.FormExtension) error {
@@ -233,8 +621,14 @@ func (b *formHandlerBuilderImpl) addFormExtension(name string, formExtension dom
 	if _, ok := formExtension.(domain.FormDataValidator); ok {
 		implements = true
 	}
+	if _, ok := formExtension.(domain.FormValidityGate); ok {
+		implements = true
+	}
+	if _, ok := formExtension.(domain.FormResultObserver); ok {
+		implements = true
+	}
 	if !implements {
-		return domain.NewFormError("FormExtension doesn't implement any of FormDataProvider, FormDataDecoder or FormDataValidator interfaces")
+		return domain.NewFormError("FormExtension doesn't implement any of FormDataProvider, FormDataDecoder, FormDataValidator, FormValidityGate or FormResultObserver interfaces")
 	}
 
 	if b.formExtensions == nil {
@@ -1,11 +1,15 @@
 package application
 
 import (
+	"net/http"
+	"reflect"
 	"testing"
 
 	"flamingo.me/flamingo/v3/framework/flamingo"
 	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/formdata"
 	"flamingo.me/form/domain/mocks"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -38,15 +42,30 @@ type (
 		secondNamedExtension *mocks.CompleteFormService
 
 		validatorProvider *mocks.ValidatorProvider
+		fieldEncryptor    *mocks.FieldEncryptor
 
 		logger *flamingo.NullLogger
 	}
+
+	formHandlerBuilderTestEnrichingService struct {
+		*mocks.FormDataValidator
+		*mocks.FormDataEnricher
+	}
+
+	formHandlerBuilderTestSubForm struct {
+		*subFormTestAddressForm
+		extensions []string
+	}
 )
 
 func TestFormHandlerBuilderImplTestSuite(t *testing.T) {
 	suite.Run(t, &FormHandlerBuilderImplTestSuite{})
 }
 
+func (s *formHandlerBuilderTestSubForm) SubFormExtensions() []string {
+	return s.extensions
+}
+
 func (t *FormHandlerBuilderImplTestSuite) SetupTest() {
 	t.firstNamedService = &mocks.CompleteFormService{}
 	t.secondNamedService = &mocks.CompleteFormService{}
@@ -71,6 +90,7 @@ func (t *FormHandlerBuilderImplTestSuite) SetupTest() {
 	t.secondNamedExtension = &mocks.CompleteFormService{}
 
 	t.validatorProvider = &mocks.ValidatorProvider{}
+	t.fieldEncryptor = &mocks.FieldEncryptor{}
 
 	t.logger = &flamingo.NullLogger{}
 
@@ -99,6 +119,7 @@ func (t *FormHandlerBuilderImplTestSuite) SetupTest() {
 		defaultFormDataDecoder:   t.defaultDecoder,
 		defaultFormDataValidator: t.defaultValidator,
 		validatorProvider:        t.validatorProvider,
+		fieldEncryptor:           t.fieldEncryptor,
 		logger:                   t.logger,
 	}
 }
@@ -127,6 +148,7 @@ func (t *FormHandlerBuilderImplTestSuite) TearDownTest() {
 	t.secondNamedExtension.AssertExpectations(t.T())
 
 	t.validatorProvider.AssertExpectations(t.T())
+	t.fieldEncryptor.AssertExpectations(t.T())
 }
 
 func (t *FormHandlerBuilderImplTestSuite) TestSetFormService_Panic() {
@@ -187,6 +209,19 @@ func (t *FormHandlerBuilderImplTestSuite) TestSetFormService_CompleteFormService
 	t.Exactly(t.service, t.builder.formDataValidator)
 }
 
+func (t *FormHandlerBuilderImplTestSuite) TestSetFormService_FormDataEnricher() {
+	service := &formHandlerBuilderTestEnrichingService{
+		FormDataValidator: &mocks.FormDataValidator{},
+		FormDataEnricher:  &mocks.FormDataEnricher{},
+	}
+
+	err := t.builder.SetFormService(service)
+	t.NoError(err)
+
+	t.Exactly(service, t.builder.formDataValidator)
+	t.Equal([]domain.FormDataEnricher{service}, t.builder.formDataEnrichers)
+}
+
 func (t *FormHandlerBuilderImplTestSuite) TestSetNamedFormService_Panic() {
 	t.Panics(func() {
 		t.builder.Must(t.builder.SetNamedFormService("third"))
@@ -296,6 +331,26 @@ func (t *FormHandlerBuilderImplTestSuite) TestAddFormExtension_CompleteFormServi
 	}, t.builder.formExtensions)
 }
 
+func (t *FormHandlerBuilderImplTestSuite) TestAddFormExtension_FormResultObserver() {
+	observer := &mocks.FormResultObserver{}
+
+	err := t.builder.AddFormExtension(observer)
+	t.NoError(err)
+	t.Equal(map[string]domain.FormExtension{
+		"FormResultObserver": observer,
+	}, t.builder.formExtensions)
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestAddFormExtension_FormValidityGate() {
+	gate := &mocks.FormValidityGate{}
+
+	err := t.builder.AddFormExtension(gate)
+	t.NoError(err)
+	t.Equal(map[string]domain.FormExtension{
+		"FormValidityGate": gate,
+	}, t.builder.formExtensions)
+}
+
 func (t *FormHandlerBuilderImplTestSuite) TestAddNamedFormExtension_Panic() {
 	t.Panics(func() {
 		t.builder.Must(t.builder.AddNamedFormExtension("third"))
@@ -312,13 +367,251 @@ func (t *FormHandlerBuilderImplTestSuite) TestAddNamedFormExtension_CompleteForm
 	}, t.builder.formExtensions)
 }
 
+func (t *FormHandlerBuilderImplTestSuite) TestAddSubForm_Panic() {
+	t.Panics(func() {
+		t.builder.Must(t.builder.AddSubForm("billing", nil))
+	})
+
+	t.Panics(func() {
+		t.builder.Must(t.builder.AddSubForm("billing", &mocks.SubForm{}))
+	})
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestAddSubForm_MissingFormExtension() {
+	subForm := &subFormTestAddressForm{}
+	t.builder.namedSubForms = map[string]domain.SubForm{"address": &formHandlerBuilderTestSubForm{
+		subFormTestAddressForm: subForm,
+		extensions:             []string{"third"},
+	}}
+
+	t.Error(t.builder.AddNamedSubForm("billing", "address"))
+	t.Empty(t.builder.subForms)
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestAddSubForm() {
+	subForm := &formHandlerBuilderTestSubForm{
+		subFormTestAddressForm: &subFormTestAddressForm{},
+		extensions:             []string{"first"},
+	}
+
+	t.NoError(t.builder.AddSubForm("billing", subForm))
+
+	t.Equal([]subFormBinding{
+		{prefix: "billing", subForm: subForm},
+	}, t.builder.subForms)
+	t.Equal(map[string]domain.FormExtension{
+		"billing.first": &prefixedFormExtension{
+			prefix:            "billing",
+			formExtension:     t.firstNamedExtension,
+			formDataProvider:  t.firstNamedExtension,
+			formDataDecoder:   t.firstNamedExtension,
+			formDataValidator: t.firstNamedExtension,
+		},
+	}, t.builder.formExtensions)
+	t.Equal([]domain.FormDataValidator{
+		&subFormDataValidator{
+			prefix:            "billing",
+			formDataValidator: subForm,
+		},
+	}, t.builder.formDataValidators)
+
+	handler := t.builder.Build().(*formHandlerImpl)
+	t.Equal(&subFormDataProvider{
+		formDataProvider: t.defaultProvider,
+		subForms:         t.builder.subForms,
+	}, handler.formDataProvider)
+	t.Equal(&subFormDataDecoder{
+		formDataDecoder: t.defaultDecoder,
+		subForms:        t.builder.subForms,
+	}, handler.formDataDecoder)
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestAddNamedSubForm_Panic() {
+	t.Panics(func() {
+		t.builder.Must(t.builder.AddNamedSubForm("billing", "address"))
+	})
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestAddNamedSubForm() {
+	subForm := &subFormTestAddressForm{}
+	t.builder.namedSubForms = map[string]domain.SubForm{"address": subForm}
+
+	t.NoError(t.builder.AddNamedSubForm("shipping", "address"))
+
+	t.Equal([]subFormBinding{
+		{prefix: "shipping", subForm: subForm},
+	}, t.builder.subForms)
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestSetDebugMode() {
+	t.False(t.builder.debug)
+
+	t.Exactly(t.builder, t.builder.SetDebugMode(true))
+	t.True(t.builder.debug)
+	t.True(t.builder.Build().(*formHandlerImpl).debug)
+
+	t.builder.SetDebugMode(false)
+	t.False(t.builder.debug)
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestSetFormDataType() {
+	type formData struct {
+		Email string `form:"email" validate:"required"`
+	}
+
+	t.Exactly(t.builder, t.builder.SetFormDataType(formData{}))
+	t.Equal(reflect.TypeOf(formData{}), t.builder.formDataType)
+
+	handler := t.builder.Build().(*formHandlerImpl)
+	t.Exactly(loadBindingPlan(reflect.TypeOf(formData{})), handler.bindingPlan)
+	t.Exactly(handler.bindingPlan, handler.bindingPlanOf(&formData{}))
+	t.Exactly(emptyBindingPlan, handler.bindingPlanOf(map[string]string{}))
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestSetFormDataType_InvalidRules() {
+	type formData struct {
+		Code string `form:"code" validate:"pattern=("`
+	}
+
+	t.builder.SetFormDataType(formData{})
+	t.Panics(func() {
+		t.builder.Build()
+	})
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestSetReportOnly() {
+	t.Nil(t.builder.Build().(*formHandlerImpl).reportOnly)
+
+	t.Exactly(t.builder, t.builder.SetReportOnlyRules("required", "email"))
+	t.Exactly(t.builder, t.builder.SetReportOnlyExtensions("formExtension.lockout"))
+
+	t.Equal(&reportOnly{
+		rules: map[string]bool{
+			"required": true,
+			"email":    true,
+		},
+		extensions: map[string]bool{
+			"formExtension.lockout": true,
+		},
+	}, t.builder.Build().(*formHandlerImpl).reportOnly)
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestSetRuleProfile() {
+	t.builder.ruleProfiles = ruleProfiles{
+		"US": &ruleProfile{},
+	}
+
+	t.Error(t.builder.SetRuleProfile("DE"))
+	t.Empty(t.builder.ruleProfile)
+
+	t.NoError(t.builder.SetRuleProfile("US"))
+	t.Equal("US", t.builder.Build().(*formHandlerImpl).ruleProfile)
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestSetValidationGroup() {
+	t.Exactly(t.builder, t.builder.SetValidationGroup("create"))
+	t.Equal("create", t.builder.Build().(*formHandlerImpl).validationGroup)
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestAddFeatureToggle() {
+	first := domain.FeatureToggle{Feature: "newsletter", Fields: []string{"newsletter"}}
+	second := domain.FeatureToggle{Feature: "birthday", Rules: []string{"minimumage"}}
+
+	t.Exactly(t.builder, t.builder.AddFeatureToggle(first))
+	t.Exactly(t.builder, t.builder.AddFeatureToggle(second))
+
+	// feature toggles are ignored without feature flag provider
+	t.Nil(t.builder.Build().(*formHandlerImpl).featureToggles)
+
+	provider := &mocks.FeatureFlagProvider{}
+	t.builder.featureFlagProvider = provider
+
+	t.Equal(&featureToggles{
+		provider: provider,
+		toggles:  []domain.FeatureToggle{first, second},
+	}, t.builder.Build().(*formHandlerImpl).featureToggles)
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestAddFormDataValidator() {
+	first := &mocks.FormDataValidator{}
+	second := &mocks.FormDataValidator{}
+
+	t.Exactly(t.builder, t.builder.AddFormDataValidator(first))
+	t.Exactly(t.builder, t.builder.AddFormDataValidator(nil))
+	t.Exactly(t.builder, t.builder.AddFormDataValidator(second))
+
+	t.Equal([]domain.FormDataValidator{first, second}, t.builder.Build().(*formHandlerImpl).formDataValidators)
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestAddFormDataEnricher() {
+	first := &mocks.FormDataEnricher{}
+	second := &mocks.FormDataEnricher{}
+
+	t.Exactly(t.builder, t.builder.AddFormDataEnricher(first))
+	t.Exactly(t.builder, t.builder.AddFormDataEnricher(nil))
+	t.Exactly(t.builder, t.builder.AddFormDataEnricher(second))
+
+	t.Equal([]domain.FormDataEnricher{first, second}, t.builder.Build().(*formHandlerImpl).formDataEnrichers)
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestAddSuccessStep() {
+	first := &mocks.SuccessStep{}
+	second := &mocks.SuccessStep{}
+
+	t.Exactly(t.builder, t.builder.AddSuccessStep(first))
+	t.Exactly(t.builder, t.builder.AddSuccessStep(nil))
+	t.Exactly(t.builder, t.builder.AddSuccessStep(second))
+
+	t.Equal([]domain.SuccessStep{first, second}, t.builder.Build().(*formHandlerImpl).successSteps)
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestSetSubmissionQueue() {
+	queue := &mocks.SubmissionQueue{}
+
+	t.Exactly(t.builder, t.builder.SetSubmissionQueue(queue))
+	t.Exactly(queue, t.builder.BuildDeferredFormHandler().(*formHandlerImpl).submissionQueue)
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestSetPostRedirectGet() {
+	t.Exactly(t.builder, t.builder.SetPostRedirectGet("form.registration"))
+	t.Equal("form.registration", t.builder.Build().(*formHandlerImpl).postRedirectGetKey)
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestSetSubmissionMethods() {
+	t.Exactly(t.builder, t.builder.SetSubmissionMethods("post", "PUT", "put", "delete"))
+	t.Equal([]string{http.MethodPost, http.MethodPut, http.MethodDelete}, t.builder.Build().(*formHandlerImpl).submissionMethods)
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestSetStreamedUploads() {
+	t.Exactly(t.builder, t.builder.SetStreamedUploads(true))
+	t.True(t.builder.Build().(*formHandlerImpl).streamedUploads)
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestSetRequestBodyLimits() {
+	t.builder.maxBodySize = 2048
+	t.Exactly(t.builder, t.builder.SetRequestBodyLimits(1024, 0))
+
+	handler := t.builder.Build().(*formHandlerImpl)
+	t.Equal(int64(1024), handler.maxBodySize)
+	t.Equal(int64(0), handler.multipartMemory)
+	t.Nil(handler.requestBodyDecoders)
+
+	t.builder.SetRequestBodyLimits(0, 512)
+
+	handler = t.builder.Build().(*formHandlerImpl)
+	t.Equal(int64(1024), handler.maxBodySize)
+	t.Equal(&formdata.MultipartBodyDecoder{MaxMemory: 512}, handler.requestBodyDecoders["multipart/form-data"])
+}
+
 func (t *FormHandlerBuilderImplTestSuite) TestBuild_Empty() {
 	t.Equal(&formHandlerImpl{
 		defaultFormDataProvider:  t.defaultProvider,
 		defaultFormDataDecoder:   t.defaultDecoder,
 		defaultFormDataValidator: t.defaultValidator,
 		formExtensions:           map[string]domain.FormExtension(nil),
+		extensionOrder:           []string{},
 		validatorProvider:        t.validatorProvider,
+		fieldEncryptor:           t.fieldEncryptor,
 		logger:                   t.logger,
 	}, t.builder.Build())
 }
@@ -339,7 +632,45 @@ func (t *FormHandlerBuilderImplTestSuite) TestBuild_Full() {
 		formExtensions: map[string]domain.FormExtension{
 			"CompleteFormService": t.service,
 		},
+		extensionOrder:    []string{"CompleteFormService"},
 		validatorProvider: t.validatorProvider,
+		fieldEncryptor:    t.fieldEncryptor,
 		logger:            t.logger,
 	}, t.builder.Build())
 }
+
+func (t *FormHandlerBuilderImplTestSuite) TestBuild_Decorators() {
+	inner := &mocks.FormHandler{}
+	outer := &mocks.FormHandler{}
+
+	firstDecorator := &mocks.FormHandlerDecorator{}
+	firstDecorator.On("Decorate", mock.AnythingOfType("*application.formHandlerImpl")).Return(inner).Once()
+	secondDecorator := &mocks.FormHandlerDecorator{}
+	secondDecorator.On("Decorate", inner).Return(outer).Once()
+
+	t.builder.formHandlerDecorators = []domain.FormHandlerDecorator{firstDecorator, secondDecorator}
+
+	t.Exactly(outer, t.builder.Build())
+	t.IsType(&formHandlerImpl{}, t.builder.BuildSearchFormHandler())
+	t.IsType(&formHandlerImpl{}, t.builder.BuildDeferredFormHandler())
+	t.IsType(&formHandlerImpl{}, t.builder.BuildConfirmFormHandler())
+
+	firstDecorator.AssertExpectations(t.T())
+	secondDecorator.AssertExpectations(t.T())
+}
+
+func (t *FormHandlerBuilderImplTestSuite) TestBuildSearchFormHandler() {
+	t.builder.SetFormDataProvider(t.provider)
+
+	t.Equal(&formHandlerImpl{
+		defaultFormDataProvider:  t.defaultProvider,
+		defaultFormDataDecoder:   t.defaultDecoder,
+		defaultFormDataValidator: t.defaultValidator,
+		formDataProvider:         t.provider,
+		formExtensions:           map[string]domain.FormExtension(nil),
+		extensionOrder:           []string{},
+		validatorProvider:        t.validatorProvider,
+		fieldEncryptor:           t.fieldEncryptor,
+		logger:                   t.logger,
+	}, t.builder.BuildSearchFormHandler())
+}
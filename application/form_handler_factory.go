@@ -1,8 +1,13 @@
 package application
 
 import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/config"
 	"flamingo.me/flamingo/v3/framework/flamingo"
 	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/card"
+	"flamingo.me/form/domain/markdown"
 )
 
 type (
@@ -29,6 +34,10 @@ type (
 		CreateFormHandlerWithFormServices(formDataProvider domain.FormDataProvider, formDataDecoder domain.FormDataDecoder, formDataValidator domain.FormDataValidator, formExtensions ...string) domain.FormHandler
 		// GetFormHandlerBuilder returns FomHandlerBuilder for creating more complex instances of form handler.
 		GetFormHandlerBuilder() FormHandlerBuilder
+		// Warmup as method for preparing all form handlers built so far for the first request (like at boot),
+		// so large forms don't cause latency spikes of first requests. Form handlers built after the first warm-up
+		// are not warmed up.
+		Warmup(ctx context.Context) error
 	}
 
 	// FormHandlerFactoryImpl as actual implementation of FormHandlerFactory interface
@@ -38,11 +47,30 @@ type (
 		namedFormDataDecoders    map[string]domain.FormDataDecoder
 		namedFormDataValidators  map[string]domain.FormDataValidator
 		namedFormExtensions      map[string]domain.FormExtension
+		namedSubForms            map[string]domain.SubForm
+		requestBodyDecoders      map[string]domain.RequestBodyDecoder
 		defaultFormDataProvider  domain.DefaultFormDataProvider
 		defaultFormDataDecoder   domain.DefaultFormDataDecoder
 		defaultFormDataValidator domain.DefaultFormDataValidator
 		validatorProvider        domain.ValidatorProvider
+		fieldEncryptor           domain.FieldEncryptor
+		cardTokenizer            card.Tokenizer
+		markdownRenderer         markdown.Renderer
+		submissionQueue          domain.SubmissionQueue
 		logger                   flamingo.Logger
+		debug                    bool
+		logPolicy                *logPolicy
+		reportOnlyRules          []string
+		reportOnlyExtensions     []string
+		featureFlagProvider      domain.FeatureFlagProvider
+		ruleProfiles             ruleProfiles
+		formHandlerDecorators    []domain.FormHandlerDecorator
+		clock                    domain.Clock
+		tokenSource              domain.TokenSource
+		confirmation             *confirmation
+		maxBodySize              int64
+		multipartMemory          int64
+		warmupRegistry           *warmupRegistry
 	}
 )
 
@@ -55,22 +83,97 @@ func (f *FormHandlerFactoryImpl) Inject(
 	d map[string]domain.FormDataDecoder,
 	v map[string]domain.FormDataValidator,
 	e map[string]domain.FormExtension,
+	sf map[string]domain.SubForm,
+	bd map[string]domain.RequestBodyDecoder,
 	dp domain.DefaultFormDataProvider,
 	dd domain.DefaultFormDataDecoder,
 	dv domain.DefaultFormDataValidator,
 	vp domain.ValidatorProvider,
+	fe domain.FieldEncryptor,
+	ct card.Tokenizer,
+	mr markdown.Renderer,
+	sq domain.SubmissionQueue,
+	ff domain.FeatureFlagProvider,
+	hd []domain.FormHandlerDecorator,
+	cl domain.Clock,
+	ts domain.TokenSource,
 	l flamingo.Logger,
+	cfg *struct {
+		Debug bool `inject:"config:form.debug"`
+	},
+	lc *struct {
+		DefaultLevel string     `inject:"config:form.logging.defaultLevel"`
+		Levels       config.Map `inject:"config:form.logging.levels"`
+		Sampling     config.Map `inject:"config:form.logging.sampling"`
+	},
+	ro *struct {
+		Rules      config.Slice `inject:"config:form.reportOnly.rules"`
+		Extensions config.Slice `inject:"config:form.reportOnly.extensions"`
+	},
+	rp *struct {
+		Profiles config.Map `inject:"config:form.ruleProfiles"`
+	},
+	cc *struct {
+		FieldName string `inject:"config:form.confirmation.fieldName"`
+		MaxAge    string `inject:"config:form.confirmation.maxAge"`
+		Secret    string `inject:"config:form.confirmation.secret"`
+	},
+	bl *struct {
+		MaxSize         int `inject:"config:form.requestBody.maxSize"`
+		MultipartMemory int `inject:"config:form.requestBody.multipartMemory"`
+	},
 ) {
 	f.namedFormServices = s
 	f.namedFormDataProviders = p
 	f.namedFormDataDecoders = d
 	f.namedFormDataValidators = v
 	f.namedFormExtensions = e
+	f.namedSubForms = sf
+	f.requestBodyDecoders = bd
 	f.defaultFormDataProvider = dp
 	f.defaultFormDataDecoder = dd
 	f.defaultFormDataValidator = dv
 	f.validatorProvider = vp
+	f.fieldEncryptor = fe
+	f.cardTokenizer = ct
+	f.markdownRenderer = mr
+	f.submissionQueue = sq
+	f.featureFlagProvider = ff
+	f.formHandlerDecorators = hd
+	f.clock = cl
+	f.tokenSource = ts
 	f.logger = l
+	f.warmupRegistry = &warmupRegistry{}
+
+	if cfg != nil {
+		f.debug = cfg.Debug
+	}
+
+	if lc != nil {
+		f.logPolicy = newLogPolicy(lc.DefaultLevel, lc.Levels, lc.Sampling)
+	}
+
+	if ro != nil {
+		if err := ro.Rules.MapInto(&f.reportOnlyRules); err != nil {
+			panic(err.Error())
+		}
+		if err := ro.Extensions.MapInto(&f.reportOnlyExtensions); err != nil {
+			panic(err.Error())
+		}
+	}
+
+	if rp != nil {
+		f.ruleProfiles = newRuleProfiles(rp.Profiles)
+	}
+
+	if cc != nil {
+		f.confirmation = newConfirmation(cc.FieldName, cc.MaxAge, cc.Secret, f.tokenSource)
+	}
+
+	if bl != nil {
+		f.maxBodySize = int64(bl.MaxSize)
+		f.multipartMemory = int64(bl.MultipartMemory)
+	}
 }
 
 // CreateSimpleFormHandler as method for creating the simplest form handler instance which uses
@@ -117,14 +220,46 @@ func (f *FormHandlerFactoryImpl) GetFormHandlerBuilder() FormHandlerBuilder {
 		namedFormDataDecoders:    f.namedFormDataDecoders,
 		namedFormDataValidators:  f.namedFormDataValidators,
 		namedFormExtensions:      f.namedFormExtensions,
+		namedSubForms:            f.namedSubForms,
+		requestBodyDecoders:      f.requestBodyDecoders,
 		defaultFormDataProvider:  f.defaultFormDataProvider,
 		defaultFormDataDecoder:   f.defaultFormDataDecoder,
 		defaultFormDataValidator: f.defaultFormDataValidator,
 		validatorProvider:        f.validatorProvider,
+		fieldEncryptor:           f.fieldEncryptor,
+		cardTokenizer:            f.cardTokenizer,
+		markdownRenderer:         f.markdownRenderer,
+		submissionQueue:          f.submissionQueue,
 		logger:                   f.logger,
+		debug:                    f.debug,
+		logPolicy:                f.logPolicy,
+		reportOnlyRules:          f.reportOnlyRules,
+		reportOnlyExtensions:     f.reportOnlyExtensions,
+		featureFlagProvider:      f.featureFlagProvider,
+		ruleProfiles:             f.ruleProfiles,
+		formHandlerDecorators:    f.formHandlerDecorators,
+		clock:                    f.clock,
+		tokenSource:              f.tokenSource,
+		confirmation:             f.confirmation,
+		maxBodySize:              f.maxBodySize,
+		multipartMemory:          f.multipartMemory,
+		warmupRegistry:           f.warmupRegistry,
 	}
 }
 
+// Warmup prepares all form handlers built by builders of the factory so far for the first request. All form handlers
+// are warmed up, even if warm-up of some of them fails, and error of the first failed warm-up is returned.
+func (f *FormHandlerFactoryImpl) Warmup(ctx context.Context) error {
+	var firstErr error
+	for _, handler := range f.warmupRegistry.seal() {
+		if err := handler.warmup(ctx); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
 // attachExtensions method for attaching form extension to the list of extensions.
 // It expects string as form extension's name or actual instance of form extension
 func (f *FormHandlerFactoryImpl) attachExtensions(builder FormHandlerBuilder, formExtensions ...string) {
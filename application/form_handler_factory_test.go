@@ -1,11 +1,17 @@
 package application
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"flamingo.me/flamingo/v3/framework/config"
 	"flamingo.me/flamingo/v3/framework/flamingo"
 	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/formdata"
 	"flamingo.me/form/domain/mocks"
+	"flamingo.me/form/formtest"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -37,7 +43,9 @@ type (
 		firstNamedExtension  *mocks.CompleteFormService
 		secondNamedExtension *mocks.CompleteFormService
 
-		validatorProvider *mocks.ValidatorProvider
+		validatorProvider   *mocks.ValidatorProvider
+		fieldEncryptor      *mocks.FieldEncryptor
+		featureFlagProvider *mocks.FeatureFlagProvider
 
 		logger *flamingo.NullLogger
 	}
@@ -71,6 +79,8 @@ func (t *FormHandlerFactoryImplTestSuite) SetupTest() {
 	t.secondNamedExtension = &mocks.CompleteFormService{}
 
 	t.validatorProvider = &mocks.ValidatorProvider{}
+	t.fieldEncryptor = &mocks.FieldEncryptor{}
+	t.featureFlagProvider = &mocks.FeatureFlagProvider{}
 
 	t.logger = &flamingo.NullLogger{}
 
@@ -96,11 +106,27 @@ func (t *FormHandlerFactoryImplTestSuite) SetupTest() {
 			"first":  t.firstNamedExtension,
 			"second": t.secondNamedExtension,
 		},
+		nil,
+		nil,
 		t.defaultProvider,
 		t.defaultDecoder,
 		t.defaultValidator,
 		t.validatorProvider,
+		t.fieldEncryptor,
+		nil,
+		nil,
+		nil,
+		t.featureFlagProvider,
+		nil,
+		nil,
+		nil,
 		t.logger,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 }
 
@@ -128,6 +154,8 @@ func (t *FormHandlerFactoryImplTestSuite) TearDownTest() {
 	t.secondNamedExtension.AssertExpectations(t.T())
 
 	t.validatorProvider.AssertExpectations(t.T())
+	t.fieldEncryptor.AssertExpectations(t.T())
+	t.featureFlagProvider.AssertExpectations(t.T())
 }
 
 func (t *FormHandlerFactoryImplTestSuite) TestCreateSimpleFormHandler() {
@@ -136,7 +164,9 @@ func (t *FormHandlerFactoryImplTestSuite) TestCreateSimpleFormHandler() {
 		defaultFormDataDecoder:   t.defaultDecoder,
 		defaultFormDataValidator: t.defaultValidator,
 		formExtensions:           map[string]domain.FormExtension(nil),
+		extensionOrder:           []string{},
 		validatorProvider:        t.validatorProvider,
+		fieldEncryptor:           t.fieldEncryptor,
 		logger:                   t.logger,
 	}, t.factory.CreateSimpleFormHandler())
 }
@@ -153,7 +183,9 @@ func (t *FormHandlerFactoryImplTestSuite) TestCreateFormHandlerWithFormService()
 			"first":  t.firstNamedExtension,
 			"second": t.secondNamedExtension,
 		},
+		extensionOrder:    []string{"first", "second"},
 		validatorProvider: t.validatorProvider,
+		fieldEncryptor:    t.fieldEncryptor,
 		logger:            t.logger,
 	}, t.factory.CreateFormHandlerWithFormService(t.service, "first", "second"))
 }
@@ -170,7 +202,9 @@ func (t *FormHandlerFactoryImplTestSuite) TestCreateFormHandlerWithFormServices(
 			"first":  t.firstNamedExtension,
 			"second": t.secondNamedExtension,
 		},
+		extensionOrder:    []string{"first", "second"},
 		validatorProvider: t.validatorProvider,
+		fieldEncryptor:    t.fieldEncryptor,
 		logger:            t.logger,
 	}, t.factory.CreateFormHandlerWithFormServices(t.provider, t.decoder, t.validator, "first", "second"))
 }
@@ -201,6 +235,192 @@ func (t *FormHandlerFactoryImplTestSuite) TestGetFormHandlerBuilder() {
 		defaultFormDataDecoder:   t.defaultDecoder,
 		defaultFormDataValidator: t.defaultValidator,
 		validatorProvider:        t.validatorProvider,
+		fieldEncryptor:           t.fieldEncryptor,
 		logger:                   t.logger,
+		featureFlagProvider:      t.featureFlagProvider,
+		warmupRegistry:           t.factory.warmupRegistry,
 	}, t.factory.GetFormHandlerBuilder())
 }
+
+func (t *FormHandlerFactoryImplTestSuite) TestWarmup() {
+	service := &warmupTestService{}
+	builder := t.factory.GetFormHandlerBuilder().SetFormDataType(warmupTestFormData{})
+	builder.Must(builder.AddFormExtension(service)).Build()
+
+	t.NoError(t.factory.Warmup(context.Background()))
+	t.Equal(1, service.warmups)
+
+	// registry is sealed by the first warm-up, so form handlers built later are not collected
+	builder = t.factory.GetFormHandlerBuilder()
+	builder.Must(builder.AddFormExtension(service)).Build()
+
+	t.NoError(t.factory.Warmup(context.Background()))
+	t.Equal(1, service.warmups)
+}
+
+func (t *FormHandlerFactoryImplTestSuite) TestGetFormHandlerBuilder_FeatureToggle() {
+	toggle := domain.FeatureToggle{
+		Feature: "newsletter",
+		Fields:  []string{"newsletter"},
+	}
+
+	formHandler := t.factory.GetFormHandlerBuilder().AddFeatureToggle(toggle).Build()
+
+	t.Equal(&featureToggles{
+		provider: t.featureFlagProvider,
+		toggles:  []domain.FeatureToggle{toggle},
+	}, formHandler.(*formHandlerImpl).featureToggles)
+}
+
+func (t *FormHandlerFactoryImplTestSuite) TestGetFormHandlerBuilder_Decorators() {
+	decorated := &mocks.FormHandler{}
+	decorator := &mocks.FormHandlerDecorator{}
+	decorator.On("Decorate", mock.AnythingOfType("*application.formHandlerImpl")).Return(decorated).Once()
+
+	t.factory.Inject(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, []domain.FormHandlerDecorator{decorator}, nil, nil, t.logger, nil, nil, nil, nil, nil, nil)
+
+	t.Equal([]domain.FormHandlerDecorator{decorator}, t.factory.GetFormHandlerBuilder().(*formHandlerBuilderImpl).formHandlerDecorators)
+	t.Exactly(decorated, t.factory.CreateSimpleFormHandler())
+
+	decorator.AssertExpectations(t.T())
+}
+
+func (t *FormHandlerFactoryImplTestSuite) TestGetFormHandlerBuilder_ClockAndTokenSource() {
+	clock := formtest.NewFakeClock(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC))
+	tokenSource := formtest.NewFakeTokenSource(1)
+
+	t.factory.Inject(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, clock, tokenSource, t.logger, nil, nil, nil, nil, &struct {
+		FieldName string `inject:"config:form.confirmation.fieldName"`
+		MaxAge    string `inject:"config:form.confirmation.maxAge"`
+		Secret    string `inject:"config:form.confirmation.secret"`
+	}{
+		FieldName: "confirmationToken",
+		MaxAge:    "1h",
+	}, nil)
+
+	formHandler := t.factory.CreateSimpleFormHandler().(*formHandlerImpl)
+	t.Equal(clock, formHandler.clock)
+	t.Equal(tokenSource, formHandler.tokenSource)
+	t.Equal(newConfirmation("confirmationToken", "1h", "", formtest.NewFakeTokenSource(1)), formHandler.confirmation)
+}
+
+func (t *FormHandlerFactoryImplTestSuite) TestGetFormHandlerBuilder_Debug() {
+	t.factory.Inject(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, t.logger, &struct {
+		Debug bool `inject:"config:form.debug"`
+	}{
+		Debug: true,
+	}, nil, nil, nil, nil, nil)
+
+	t.True(t.factory.GetFormHandlerBuilder().(*formHandlerBuilderImpl).debug)
+	t.True(t.factory.CreateSimpleFormHandler().(*formHandlerImpl).debug)
+}
+
+func (t *FormHandlerFactoryImplTestSuite) TestGetFormHandlerBuilder_LogPolicy() {
+	t.factory.Inject(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, t.logger, nil, &struct {
+		DefaultLevel string     `inject:"config:form.logging.defaultLevel"`
+		Levels       config.Map `inject:"config:form.logging.levels"`
+		Sampling     config.Map `inject:"config:form.logging.sampling"`
+	}{
+		DefaultLevel: "error",
+		Levels: config.Map{
+			"formValidation": "warn",
+		},
+		Sampling: config.Map{
+			"formValidation": 10,
+		},
+	}, nil, nil, nil, nil)
+
+	policy := t.factory.GetFormHandlerBuilder().(*formHandlerBuilderImpl).logPolicy
+	t.Equal(logLevelWarn, policy.level("formValidation"))
+	t.Equal(logLevelError, policy.level("formDecoding"))
+	t.Exactly(policy, t.factory.CreateSimpleFormHandler().(*formHandlerImpl).logPolicy)
+}
+
+func (t *FormHandlerFactoryImplTestSuite) TestGetFormHandlerBuilder_ReportOnly() {
+	t.factory.Inject(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, t.logger, nil, nil, &struct {
+		Rules      config.Slice `inject:"config:form.reportOnly.rules"`
+		Extensions config.Slice `inject:"config:form.reportOnly.extensions"`
+	}{
+		Rules:      config.Slice{"maximumage"},
+		Extensions: config.Slice{"formExtension.originCheck"},
+	}, nil, nil, nil)
+
+	builder := t.factory.GetFormHandlerBuilder().(*formHandlerBuilderImpl)
+	t.Equal([]string{"maximumage"}, builder.reportOnlyRules)
+	t.Equal([]string{"formExtension.originCheck"}, builder.reportOnlyExtensions)
+	t.Equal(&reportOnly{
+		rules:      map[string]bool{"maximumage": true},
+		extensions: map[string]bool{"formExtension.originCheck": true},
+	}, t.factory.CreateSimpleFormHandler().(*formHandlerImpl).reportOnly)
+}
+
+func (t *FormHandlerFactoryImplTestSuite) TestGetFormHandlerBuilder_RuleProfiles() {
+	t.factory.Inject(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, t.logger, nil, nil, nil, &struct {
+		Profiles config.Map `inject:"config:form.ruleProfiles"`
+	}{
+		Profiles: config.Map{
+			"US": config.Map{
+				"extend": config.Map{
+					"address.state": "required",
+				},
+			},
+		},
+	}, nil, nil)
+
+	expected := ruleProfiles{
+		"US": &ruleProfile{
+			Extend: map[string]string{
+				"address.state": "required",
+			},
+		},
+	}
+	t.Equal(expected, t.factory.GetFormHandlerBuilder().(*formHandlerBuilderImpl).ruleProfiles)
+	t.Equal(expected, t.factory.CreateSimpleFormHandler().(*formHandlerImpl).ruleProfiles)
+}
+
+func (t *FormHandlerFactoryImplTestSuite) TestGetFormHandlerBuilder_Confirmation() {
+	t.factory.Inject(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, t.logger, nil, nil, nil, nil, &struct {
+		FieldName string `inject:"config:form.confirmation.fieldName"`
+		MaxAge    string `inject:"config:form.confirmation.maxAge"`
+		Secret    string `inject:"config:form.confirmation.secret"`
+	}{
+		FieldName: "confirmationToken",
+		MaxAge:    "1h",
+		Secret:    "secret",
+	}, nil)
+
+	expected := newConfirmation("confirmationToken", "1h", "secret", nil)
+	t.Equal(expected, t.factory.GetFormHandlerBuilder().(*formHandlerBuilderImpl).confirmation)
+	t.Equal(expected, t.factory.GetFormHandlerBuilder().BuildConfirmFormHandler().(*formHandlerImpl).confirmation)
+}
+
+func (t *FormHandlerFactoryImplTestSuite) TestGetFormHandlerBuilder_ConfirmationInvalidMaxAge() {
+	t.Panics(func() {
+		t.factory.Inject(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, t.logger, nil, nil, nil, nil, &struct {
+			FieldName string `inject:"config:form.confirmation.fieldName"`
+			MaxAge    string `inject:"config:form.confirmation.maxAge"`
+			Secret    string `inject:"config:form.confirmation.secret"`
+		}{
+			FieldName: "confirmationToken",
+			MaxAge:    "hour",
+		}, nil)
+	})
+}
+
+func (t *FormHandlerFactoryImplTestSuite) TestGetFormHandlerBuilder_RequestBodyLimits() {
+	t.factory.Inject(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, t.logger, nil, nil, nil, nil, nil, &struct {
+		MaxSize         int `inject:"config:form.requestBody.maxSize"`
+		MultipartMemory int `inject:"config:form.requestBody.multipartMemory"`
+	}{
+		MaxSize:         1024,
+		MultipartMemory: 512,
+	})
+
+	builder := t.factory.GetFormHandlerBuilder().(*formHandlerBuilderImpl)
+	t.Equal(int64(1024), builder.maxBodySize)
+	t.Equal(int64(512), builder.multipartMemory)
+
+	handler := t.factory.CreateSimpleFormHandler().(*formHandlerImpl)
+	t.Equal(int64(1024), handler.maxBodySize)
+	t.Equal(&formdata.MultipartBodyDecoder{MaxMemory: 512}, handler.requestBodyDecoders["multipart/form-data"])
+}
@@ -0,0 +1,302 @@
+package application
+
+import (
+	"sort"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// FormHandlerPresets as interface for creation of form handlers for the most common forms.
+	// Each preset uses named form service and named form extensions defined in configuration "form.presets",
+	// so projects can customize them by binding their own named form services or changing list of extensions.
+	// Presets can extend other presets, so variants of a form (like per market or brand) override only pieces
+	// which differ from the parent preset. Presets can define variants per tenant, which are selected
+	// by domain.TenantResolver at request time.
+	FormHandlerPresets interface {
+		// CreateFormHandler creates form handler for any preset defined in configuration "form.presets" by its name,
+		// including project specific presets and variants of other presets
+		CreateFormHandler(name string) domain.FormHandler
+		// CreateLoginFormHandler creates form handler for login form, with presets.LoginFormData by default
+		CreateLoginFormHandler() domain.FormHandler
+		// CreateRegistrationFormHandler creates form handler for registration form, with presets.RegistrationFormData by default
+		CreateRegistrationFormHandler() domain.FormHandler
+		// CreatePasswordResetRequestFormHandler creates form handler for form where user asks for password reset link,
+		// with presets.PasswordResetRequestFormData by default
+		CreatePasswordResetRequestFormHandler() domain.FormHandler
+		// CreatePasswordResetFormHandler creates form handler for form where user sets new password,
+		// with presets.PasswordResetFormData by default
+		CreatePasswordResetFormHandler() domain.FormHandler
+		// CreateContactFormHandler creates form handler for contact form, with presets.ContactFormData by default.
+		// Submitted messages are delivered via extensions.ContactSink.
+		CreateContactFormHandler() domain.FormHandler
+	}
+
+	// FormHandlerPresetsImpl as actual implementation of FormHandlerPresets interface
+	FormHandlerPresetsImpl struct {
+		formHandlerFactory FormHandlerFactory
+		tenantResolver     domain.TenantResolver
+		presets            map[string]formHandlerPreset
+	}
+
+	// formHandlerPreset defines configuration of single preset. Preset which extends another preset inherits its
+	// form service, form extensions, sub forms and rule profile, and overrides only configured pieces.
+	formHandlerPreset struct {
+		// Extends name of the parent preset
+		Extends string `json:"extends"`
+		// Service name of form service, which overrides form service of the parent preset
+		Service string `json:"service"`
+		// Extensions names of form extensions, which are added to form extensions of the parent preset
+		Extensions []string `json:"extensions"`
+		// RemoveExtensions names of form extensions of the parent preset, which are not used by the preset
+		RemoveExtensions []string `json:"removeExtensions"`
+		// SubForms names of sub forms per prefix, which override sub forms of the parent preset.
+		// Sub form of the parent preset is removed by empty name.
+		SubForms map[string]string `json:"subForms"`
+		// RuleProfile name of rule profile applied if there is no rule profile selected for the request,
+		// which overrides rule profile of the parent preset
+		RuleProfile string `json:"ruleProfile"`
+		// Messages default labels of error messages per message key, which are added to messages of the parent preset
+		Messages map[string]string `json:"messages"`
+		// Tenants variants of the preset per tenant, which inherit pieces of the preset and override only configured
+		// pieces. They are added to tenant variants of the parent preset, replacing variants of the same tenant.
+		Tenants map[string]formHandlerPreset `json:"tenants"`
+	}
+)
+
+const (
+	presetLogin                = "login"
+	presetRegistration         = "registration"
+	presetPasswordResetRequest = "passwordResetRequest"
+	presetPasswordReset        = "passwordReset"
+	presetContact              = "contact"
+)
+
+var _ FormHandlerPresets = &FormHandlerPresetsImpl{}
+
+// Inject is method used to set all dependencies as local variables
+func (f *FormHandlerPresetsImpl) Inject(
+	formHandlerFactory FormHandlerFactory,
+	tenantResolver domain.TenantResolver,
+	cfg *struct {
+		Presets config.Map `inject:"config:form.presets"`
+	},
+) {
+	f.formHandlerFactory = formHandlerFactory
+	f.tenantResolver = tenantResolver
+
+	presets := map[string]formHandlerPreset{}
+	if cfg != nil {
+		if err := cfg.Presets.MapInto(&presets); err != nil {
+			panic(err.Error())
+		}
+	}
+	f.presets = resolvePresets(presets)
+}
+
+// CreateFormHandler creates form handler for any preset defined in configuration "form.presets" by its name,
+// including project specific presets and variants of other presets
+func (f *FormHandlerPresetsImpl) CreateFormHandler(name string) domain.FormHandler {
+	return f.createFormHandler(name)
+}
+
+// CreateLoginFormHandler creates form handler for login form, with presets.LoginFormData by default
+func (f *FormHandlerPresetsImpl) CreateLoginFormHandler() domain.FormHandler {
+	return f.createFormHandler(presetLogin)
+}
+
+// CreateRegistrationFormHandler creates form handler for registration form, with presets.RegistrationFormData by default
+func (f *FormHandlerPresetsImpl) CreateRegistrationFormHandler() domain.FormHandler {
+	return f.createFormHandler(presetRegistration)
+}
+
+// CreatePasswordResetRequestFormHandler creates form handler for form where user asks for password reset link,
+// with presets.PasswordResetRequestFormData by default
+func (f *FormHandlerPresetsImpl) CreatePasswordResetRequestFormHandler() domain.FormHandler {
+	return f.createFormHandler(presetPasswordResetRequest)
+}
+
+// CreatePasswordResetFormHandler creates form handler for form where user sets new password,
+// with presets.PasswordResetFormData by default
+func (f *FormHandlerPresetsImpl) CreatePasswordResetFormHandler() domain.FormHandler {
+	return f.createFormHandler(presetPasswordReset)
+}
+
+// CreateContactFormHandler creates form handler for contact form, with presets.ContactFormData by default.
+// Submitted messages are delivered via extensions.ContactSink.
+func (f *FormHandlerPresetsImpl) CreateContactFormHandler() domain.FormHandler {
+	return f.createFormHandler(presetContact)
+}
+
+// createFormHandler creates form handler of the preset. Form handler of preset with tenant variants or messages
+// delegates to form handler of the tenant resolved for the request.
+func (f *FormHandlerPresetsImpl) createFormHandler(name string) domain.FormHandler {
+	preset := f.presets[name]
+
+	formHandler := f.buildFormHandler(preset)
+	if len(preset.Tenants) == 0 && len(preset.Messages) == 0 {
+		return formHandler
+	}
+
+	tenantHandler := &tenantFormHandler{
+		tenantResolver: f.tenantResolver,
+		defaultVariant: tenantFormHandlerVariant{
+			formHandler: formHandler,
+			messages:    preset.Messages,
+		},
+		variants: make(map[string]tenantFormHandlerVariant, len(preset.Tenants)),
+	}
+
+	for tenant, variant := range preset.Tenants {
+		tenantHandler.variants[tenant] = tenantFormHandlerVariant{
+			formHandler: f.buildFormHandler(variant),
+			messages:    variant.Messages,
+		}
+	}
+
+	return tenantHandler
+}
+
+// buildFormHandler builds form handler with named form service, named form extensions, named sub forms
+// and rule profile of the preset. It panics if preset uses form service, form extension, sub form or rule profile
+// which is not injected, same as FormHandlerFactory.
+func (f *FormHandlerPresetsImpl) buildFormHandler(preset formHandlerPreset) domain.FormHandler {
+	builder := f.formHandlerFactory.GetFormHandlerBuilder()
+	builder.Must(builder.SetNamedFormService(preset.Service))
+	for _, extension := range preset.Extensions {
+		builder.Must(builder.AddNamedFormExtension(extension))
+	}
+
+	prefixes := make([]string, 0, len(preset.SubForms))
+	for prefix := range preset.SubForms {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	for _, prefix := range prefixes {
+		builder.Must(builder.AddNamedSubForm(prefix, preset.SubForms[prefix]))
+	}
+
+	if preset.RuleProfile != "" {
+		builder.Must(builder.SetRuleProfile(preset.RuleProfile))
+	}
+
+	return builder.Build()
+}
+
+// resolvePresets returns presets with pieces inherited from their parent presets, and their tenant variants
+// with pieces inherited from the presets. It panics if preset extends unknown preset, if presets extend each other
+// in a cycle, or if tenant variant extends presets or defines its own tenant variants.
+func resolvePresets(presets map[string]formHandlerPreset) map[string]formHandlerPreset {
+	resolved := make(map[string]formHandlerPreset, len(presets))
+	for name := range presets {
+		preset := resolvePreset(presets, name, map[string]bool{})
+
+		tenants := preset.Tenants
+		preset.Tenants = nil
+		for tenant, variant := range tenants {
+			if variant.Extends != "" || len(variant.Tenants) > 0 {
+				panic("tenant variant " + tenant + " of form preset " + name + " can't extend presets or define tenant variants")
+			}
+
+			if preset.Tenants == nil {
+				preset.Tenants = make(map[string]formHandlerPreset, len(tenants))
+			}
+			preset.Tenants[tenant] = variant.inherit(formHandlerPreset{
+				Service:     preset.Service,
+				Extensions:  preset.Extensions,
+				SubForms:    preset.SubForms,
+				RuleProfile: preset.RuleProfile,
+				Messages:    preset.Messages,
+			})
+		}
+
+		resolved[name] = preset
+	}
+
+	return resolved
+}
+
+// resolvePreset returns preset with pieces inherited from all its ancestors. Names of presets which are
+// already part of current chain of parents are passed as path.
+func resolvePreset(presets map[string]formHandlerPreset, name string, path map[string]bool) formHandlerPreset {
+	preset, ok := presets[name]
+	if !ok {
+		panic("there is no form preset with name " + name)
+	}
+
+	if path[name] {
+		panic("form preset " + name + " is part of cycle of extended presets")
+	}
+	path[name] = true
+
+	var parent formHandlerPreset
+	if preset.Extends != "" {
+		parent = resolvePreset(presets, preset.Extends, path)
+	}
+
+	return preset.inherit(parent)
+}
+
+// inherit returns preset with pieces of the parent preset, which are not overridden by the preset.
+// Tenant variants are merged without inheritance, which is resolved for final presets only.
+func (p formHandlerPreset) inherit(parent formHandlerPreset) formHandlerPreset {
+	resolved := formHandlerPreset{
+		Service:     parent.Service,
+		RuleProfile: parent.RuleProfile,
+	}
+
+	if p.Service != "" {
+		resolved.Service = p.Service
+	}
+
+	if p.RuleProfile != "" {
+		resolved.RuleProfile = p.RuleProfile
+	}
+
+	skipped := make(map[string]bool, len(p.RemoveExtensions))
+	for _, extension := range p.RemoveExtensions {
+		skipped[extension] = true
+	}
+
+	for _, extension := range append(append([]string{}, parent.Extensions...), p.Extensions...) {
+		if !skipped[extension] {
+			resolved.Extensions = append(resolved.Extensions, extension)
+			skipped[extension] = true
+		}
+	}
+
+	for _, subForms := range []map[string]string{parent.SubForms, p.SubForms} {
+		for prefix, subForm := range subForms {
+			if resolved.SubForms == nil {
+				resolved.SubForms = map[string]string{}
+			}
+
+			if subForm == "" {
+				delete(resolved.SubForms, prefix)
+			} else {
+				resolved.SubForms[prefix] = subForm
+			}
+		}
+	}
+
+	for _, messages := range []map[string]string{parent.Messages, p.Messages} {
+		for messageKey, label := range messages {
+			if resolved.Messages == nil {
+				resolved.Messages = map[string]string{}
+			}
+			resolved.Messages[messageKey] = label
+		}
+	}
+
+	for _, tenants := range []map[string]formHandlerPreset{parent.Tenants, p.Tenants} {
+		for tenant, variant := range tenants {
+			if resolved.Tenants == nil {
+				resolved.Tenants = map[string]formHandlerPreset{}
+			}
+			resolved.Tenants[tenant] = variant
+		}
+	}
+
+	return resolved
+}
@@ -0,0 +1,352 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	FormHandlerPresetsImplTestSuite struct {
+		suite.Suite
+
+		presets *FormHandlerPresetsImpl
+
+		loginService    *mocks.CompleteFormService
+		registerService *mocks.CompleteFormService
+		csrfExtension   *mocks.CompleteFormService
+		lockExtension   *mocks.CompleteFormService
+		tenantResolver  *mocks.TenantResolver
+
+		logger *flamingo.NullLogger
+	}
+)
+
+func TestFormHandlerPresetsImplTestSuite(t *testing.T) {
+	suite.Run(t, &FormHandlerPresetsImplTestSuite{})
+}
+
+func (t *FormHandlerPresetsImplTestSuite) SetupTest() {
+	t.loginService = &mocks.CompleteFormService{}
+	t.registerService = &mocks.CompleteFormService{}
+	t.csrfExtension = &mocks.CompleteFormService{}
+	t.lockExtension = &mocks.CompleteFormService{}
+	t.tenantResolver = &mocks.TenantResolver{}
+	t.logger = &flamingo.NullLogger{}
+
+	factory := &FormHandlerFactoryImpl{}
+	factory.Inject(
+		map[string]domain.FormService{
+			"formService.login":        t.loginService,
+			"formService.registration": t.registerService,
+		},
+		nil,
+		nil,
+		nil,
+		map[string]domain.FormExtension{
+			"formExtension.csrfToken": t.csrfExtension,
+			"formExtension.lockout":   t.lockExtension,
+		},
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		t.logger,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	t.presets = &FormHandlerPresetsImpl{}
+	t.presets.Inject(factory, t.tenantResolver, &struct {
+		Presets config.Map `inject:"config:form.presets"`
+	}{
+		Presets: config.Map{
+			"login": config.Map{
+				"service":    "formService.login",
+				"extensions": config.Slice{"formExtension.csrfToken", "formExtension.lockout"},
+			},
+			"registration": config.Map{
+				"service":    "formService.registration",
+				"extensions": config.Slice{"formExtension.csrfToken"},
+			},
+			"passwordReset": config.Map{
+				"service":    "formService.unknown",
+				"extensions": config.Slice{},
+			},
+			"registrationCH": config.Map{
+				"extends":          "registration",
+				"extensions":       config.Slice{"formExtension.lockout"},
+				"removeExtensions": config.Slice{"formExtension.csrfToken"},
+				"ruleProfile":      "CH",
+			},
+			"loginBrand": config.Map{
+				"service":    "formService.login",
+				"extensions": config.Slice{"formExtension.csrfToken"},
+				"messages": config.Map{
+					"formError.email.required": "email is required",
+				},
+				"tenants": config.Map{
+					"brandA": config.Map{
+						"extensions": config.Slice{"formExtension.lockout"},
+						"messages": config.Map{
+							"formError.email.required": "Please tell us your email",
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func (t *FormHandlerPresetsImplTestSuite) TestCreateLoginFormHandler() {
+	t.Equal(&formHandlerImpl{
+		formDataProvider:  t.loginService,
+		formDataDecoder:   t.loginService,
+		formDataValidator: t.loginService,
+		formExtensions: map[string]domain.FormExtension{
+			"formExtension.csrfToken": t.csrfExtension,
+			"formExtension.lockout":   t.lockExtension,
+		},
+		extensionOrder: []string{"formExtension.csrfToken", "formExtension.lockout"},
+		logger:         t.logger,
+	}, t.presets.CreateLoginFormHandler())
+}
+
+func (t *FormHandlerPresetsImplTestSuite) TestCreateRegistrationFormHandler() {
+	t.Equal(&formHandlerImpl{
+		formDataProvider:  t.registerService,
+		formDataDecoder:   t.registerService,
+		formDataValidator: t.registerService,
+		formExtensions: map[string]domain.FormExtension{
+			"formExtension.csrfToken": t.csrfExtension,
+		},
+		extensionOrder: []string{"formExtension.csrfToken"},
+		logger:         t.logger,
+	}, t.presets.CreateRegistrationFormHandler())
+}
+
+func (t *FormHandlerPresetsImplTestSuite) TestCreateFormHandler_Misconfigured() {
+	t.Panics(func() {
+		t.presets.CreatePasswordResetFormHandler()
+	})
+	t.Panics(func() {
+		t.presets.CreatePasswordResetRequestFormHandler()
+	})
+	t.Panics(func() {
+		t.presets.CreateContactFormHandler()
+	})
+	t.Panics(func() {
+		t.presets.CreateFormHandler("registrationCH")
+	})
+	t.Panics(func() {
+		t.presets.CreateFormHandler("unknown")
+	})
+}
+
+func (t *FormHandlerPresetsImplTestSuite) TestCreateFormHandler() {
+	t.presets.formHandlerFactory.(*FormHandlerFactoryImpl).ruleProfiles = ruleProfiles{
+		"CH": &ruleProfile{},
+	}
+
+	t.Equal(&formHandlerImpl{
+		formDataProvider:  t.registerService,
+		formDataDecoder:   t.registerService,
+		formDataValidator: t.registerService,
+		formExtensions: map[string]domain.FormExtension{
+			"formExtension.lockout": t.lockExtension,
+		},
+		extensionOrder: []string{"formExtension.lockout"},
+		logger:         t.logger,
+		ruleProfiles: ruleProfiles{
+			"CH": &ruleProfile{},
+		},
+		ruleProfile: "CH",
+	}, t.presets.CreateFormHandler("registrationCH"))
+}
+
+func (t *FormHandlerPresetsImplTestSuite) TestCreateFormHandler_Tenants() {
+	t.Equal(&tenantFormHandler{
+		tenantResolver: t.tenantResolver,
+		defaultVariant: tenantFormHandlerVariant{
+			formHandler: &formHandlerImpl{
+				formDataProvider:  t.loginService,
+				formDataDecoder:   t.loginService,
+				formDataValidator: t.loginService,
+				formExtensions: map[string]domain.FormExtension{
+					"formExtension.csrfToken": t.csrfExtension,
+				},
+				extensionOrder: []string{"formExtension.csrfToken"},
+				logger:         t.logger,
+			},
+			messages: map[string]string{
+				"formError.email.required": "email is required",
+			},
+		},
+		variants: map[string]tenantFormHandlerVariant{
+			"brandA": {
+				formHandler: &formHandlerImpl{
+					formDataProvider:  t.loginService,
+					formDataDecoder:   t.loginService,
+					formDataValidator: t.loginService,
+					formExtensions: map[string]domain.FormExtension{
+						"formExtension.csrfToken": t.csrfExtension,
+						"formExtension.lockout":   t.lockExtension,
+					},
+					extensionOrder: []string{"formExtension.csrfToken", "formExtension.lockout"},
+					logger:         t.logger,
+				},
+				messages: map[string]string{
+					"formError.email.required": "Please tell us your email",
+				},
+			},
+		},
+	}, t.presets.CreateFormHandler("loginBrand"))
+}
+
+func (t *FormHandlerPresetsImplTestSuite) TestResolvePresets() {
+	t.Equal(map[string]formHandlerPreset{
+		"checkout": {
+			Service:    "formService.checkout",
+			Extensions: []string{"formExtension.csrfToken", "formExtension.submissionLock"},
+			SubForms: map[string]string{
+				"billing":  "subForm.address",
+				"shipping": "subForm.address",
+			},
+		},
+		"checkoutCH": {
+			Service:     "formService.checkout",
+			Extensions:  []string{"formExtension.csrfToken", "formExtension.captcha"},
+			SubForms:    map[string]string{"billing": "subForm.addressCH"},
+			RuleProfile: "CH",
+		},
+		"checkoutCHBrand": {
+			Service:     "formService.brandCheckout",
+			Extensions:  []string{"formExtension.csrfToken", "formExtension.captcha", "formExtension.submissionLock"},
+			SubForms:    map[string]string{"billing": "subForm.addressCH"},
+			RuleProfile: "CH",
+		},
+	}, resolvePresets(map[string]formHandlerPreset{
+		"checkout": {
+			Service:    "formService.checkout",
+			Extensions: []string{"formExtension.csrfToken", "formExtension.submissionLock"},
+			SubForms: map[string]string{
+				"billing":  "subForm.address",
+				"shipping": "subForm.address",
+			},
+		},
+		"checkoutCH": {
+			Extends:          "checkout",
+			Extensions:       []string{"formExtension.csrfToken", "formExtension.captcha"},
+			RemoveExtensions: []string{"formExtension.submissionLock"},
+			SubForms: map[string]string{
+				"billing":  "subForm.addressCH",
+				"shipping": "",
+			},
+			RuleProfile: "CH",
+		},
+		"checkoutCHBrand": {
+			Extends:    "checkoutCH",
+			Service:    "formService.brandCheckout",
+			Extensions: []string{"formExtension.submissionLock"},
+		},
+	}))
+}
+
+func (t *FormHandlerPresetsImplTestSuite) TestResolvePresets_Tenants() {
+	t.Equal(map[string]formHandlerPreset{
+		"checkout": {
+			Service:    "formService.checkout",
+			Extensions: []string{"formExtension.csrfToken"},
+			Messages:   map[string]string{"formError.email.required": "email is required"},
+			Tenants: map[string]formHandlerPreset{
+				"brandA": {
+					Service:     "formService.checkout",
+					Extensions:  []string{"formExtension.csrfToken", "formExtension.captcha"},
+					RuleProfile: "brandA",
+					Messages:    map[string]string{"formError.email.required": "email is required"},
+				},
+			},
+		},
+		"checkoutCH": {
+			Service:     "formService.checkout",
+			Extensions:  []string{"formExtension.csrfToken"},
+			RuleProfile: "CH",
+			Messages: map[string]string{
+				"formError.email.required": "email is required",
+				"formError.zip.required":   "zip is required",
+			},
+			Tenants: map[string]formHandlerPreset{
+				"brandA": {
+					Service:     "formService.checkout",
+					Extensions:  []string{"formExtension.csrfToken", "formExtension.captcha"},
+					RuleProfile: "brandA",
+					Messages: map[string]string{
+						"formError.email.required": "email is required",
+						"formError.zip.required":   "zip is required",
+					},
+				},
+				"brandB": {
+					Service:     "formService.brandCheckout",
+					Extensions:  []string{"formExtension.csrfToken"},
+					RuleProfile: "CH",
+					Messages: map[string]string{
+						"formError.email.required": "Please tell us your email",
+						"formError.zip.required":   "zip is required",
+					},
+				},
+			},
+		},
+	}, resolvePresets(map[string]formHandlerPreset{
+		"checkout": {
+			Service:    "formService.checkout",
+			Extensions: []string{"formExtension.csrfToken"},
+			Messages:   map[string]string{"formError.email.required": "email is required"},
+			Tenants: map[string]formHandlerPreset{
+				"brandA": {
+					Extensions:  []string{"formExtension.captcha"},
+					RuleProfile: "brandA",
+				},
+			},
+		},
+		"checkoutCH": {
+			Extends:     "checkout",
+			RuleProfile: "CH",
+			Messages:    map[string]string{"formError.zip.required": "zip is required"},
+			Tenants: map[string]formHandlerPreset{
+				"brandB": {
+					Service:  "formService.brandCheckout",
+					Messages: map[string]string{"formError.email.required": "Please tell us your email"},
+				},
+			},
+		},
+	}))
+}
+
+func (t *FormHandlerPresetsImplTestSuite) TestResolvePresets_Misconfigured() {
+	t.Panics(func() {
+		resolvePresets(map[string]formHandlerPreset{
+			"checkoutCH": {Extends: "checkout"},
+		})
+	})
+	t.Panics(func() {
+		resolvePresets(map[string]formHandlerPreset{
+			"checkout":   {Extends: "checkoutCH"},
+			"checkoutCH": {Extends: "checkout"},
+		})
+	})
+	t.Panics(func() {
+		resolvePresets(map[string]formHandlerPreset{
+			"checkout": {
+				Tenants: map[string]formHandlerPreset{
+					"brandA": {Extends: "checkout"},
+				},
+			},
+		})
+	})
+}
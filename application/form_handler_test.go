@@ -1,16 +1,24 @@
 package application
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"html/template"
+	"io/ioutil"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 
 	"flamingo.me/flamingo/v3/framework/flamingo"
 	"flamingo.me/flamingo/v3/framework/web"
 	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/card"
+	"flamingo.me/form/domain/markdown"
 	"flamingo.me/form/domain/mocks"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -31,13 +39,41 @@ type (
 		thirdExtension    *mocks.FormDataDecoder
 		fourthExtension   *mocks.FormDataValidator
 		validatorProvider *mocks.ValidatorProvider
+		fieldEncryptor    *mocks.FieldEncryptor
 		logger            *flamingo.NullLogger
 
 		context context.Context
 		request *web.Request
 	}
+
+	cardTestTokenizer struct {
+		token string
+		err   error
+		cards []card.Card
+	}
+
+	markdownTestRenderer struct {
+		err error
+	}
+
+	dryRunTestExtension struct {
+		*mocks.CompleteFormService
+	}
 )
 
+func (e *dryRunTestExtension) IsReadOnly() bool {
+	return true
+}
+
+func (r *markdownTestRenderer) Render(_ context.Context, text markdown.Text) (template.HTML, error) {
+	return template.HTML("<p>" + text + "</p>"), r.err
+}
+
+func (t *cardTestTokenizer) Tokenize(_ context.Context, paymentCard card.Card) (string, error) {
+	t.cards = append(t.cards, paymentCard)
+	return t.token, t.err
+}
+
 func TestFormHandlerImplTestSuite(t *testing.T) {
 	suite.Run(t, &FormHandlerImplTestSuite{})
 }
@@ -58,6 +94,7 @@ func (t *FormHandlerImplTestSuite) SetupTest() {
 	t.thirdExtension = &mocks.FormDataDecoder{}
 	t.fourthExtension = &mocks.FormDataValidator{}
 	t.validatorProvider = &mocks.ValidatorProvider{}
+	t.fieldEncryptor = &mocks.FieldEncryptor{}
 	t.logger = &flamingo.NullLogger{}
 
 	t.handler = &formHandlerImpl{
@@ -74,10 +111,13 @@ func (t *FormHandlerImplTestSuite) SetupTest() {
 			"fourth": t.fourthExtension,
 		},
 		validatorProvider: t.validatorProvider,
+		fieldEncryptor:    t.fieldEncryptor,
 		logger:            t.logger,
 	}
 
-	t.request = web.CreateRequest(&http.Request{}, nil)
+	t.request = web.CreateRequest(&http.Request{Header: http.Header{
+		http.CanonicalHeaderKey(domain.CorrelationIDHeader): []string{"correlation"},
+	}}, nil)
 }
 
 func (t *FormHandlerImplTestSuite) TearDownTest() {
@@ -92,6 +132,7 @@ func (t *FormHandlerImplTestSuite) TearDownTest() {
 	t.thirdExtension.AssertExpectations(t.T())
 	t.fourthExtension.AssertExpectations(t.T())
 	t.validatorProvider.AssertExpectations(t.T())
+	t.fieldEncryptor.AssertExpectations(t.T())
 }
 
 func (t *FormHandlerImplTestSuite) TestHandleUnsubmittedForm_Error() {
@@ -153,6 +194,8 @@ func (t *FormHandlerImplTestSuite) TestHandleUnsubmittedForm_Success() {
 		"fourth": map[string]int{},
 	}
 
+	form.CacheHint = &domain.CacheHint{Cacheable: true}
+	form.CorrelationID = "correlation"
 	t.Equal(&form, result)
 }
 
@@ -175,14 +218,16 @@ func (t *FormHandlerImplTestSuite) TestExtractValidationRules_Struct() {
 				Name: "required",
 			},
 			{
-				Name:  "gte",
-				Value: "10",
+				Name:      "gte",
+				Value:     "10",
+				ValueType: domain.RuleValueTypeLength,
 			},
 		},
 		"second": {
 			{
-				Name:  "gte",
-				Value: "10",
+				Name:      "gte",
+				Value:     "10",
+				ValueType: domain.RuleValueTypeLength,
 			},
 		},
 		"Sixth": {
@@ -190,8 +235,9 @@ func (t *FormHandlerImplTestSuite) TestExtractValidationRules_Struct() {
 				Name: "required",
 			},
 			{
-				Name:  "gte",
-				Value: "10",
+				Name:      "gte",
+				Value:     "10",
+				ValueType: domain.RuleValueTypeLength,
 			},
 		},
 		"Seventh": {
@@ -204,14 +250,16 @@ func (t *FormHandlerImplTestSuite) TestExtractValidationRules_Struct() {
 				Name: "required",
 			},
 			{
-				Name:  "gte",
-				Value: "10",
+				Name:      "gte",
+				Value:     "10",
+				ValueType: domain.RuleValueTypeLength,
 			},
 		},
 		"subStruct.second": {
 			{
-				Name:  "gte",
-				Value: "10",
+				Name:      "gte",
+				Value:     "10",
+				ValueType: domain.RuleValueTypeLength,
 			},
 		},
 		"subStruct.Sixth": {
@@ -219,8 +267,9 @@ func (t *FormHandlerImplTestSuite) TestExtractValidationRules_Struct() {
 				Name: "required",
 			},
 			{
-				Name:  "gte",
-				Value: "10",
+				Name:      "gte",
+				Value:     "10",
+				ValueType: domain.RuleValueTypeLength,
 			},
 		},
 		"StructWithoutName.first": {
@@ -228,14 +277,16 @@ func (t *FormHandlerImplTestSuite) TestExtractValidationRules_Struct() {
 				Name: "required",
 			},
 			{
-				Name:  "gte",
-				Value: "10",
+				Name:      "gte",
+				Value:     "10",
+				ValueType: domain.RuleValueTypeLength,
 			},
 		},
 		"StructWithoutName.second": {
 			{
-				Name:  "gte",
-				Value: "10",
+				Name:      "gte",
+				Value:     "10",
+				ValueType: domain.RuleValueTypeLength,
 			},
 		},
 		"StructWithoutName.Sixth": {
@@ -243,8 +294,9 @@ func (t *FormHandlerImplTestSuite) TestExtractValidationRules_Struct() {
 				Name: "required",
 			},
 			{
-				Name:  "gte",
-				Value: "10",
+				Name:      "gte",
+				Value:     "10",
+				ValueType: domain.RuleValueTypeLength,
 			},
 		},
 		"referenceStruct.first": {
@@ -252,14 +304,16 @@ func (t *FormHandlerImplTestSuite) TestExtractValidationRules_Struct() {
 				Name: "required",
 			},
 			{
-				Name:  "gte",
-				Value: "10",
+				Name:      "gte",
+				Value:     "10",
+				ValueType: domain.RuleValueTypeLength,
 			},
 		},
 		"referenceStruct.second": {
 			{
-				Name:  "gte",
-				Value: "10",
+				Name:      "gte",
+				Value:     "10",
+				ValueType: domain.RuleValueTypeLength,
 			},
 		},
 		"referenceStruct.Sixth": {
@@ -267,8 +321,9 @@ func (t *FormHandlerImplTestSuite) TestExtractValidationRules_Struct() {
 				Name: "required",
 			},
 			{
-				Name:  "gte",
-				Value: "10",
+				Name:      "gte",
+				Value:     "10",
+				ValueType: domain.RuleValueTypeLength,
 			},
 		},
 		"ReferenceWithoutName.first": {
@@ -276,14 +331,16 @@ func (t *FormHandlerImplTestSuite) TestExtractValidationRules_Struct() {
 				Name: "required",
 			},
 			{
-				Name:  "gte",
-				Value: "10",
+				Name:      "gte",
+				Value:     "10",
+				ValueType: domain.RuleValueTypeLength,
 			},
 		},
 		"ReferenceWithoutName.second": {
 			{
-				Name:  "gte",
-				Value: "10",
+				Name:      "gte",
+				Value:     "10",
+				ValueType: domain.RuleValueTypeLength,
 			},
 		},
 		"ReferenceWithoutName.Sixth": {
@@ -291,8 +348,9 @@ func (t *FormHandlerImplTestSuite) TestExtractValidationRules_Struct() {
 				Name: "required",
 			},
 			{
-				Name:  "gte",
-				Value: "10",
+				Name:      "gte",
+				Value:     "10",
+				ValueType: domain.RuleValueTypeLength,
 			},
 		},
 	}, t.handler.extractValidationRules(struct {
@@ -339,6 +397,125 @@ func (t *FormHandlerImplTestSuite) TestExtractValidationRules_Struct() {
 	}{}))
 }
 
+func (t *FormHandlerImplTestSuite) TestExtractValidationRules_Cached() {
+	type cachedFormData struct {
+		First  string `form:"first" validate:"required"`
+		Nested struct {
+			Second string `form:"second" validate:"max=10"`
+		} `form:"nested"`
+	}
+
+	var formData interface{} = cachedFormData{}
+
+	first := t.handler.extractValidationRules(formData)
+	t.Equal(map[string][]domain.ValidationRule{
+		"first": {
+			{
+				Name: "required",
+			},
+		},
+		"nested.second": {
+			{
+				Name:      "max",
+				Value:     "10",
+				ValueType: domain.RuleValueTypeLength,
+			},
+		},
+	}, first)
+	t.Equal(first, t.handler.extractValidationRules(&cachedFormData{}))
+
+	// allocation budget: validation rules of already processed type are not extracted again
+	t.Zero(testing.AllocsPerRun(100, func() {
+		t.handler.extractValidationRules(formData)
+	}))
+}
+
+func (t *FormHandlerImplTestSuite) TestExtractValidationRules_ConfirmField() {
+	t.Equal(map[string][]domain.ValidationRule{
+		"email": {
+			{
+				Name: "required",
+			},
+		},
+		"emailConfirmation": {
+			{
+				Name:  "confirmfield",
+				Value: "email",
+			},
+		},
+		"PasswordConfirmation": {
+			{
+				Name:  "confirmfield",
+				Value: "Password",
+			},
+			{
+				Name: "required",
+			},
+		},
+	}, t.handler.extractValidationRules(struct {
+		Email                string `form:"email" validate:"required"`
+		EmailConfirmation    string `form:"emailConfirmation" confirmfield:"Email"`
+		Password             string
+		PasswordConfirmation string `confirmfield:"Password" validate:"required"`
+	}{}))
+}
+
+func (t *FormHandlerImplTestSuite) TestConfirmFields() {
+	type account struct {
+		Password             string
+		PasswordConfirmation string `confirmfield:"Password"`
+	}
+	type formData struct {
+		Email             string
+		EmailConfirmation string `confirmfield:"Email"`
+		Account           *account
+	}
+
+	validationInfo := &domain.ValidationInfo{}
+	data := formData{
+		Email:             "user@example.com",
+		EmailConfirmation: "user@example.com",
+		Account: &account{
+			Password:             "secret",
+			PasswordConfirmation: "other",
+		},
+	}
+
+	result, err := t.handler.confirmFields(data, validationInfo)
+	t.NoError(err)
+	t.Equal(formData{
+		Email: "user@example.com",
+		Account: &account{
+			Password: "secret",
+		},
+	}, result)
+	t.Equal("user@example.com", data.EmailConfirmation)
+
+	t.False(validationInfo.HasErrorsForField("emailConfirmation"))
+	t.Equal([]domain.Error{
+		{
+			MessageKey:   "formError.account.passwordConfirmation.confirmfield",
+			DefaultLabel: "PasswordConfirmation confirmfield",
+			Params:       map[string]string{"field": "PasswordConfirmation", "rule": "confirmfield"},
+		},
+	}, validationInfo.GetErrorsForField("account.passwordConfirmation"))
+}
+
+func (t *FormHandlerImplTestSuite) TestConfirmFields_Error() {
+	validationInfo := &domain.ValidationInfo{}
+
+	result, err := t.handler.confirmFields(struct {
+		EmailConfirmation string `confirmfield:"Email"`
+	}{}, validationInfo)
+	t.Error(err)
+	t.Nil(result)
+
+	result, err = t.handler.confirmFields(map[string]string{"first": "first"}, validationInfo)
+	t.NoError(err)
+	t.Equal(map[string]string{"first": "first"}, result)
+	t.True(validationInfo.IsValid())
+}
+
 func (t *FormHandlerImplTestSuite) TestCollectFormExtensionValidationRules() {
 	t.firstExtension.On("GetFormData", t.context, t.request).Return(struct {
 		FirstFirstField  string `form:"firstFirstField" validate:"required,min=10"`
@@ -359,8 +536,9 @@ func (t *FormHandlerImplTestSuite) TestCollectFormExtensionValidationRules() {
 				Name: "required",
 			},
 			{
-				Name:  "min",
-				Value: "10",
+				Name:      "min",
+				Value:     "10",
+				ValueType: domain.RuleValueTypeLength,
 			},
 		},
 		"firstSecondField": {
@@ -373,8 +551,9 @@ func (t *FormHandlerImplTestSuite) TestCollectFormExtensionValidationRules() {
 				Name: "required",
 			},
 			{
-				Name:  "min",
-				Value: "10",
+				Name:      "min",
+				Value:     "10",
+				ValueType: domain.RuleValueTypeLength,
 			},
 		},
 		"secondSecondField": {
@@ -388,7 +567,7 @@ func (t *FormHandlerImplTestSuite) TestCollectFormExtensionValidationRules() {
 func (t *FormHandlerImplTestSuite) TestGetUrlValues_PostError() {
 	t.request.Request().Method = http.MethodPost
 
-	values, err := t.handler.getURLValues(t.request, http.MethodPost)
+	values, err := t.handler.getURLValues(t.context, t.request, http.MethodPost)
 	t.Error(err)
 	t.Nil(values)
 }
@@ -400,7 +579,7 @@ func (t *FormHandlerImplTestSuite) TestGetUrlValues_PostSuccess() {
 		"second": []string{"second"},
 	}
 
-	values, err := t.handler.getURLValues(t.request, http.MethodPost)
+	values, err := t.handler.getURLValues(t.context, t.request, http.MethodPost)
 	t.NoError(err)
 	t.Equal(&url.Values{
 		"first":  []string{"first"},
@@ -408,6 +587,74 @@ func (t *FormHandlerImplTestSuite) TestGetUrlValues_PostSuccess() {
 	}, values)
 }
 
+func (t *FormHandlerImplTestSuite) TestGetUrlValues_PostJSON() {
+	t.request.Request().Method = http.MethodPost
+	t.request.Request().Header = http.Header{"Content-Type": []string{"application/json; charset=utf-8"}}
+	t.request.Request().URL = &url.URL{
+		RawQuery: url.Values{
+			"first": []string{"query"},
+			"third": []string{"third"},
+		}.Encode(),
+	}
+	t.request.Request().Body = ioutil.NopCloser(strings.NewReader(`{"first": "first", "second": {"value": 2}}`))
+
+	values, err := t.handler.getURLValues(t.context, t.request, http.MethodPost)
+	t.NoError(err)
+	t.Equal(&url.Values{
+		"first":        []string{"first"},
+		"second.value": []string{"2"},
+		"third":        []string{"third"},
+	}, values)
+}
+
+func (t *FormHandlerImplTestSuite) TestGetUrlValues_PostJSONError() {
+	t.request.Request().Method = http.MethodPost
+	t.request.Request().Header = http.Header{"Content-Type": []string{"application/json"}}
+	t.request.Request().Body = ioutil.NopCloser(strings.NewReader(`["first"]`))
+
+	values, err := t.handler.getURLValues(t.context, t.request, http.MethodPost)
+	t.Error(err)
+	t.Nil(values)
+}
+
+func (t *FormHandlerImplTestSuite) TestGetUrlValues_PostMultipart() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	t.NoError(writer.WriteField("first", "first"))
+	file, err := writer.CreateFormFile("avatar", "avatar.png")
+	t.NoError(err)
+	_, err = file.Write([]byte("content"))
+	t.NoError(err)
+	t.NoError(writer.Close())
+
+	t.request.Request().Method = http.MethodPost
+	t.request.Request().Header = http.Header{"Content-Type": []string{writer.FormDataContentType()}}
+	t.request.Request().URL = &url.URL{
+		RawQuery: url.Values{
+			"second": []string{"second"},
+		}.Encode(),
+	}
+	t.request.Request().Body = ioutil.NopCloser(body)
+
+	values, err := t.handler.getURLValues(t.context, t.request, http.MethodPost)
+	t.NoError(err)
+	t.Equal(&url.Values{
+		"first":  []string{"first"},
+		"second": []string{"second"},
+	}, values)
+	t.Len(t.request.Request().MultipartForm.File["avatar"], 1)
+}
+
+func (t *FormHandlerImplTestSuite) TestGetUrlValues_PostMultipartError() {
+	t.request.Request().Method = http.MethodPost
+	t.request.Request().Header = http.Header{"Content-Type": []string{"multipart/form-data; boundary=missing"}}
+	t.request.Request().Body = ioutil.NopCloser(strings.NewReader("invalid"))
+
+	values, err := t.handler.getURLValues(t.context, t.request, http.MethodPost)
+	t.Error(err)
+	t.Nil(values)
+}
+
 func (t *FormHandlerImplTestSuite) TestGetUrlValues_GetSuccess() {
 	t.request.Request().Method = http.MethodGet
 	t.request.Request().URL = &url.URL{
@@ -417,7 +664,7 @@ func (t *FormHandlerImplTestSuite) TestGetUrlValues_GetSuccess() {
 		}.Encode(),
 	}
 
-	values, err := t.handler.getURLValues(t.request, http.MethodGet)
+	values, err := t.handler.getURLValues(t.context, t.request, http.MethodGet)
 	t.NoError(err)
 	t.Equal(&url.Values{
 		"first":  []string{"first"},
@@ -481,172 +728,995 @@ func (t *FormHandlerImplTestSuite) TestProcessExtension_ValidatorSuccess() {
 	t.NoError(err)
 }
 
-func (t *FormHandlerImplTestSuite) TestHandleSubmittedForm_GetFormDataError() {
-	t.provider.On("GetFormData", t.context, t.request).Return(nil, errors.New("error")).Once()
+func (t *FormHandlerImplTestSuite) TestProcessExtension_ReportOnly() {
+	validationInfo := &domain.ValidationInfo{}
+	validationInfo.AddGeneralError("formError.fourth.invalid", "invalid")
 
-	t.firstExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
-	t.secondExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
-	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
+	t.defaultDecoder.On("Decode", t.context, t.request, url.Values{}, map[string]int{}).Return(map[string]int{}, nil).Once()
+	t.fourthExtension.On("Validate", t.context, t.request, t.validatorProvider, map[string]int{}).Return(validationInfo, nil).Once()
 
-	result, err := t.handler.HandleSubmittedForm(t.context, t.request)
-	t.Equal(domain.NewFormErrorWithParent(errors.New("error")), err)
-	t.Nil(result)
+	t.handler.reportOnly = newReportOnly(nil, []string{"fourth"})
+
+	form := domain.NewForm(true, nil)
+	err := t.handler.processExtension(t.context, t.request, url.Values{}, "fourth", t.fourthExtension, &form)
+	t.NoError(err)
+	t.True(form.IsValid())
 }
 
-func (t *FormHandlerImplTestSuite) TestHandleSubmittedForm_PostValueProcessingError() {
-	t.provider.On("GetFormData", t.context, t.request).Return(map[string]string{}, nil).Once()
+func (t *FormHandlerImplTestSuite) TestObserveFormResult() {
+	observer := &mocks.FormResultObserver{}
+	form := domain.NewForm(true, nil)
 
-	t.firstExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
-	t.secondExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
-	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+	t.handler.formExtensions = map[string]domain.FormExtension{
+		"first":    t.firstExtension,
+		"observer": observer,
+	}
 
-	t.request.Request().Method = http.MethodPost
+	observer.On("ObserveFormResult", t.context, t.request, url.Values{}, &form).Return(nil).Once()
 
-	result, err := t.handler.HandleSubmittedForm(t.context, t.request)
-	t.Equal(domain.NewFormErrorWithParent(errors.New("missing form body")), err)
-	t.Nil(result)
-}
+	err := t.handler.observeFormResult(t.context, t.request, url.Values{}, &form)
+	t.NoError(err)
 
-func (t *FormHandlerImplTestSuite) TestHandleSubmittedForm_DecodeError() {
-	t.provider.On("GetFormData", t.context, t.request).Return(map[string]string{}, nil).Once()
+	observer.AssertExpectations(t.T())
+}
 
-	t.firstExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
-	t.secondExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
-	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+func (t *FormHandlerImplTestSuite) TestObserveFormResult_DisabledExtension() {
+	observer := &mocks.FormResultObserver{}
+	featureFlagProvider := &mocks.FeatureFlagProvider{}
+	form := domain.NewForm(true, nil)
 
-	t.request.Request().Method = http.MethodPost
-	t.request.Request().PostForm = url.Values{
-		"first":  []string{"first"},
-		"second": []string{"second"},
+	t.handler.formExtensions = map[string]domain.FormExtension{
+		"observer": observer,
 	}
+	t.handler.featureToggles = newFeatureToggles(featureFlagProvider, []domain.FeatureToggle{
+		{
+			Feature:    "observer",
+			Extensions: []string{"observer"},
+		},
+	})
 
-	t.decoder.On("Decode", t.context, t.request, url.Values{
-		"first":  []string{"first"},
-		"second": []string{"second"},
-	}, map[string]string{}).Return(nil, errors.New("error")).Once()
+	featureFlagProvider.On("IsEnabled", t.context, t.request, "observer").Return(false).Once()
 
-	result, err := t.handler.HandleSubmittedForm(t.context, t.request)
-	t.Equal(domain.NewFormErrorWithParent(errors.New("error")), err)
-	t.Nil(result)
-}
+	err := t.handler.observeFormResult(t.context, t.request, url.Values{}, &form)
+	t.NoError(err)
 
-func (t *FormHandlerImplTestSuite) TestHandleSubmittedForm_ValidateError() {
-	t.provider.On("GetFormData", t.context, t.request).Return(map[string]string{}, nil).Once()
+	observer.AssertExpectations(t.T())
+	featureFlagProvider.AssertExpectations(t.T())
+}
 
-	t.firstExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
-	t.secondExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
-	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+func (t *FormHandlerImplTestSuite) TestObserveFormResult_Error() {
+	observer := &mocks.FormResultObserver{}
+	form := domain.NewForm(true, nil)
 
-	t.request.Request().Method = http.MethodPost
-	t.request.Request().PostForm = url.Values{
-		"first":  []string{"first"},
-		"second": []string{"second"},
+	t.handler.formExtensions = map[string]domain.FormExtension{
+		"observer": observer,
 	}
 
-	t.decoder.On("Decode", t.context, t.request, url.Values{
-		"first":  []string{"first"},
-		"second": []string{"second"},
-	}, map[string]string{}).Return(map[string]string{
-		"first":  "first",
-		"second": "second",
-	}, nil).Once()
+	observer.On("ObserveFormResult", t.context, t.request, url.Values{}, &form).Return(errors.New("error")).Once()
 
-	t.validator.On("Validate", t.context, t.request, t.validatorProvider, map[string]string{
-		"first":  "first",
-		"second": "second",
-	}).Return(nil, errors.New("error")).Once()
+	err := t.handler.observeFormResult(t.context, t.request, url.Values{}, &form)
+	t.Equal(errors.New("error"), err)
 
-	result, err := t.handler.HandleSubmittedForm(t.context, t.request)
-	t.Equal(domain.NewFormErrorWithParent(errors.New("error")), err)
-	t.Nil(result)
+	observer.AssertExpectations(t.T())
 }
 
-func (t *FormHandlerImplTestSuite) TestHandleSubmittedForm_FormExtensionError() {
-	t.request.Request().Method = http.MethodPost
-	t.request.Request().PostForm = url.Values{
-		"first":  []string{"first"},
-		"second": []string{"second"},
-	}
+func (t *FormHandlerImplTestSuite) TestValidateFormData() {
+	first := &mocks.FormDataValidator{}
+	second := &mocks.FormDataValidator{}
+	t.handler.formDataValidators = []domain.FormDataValidator{first, second}
 
-	t.firstExtension.On("GetFormData", t.context, t.request).Return(nil, errors.New("error")).Maybe()
-	t.secondExtension.On("GetFormData", t.context, t.request).Return(nil, errors.New("error")).Maybe()
-	t.defaultProvider.On("GetFormData", t.context, t.request).Return(nil, errors.New("error")).Maybe()
+	formData := map[string]string{"email": "invalid"}
 
-	result, err := t.handler.HandleSubmittedForm(t.context, t.request)
-	t.Equal(domain.NewFormErrorWithParent(errors.New("error")), err)
-	t.Nil(result)
+	validationInfo := domain.ValidationInfo{}
+	validationInfo.AddFieldError("email", "email", "invalid email")
+	firstInfo := domain.ValidationInfo{}
+	firstInfo.AddGeneralError("blocked", "blocked domain")
+
+	t.validator.On("Validate", t.context, t.request, t.validatorProvider, formData).Return(&validationInfo, nil).Once()
+	first.On("Validate", t.context, t.request, t.validatorProvider, formData).Return(&firstInfo, nil).Once()
+	second.On("Validate", t.context, t.request, t.validatorProvider, formData).Return(nil, nil).Once()
+
+	result, err := t.handler.validateFormData(t.context, t.request, formData)
+	t.NoError(err)
+	t.True(result.HasErrorsForField("email"))
+	t.Equal([]domain.Error{{MessageKey: "blocked", DefaultLabel: "blocked domain"}}, result.GetGeneralErrors())
+	t.False(result.IsValidationStopped())
+
+	first.AssertExpectations(t.T())
+	second.AssertExpectations(t.T())
 }
 
-func (t *FormHandlerImplTestSuite) TestHandleSubmittedForm_Success() {
-	t.provider.On("GetFormData", t.context, t.request).Return(map[string]string{}, nil).Once()
+func (t *FormHandlerImplTestSuite) TestValidateFormData_Stopped() {
+	first := &mocks.FormDataValidator{}
+	second := &mocks.FormDataValidator{}
+	t.handler.formDataValidators = []domain.FormDataValidator{first, second}
 
-	t.request.Request().Method = http.MethodPost
-	t.request.Request().PostForm = url.Values{
-		"first":  []string{"first"},
-		"second": []string{"second"},
-	}
+	formData := map[string]string{"email": "invalid"}
 
-	t.decoder.On("Decode", t.context, t.request, url.Values{
-		"first":  []string{"first"},
-		"second": []string{"second"},
-	}, map[string]string{}).Return(map[string]string{
-		"first":  "first",
-		"second": "second",
-	}, nil).Once()
+	firstInfo := domain.ValidationInfo{}
+	firstInfo.AddFieldError("email", "email", "invalid email")
+	firstInfo.StopValidation()
 
-	t.validator.On("Validate", t.context, t.request, t.validatorProvider, map[string]string{
-		"first":  "first",
-		"second": "second",
-	}).Return(&domain.ValidationInfo{}, nil).Once()
+	t.validator.On("Validate", t.context, t.request, t.validatorProvider, formData).Return(nil, nil).Once()
+	first.On("Validate", t.context, t.request, t.validatorProvider, formData).Return(&firstInfo, nil).Once()
 
-	t.firstExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
-	t.firstExtension.On("Decode", t.context, t.request, url.Values{
-		"first":  []string{"first"},
-		"second": []string{"second"},
-	}, map[string]int{}).Return(map[string]int{}, nil).Once()
-	t.firstExtension.On("Validate", t.context, t.request, t.validatorProvider, map[string]int{}).Return(&domain.ValidationInfo{}, nil).Once()
+	result, err := t.handler.validateFormData(t.context, t.request, formData)
+	t.NoError(err)
+	t.True(result.HasErrorsForField("email"))
+	t.True(result.IsValidationStopped())
 
-	t.secondExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
-	t.defaultDecoder.On("Decode", t.context, t.request, url.Values{
-		"first":  []string{"first"},
-		"second": []string{"second"},
-	}, map[string]int{}).Return(map[string]int{}, nil).Once()
-	t.defaultValidator.On("Validate", t.context, t.request, t.validatorProvider, map[string]int{}).Return(&domain.ValidationInfo{}, nil).Once()
+	first.AssertExpectations(t.T())
+	second.AssertExpectations(t.T())
+}
 
-	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
-	t.thirdExtension.On("Decode", t.context, t.request, url.Values{
-		"first":  []string{"first"},
-		"second": []string{"second"},
-	}, map[string]int{}).Return(map[string]int{}, nil).Once()
-	t.defaultValidator.On("Validate", t.context, t.request, t.validatorProvider, map[string]int{}).Return(&domain.ValidationInfo{}, nil).Once()
+func (t *FormHandlerImplTestSuite) TestValidateFormData_StoppedByFormDataValidator() {
+	additional := &mocks.FormDataValidator{}
+	t.handler.formDataValidators = []domain.FormDataValidator{additional}
 
-	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
-	t.defaultDecoder.On("Decode", t.context, t.request, url.Values{
-		"first":  []string{"first"},
-		"second": []string{"second"},
-	}, map[string]int{}).Return(map[string]int{}, nil).Once()
-	t.fourthExtension.On("Validate", t.context, t.request, t.validatorProvider, map[string]int{}).Return(&domain.ValidationInfo{}, nil).Once()
+	formData := map[string]string{}
 
-	result, err := t.handler.HandleSubmittedForm(t.context, t.request)
-	t.NoError(err)
+	validationInfo := domain.ValidationInfo{}
+	validationInfo.StopValidation()
 
-	form := domain.NewForm(true, map[string][]domain.ValidationRule{})
-	form.Data = map[string]string{
-		"first":  "first",
-		"second": "second",
-	}
-	form.FormExtensionsData = map[string]interface{}{
-		"first":  map[string]int{},
-		"second": map[string]int{},
-		"third":  map[string]int{},
-		"fourth": map[string]int{},
-	}
+	t.validator.On("Validate", t.context, t.request, t.validatorProvider, formData).Return(&validationInfo, nil).Once()
 
-	t.Equal(&form, result)
+	result, err := t.handler.validateFormData(t.context, t.request, formData)
+	t.NoError(err)
+	t.True(result.IsValid())
+
+	additional.AssertExpectations(t.T())
 }
 
-func (t *FormHandlerImplTestSuite) TestHandleForm_Unsubmitted() {
-	t.provider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
+func (t *FormHandlerImplTestSuite) TestValidateFormData_Error() {
+	additional := &mocks.FormDataValidator{}
+	t.handler.formDataValidators = []domain.FormDataValidator{additional}
+
+	formData := map[string]string{}
+
+	t.validator.On("Validate", t.context, t.request, t.validatorProvider, formData).Return(&domain.ValidationInfo{}, nil).Once()
+	additional.On("Validate", t.context, t.request, t.validatorProvider, formData).Return(nil, errors.New("error")).Once()
+
+	result, err := t.handler.validateFormData(t.context, t.request, formData)
+	t.Equal(errors.New("error"), err)
+	t.Nil(result)
+
+	additional.AssertExpectations(t.T())
+}
+
+func (t *FormHandlerImplTestSuite) TestEnrichFormData() {
+	first := &mocks.FormDataEnricher{}
+	second := &mocks.FormDataEnricher{}
+	t.handler.formDataEnrichers = []domain.FormDataEnricher{first, second}
+
+	first.On("Enrich", t.context, t.request, map[string]string{"address": "Main Street"}).Return(map[string]string{
+		"address": "Main Street",
+		"lat":     "52.52",
+	}, nil, nil).Once()
+	second.On("Enrich", t.context, t.request, map[string]string{"address": "Main Street", "lat": "52.52"}).Return(map[string]string{
+		"address": "Main Street",
+		"lat":     "52.52",
+		"group":   "retail",
+	}, &domain.ValidationInfo{}, nil).Once()
+
+	validationInfo := &domain.ValidationInfo{}
+	result, err := t.handler.enrichFormData(t.context, t.request, map[string]string{"address": "Main Street"}, validationInfo)
+	t.NoError(err)
+	t.Equal(map[string]string{
+		"address": "Main Street",
+		"lat":     "52.52",
+		"group":   "retail",
+	}, result)
+	t.True(validationInfo.IsValid())
+
+	first.AssertExpectations(t.T())
+	second.AssertExpectations(t.T())
+}
+
+func (t *FormHandlerImplTestSuite) TestEnrichFormData_ValidationErrors() {
+	first := &mocks.FormDataEnricher{}
+	second := &mocks.FormDataEnricher{}
+	t.handler.formDataEnrichers = []domain.FormDataEnricher{first, second}
+
+	enrichmentInfo := &domain.ValidationInfo{}
+	enrichmentInfo.AddFieldError("address", "formError.address.geocode", "Address can't be found")
+
+	first.On("Enrich", t.context, t.request, map[string]string{"address": "Nowhere"}).Return(map[string]string{
+		"address": "Nowhere",
+	}, enrichmentInfo, nil).Once()
+
+	validationInfo := &domain.ValidationInfo{}
+	result, err := t.handler.enrichFormData(t.context, t.request, map[string]string{"address": "Nowhere"}, validationInfo)
+	t.NoError(err)
+	t.Equal(map[string]string{"address": "Nowhere"}, result)
+	t.Equal(map[string][]domain.Error{
+		"address": {
+			{
+				MessageKey:   "formError.address.geocode",
+				DefaultLabel: "Address can't be found",
+			},
+		},
+	}, validationInfo.GetErrorsForAllFields())
+
+	first.AssertExpectations(t.T())
+	second.AssertExpectations(t.T())
+}
+
+func (t *FormHandlerImplTestSuite) TestEnrichFormData_InvalidForm() {
+	enricher := &mocks.FormDataEnricher{}
+	t.handler.formDataEnrichers = []domain.FormDataEnricher{enricher}
+
+	validationInfo := &domain.ValidationInfo{}
+	validationInfo.AddGeneralError("error", "error")
+
+	result, err := t.handler.enrichFormData(t.context, t.request, map[string]string{"address": "Main Street"}, validationInfo)
+	t.NoError(err)
+	t.Equal(map[string]string{"address": "Main Street"}, result)
+
+	enricher.AssertExpectations(t.T())
+}
+
+func (t *FormHandlerImplTestSuite) TestEnrichFormData_Error() {
+	enricher := &mocks.FormDataEnricher{}
+	t.handler.formDataEnrichers = []domain.FormDataEnricher{enricher}
+
+	enricher.On("Enrich", t.context, t.request, map[string]string{"address": "Main Street"}).Return(nil, nil, errors.New("error")).Once()
+
+	result, err := t.handler.enrichFormData(t.context, t.request, map[string]string{"address": "Main Street"}, &domain.ValidationInfo{})
+	t.Equal(errors.New("error"), err)
+	t.Nil(result)
+
+	enricher.AssertExpectations(t.T())
+}
+
+func (t *FormHandlerImplTestSuite) TestGateFormValidity() {
+	gate := &mocks.FormValidityGate{}
+	form := domain.NewForm(true, nil)
+
+	t.handler.formExtensions = map[string]domain.FormExtension{
+		"first": t.firstExtension,
+		"gate":  gate,
+	}
+
+	gate.On("GateFormValidity", t.context, t.request, url.Values{}, &form).Return(nil, nil).Once()
+
+	err := t.handler.gateFormValidity(t.context, t.request, url.Values{}, &form)
+	t.NoError(err)
+	t.True(form.IsValid())
+
+	gate.AssertExpectations(t.T())
+}
+
+func (t *FormHandlerImplTestSuite) TestGateFormValidity_Veto() {
+	firstGate := &mocks.FormValidityGate{}
+	secondGate := &mocks.FormValidityGate{}
+	form := domain.NewForm(true, nil)
+
+	t.handler.formExtensions = map[string]domain.FormExtension{
+		"firstGate":  firstGate,
+		"secondGate": secondGate,
+	}
+
+	firstGate.On("GateFormValidity", t.context, t.request, url.Values{}, &form).Return(&domain.Error{
+		MessageKey:   "formError.fraud",
+		DefaultLabel: "fraud",
+	}, nil).Once()
+
+	err := t.handler.gateFormValidity(t.context, t.request, url.Values{}, &form)
+	t.NoError(err)
+	t.False(form.IsValid())
+	t.Equal([]domain.Error{
+		{
+			MessageKey:   "formError.fraud",
+			DefaultLabel: "fraud",
+		},
+	}, form.ValidationInfo.GetGeneralErrors())
+
+	firstGate.AssertExpectations(t.T())
+	secondGate.AssertExpectations(t.T())
+}
+
+func (t *FormHandlerImplTestSuite) TestGateFormValidity_InvalidForm() {
+	gate := &mocks.FormValidityGate{}
+	form := domain.NewForm(true, nil)
+	form.ValidationInfo.AddGeneralError("error", "error")
+
+	t.handler.formExtensions = map[string]domain.FormExtension{
+		"gate": gate,
+	}
+
+	err := t.handler.gateFormValidity(t.context, t.request, url.Values{}, &form)
+	t.NoError(err)
+
+	gate.AssertExpectations(t.T())
+}
+
+func (t *FormHandlerImplTestSuite) TestGateFormValidity_ReportOnly() {
+	gate := &mocks.FormValidityGate{}
+	form := domain.NewForm(true, nil)
+
+	t.handler.formExtensions = map[string]domain.FormExtension{
+		"gate": gate,
+	}
+	t.handler.reportOnly = newReportOnly(nil, []string{"gate"})
+
+	gate.On("GateFormValidity", t.context, t.request, url.Values{}, &form).Return(&domain.Error{
+		MessageKey:   "formError.fraud",
+		DefaultLabel: "fraud",
+	}, nil).Once()
+
+	err := t.handler.gateFormValidity(t.context, t.request, url.Values{}, &form)
+	t.NoError(err)
+	t.True(form.IsValid())
+
+	gate.AssertExpectations(t.T())
+}
+
+func (t *FormHandlerImplTestSuite) TestGateFormValidity_DisabledExtension() {
+	gate := &mocks.FormValidityGate{}
+	featureFlagProvider := &mocks.FeatureFlagProvider{}
+	form := domain.NewForm(true, nil)
+
+	t.handler.formExtensions = map[string]domain.FormExtension{
+		"gate": gate,
+	}
+	t.handler.featureToggles = newFeatureToggles(featureFlagProvider, []domain.FeatureToggle{
+		{
+			Feature:    "gate",
+			Extensions: []string{"gate"},
+		},
+	})
+
+	featureFlagProvider.On("IsEnabled", t.context, t.request, "gate").Return(false).Once()
+
+	err := t.handler.gateFormValidity(t.context, t.request, url.Values{}, &form)
+	t.NoError(err)
+	t.True(form.IsValid())
+
+	gate.AssertExpectations(t.T())
+	featureFlagProvider.AssertExpectations(t.T())
+}
+
+func (t *FormHandlerImplTestSuite) TestGateFormValidity_Error() {
+	gate := &mocks.FormValidityGate{}
+	form := domain.NewForm(true, nil)
+
+	t.handler.formExtensions = map[string]domain.FormExtension{
+		"gate": gate,
+	}
+
+	gate.On("GateFormValidity", t.context, t.request, url.Values{}, &form).Return(nil, errors.New("error")).Once()
+
+	err := t.handler.gateFormValidity(t.context, t.request, url.Values{}, &form)
+	t.Equal(errors.New("error"), err)
+
+	gate.AssertExpectations(t.T())
+}
+
+func (t *FormHandlerImplTestSuite) TestEncryptFields_NoTags() {
+	result, err := t.handler.encryptFields(t.context, map[string]string{"first": "first"})
+	t.NoError(err)
+	t.Equal(map[string]string{"first": "first"}, result)
+
+	result, err = t.handler.encryptFields(t.context, struct{ Name string }{Name: "name"})
+	t.NoError(err)
+	t.Equal(struct{ Name string }{Name: "name"}, result)
+
+	result, err = t.handler.encryptFields(t.context, nil)
+	t.NoError(err)
+	t.Nil(result)
+}
+
+func (t *FormHandlerImplTestSuite) TestEncryptFields_Struct() {
+	type address struct {
+		Street string `encrypt:"true"`
+		City   string
+	}
+	type formData struct {
+		Name     string
+		IBAN     string  `encrypt:"true"`
+		TaxID    *string `encrypt:"true"`
+		Empty    string  `encrypt:"true"`
+		Address  address
+		Delivery *address
+	}
+
+	taxID := "tax"
+	data := formData{
+		Name:  "name",
+		IBAN:  "iban",
+		TaxID: &taxID,
+		Address: address{
+			Street: "street",
+			City:   "city",
+		},
+		Delivery: &address{
+			Street: "delivery",
+		},
+	}
+
+	t.fieldEncryptor.On("Encrypt", t.context, "iban").Return("encrypted-iban", nil).Once()
+	t.fieldEncryptor.On("Encrypt", t.context, "tax").Return("encrypted-tax", nil).Once()
+	t.fieldEncryptor.On("Encrypt", t.context, "street").Return("encrypted-street", nil).Once()
+	t.fieldEncryptor.On("Encrypt", t.context, "delivery").Return("encrypted-delivery", nil).Once()
+
+	result, err := t.handler.encryptFields(t.context, data)
+	t.NoError(err)
+
+	encryptedTaxID := "encrypted-tax"
+	t.Equal(formData{
+		Name:  "name",
+		IBAN:  "encrypted-iban",
+		TaxID: &encryptedTaxID,
+		Address: address{
+			Street: "encrypted-street",
+			City:   "city",
+		},
+		Delivery: &address{
+			Street: "encrypted-delivery",
+		},
+	}, result)
+	t.Equal("iban", data.IBAN)
+	t.Equal("tax", taxID)
+}
+
+func (t *FormHandlerImplTestSuite) TestEncryptFields_Pointer() {
+	type formData struct {
+		IBAN string `encrypt:"true"`
+	}
+
+	data := &formData{IBAN: "iban"}
+
+	t.fieldEncryptor.On("Encrypt", t.context, "iban").Return("encrypted-iban", nil).Once()
+
+	result, err := t.handler.encryptFields(t.context, data)
+	t.NoError(err)
+	t.Equal(&formData{IBAN: "encrypted-iban"}, result)
+	t.Equal("encrypted-iban", data.IBAN)
+}
+
+func (t *FormHandlerImplTestSuite) TestEncryptFields_Error() {
+	type formData struct {
+		IBAN string `encrypt:"true"`
+	}
+
+	t.fieldEncryptor.On("Encrypt", t.context, "iban").Return("", errors.New("error")).Once()
+
+	result, err := t.handler.encryptFields(t.context, formData{IBAN: "iban"})
+	t.Equal(errors.New("error"), err)
+	t.Nil(result)
+
+	t.handler.fieldEncryptor = nil
+
+	result, err = t.handler.encryptFields(t.context, formData{IBAN: "iban"})
+	t.Error(err)
+	t.Nil(result)
+}
+
+func (t *FormHandlerImplTestSuite) TestTokenizeCards() {
+	type formData struct {
+		Payment card.Card
+		Backup  *card.Card
+		Missing *card.Card
+	}
+
+	paymentCard := card.Card{Number: "4111111111111111", Expiry: "12/25", CVC: "123"}
+	backup := &card.Card{Number: "5555555555554444", Expiry: "01/26", CVC: "456"}
+	tokenizer := &cardTestTokenizer{token: "token"}
+	t.handler.cardTokenizer = tokenizer
+
+	result, err := t.handler.tokenizeCards(t.context, formData{Payment: paymentCard, Backup: backup})
+	t.NoError(err)
+	t.Equal(formData{
+		Payment: card.Card{Expiry: "12/25", Token: "token"},
+		Backup:  &card.Card{Expiry: "01/26", Token: "token"},
+	}, result)
+	t.Equal([]card.Card{paymentCard, *backup}, tokenizer.cards)
+	t.Equal(card.Number("5555555555554444"), backup.Number)
+}
+
+func (t *FormHandlerImplTestSuite) TestTokenizeCards_NoToken() {
+	type formData struct {
+		Payment card.Card
+	}
+
+	data := formData{Payment: card.Card{Number: "4111111111111111", Expiry: "12/25", CVC: "123"}}
+
+	result, err := t.handler.tokenizeCards(t.context, data)
+	t.NoError(err)
+	t.Equal(data, result)
+
+	t.handler.cardTokenizer = &cardTestTokenizer{}
+
+	result, err = t.handler.tokenizeCards(t.context, data)
+	t.NoError(err)
+	t.Equal(data, result)
+}
+
+func (t *FormHandlerImplTestSuite) TestTokenizeCards_Error() {
+	type formData struct {
+		Payment card.Card
+	}
+
+	t.handler.cardTokenizer = &cardTestTokenizer{err: errors.New("error")}
+
+	result, err := t.handler.tokenizeCards(t.context, formData{Payment: card.Card{Number: "4111111111111111"}})
+	t.Equal(errors.New("error"), err)
+	t.Nil(result)
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleSubmittedValues_TokenizeCardsAfterGates() {
+	type formData struct {
+		Payment card.Card
+	}
+
+	paymentCard := card.Card{Number: "4111111111111111", Expiry: "12/25", CVC: "123"}
+	tokenizer := &cardTestTokenizer{token: "token"}
+	gate := &mocks.FormValidityGate{}
+	values := url.Values{"Payment.Number": []string{"4111111111111111"}}
+
+	t.handler.cardTokenizer = tokenizer
+	t.handler.formExtensions = map[string]domain.FormExtension{"gate": gate}
+
+	t.decoder.On("Decode", t.context, t.request, values, formData{}).Return(formData{Payment: paymentCard}, nil).Twice()
+	t.validator.On("Validate", t.context, t.request, t.validatorProvider, formData{Payment: paymentCard}).Return(&domain.ValidationInfo{}, nil).Twice()
+	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+	t.defaultDecoder.On("Decode", t.context, t.request, values, map[string]int{}).Return(map[string]int{}, nil).Twice()
+	t.defaultValidator.On("Validate", t.context, t.request, t.validatorProvider, map[string]int{}).Return(&domain.ValidationInfo{}, nil).Twice()
+
+	// card of submission rejected by gate is never sent to tokenizer
+	gate.On("GateFormValidity", t.context, t.request, values, mock.Anything).Return(&domain.Error{
+		MessageKey:   "formError.fraud",
+		DefaultLabel: "fraud",
+	}, nil).Once()
+
+	form := domain.NewForm(true, nil)
+	form.Data = formData{}
+	result, err := t.handler.handleSubmittedValues(t.context, t.request, &form, values)
+	t.NoError(err)
+	t.False(result.IsValid())
+	t.Equal(formData{Payment: paymentCard}, result.Data)
+	t.Empty(tokenizer.cards)
+
+	gate.On("GateFormValidity", t.context, t.request, values, mock.Anything).Return(nil, nil).Once()
+
+	form = domain.NewForm(true, nil)
+	form.Data = formData{}
+	result, err = t.handler.handleSubmittedValues(t.context, t.request, &form, values)
+	t.NoError(err)
+	t.True(result.IsValid())
+	t.Equal(formData{Payment: card.Card{Expiry: "12/25", Token: "token"}}, result.Data)
+	t.Equal([]card.Card{paymentCard}, tokenizer.cards)
+
+	gate.AssertExpectations(t.T())
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleSubmittedValues_EnrichFormDataAfterGates() {
+	enricher := &mocks.FormDataEnricher{}
+	gate := &mocks.FormValidityGate{}
+	values := url.Values{"address": []string{"Main Street"}}
+	formData := map[string]string{"address": "Main Street"}
+
+	t.handler.formDataEnrichers = []domain.FormDataEnricher{enricher}
+	t.handler.formExtensions = map[string]domain.FormExtension{"gate": gate}
+
+	t.decoder.On("Decode", t.context, t.request, values, map[string]string{}).Return(formData, nil).Twice()
+	t.validator.On("Validate", t.context, t.request, t.validatorProvider, formData).Return(&domain.ValidationInfo{}, nil).Twice()
+	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+	t.defaultDecoder.On("Decode", t.context, t.request, values, map[string]int{}).Return(map[string]int{}, nil).Twice()
+	t.defaultValidator.On("Validate", t.context, t.request, t.validatorProvider, map[string]int{}).Return(&domain.ValidationInfo{}, nil).Twice()
+
+	// submission rejected by gate is never enriched
+	gate.On("GateFormValidity", t.context, t.request, values, mock.Anything).Return(&domain.Error{
+		MessageKey:   "formError.fraud",
+		DefaultLabel: "fraud",
+	}, nil).Once()
+
+	form := domain.NewForm(true, nil)
+	form.Data = map[string]string{}
+	result, err := t.handler.handleSubmittedValues(t.context, t.request, &form, values)
+	t.NoError(err)
+	t.False(result.IsValid())
+	t.Equal(formData, result.Data)
+
+	gate.On("GateFormValidity", t.context, t.request, values, mock.Anything).Return(nil, nil).Once()
+	enricher.On("Enrich", t.context, t.request, formData).Return(map[string]string{
+		"address": "Main Street",
+		"lat":     "52.52",
+	}, nil, nil).Once()
+
+	form = domain.NewForm(true, nil)
+	form.Data = map[string]string{}
+	result, err = t.handler.handleSubmittedValues(t.context, t.request, &form, values)
+	t.NoError(err)
+	t.True(result.IsValid())
+	t.Equal(map[string]string{"address": "Main Street", "lat": "52.52"}, result.Data)
+
+	enricher.AssertExpectations(t.T())
+	gate.AssertExpectations(t.T())
+}
+
+func (t *FormHandlerImplTestSuite) TestRenderMarkdown() {
+	type formData struct {
+		Title   string
+		Comment markdown.Text
+		Review  *struct {
+			Body markdown.Text
+		}
+		Empty markdown.Text
+	}
+
+	previews, err := t.handler.renderMarkdown(t.context, formData{Comment: "**great**"})
+	t.NoError(err)
+	t.Nil(previews)
+
+	t.handler.markdownRenderer = &markdownTestRenderer{}
+
+	previews, err = t.handler.renderMarkdown(t.context, &formData{Title: "title", Comment: "**great**"})
+	t.NoError(err)
+	t.Equal(map[string]template.HTML{
+		"comment": "<p>**great**</p>",
+	}, previews)
+
+	data := formData{Comment: "**great**"}
+	data.Review = &struct {
+		Body markdown.Text
+	}{Body: "*fast*"}
+
+	previews, err = t.handler.renderMarkdown(t.context, data)
+	t.NoError(err)
+	t.Equal(map[string]template.HTML{
+		"comment":     "<p>**great**</p>",
+		"review.body": "<p>*fast*</p>",
+	}, previews)
+
+	previews, err = t.handler.renderMarkdown(t.context, map[string]string{"comment": "**great**"})
+	t.NoError(err)
+	t.Nil(previews)
+}
+
+func (t *FormHandlerImplTestSuite) TestRenderMarkdown_Error() {
+	type formData struct {
+		Comment markdown.Text
+	}
+
+	t.handler.markdownRenderer = &markdownTestRenderer{err: errors.New("error")}
+
+	previews, err := t.handler.renderMarkdown(t.context, formData{Comment: "**great**"})
+	t.Equal(errors.New("error"), err)
+	t.Nil(previews)
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleSubmittedForm_GetFormDataError() {
+	t.provider.On("GetFormData", t.context, t.request).Return(nil, errors.New("error")).Once()
+
+	t.firstExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
+	t.secondExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
+	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+
+	result, err := t.handler.HandleSubmittedForm(t.context, t.request)
+	t.Equal(domain.NewFormErrorWithParent(errors.New("error")), err)
+	t.Nil(result)
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleSubmittedForm_PostValueProcessingError() {
+	t.provider.On("GetFormData", t.context, t.request).Return(map[string]string{}, nil).Once()
+
+	t.firstExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
+	t.secondExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
+	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+
+	t.request.Request().Method = http.MethodPost
+
+	result, err := t.handler.HandleSubmittedForm(t.context, t.request)
+	t.Equal(domain.NewFormErrorWithParent(errors.New("missing form body")), err)
+	t.Nil(result)
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleSubmittedForm_DecodeError() {
+	t.provider.On("GetFormData", t.context, t.request).Return(map[string]string{}, nil).Once()
+
+	t.firstExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
+	t.secondExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
+	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+
+	t.request.Request().Method = http.MethodPost
+	t.request.Request().PostForm = url.Values{
+		"first":  []string{"first"},
+		"second": []string{"second"},
+	}
+
+	t.decoder.On("Decode", t.context, t.request, url.Values{
+		"first":  []string{"first"},
+		"second": []string{"second"},
+	}, map[string]string{}).Return(nil, errors.New("error")).Once()
+
+	result, err := t.handler.HandleSubmittedForm(t.context, t.request)
+	t.Equal(domain.NewFormErrorWithParent(errors.New("error")), err)
+	t.Nil(result)
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleSubmittedForm_ValidateError() {
+	t.provider.On("GetFormData", t.context, t.request).Return(map[string]string{}, nil).Once()
+
+	t.firstExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
+	t.secondExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
+	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+
+	t.request.Request().Method = http.MethodPost
+	t.request.Request().PostForm = url.Values{
+		"first":  []string{"first"},
+		"second": []string{"second"},
+	}
+
+	t.decoder.On("Decode", t.context, t.request, url.Values{
+		"first":  []string{"first"},
+		"second": []string{"second"},
+	}, map[string]string{}).Return(map[string]string{
+		"first":  "first",
+		"second": "second",
+	}, nil).Once()
+
+	t.validator.On("Validate", t.context, t.request, t.validatorProvider, map[string]string{
+		"first":  "first",
+		"second": "second",
+	}).Return(nil, errors.New("error")).Once()
+
+	result, err := t.handler.HandleSubmittedForm(t.context, t.request)
+	t.Equal(domain.NewFormErrorWithParent(errors.New("error")), err)
+	t.Nil(result)
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleSubmittedForm_RuleError() {
+	type ruleErrorData struct {
+		Name string `form:"name" validate:"min=three"`
+	}
+
+	t.handler.formExtensions = nil
+	t.provider.On("GetFormData", t.context, t.request).Return(ruleErrorData{}, nil).Once()
+
+	t.request.Request().Method = http.MethodGet
+	t.request.Request().URL = &url.URL{}
+
+	t.decoder.On("Decode", t.context, t.request, url.Values{}, ruleErrorData{}).Return(ruleErrorData{}, nil).Once()
+
+	result, err := t.handler.HandleSubmittedGETForm(t.context, t.request)
+	t.Error(err)
+	t.Nil(result)
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleSubmittedForm_FormExtensionError() {
+	t.request.Request().Method = http.MethodPost
+	t.request.Request().PostForm = url.Values{
+		"first":  []string{"first"},
+		"second": []string{"second"},
+	}
+
+	t.firstExtension.On("GetFormData", t.context, t.request).Return(nil, errors.New("error")).Maybe()
+	t.secondExtension.On("GetFormData", t.context, t.request).Return(nil, errors.New("error")).Maybe()
+	t.defaultProvider.On("GetFormData", t.context, t.request).Return(nil, errors.New("error")).Maybe()
+
+	result, err := t.handler.HandleSubmittedForm(t.context, t.request)
+	t.Equal(domain.NewFormErrorWithParent(errors.New("error")), err)
+	t.Nil(result)
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleSubmittedForm_Success() {
+	t.provider.On("GetFormData", t.context, t.request).Return(map[string]string{}, nil).Once()
+
+	t.request.Request().Method = http.MethodPost
+	t.request.Request().PostForm = url.Values{
+		"first":  []string{"first"},
+		"second": []string{"second"},
+	}
+
+	t.decoder.On("Decode", t.context, t.request, url.Values{
+		"first":  []string{"first"},
+		"second": []string{"second"},
+	}, map[string]string{}).Return(map[string]string{
+		"first":  "first",
+		"second": "second",
+	}, nil).Once()
+
+	t.validator.On("Validate", t.context, t.request, t.validatorProvider, map[string]string{
+		"first":  "first",
+		"second": "second",
+	}).Return(&domain.ValidationInfo{}, nil).Once()
+
+	t.firstExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+	t.firstExtension.On("Decode", t.context, t.request, url.Values{
+		"first":  []string{"first"},
+		"second": []string{"second"},
+	}, map[string]int{}).Return(map[string]int{}, nil).Once()
+	t.firstExtension.On("Validate", t.context, t.request, t.validatorProvider, map[string]int{}).Return(&domain.ValidationInfo{}, nil).Once()
+
+	t.secondExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+	t.defaultDecoder.On("Decode", t.context, t.request, url.Values{
+		"first":  []string{"first"},
+		"second": []string{"second"},
+	}, map[string]int{}).Return(map[string]int{}, nil).Once()
+	t.defaultValidator.On("Validate", t.context, t.request, t.validatorProvider, map[string]int{}).Return(&domain.ValidationInfo{}, nil).Once()
+
+	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+	t.thirdExtension.On("Decode", t.context, t.request, url.Values{
+		"first":  []string{"first"},
+		"second": []string{"second"},
+	}, map[string]int{}).Return(map[string]int{}, nil).Once()
+	t.defaultValidator.On("Validate", t.context, t.request, t.validatorProvider, map[string]int{}).Return(&domain.ValidationInfo{}, nil).Once()
+
+	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+	t.defaultDecoder.On("Decode", t.context, t.request, url.Values{
+		"first":  []string{"first"},
+		"second": []string{"second"},
+	}, map[string]int{}).Return(map[string]int{}, nil).Once()
+	t.fourthExtension.On("Validate", t.context, t.request, t.validatorProvider, map[string]int{}).Return(&domain.ValidationInfo{}, nil).Once()
+
+	result, err := t.handler.HandleSubmittedForm(t.context, t.request)
+	t.NoError(err)
+
+	form := domain.NewForm(true, map[string][]domain.ValidationRule{})
+	form.Data = map[string]string{
+		"first":  "first",
+		"second": "second",
+	}
+	form.FormExtensionsData = map[string]interface{}{
+		"first":  map[string]int{},
+		"second": map[string]int{},
+		"third":  map[string]int{},
+		"fourth": map[string]int{},
+	}
+
+	form.CorrelationID = "correlation"
+	t.Equal(&form, result)
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleSubmittedFormDryRun() {
+	readOnlyExtension := &dryRunTestExtension{CompleteFormService: &mocks.CompleteFormService{}}
+	observer := &mocks.FormResultObserver{}
+	gate := &mocks.FormValidityGate{}
+	successStep := &mocks.SuccessStep{}
+
+	t.handler.formExtensions = map[string]domain.FormExtension{
+		"first":    t.firstExtension,
+		"readOnly": readOnlyExtension,
+		"observer": observer,
+		"gate":     gate,
+	}
+	t.handler.successSteps = []domain.SuccessStep{successStep}
+
+	t.provider.On("GetFormData", t.context, t.request).Return(map[string]string{}, nil).Once()
+
+	t.request.Request().Method = http.MethodPost
+	t.request.Request().PostForm = url.Values{
+		"first": []string{"first"},
+	}
+
+	t.decoder.On("Decode", t.context, t.request, url.Values{
+		"first": []string{"first"},
+	}, map[string]string{}).Return(map[string]string{
+		"first": "first",
+	}, nil).Once()
+	t.validator.On("Validate", t.context, t.request, t.validatorProvider, map[string]string{
+		"first": "first",
+	}).Return(&domain.ValidationInfo{}, nil).Once()
+
+	// form extensions which aren't read-only only provide their form data
+	t.firstExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Times(4)
+
+	readOnlyExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+	readOnlyExtension.On("Decode", t.context, t.request, url.Values{
+		"first": []string{"first"},
+	}, map[string]int{}).Return(map[string]int{}, nil).Once()
+	readOnlyExtension.On("Validate", t.context, t.request, t.validatorProvider, map[string]int{}).Return(&domain.ValidationInfo{}, nil).Once()
+
+	result, err := t.handler.HandleSubmittedFormDryRun(t.context, t.request)
+	t.NoError(err)
+	t.True(result.DryRun)
+	t.True(result.IsValidAndSubmitted())
+	t.Equal(map[string]string{"first": "first"}, result.Data)
+	t.Equal(map[string]interface{}{
+		"first":    map[string]int{},
+		"readOnly": map[string]int{},
+		"observer": map[string]int{},
+		"gate":     map[string]int{},
+	}, result.FormExtensionsData)
+
+	readOnlyExtension.AssertExpectations(t.T())
+	observer.AssertExpectations(t.T())
+	gate.AssertExpectations(t.T())
+	successStep.AssertExpectations(t.T())
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleFormResult_NotSubmitted() {
+	t.provider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
+
+	t.firstExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
+	t.secondExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
+	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+
+	result := t.handler.HandleFormResult(t.context, t.request)
+	t.Equal(domain.FormStateNotSubmitted, result.State())
+	t.NoError(result.Err())
+	t.Equal(map[string]int{}, result.Data())
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleFormResult_Failed() {
+	t.provider.On("GetFormData", t.context, t.request).Return(nil, errors.New("error")).Once()
+
+	t.firstExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
+	t.secondExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
+	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+
+	result := t.handler.HandleFormResult(t.context, t.request)
+	t.Equal(domain.FormStateFailed, result.State())
+	t.Error(result.Err())
+	t.Nil(result.Form())
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleSubmittedForm_Debug() {
+	t.handler.debug = true
+	t.handler.formExtensions = map[string]domain.FormExtension{
+		"first": t.firstExtension,
+	}
+
+	t.provider.On("GetFormData", t.context, t.request).Return(map[string]string{}, nil).Once()
+
+	t.request.Request().Method = http.MethodPost
+	t.request.Request().PostForm = url.Values{
+		"first":    []string{"first"},
+		"password": []string{"secret"},
+		"note":     []string{"card 4111111111111111"},
+	}
+
+	t.decoder.On("Decode", t.context, t.request, url.Values{
+		"first":    []string{"first"},
+		"password": []string{"secret"},
+		"note":     []string{"card 4111111111111111"},
+	}, map[string]string{}).Return(map[string]string{
+		"first": "first",
+	}, nil).Once()
+
+	validationInfo := domain.ValidationInfo{}
+	validationInfo.AddFieldError("first", "formError.first.invalid", "invalid")
+	t.validator.On("Validate", t.context, t.request, t.validatorProvider, map[string]string{
+		"first": "first",
+	}).Return(&validationInfo, nil).Once()
+
+	extensionValidationInfo := domain.ValidationInfo{}
+	extensionValidationInfo.AddGeneralError("formError.general", "general")
+	t.firstExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+	t.firstExtension.On("Decode", t.context, t.request, url.Values{
+		"first":    []string{"first"},
+		"password": []string{"secret"},
+		"note":     []string{"card 4111111111111111"},
+	}, map[string]int{}).Return(map[string]int{"first": 1}, nil).Once()
+	t.firstExtension.On("Validate", t.context, t.request, t.validatorProvider, map[string]int{"first": 1}).Return(&extensionValidationInfo, nil).Once()
+
+	result, err := t.handler.HandleSubmittedForm(t.context, t.request)
+	t.NoError(err)
+
+	t.Equal(&domain.DebugInfo{
+		RawValues: url.Values{
+			"first":    []string{"first"},
+			"password": []string{domain.RedactedValue},
+			"note":     []string{"card ************1111"},
+		},
+		DecodedData:     `{"first":"first"}`,
+		ValidationRules: map[string][]domain.ValidationRule{},
+		ValidationInfo:  validationInfo,
+		Extensions: map[string]domain.ExtensionDebugInfo{
+			"first": {
+				ProvidedData:   `{}`,
+				DecodedData:    `{"first":1}`,
+				ValidationInfo: extensionValidationInfo,
+			},
+		},
+	}, result.DebugInfo)
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleForm_Unsubmitted() {
+	t.provider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Once()
 
 	t.firstExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
 	t.secondExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
@@ -664,6 +1734,8 @@ func (t *FormHandlerImplTestSuite) TestHandleForm_Unsubmitted() {
 		"fourth": map[string]int{},
 	}
 
+	form.CacheHint = &domain.CacheHint{Cacheable: true}
+	form.CorrelationID = "correlation"
 	t.Equal(&form, result)
 }
 
@@ -732,6 +1804,7 @@ func (t *FormHandlerImplTestSuite) TestHandleForm_Submitted() {
 		"fourth": map[string]int{},
 	}
 
+	form.CorrelationID = "correlation"
 	t.Equal(&form, result)
 }
 
@@ -802,5 +1875,209 @@ func (t *FormHandlerImplTestSuite) TestHandleSubmittedGETForm() {
 		"fourth": map[string]int{},
 	}
 
+	form.CorrelationID = "correlation"
 	t.Equal(&form, result)
 }
+
+func (t *FormHandlerImplTestSuite) TestHandleSearchForm() {
+	type searchData struct {
+		Query string `form:"q"`
+		Sort  string `form:"sort" default:"relevance"`
+		Page  int    `form:"page" default:"1"`
+	}
+
+	t.handler.formExtensions = nil
+	t.provider.On("GetFormData", t.context, t.request).Return(searchData{}, nil).Once()
+
+	t.request.Request().Method = http.MethodGet
+	t.request.Request().URL = &url.URL{
+		RawQuery: url.Values{
+			"q":     []string{"shoes"},
+			"sort":  []string{"relevance"},
+			"page":  []string{"x"},
+			"utm":   []string{"campaign"},
+			"empty": []string{""},
+		}.Encode(),
+	}
+
+	t.decoder.On("Decode", t.context, t.request, url.Values{
+		"q":     []string{"shoes"},
+		"sort":  []string{"relevance"},
+		"page":  []string{"x"},
+		"utm":   []string{"campaign"},
+		"empty": []string{""},
+	}, searchData{}).Return(searchData{
+		Query: "shoes",
+		Sort:  "relevance",
+	}, nil).Once()
+
+	validationInfo := domain.ValidationInfo{}
+	validationInfo.AddFieldError("page", "formError.page.numeric", "numeric")
+	t.validator.On("Validate", t.context, t.request, t.validatorProvider, searchData{
+		Query: "shoes",
+		Sort:  "relevance",
+	}).Return(&validationInfo, nil).Once()
+
+	result, err := t.handler.HandleSearchForm(t.context, t.request)
+	t.NoError(err)
+	t.Equal(searchData{Query: "shoes", Sort: "relevance"}, result.Data)
+	t.False(result.IsValid())
+	t.Equal(url.Values{
+		"q": []string{"shoes"},
+	}, result.CanonicalValues)
+	t.False(result.IsCanonical(t.request))
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleSearchForm_Defaults() {
+	type searchData struct {
+		Sort string `form:"sort" default:"relevance"`
+	}
+
+	t.handler.formExtensions = nil
+	t.provider.On("GetFormData", t.context, t.request).Return(searchData{}, nil).Once()
+
+	t.request.Request().Method = http.MethodGet
+	t.request.Request().URL = &url.URL{}
+
+	t.decoder.On("Decode", t.context, t.request, url.Values{
+		"sort": []string{"relevance"},
+	}, searchData{}).Return(searchData{
+		Sort: "relevance",
+	}, nil).Once()
+	t.validator.On("Validate", t.context, t.request, t.validatorProvider, searchData{
+		Sort: "relevance",
+	}).Return(&domain.ValidationInfo{}, nil).Once()
+
+	result, err := t.handler.HandleSearchForm(t.context, t.request)
+	t.NoError(err)
+	t.True(result.IsValidAndSubmitted())
+	t.Equal(url.Values{}, result.CanonicalValues)
+	t.True(result.IsCanonical(t.request))
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleSearchForm_WithoutSideEffects() {
+	type searchData struct {
+		Query string `form:"q"`
+	}
+
+	readOnlyExtension := &dryRunTestExtension{CompleteFormService: &mocks.CompleteFormService{}}
+	observer := &mocks.FormResultObserver{}
+	gate := &mocks.FormValidityGate{}
+	successStep := &mocks.SuccessStep{}
+
+	t.handler.formExtensions = map[string]domain.FormExtension{
+		"first":    t.firstExtension,
+		"readOnly": readOnlyExtension,
+		"observer": observer,
+		"gate":     gate,
+	}
+	t.handler.successSteps = []domain.SuccessStep{successStep}
+
+	t.provider.On("GetFormData", t.context, t.request).Return(searchData{}, nil).Once()
+
+	t.request.Request().Method = http.MethodGet
+	t.request.Request().URL = &url.URL{RawQuery: "q=shoes"}
+
+	t.decoder.On("Decode", t.context, t.request, url.Values{
+		"q": []string{"shoes"},
+	}, searchData{}).Return(searchData{Query: "shoes"}, nil).Once()
+	t.validator.On("Validate", t.context, t.request, t.validatorProvider, searchData{Query: "shoes"}).Return(&domain.ValidationInfo{}, nil).Once()
+
+	// form extensions which aren't read-only only provide their form data
+	t.firstExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+	t.defaultProvider.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Times(4)
+
+	readOnlyExtension.On("GetFormData", t.context, t.request).Return(map[string]int{}, nil).Twice()
+	readOnlyExtension.On("Decode", t.context, t.request, url.Values{
+		"q": []string{"shoes"},
+	}, map[string]int{}).Return(map[string]int{}, nil).Once()
+	readOnlyExtension.On("Validate", t.context, t.request, t.validatorProvider, map[string]int{}).Return(&domain.ValidationInfo{}, nil).Once()
+
+	result, err := t.handler.HandleSearchForm(t.context, t.request)
+	t.NoError(err)
+	t.True(result.DryRun)
+	t.True(result.IsValidAndSubmitted())
+	t.Equal(searchData{Query: "shoes"}, result.Data)
+	t.Equal(url.Values{"q": []string{"shoes"}}, result.CanonicalValues)
+
+	readOnlyExtension.AssertExpectations(t.T())
+	observer.AssertExpectations(t.T())
+	gate.AssertExpectations(t.T())
+	successStep.AssertExpectations(t.T())
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleSearchForm_Error() {
+	t.handler.formExtensions = nil
+	t.provider.On("GetFormData", t.context, t.request).Return(nil, errors.New("error")).Once()
+
+	result, err := t.handler.HandleSearchForm(t.context, t.request)
+	t.Equal(domain.NewFormErrorWithParent(errors.New("error")), err)
+	t.Nil(result)
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleDeferredForm() {
+	queue := &deferredFormTestQueue{}
+	t.handler.submissionQueue = queue
+	t.handler.formExtensions = nil
+	t.provider.On("GetFormData", t.context, t.request).Return(map[string]string{}, nil).Once()
+
+	t.request.Request().Method = http.MethodPost
+	t.request.Request().PostForm = url.Values{
+		"email": []string{"user@example.com"},
+	}
+
+	t.decoder.On("Decode", t.context, t.request, url.Values{
+		"email": []string{"user@example.com"},
+	}, map[string]string{}).Return(map[string]string{
+		"email": "user@example.com",
+	}, nil).Once()
+	t.validator.On("Validate", t.context, t.request, t.validatorProvider, map[string]string{
+		"email": "user@example.com",
+	}).Return(&domain.ValidationInfo{}, nil).Once()
+
+	result, err := t.handler.HandleDeferredForm(t.context, t.request)
+	t.NoError(err)
+	t.True(result.IsValidAndSubmitted())
+	t.True(result.IsQueued())
+	t.Require().Len(queue.submissions, 1)
+	t.Equal(result.Token, queue.submissions[0].Token)
+	t.Equal("map[string]string", queue.submissions[0].Type)
+	t.JSONEq(`{"email":"user@example.com"}`, string(queue.submissions[0].Data))
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleDeferredForm_Invalid() {
+	queue := &deferredFormTestQueue{}
+	t.handler.submissionQueue = queue
+	t.handler.formExtensions = nil
+	t.provider.On("GetFormData", t.context, t.request).Return(map[string]string{}, nil).Once()
+
+	t.request.Request().Method = http.MethodPost
+	t.request.Request().PostForm = url.Values{}
+
+	validationInfo := domain.ValidationInfo{}
+	validationInfo.AddFieldError("email", "formError.email.required", "required")
+	t.decoder.On("Decode", t.context, t.request, url.Values{}, map[string]string{}).Return(map[string]string{}, nil).Once()
+	t.validator.On("Validate", t.context, t.request, t.validatorProvider, map[string]string{}).Return(&validationInfo, nil).Once()
+
+	result, err := t.handler.HandleDeferredForm(t.context, t.request)
+	t.NoError(err)
+	t.False(result.IsValid())
+	t.False(result.IsQueued())
+	t.Empty(queue.submissions)
+}
+
+func (t *FormHandlerImplTestSuite) TestHandleDeferredForm_QueueError() {
+	t.handler.submissionQueue = &deferredFormTestQueue{err: errors.New("error")}
+	t.handler.formExtensions = nil
+	t.provider.On("GetFormData", t.context, t.request).Return(map[string]string{}, nil).Once()
+
+	t.request.Request().Method = http.MethodPost
+	t.request.Request().PostForm = url.Values{}
+
+	t.decoder.On("Decode", t.context, t.request, url.Values{}, map[string]string{}).Return(map[string]string{}, nil).Once()
+	t.validator.On("Validate", t.context, t.request, t.validatorProvider, map[string]string{}).Return(&domain.ValidationInfo{}, nil).Once()
+
+	result, err := t.handler.HandleDeferredForm(t.context, t.request)
+	t.Equal(domain.NewFormErrorWithParent(errors.New("error")), err)
+	t.Nil(result)
+}
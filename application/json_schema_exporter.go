@@ -0,0 +1,434 @@
+package application
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/validators"
+)
+
+type (
+	// JSONSchemaExporterImpl as struct which implements interface FormSchemaExporter. Fields are typed by form data
+	// struct, and nested by form names of sub structs ("address.zip") and slice elements ("items[].name").
+	// Rules without JSON Schema keyword are exported under "x-rules" keyword of the field, together with their
+	// descriptions from rule registry, so clients can still implement project-specific rules.
+	JSONSchemaExporterImpl struct {
+		ruleRegistry domain.RuleRegistry
+	}
+
+	// schemaNode as single (sub) schema of JSON Schema document
+	schemaNode map[string]interface{}
+)
+
+const (
+	// jsonSchemaDialect as dialect of exported documents, which supports "dependentRequired" keyword
+	jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+	// jsonSchemaRulesKeyword as extension keyword for rules without JSON Schema keyword
+	jsonSchemaRulesKeyword = "x-rules"
+)
+
+var (
+	_ domain.FormSchemaExporter = &JSONSchemaExporterImpl{}
+
+	// schemaFormats maps rules to values of "format" keyword
+	schemaFormats = map[string]string{
+		"email":    "email",
+		"url":      "uri",
+		"uri":      "uri",
+		"uuid":     "uuid",
+		"uuid4":    "uuid",
+		"ipv4":     "ipv4",
+		"ipv6":     "ipv6",
+		"hostname": "hostname",
+	}
+
+	// schemaPatterns maps rules to values of "pattern" keyword
+	schemaPatterns = map[string]string{
+		"alpha":    "^[a-zA-Z]+$",
+		"alphanum": "^[a-zA-Z0-9]+$",
+		"numeric":  "^[-+]?[0-9]+(?:\\.[0-9]+)?$",
+	}
+)
+
+// Inject dependencies
+func (e *JSONSchemaExporterImpl) Inject(ruleRegistry domain.RuleRegistry) *JSONSchemaExporterImpl {
+	e.ruleRegistry = ruleRegistry
+
+	return e
+}
+
+// ExportSchema returns JSON Schema document of validation rules of the form, which are already filtered by feature
+// toggles and validation group of form handler
+func (e *JSONSchemaExporterImpl) ExportSchema(form *domain.Form) (map[string]interface{}, error) {
+	if form == nil {
+		return nil, domain.NewFormError("form is missing, JSON Schema can't be exported")
+	}
+
+	root := schemaNode{
+		"$schema": jsonSchemaDialect,
+		"type":    "object",
+	}
+
+	validationRules := form.GetValidationRules()
+	names := make([]string, 0, len(validationRules))
+	for name := range validationRules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		e.addField(root, reflect.TypeOf(form.Data), name, validationRules[name])
+	}
+
+	return root, nil
+}
+
+// addField adds schema of field with desired form name, creating schemas of its parent objects and arrays
+func (e *JSONSchemaExporterImpl) addField(root schemaNode, typeOf reflect.Type, name string, rules []domain.ValidationRule) {
+	segments := strings.Split(name, ".")
+
+	parent := root
+	for i, segment := range segments {
+		propertyName := strings.TrimSuffix(segment, "[]")
+		typeOf = schemaFieldType(typeOf, propertyName)
+		property := parent.property(propertyName, typeOf)
+
+		if i == len(segments)-1 {
+			e.applyRules(parent, propertyName, property, typeOf, rules)
+			return
+		}
+
+		if propertyName != segment {
+			property["type"] = "array"
+			typeOf = elemTypeOf(typeOf)
+			property = property.items(typeOf)
+		}
+		property["type"] = "object"
+		parent = property
+	}
+}
+
+// applyRules converts rules of the field into keywords of its schema. Rules after "dive" are applied to schema
+// of elements, while "required" and "required_with" rules are applied to schema of parent object.
+func (e *JSONSchemaExporterImpl) applyRules(parent schemaNode, name string, property schemaNode, typeOf reflect.Type, rules []domain.ValidationRule) {
+	target := property
+	for _, rule := range rules {
+		if rule.Name == "dive" {
+			property["type"] = "array"
+			typeOf = elemTypeOf(typeOf)
+			target = property.items(typeOf)
+			parent = nil
+			continue
+		}
+
+		if !applyRule(parent, name, target, rule) {
+			target.addRule(e.ruleEntry(rule))
+		}
+	}
+}
+
+// ruleEntry returns entry of "x-rules" keyword for the rule, with its description if it's registered, and marked
+// as unsupported if its comparison can't be described for clients
+func (e *JSONSchemaExporterImpl) ruleEntry(rule domain.ValidationRule) map[string]interface{} {
+	entry := map[string]interface{}{
+		"name": rule.Name,
+	}
+	if rule.Value != "" {
+		entry["value"] = rule.Value
+	}
+	if len(rule.Conditions) > 0 {
+		entry["conditions"] = rule.Conditions
+	}
+	if rule.ValueType == domain.RuleValueTypeUnsupported {
+		entry["unsupported"] = true
+	}
+
+	if e.ruleRegistry == nil {
+		return entry
+	}
+
+	if description, ok := e.ruleRegistry.Describe(rule.Name); ok && description.Description != "" {
+		entry["description"] = description.Description
+	}
+
+	return entry
+}
+
+// applyRule converts rule into keywords of the schema, and returns false if there is no keyword for the rule.
+// Parent is nil for schemas of elements, which can't be required.
+func applyRule(parent schemaNode, name string, schema schemaNode, rule domain.ValidationRule) bool {
+	switch rule.Name {
+	case "required":
+		if parent == nil {
+			return false
+		}
+		parent.addRequired(name)
+		return true
+	case "required_with":
+		return applyDependentRequired(parent, name, rule)
+	case "min", "max", "len", "gt", "gte", "lt", "lte":
+		return applyComparison(schema, rule)
+	case "oneof":
+		return applyEnum(schema, rule)
+	case validators.PatternValidatorName:
+		if rule.Meta["jsPattern"] == "" {
+			return false
+		}
+		schema["pattern"] = rule.Meta["jsPattern"]
+		return true
+	}
+
+	if format, ok := schemaFormats[rule.Name]; ok {
+		schema["format"] = format
+		return true
+	}
+
+	if pattern, ok := schemaPatterns[rule.Name]; ok {
+		schema["pattern"] = pattern
+		return true
+	}
+
+	return false
+}
+
+// applyDependentRequired converts "required_with" rule into "dependentRequired" keyword of parent object,
+// in case that all referenced fields are properties of the same object
+func applyDependentRequired(parent schemaNode, name string, rule domain.ValidationRule) bool {
+	if parent == nil || len(rule.Conditions) == 0 {
+		return false
+	}
+
+	for _, condition := range rule.Conditions {
+		if strings.ContainsAny(condition.Field, ".[") {
+			return false
+		}
+	}
+
+	dependentRequired, _ := parent["dependentRequired"].(map[string][]string)
+	if dependentRequired == nil {
+		dependentRequired = map[string][]string{}
+		parent["dependentRequired"] = dependentRequired
+	}
+
+	for _, condition := range rule.Conditions {
+		dependentRequired[condition.Field] = appendUnique(dependentRequired[condition.Field], name)
+	}
+
+	return true
+}
+
+// applyComparison converts comparison rule into keywords of numeric values, or of lengths of strings, arrays
+// and objects, depending on type of its value. Comparisons of times have no keyword.
+func applyComparison(schema schemaNode, rule domain.ValidationRule) bool {
+	switch rule.ValueType {
+	case domain.RuleValueTypeNumber:
+		value, err := strconv.ParseFloat(rule.Value, 64)
+		if err != nil {
+			return false
+		}
+		if schema["type"] == nil {
+			schema["type"] = "number"
+		}
+
+		switch rule.Name {
+		case "min", "gte":
+			schema["minimum"] = value
+		case "max", "lte":
+			schema["maximum"] = value
+		case "gt":
+			schema["exclusiveMinimum"] = value
+		case "lt":
+			schema["exclusiveMaximum"] = value
+		case "len":
+			schema["minimum"] = value
+			schema["maximum"] = value
+		}
+
+		return true
+	case domain.RuleValueTypeLength:
+		minKeyword, maxKeyword := lengthKeywords(schema["type"])
+		value, err := strconv.ParseUint(rule.Value, 10, 64)
+		if err != nil || minKeyword == "" {
+			return false
+		}
+
+		switch rule.Name {
+		case "min", "gte":
+			schema[minKeyword] = value
+		case "max", "lte":
+			schema[maxKeyword] = value
+		case "gt":
+			schema[minKeyword] = value + 1
+		case "lt":
+			if value == 0 {
+				return false
+			}
+			schema[maxKeyword] = value - 1
+		case "len":
+			schema[minKeyword] = value
+			schema[maxKeyword] = value
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// applyEnum converts "oneof" rule into "enum" keyword, with numeric values for numeric fields
+func applyEnum(schema schemaNode, rule domain.ValidationRule) bool {
+	values := strings.Fields(rule.Value)
+	if len(values) == 0 {
+		return false
+	}
+
+	enum := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		if schema["type"] == "integer" || schema["type"] == "number" {
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return false
+			}
+			enum = append(enum, number)
+			continue
+		}
+		enum = append(enum, value)
+	}
+	schema["enum"] = enum
+
+	return true
+}
+
+// lengthKeywords returns keywords of minimal and maximal length for desired schema type
+func lengthKeywords(schemaType interface{}) (string, string) {
+	switch schemaType {
+	case "string":
+		return "minLength", "maxLength"
+	case "array":
+		return "minItems", "maxItems"
+	case "object":
+		return "minProperties", "maxProperties"
+	}
+
+	return "", ""
+}
+
+// schemaFieldType returns type of struct field with desired form name, or nil if it doesn't exist
+func schemaFieldType(typeOf reflect.Type, name string) reflect.Type {
+	for typeOf != nil && typeOf.Kind() == reflect.Ptr {
+		typeOf = typeOf.Elem()
+	}
+	if typeOf == nil || typeOf.Kind() != reflect.Struct {
+		return nil
+	}
+
+	for i := 0; i < typeOf.NumField(); i++ {
+		fieldType := typeOf.Field(i)
+		if fieldType.Tag.Get("form") != "-" && formFieldName(typeOf, fieldType.Name) == name {
+			return fieldType.Type
+		}
+	}
+
+	return nil
+}
+
+// schemaType returns JSON Schema type and format of Go type, which are empty for unsupported types
+func schemaType(typeOf reflect.Type) (string, string) {
+	for typeOf != nil && typeOf.Kind() == reflect.Ptr {
+		typeOf = typeOf.Elem()
+	}
+	if typeOf == nil {
+		return "", ""
+	}
+
+	if typeOf == timeType {
+		return "string", "date-time"
+	}
+
+	switch typeOf.Kind() {
+	case reflect.String:
+		return "string", ""
+	case reflect.Bool:
+		return "boolean", ""
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer", ""
+	case reflect.Float32, reflect.Float64:
+		return "number", ""
+	case reflect.Slice, reflect.Array:
+		return "array", ""
+	case reflect.Struct, reflect.Map:
+		return "object", ""
+	}
+
+	return "", ""
+}
+
+// property returns schema of property with desired name, which is created and typed by Go type if it doesn't exist
+func (n schemaNode) property(name string, typeOf reflect.Type) schemaNode {
+	properties, _ := n["properties"].(map[string]interface{})
+	if properties == nil {
+		properties = map[string]interface{}{}
+		n["properties"] = properties
+	}
+
+	property, _ := properties[name].(map[string]interface{})
+	if property == nil {
+		property = newSchemaNode(typeOf)
+		properties[name] = property
+	}
+
+	return property
+}
+
+// items returns schema of array elements, which is created and typed by Go type if it doesn't exist
+func (n schemaNode) items(typeOf reflect.Type) schemaNode {
+	items, _ := n["items"].(map[string]interface{})
+	if items == nil {
+		items = newSchemaNode(typeOf)
+		n["items"] = items
+	}
+
+	return items
+}
+
+// addRequired adds name of property into "required" keyword of the object
+func (n schemaNode) addRequired(name string) {
+	required, _ := n["required"].([]string)
+	n["required"] = appendUnique(required, name)
+}
+
+// addRule adds entry of rule into "x-rules" keyword of the schema
+func (n schemaNode) addRule(entry map[string]interface{}) {
+	rules, _ := n[jsonSchemaRulesKeyword].([]map[string]interface{})
+	n[jsonSchemaRulesKeyword] = append(rules, entry)
+}
+
+// newSchemaNode returns schema typed by Go type
+func newSchemaNode(typeOf reflect.Type) map[string]interface{} {
+	node := map[string]interface{}{}
+
+	schemaType, format := schemaType(typeOf)
+	if schemaType != "" {
+		node["type"] = schemaType
+	}
+	if format != "" {
+		node["format"] = format
+	}
+
+	return node
+}
+
+// appendUnique appends value into list, unless it's already part of it
+func appendUnique(list []string, value string) []string {
+	for _, item := range list {
+		if item == value {
+			return list
+		}
+	}
+
+	return append(list, value)
+}
@@ -0,0 +1,193 @@
+package application
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	JSONSchemaExporterTestSuite struct {
+		suite.Suite
+
+		exporter *JSONSchemaExporterImpl
+
+		ruleRegistry *mocks.RuleRegistry
+	}
+
+	jsonSchemaTestItem struct {
+		Name string `form:"name" validate:"required,max=10"`
+	}
+
+	jsonSchemaTestData struct {
+		Email  string               `form:"email" validate:"required,email"`
+		Age    int                  `form:"age" validate:"gte=18,lt=130"`
+		Mobile string               `form:"mobile" validate:"required_with=Email"`
+		Size   string               `form:"size" validate:"oneof=s m l"`
+		Tags   []string             `form:"tags" validate:"min=1,dive,max=5"`
+		Items  []jsonSchemaTestItem `form:"items" validate:"dive"`
+		Code   string               `form:"code" validate:"pattern=^[A-Z]+$,iban"`
+	}
+)
+
+func TestJSONSchemaExporterTestSuite(t *testing.T) {
+	suite.Run(t, &JSONSchemaExporterTestSuite{})
+}
+
+func (t *JSONSchemaExporterTestSuite) SetupTest() {
+	t.ruleRegistry = &mocks.RuleRegistry{}
+	t.exporter = (&JSONSchemaExporterImpl{}).Inject(t.ruleRegistry)
+}
+
+func (t *JSONSchemaExporterTestSuite) TearDownTest() {
+	t.ruleRegistry.AssertExpectations(t.T())
+	t.ruleRegistry = nil
+	t.exporter = nil
+}
+
+func (t *JSONSchemaExporterTestSuite) TestExportSchema_NoForm() {
+	schema, err := t.exporter.ExportSchema(nil)
+	t.Error(err)
+	t.Nil(schema)
+}
+
+func (t *JSONSchemaExporterTestSuite) TestExportSchema_NoRules() {
+	form := domain.NewForm(false, nil)
+
+	schema, err := t.exporter.ExportSchema(&form)
+	t.NoError(err)
+	t.Equal(map[string]interface{}{
+		"$schema": jsonSchemaDialect,
+		"type":    "object",
+	}, schema)
+}
+
+func (t *JSONSchemaExporterTestSuite) TestExportSchema() {
+	t.ruleRegistry.On("Describe", "iban").Return(domain.RuleDescription{
+		Name:        "iban",
+		Description: "IBAN with valid checksum",
+	}, true).Once()
+
+	rules, err := compileValidationRules(reflect.TypeOf(jsonSchemaTestData{}), map[reflect.Type]bool{})
+	t.NoError(err)
+
+	form := domain.NewForm(false, rules)
+	form.Data = jsonSchemaTestData{}
+
+	schema, err := t.exporter.ExportSchema(&form)
+	t.NoError(err)
+	t.Equal(map[string]interface{}{
+		"$schema": jsonSchemaDialect,
+		"type":    "object",
+		"properties": map[string]interface{}{
+			"email": map[string]interface{}{
+				"type":   "string",
+				"format": "email",
+			},
+			"age": map[string]interface{}{
+				"type":             "integer",
+				"minimum":          float64(18),
+				"exclusiveMaximum": float64(130),
+			},
+			"mobile": map[string]interface{}{
+				"type": "string",
+			},
+			"size": map[string]interface{}{
+				"type": "string",
+				"enum": []interface{}{"s", "m", "l"},
+			},
+			"tags": map[string]interface{}{
+				"type":     "array",
+				"minItems": uint64(1),
+				"items": map[string]interface{}{
+					"type":      "string",
+					"maxLength": uint64(5),
+				},
+			},
+			"items": map[string]interface{}{
+				"type": "array",
+				"items": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"name": map[string]interface{}{
+							"type":      "string",
+							"maxLength": uint64(10),
+						},
+					},
+					"required": []string{"name"},
+				},
+			},
+			"code": map[string]interface{}{
+				"type":    "string",
+				"pattern": "^[A-Z]+$",
+				"x-rules": []map[string]interface{}{
+					{"name": "iban", "description": "IBAN with valid checksum"},
+				},
+			},
+		},
+		"required": []string{"email"},
+		"dependentRequired": map[string][]string{
+			"email": {"mobile"},
+		},
+	}, schema)
+}
+
+func (t *JSONSchemaExporterTestSuite) TestExportSchema_UntypedForm() {
+	t.ruleRegistry.On("Describe", "min").Return(domain.RuleDescription{}, false).Once()
+	t.ruleRegistry.On("Describe", "eqfield").Return(domain.RuleDescription{}, false).Once()
+	t.ruleRegistry.On("Describe", "max").Return(domain.RuleDescription{}, false).Once()
+
+	form := domain.NewForm(false, map[string][]domain.ValidationRule{
+		"address.zip": {
+			{Name: "required"},
+			{Name: "min", Value: "5", ValueType: domain.RuleValueTypeLength},
+		},
+		"amount": {
+			{Name: "lte", Value: "99.5", ValueType: domain.RuleValueTypeNumber},
+		},
+		"confirmation": {
+			{Name: "eqfield", Value: "password"},
+		},
+		"startDate": {
+			{Name: "max", Value: "2030-01-01", ValueType: domain.RuleValueTypeUnsupported},
+		},
+	})
+
+	schema, err := t.exporter.ExportSchema(&form)
+	t.NoError(err)
+	t.Equal(map[string]interface{}{
+		"$schema": jsonSchemaDialect,
+		"type":    "object",
+		"properties": map[string]interface{}{
+			"address": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"zip": map[string]interface{}{
+						"x-rules": []map[string]interface{}{
+							{"name": "min", "value": "5"},
+						},
+					},
+				},
+				"required": []string{"zip"},
+			},
+			"amount": map[string]interface{}{
+				"type":    "number",
+				"maximum": 99.5,
+			},
+			"confirmation": map[string]interface{}{
+				"x-rules": []map[string]interface{}{
+					{"name": "eqfield", "value": "password"},
+				},
+			},
+			"startDate": map[string]interface{}{
+				"x-rules": []map[string]interface{}{
+					{"name": "max", "value": "2030-01-01", "unsupported": true},
+				},
+			},
+		},
+	}, schema)
+}
@@ -0,0 +1,87 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	validator "gopkg.in/go-playground/validator.v9"
+
+	"flamingo.me/flamingo/v3/framework/opencensus"
+	"flamingo.me/form/domain"
+)
+
+var (
+	// extensionLatency records latency of form extensions per stage of form handling
+	extensionLatency = stats.Float64("flamingo-form/extension/latency", "Latency of form extensions", stats.UnitMilliseconds)
+	// validatorLatency records latency of field validators per validated field
+	validatorLatency = stats.Float64("flamingo-form/validator/latency", "Latency of field validators", stats.UnitMilliseconds)
+	// latencyFormKey tag key with package qualified name of form data type
+	latencyFormKey = tag.MustNewKey("flamingo-form.form")
+	// latencyExtensionKey tag key with name of form extension
+	latencyExtensionKey = tag.MustNewKey("flamingo-form.extension")
+	// latencyStageKey tag key with stage of form handling (like "processing" or "observation")
+	latencyStageKey = tag.MustNewKey("flamingo-form.stage")
+	// latencyValidatorKey tag key with name of field validator
+	latencyValidatorKey = tag.MustNewKey("flamingo-form.validator")
+
+	// latencyBuckets bounds of latency histograms in milliseconds, from in-memory checks to slow external services
+	latencyBuckets = []float64{0.1, 0.5, 1, 2.5, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000}
+)
+
+// Stages of form handling, in which latency of form extensions is recorded
+const (
+	latencyStageProcessing  = "processing"
+	latencyStageGate        = "gate"
+	latencyStageObservation = "observation"
+)
+
+func init() {
+	if err := opencensus.View("flamingo-form/extension/latency", extensionLatency, view.Distribution(latencyBuckets...), latencyFormKey, latencyExtensionKey, latencyStageKey); err != nil {
+		panic(err)
+	}
+	if err := opencensus.View("flamingo-form/validator/latency", validatorLatency, view.Distribution(latencyBuckets...), latencyFormKey, latencyValidatorKey); err != nil {
+		panic(err)
+	}
+}
+
+// recordExtensionLatency records latency of form extension since start, tagged by form, form extension and stage
+func recordExtensionLatency(ctx context.Context, form *domain.Form, name string, stage string, start time.Time) {
+	metricCtx, _ := tag.New(ctx,
+		tag.Upsert(latencyFormKey, formDataTypeName(form.Data)),
+		tag.Upsert(latencyExtensionKey, name),
+		tag.Upsert(latencyStageKey, stage),
+	)
+	stats.Record(metricCtx, extensionLatency.M(milliseconds(time.Since(start))))
+}
+
+// contextWithLatencyForm returns context tagged by form data type, so latency of field validators is recorded per form
+func contextWithLatencyForm(ctx context.Context, formData interface{}) context.Context {
+	metricCtx, err := tag.New(ctx, tag.Upsert(latencyFormKey, formDataTypeName(formData)))
+	if err != nil {
+		return ctx
+	}
+
+	return metricCtx
+}
+
+// timedFieldValidation wraps validation function of field validator, so its latency is recorded by metric tagged by
+// name of field validator, and form of the context
+func timedFieldValidation(name string, validate validator.FuncCtx) validator.FuncCtx {
+	return func(ctx context.Context, fl validator.FieldLevel) bool {
+		start := time.Now()
+		valid := validate(ctx, fl)
+
+		metricCtx, _ := tag.New(ctx, tag.Upsert(latencyValidatorKey, name))
+		stats.Record(metricCtx, validatorLatency.M(milliseconds(time.Since(start))))
+
+		return valid
+	}
+}
+
+// milliseconds returns duration in fractional milliseconds
+func milliseconds(duration time.Duration) float64 {
+	return float64(duration) / float64(time.Millisecond)
+}
@@ -0,0 +1,51 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	validator "gopkg.in/go-playground/validator.v9"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	LatencyTestSuite struct {
+		suite.Suite
+	}
+
+	latencyTestData struct {
+		Name string
+	}
+)
+
+func TestLatencyTestSuite(t *testing.T) {
+	suite.Run(t, &LatencyTestSuite{})
+}
+
+func (t *LatencyTestSuite) TestRecordExtensionLatency() {
+	form := domain.NewForm(true, nil)
+	form.Data = latencyTestData{}
+
+	t.NotPanics(func() {
+		recordExtensionLatency(context.Background(), &form, "formExtension.captcha", latencyStageProcessing, time.Now())
+	})
+}
+
+func (t *LatencyTestSuite) TestTimedFieldValidation() {
+	var called bool
+	validate := timedFieldValidation("handle", func(context.Context, validator.FieldLevel) bool {
+		called = true
+		return false
+	})
+
+	t.False(validate(contextWithLatencyForm(context.Background(), latencyTestData{}), nil))
+	t.True(called)
+}
+
+func (t *LatencyTestSuite) TestMilliseconds() {
+	t.Equal(1.5, milliseconds(1500*time.Microsecond))
+	t.Equal(float64(0), milliseconds(0))
+}
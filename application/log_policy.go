@@ -0,0 +1,128 @@
+package application
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// logPolicy as definition of log levels and sampling of form processing errors per logging category.
+	// Categories are form processing stages, like "formDecoding" or "formValidation".
+	// It allows to log expected user errors (like invalid input) with lower level, or only once per number of errors,
+	// so they don't flood error logs and alerting.
+	logPolicy struct {
+		defaultLevel logLevel
+		levels       map[string]logLevel
+		// sampling contains counters of logged errors per category, it's never modified after creation
+		sampling map[string]*logSampling
+	}
+
+	// logSampling as sampling counter of single logging category
+	logSampling struct {
+		rate    uint64
+		counter uint64
+	}
+
+	// logLevel as level which errors are logged with
+	logLevel int
+)
+
+const (
+	logLevelSilent logLevel = iota
+	logLevelDebug
+	logLevelInfo
+	logLevelWarn
+	logLevelError
+)
+
+// newLogPolicy creates log policy from configuration of default log level, log levels per category
+// and sampling rates per category. Sampling rate N means that only every N-th error of category is logged.
+func newLogPolicy(defaultLevel string, levels config.Map, sampling config.Map) *logPolicy {
+	policy := &logPolicy{
+		defaultLevel: parseLogLevel(defaultLevel),
+		levels:       map[string]logLevel{},
+		sampling:     map[string]*logSampling{},
+	}
+
+	configuredLevels := map[string]string{}
+	if err := levels.MapInto(&configuredLevels); err != nil {
+		panic(err.Error())
+	}
+	for category, level := range configuredLevels {
+		policy.levels[category] = parseLogLevel(level)
+	}
+
+	configuredSampling := map[string]int{}
+	if err := sampling.MapInto(&configuredSampling); err != nil {
+		panic(err.Error())
+	}
+	for category, rate := range configuredSampling {
+		if rate > 1 {
+			policy.sampling[category] = &logSampling{
+				rate: uint64(rate),
+			}
+		}
+	}
+
+	return policy
+}
+
+// parseLogLevel returns log level by its name. Unknown names are treated as error level,
+// so misconfiguration never hides errors.
+func parseLogLevel(level string) logLevel {
+	switch strings.ToLower(level) {
+	case "silent", "none", "off":
+		return logLevelSilent
+	case "debug":
+		return logLevelDebug
+	case "info":
+		return logLevelInfo
+	case "warn", "warning":
+		return logLevelWarn
+	default:
+		return logLevelError
+	}
+}
+
+// log writes error of category into logger, with category's log level and sampling.
+// Without log policy, all errors are logged with error level.
+func (p *logPolicy) log(logger flamingo.Logger, category string, err error) {
+	level := logLevelError
+	if p != nil {
+		level = p.level(category)
+
+		if sampling, ok := p.sampling[category]; ok && level != logLevelSilent {
+			if (atomic.AddUint64(&sampling.counter, 1)-1)%sampling.rate != 0 {
+				return
+			}
+			logger = logger.WithField("sampleRate", sampling.rate)
+		}
+	}
+
+	// errors may contain submitted values, so card numbers are masked
+	message := domain.MaskCardNumbers(err.Error())
+
+	switch level {
+	case logLevelDebug:
+		logger.Debug(message)
+	case logLevelInfo:
+		logger.Info(message)
+	case logLevelWarn:
+		logger.Warn(message)
+	case logLevelError:
+		logger.Error(message)
+	}
+}
+
+// level returns log level of category
+func (p *logPolicy) level(category string) logLevel {
+	if level, ok := p.levels[category]; ok {
+		return level
+	}
+
+	return p.defaultLevel
+}
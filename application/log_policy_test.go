@@ -0,0 +1,124 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+)
+
+type (
+	LogPolicyTestSuite struct {
+		suite.Suite
+
+		logger *logPolicyTestLogger
+	}
+
+	logPolicyTestLogger struct {
+		flamingo.NullLogger
+
+		entries *[]string
+		fields  map[flamingo.LogKey]interface{}
+	}
+)
+
+func (l *logPolicyTestLogger) WithField(key flamingo.LogKey, value interface{}) flamingo.Logger {
+	fields := map[flamingo.LogKey]interface{}{key: value}
+	for k, v := range l.fields {
+		fields[k] = v
+	}
+
+	return &logPolicyTestLogger{
+		entries: l.entries,
+		fields:  fields,
+	}
+}
+
+func (l *logPolicyTestLogger) Debug(args ...interface{}) { l.record("debug", args...) }
+func (l *logPolicyTestLogger) Info(args ...interface{})  { l.record("info", args...) }
+func (l *logPolicyTestLogger) Warn(args ...interface{})  { l.record("warn", args...) }
+func (l *logPolicyTestLogger) Error(args ...interface{}) { l.record("error", args...) }
+
+func (l *logPolicyTestLogger) record(level string, args ...interface{}) {
+	entry := level + ":" + args[0].(string)
+	if rate, ok := l.fields["sampleRate"]; ok {
+		entry += fmt.Sprintf(":%d", rate)
+	}
+	*l.entries = append(*l.entries, entry)
+}
+
+func TestLogPolicyTestSuite(t *testing.T) {
+	suite.Run(t, &LogPolicyTestSuite{})
+}
+
+func (t *LogPolicyTestSuite) SetupTest() {
+	t.logger = &logPolicyTestLogger{
+		entries: &[]string{},
+	}
+}
+
+func (t *LogPolicyTestSuite) TestParseLogLevel() {
+	t.Equal(logLevelSilent, parseLogLevel("silent"))
+	t.Equal(logLevelSilent, parseLogLevel("none"))
+	t.Equal(logLevelDebug, parseLogLevel("debug"))
+	t.Equal(logLevelInfo, parseLogLevel("Info"))
+	t.Equal(logLevelWarn, parseLogLevel("warn"))
+	t.Equal(logLevelWarn, parseLogLevel("warning"))
+	t.Equal(logLevelError, parseLogLevel("error"))
+	t.Equal(logLevelError, parseLogLevel("unknown"))
+	t.Equal(logLevelError, parseLogLevel(""))
+}
+
+func (t *LogPolicyTestSuite) TestLog_NilPolicy() {
+	var policy *logPolicy
+
+	policy.log(t.logger, "formDecoding", errors.New("decoding"))
+
+	t.Equal([]string{"error:decoding"}, *t.logger.entries)
+}
+
+func (t *LogPolicyTestSuite) TestLog_MaskedCardNumbers() {
+	var policy *logPolicy
+
+	policy.log(t.logger, "formDecoding", errors.New("invalid value '4111111111111111'"))
+
+	t.Equal([]string{"error:invalid value '************1111'"}, *t.logger.entries)
+}
+
+func (t *LogPolicyTestSuite) TestLog_Levels() {
+	policy := newLogPolicy("info", config.Map{
+		"formDecoding":   "warn",
+		"formValidation": "silent",
+	}, config.Map{})
+
+	policy.log(t.logger, "formDecoding", errors.New("decoding"))
+	policy.log(t.logger, "formValidation", errors.New("validation"))
+	policy.log(t.logger, "formBuilding", errors.New("building"))
+
+	t.Equal([]string{"warn:decoding", "info:building"}, *t.logger.entries)
+}
+
+func (t *LogPolicyTestSuite) TestLog_Sampling() {
+	policy := newLogPolicy("error", config.Map{}, config.Map{
+		"formDecoding": 3,
+		"formBuilding": 1,
+	})
+
+	for i := 0; i < 7; i++ {
+		policy.log(t.logger, "formDecoding", errors.New("decoding"))
+	}
+	policy.log(t.logger, "formBuilding", errors.New("building"))
+	policy.log(t.logger, "formBuilding", errors.New("building"))
+
+	t.Equal([]string{
+		"error:decoding:3",
+		"error:decoding:3",
+		"error:decoding:3",
+		"error:building",
+		"error:building",
+	}, *t.logger.entries)
+}
@@ -14,6 +14,54 @@ type FormHandlerBuilder struct {
 	mock.Mock
 }
 
+// AddFeatureToggle provides a mock function with given fields: toggle
+func (_m *FormHandlerBuilder) AddFeatureToggle(toggle domain.FeatureToggle) application.FormHandlerBuilder {
+	ret := _m.Called(toggle)
+
+	var r0 application.FormHandlerBuilder
+	if rf, ok := ret.Get(0).(func(domain.FeatureToggle) application.FormHandlerBuilder); ok {
+		r0 = rf(toggle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(application.FormHandlerBuilder)
+		}
+	}
+
+	return r0
+}
+
+// AddFormDataEnricher provides a mock function with given fields: formDataEnricher
+func (_m *FormHandlerBuilder) AddFormDataEnricher(formDataEnricher domain.FormDataEnricher) application.FormHandlerBuilder {
+	ret := _m.Called(formDataEnricher)
+
+	var r0 application.FormHandlerBuilder
+	if rf, ok := ret.Get(0).(func(domain.FormDataEnricher) application.FormHandlerBuilder); ok {
+		r0 = rf(formDataEnricher)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(application.FormHandlerBuilder)
+		}
+	}
+
+	return r0
+}
+
+// AddFormDataValidator provides a mock function with given fields: formDataValidator
+func (_m *FormHandlerBuilder) AddFormDataValidator(formDataValidator domain.FormDataValidator) application.FormHandlerBuilder {
+	ret := _m.Called(formDataValidator)
+
+	var r0 application.FormHandlerBuilder
+	if rf, ok := ret.Get(0).(func(domain.FormDataValidator) application.FormHandlerBuilder); ok {
+		r0 = rf(formDataValidator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(application.FormHandlerBuilder)
+		}
+	}
+
+	return r0
+}
+
 // AddFormExtension provides a mock function with given fields: formExtension
 func (_m *FormHandlerBuilder) AddFormExtension(formExtension domain.FormExtension) error {
 	ret := _m.Called(formExtension)
@@ -42,6 +90,50 @@ func (_m *FormHandlerBuilder) AddNamedFormExtension(name string) error {
 	return r0
 }
 
+// AddNamedSubForm provides a mock function with given fields: prefix, name
+func (_m *FormHandlerBuilder) AddNamedSubForm(prefix string, name string) error {
+	ret := _m.Called(prefix, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(prefix, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddSubForm provides a mock function with given fields: prefix, subForm
+func (_m *FormHandlerBuilder) AddSubForm(prefix string, subForm domain.SubForm) error {
+	ret := _m.Called(prefix, subForm)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, domain.SubForm) error); ok {
+		r0 = rf(prefix, subForm)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddSuccessStep provides a mock function with given fields: successStep
+func (_m *FormHandlerBuilder) AddSuccessStep(successStep domain.SuccessStep) application.FormHandlerBuilder {
+	ret := _m.Called(successStep)
+
+	var r0 application.FormHandlerBuilder
+	if rf, ok := ret.Get(0).(func(domain.SuccessStep) application.FormHandlerBuilder); ok {
+		r0 = rf(successStep)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(application.FormHandlerBuilder)
+		}
+	}
+
+	return r0
+}
+
 // Build provides a mock function with given fields:
 func (_m *FormHandlerBuilder) Build() domain.FormHandler {
 	ret := _m.Called()
@@ -58,6 +150,54 @@ func (_m *FormHandlerBuilder) Build() domain.FormHandler {
 	return r0
 }
 
+// BuildConfirmFormHandler provides a mock function with given fields:
+func (_m *FormHandlerBuilder) BuildConfirmFormHandler() domain.ConfirmFormHandler {
+	ret := _m.Called()
+
+	var r0 domain.ConfirmFormHandler
+	if rf, ok := ret.Get(0).(func() domain.ConfirmFormHandler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.ConfirmFormHandler)
+		}
+	}
+
+	return r0
+}
+
+// BuildDeferredFormHandler provides a mock function with given fields:
+func (_m *FormHandlerBuilder) BuildDeferredFormHandler() domain.DeferredFormHandler {
+	ret := _m.Called()
+
+	var r0 domain.DeferredFormHandler
+	if rf, ok := ret.Get(0).(func() domain.DeferredFormHandler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.DeferredFormHandler)
+		}
+	}
+
+	return r0
+}
+
+// BuildSearchFormHandler provides a mock function with given fields:
+func (_m *FormHandlerBuilder) BuildSearchFormHandler() domain.SearchFormHandler {
+	ret := _m.Called()
+
+	var r0 domain.SearchFormHandler
+	if rf, ok := ret.Get(0).(func() domain.SearchFormHandler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.SearchFormHandler)
+		}
+	}
+
+	return r0
+}
+
 // Must provides a mock function with given fields: err
 func (_m *FormHandlerBuilder) Must(err error) application.FormHandlerBuilder {
 	ret := _m.Called(err)
@@ -74,6 +214,22 @@ func (_m *FormHandlerBuilder) Must(err error) application.FormHandlerBuilder {
 	return r0
 }
 
+// SetDebugMode provides a mock function with given fields: debug
+func (_m *FormHandlerBuilder) SetDebugMode(debug bool) application.FormHandlerBuilder {
+	ret := _m.Called(debug)
+
+	var r0 application.FormHandlerBuilder
+	if rf, ok := ret.Get(0).(func(bool) application.FormHandlerBuilder); ok {
+		r0 = rf(debug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(application.FormHandlerBuilder)
+		}
+	}
+
+	return r0
+}
+
 // SetFormDataDecoder provides a mock function with given fields: formDataDecoder
 func (_m *FormHandlerBuilder) SetFormDataDecoder(formDataDecoder domain.FormDataDecoder) application.FormHandlerBuilder {
 	ret := _m.Called(formDataDecoder)
@@ -106,6 +262,22 @@ func (_m *FormHandlerBuilder) SetFormDataProvider(formDataProvider domain.FormDa
 	return r0
 }
 
+// SetFormDataType provides a mock function with given fields: formData
+func (_m *FormHandlerBuilder) SetFormDataType(formData interface{}) application.FormHandlerBuilder {
+	ret := _m.Called(formData)
+
+	var r0 application.FormHandlerBuilder
+	if rf, ok := ret.Get(0).(func(interface{}) application.FormHandlerBuilder); ok {
+		r0 = rf(formData)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(application.FormHandlerBuilder)
+		}
+	}
+
+	return r0
+}
+
 // SetFormDataValidator provides a mock function with given fields: formDataValidator
 func (_m *FormHandlerBuilder) SetFormDataValidator(formDataValidator domain.FormDataValidator) application.FormHandlerBuilder {
 	ret := _m.Called(formDataValidator)
@@ -191,3 +363,163 @@ func (_m *FormHandlerBuilder) SetNamedFormService(name string) error {
 
 	return r0
 }
+
+// SetPostRedirectGet provides a mock function with given fields: sessionKey
+func (_m *FormHandlerBuilder) SetPostRedirectGet(sessionKey string) application.FormHandlerBuilder {
+	ret := _m.Called(sessionKey)
+
+	var r0 application.FormHandlerBuilder
+	if rf, ok := ret.Get(0).(func(string) application.FormHandlerBuilder); ok {
+		r0 = rf(sessionKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(application.FormHandlerBuilder)
+		}
+	}
+
+	return r0
+}
+
+// SetReportOnlyExtensions provides a mock function with given fields: names
+func (_m *FormHandlerBuilder) SetReportOnlyExtensions(names ...string) application.FormHandlerBuilder {
+	_va := make([]interface{}, len(names))
+	for _i := range names {
+		_va[_i] = names[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 application.FormHandlerBuilder
+	if rf, ok := ret.Get(0).(func(...string) application.FormHandlerBuilder); ok {
+		r0 = rf(names...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(application.FormHandlerBuilder)
+		}
+	}
+
+	return r0
+}
+
+// SetReportOnlyRules provides a mock function with given fields: rules
+func (_m *FormHandlerBuilder) SetReportOnlyRules(rules ...string) application.FormHandlerBuilder {
+	_va := make([]interface{}, len(rules))
+	for _i := range rules {
+		_va[_i] = rules[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 application.FormHandlerBuilder
+	if rf, ok := ret.Get(0).(func(...string) application.FormHandlerBuilder); ok {
+		r0 = rf(rules...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(application.FormHandlerBuilder)
+		}
+	}
+
+	return r0
+}
+
+// SetRequestBodyLimits provides a mock function with given fields: maxSize, multipartMemory
+func (_m *FormHandlerBuilder) SetRequestBodyLimits(maxSize int64, multipartMemory int64) application.FormHandlerBuilder {
+	ret := _m.Called(maxSize, multipartMemory)
+
+	var r0 application.FormHandlerBuilder
+	if rf, ok := ret.Get(0).(func(int64, int64) application.FormHandlerBuilder); ok {
+		r0 = rf(maxSize, multipartMemory)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(application.FormHandlerBuilder)
+		}
+	}
+
+	return r0
+}
+
+// SetRuleProfile provides a mock function with given fields: name
+func (_m *FormHandlerBuilder) SetRuleProfile(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetStreamedUploads provides a mock function with given fields: streamed
+func (_m *FormHandlerBuilder) SetStreamedUploads(streamed bool) application.FormHandlerBuilder {
+	ret := _m.Called(streamed)
+
+	var r0 application.FormHandlerBuilder
+	if rf, ok := ret.Get(0).(func(bool) application.FormHandlerBuilder); ok {
+		r0 = rf(streamed)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(application.FormHandlerBuilder)
+		}
+	}
+
+	return r0
+}
+
+// SetSubmissionMethods provides a mock function with given fields: methods
+func (_m *FormHandlerBuilder) SetSubmissionMethods(methods ...string) application.FormHandlerBuilder {
+	_va := make([]interface{}, len(methods))
+	for _i := range methods {
+		_va[_i] = methods[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 application.FormHandlerBuilder
+	if rf, ok := ret.Get(0).(func(...string) application.FormHandlerBuilder); ok {
+		r0 = rf(methods...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(application.FormHandlerBuilder)
+		}
+	}
+
+	return r0
+}
+
+// SetSubmissionQueue provides a mock function with given fields: submissionQueue
+func (_m *FormHandlerBuilder) SetSubmissionQueue(submissionQueue domain.SubmissionQueue) application.FormHandlerBuilder {
+	ret := _m.Called(submissionQueue)
+
+	var r0 application.FormHandlerBuilder
+	if rf, ok := ret.Get(0).(func(domain.SubmissionQueue) application.FormHandlerBuilder); ok {
+		r0 = rf(submissionQueue)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(application.FormHandlerBuilder)
+		}
+	}
+
+	return r0
+}
+
+// SetValidationGroup provides a mock function with given fields: group
+func (_m *FormHandlerBuilder) SetValidationGroup(group string) application.FormHandlerBuilder {
+	ret := _m.Called(group)
+
+	var r0 application.FormHandlerBuilder
+	if rf, ok := ret.Get(0).(func(string) application.FormHandlerBuilder); ok {
+		r0 = rf(group)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(application.FormHandlerBuilder)
+		}
+	}
+
+	return r0
+}
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	application "flamingo.me/form/application"
 	domain "flamingo.me/form/domain"
 
@@ -91,3 +93,17 @@ func (_m *FormHandlerFactory) GetFormHandlerBuilder() application.FormHandlerBui
 
 	return r0
 }
+
+// Warmup provides a mock function with given fields: ctx
+func (_m *FormHandlerFactory) Warmup(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
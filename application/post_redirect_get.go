@@ -0,0 +1,125 @@
+package application
+
+import (
+	"context"
+	"encoding/gob"
+	"net/url"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// persistedSubmission defines state of failed submission stored in web session, so form can be restored by
+	// the request after redirect (Post/Redirect/Get). Form data is restored by decoding submitted values again.
+	persistedSubmission struct {
+		// Values submitted values of the form, without values of sensitive fields
+		Values url.Values
+		// FieldErrors errors of form fields
+		FieldErrors map[string][]domain.Error
+		// GeneralErrors general errors of the form
+		GeneralErrors []domain.Error
+		// CorrelationID identifier of the failed submission, so restored form is traced by the same ID
+		CorrelationID string
+	}
+)
+
+func init() {
+	// web sessions are encoded via gob, so stored type must be registered
+	gob.Register(persistedSubmission{})
+}
+
+// persistSubmission stores failed submission in web session, if Post/Redirect/Get is enabled.
+// State of previous failed submission is removed once submission is valid. Values of sensitive fields
+// (like payment card data, passwords and encrypted fields) are never stored, so they have to be submitted again.
+func (h *formHandlerImpl) persistSubmission(req *web.Request, values url.Values, form *domain.Form) {
+	if h.postRedirectGetKey == "" || req.Session() == nil {
+		return
+	}
+
+	if form.IsValid() {
+		req.Session().Delete(h.postRedirectGetKey)
+		return
+	}
+
+	req.Session().Store(h.postRedirectGetKey, persistedSubmission{
+		Values:        persistedValues(values, form),
+		FieldErrors:   form.ValidationInfo.GetErrorsForAllFields(),
+		GeneralErrors: form.ValidationInfo.GetGeneralErrors(),
+		CorrelationID: form.CorrelationID,
+	})
+}
+
+// persistedValues returns copy of submitted values without values of sensitive fields of the form, including fields
+// named as passwords, and without values containing card numbers, which are part of sub structs not covered
+// by binding plan (like slices of sub structs)
+func persistedValues(values url.Values, form *domain.Form) url.Values {
+	persisted := make(url.Values, len(values))
+
+	for name, fieldValues := range values {
+		if form.IsSensitiveField(name) || containsCardNumber(fieldValues) {
+			continue
+		}
+
+		persisted[name] = fieldValues
+	}
+
+	return persisted
+}
+
+// containsCardNumber checks if any of values contains valid card number
+func containsCardNumber(values []string) bool {
+	for _, value := range values {
+		if domain.MaskCardNumbers(value) != value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// restoreSubmission returns form restored from failed submission stored in web session, if Post/Redirect/Get
+// is enabled. Stored submission is removed from web session, so it's restored only once. Restored form is
+// submitted, with form data decoded from submitted values and with validation errors of the submission, while
+// form extension data is provided freshly, same as for unsubmitted form.
+func (h *formHandlerImpl) restoreSubmission(ctx context.Context, req *web.Request, form *domain.Form) (*domain.Form, error) {
+	if h.postRedirectGetKey == "" || req.Session() == nil {
+		return form, nil
+	}
+
+	stored, ok := req.Session().Load(h.postRedirectGetKey)
+	if !ok {
+		return form, nil
+	}
+	req.Session().Delete(h.postRedirectGetKey)
+
+	submission, ok := stored.(persistedSubmission)
+	if !ok {
+		return form, nil
+	}
+
+	values := h.featureToggles.disabled(ctx, req).filterValues(submission.Values)
+	formData, err := h.decode(ctx, req, values, form.Data, h.formDataDecoder)
+	if err != nil {
+		return nil, err
+	}
+
+	restored := domain.NewForm(true, form.GetValidationRules())
+	restored.Data = formData
+	restored.FormExtensionsData = form.FormExtensionsData
+	restored.SensitiveFields = form.SensitiveFields
+	restored.DebugInfo = form.DebugInfo
+	restored.CorrelationID = form.CorrelationID
+	if submission.CorrelationID != "" {
+		restored.CorrelationID = submission.CorrelationID
+	}
+	restored.ValidationInfo.AppendFieldErrors(submission.FieldErrors)
+	restored.ValidationInfo.AppendGeneralErrors(submission.GeneralErrors)
+
+	restored.MarkdownPreviews, err = h.renderMarkdown(ctx, formData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &restored, nil
+}
@@ -0,0 +1,190 @@
+package application
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/card"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	PostRedirectGetTestSuite struct {
+		suite.Suite
+
+		decoder *mocks.DefaultFormDataDecoder
+		handler *formHandlerImpl
+
+		context context.Context
+		session *web.Session
+		request *web.Request
+	}
+
+	postRedirectGetTestData struct {
+		Email string
+	}
+
+	postRedirectGetSensitiveTestData struct {
+		Email    string    `form:"email"`
+		Password string    `form:"password"`
+		Secret   string    `form:"secret" encrypt:"true"`
+		Payment  card.Card `form:"payment"`
+		Note     string    `form:"note"`
+		Contacts []struct {
+			Name string `form:"name"`
+		} `form:"contacts"`
+	}
+)
+
+func TestPostRedirectGetTestSuite(t *testing.T) {
+	suite.Run(t, &PostRedirectGetTestSuite{})
+}
+
+func (t *PostRedirectGetTestSuite) SetupTest() {
+	t.decoder = &mocks.DefaultFormDataDecoder{}
+	t.handler = &formHandlerImpl{
+		defaultFormDataDecoder: t.decoder,
+		postRedirectGetKey:     "form.registration",
+	}
+
+	t.context = context.Background()
+	t.session = web.EmptySession()
+	t.request = web.CreateRequest(&http.Request{}, t.session)
+}
+
+func (t *PostRedirectGetTestSuite) TearDownTest() {
+	t.decoder.AssertExpectations(t.T())
+}
+
+func (t *PostRedirectGetTestSuite) TestPersistSubmission() {
+	form := domain.NewForm(true, nil)
+	form.ValidationInfo.AddFieldError("email", "formError.email.required", "email is required")
+	form.ValidationInfo.AddGeneralError("formError.general", "general error")
+	form.CorrelationID = "correlation"
+
+	t.handler.persistSubmission(t.request, url.Values{"email": []string{""}}, &form)
+
+	stored, ok := t.session.Load("form.registration")
+	t.True(ok)
+	t.Equal(persistedSubmission{
+		Values: url.Values{"email": []string{""}},
+		FieldErrors: map[string][]domain.Error{
+			"email": {
+				{
+					MessageKey:   "formError.email.required",
+					DefaultLabel: "email is required",
+				},
+			},
+		},
+		GeneralErrors: []domain.Error{
+			{
+				MessageKey:   "formError.general",
+				DefaultLabel: "general error",
+			},
+		},
+		CorrelationID: "correlation",
+	}, stored)
+}
+
+func (t *PostRedirectGetTestSuite) TestPersistSubmission_SensitiveFields() {
+	form := domain.NewForm(true, nil)
+	form.Data = postRedirectGetSensitiveTestData{}
+	form.SensitiveFields = loadBindingPlan(reflect.TypeOf(form.Data)).sensitiveFields
+	form.ValidationInfo.AddFieldError("email", "formError.email.email", "email is invalid")
+
+	t.handler.persistSubmission(t.request, url.Values{
+		"email":            []string{"user@"},
+		"password":         []string{"correct horse"},
+		"secret":           []string{"my secret"},
+		"payment.number":   []string{"4111 1111 1111 1111"},
+		"payment.expiry":   []string{"12/30"},
+		"payment.cvc":      []string{"123"},
+		"note":             []string{"call 4111111111111111"},
+		"contacts[0].name": []string{"John"},
+		"contacts[1].name": []string{"4111111111111111"},
+		"repeatPassword":   []string{"correct horse"},
+	}, &form)
+
+	stored, ok := t.session.Load("form.registration")
+	t.True(ok)
+	t.Equal(url.Values{
+		"email":            []string{"user@"},
+		"contacts[0].name": []string{"John"},
+	}, stored.(persistedSubmission).Values)
+}
+
+func (t *PostRedirectGetTestSuite) TestPersistSubmission_Valid() {
+	t.session.Store("form.registration", persistedSubmission{})
+	form := domain.NewForm(true, nil)
+
+	t.handler.persistSubmission(t.request, url.Values{"email": []string{"user@example.com"}}, &form)
+
+	_, ok := t.session.Load("form.registration")
+	t.False(ok)
+}
+
+func (t *PostRedirectGetTestSuite) TestPersistSubmission_Disabled() {
+	t.handler.postRedirectGetKey = ""
+	form := domain.NewForm(true, nil)
+	form.ValidationInfo.AddFieldError("email", "formError.email.required", "email is required")
+
+	t.handler.persistSubmission(t.request, url.Values{"email": []string{""}}, &form)
+
+	t.Empty(t.session.Keys())
+}
+
+func (t *PostRedirectGetTestSuite) TestRestoreSubmission() {
+	values := url.Values{"email": []string{"user@"}}
+	t.session.Store("form.registration", persistedSubmission{
+		Values: values,
+		FieldErrors: map[string][]domain.Error{
+			"email": {
+				{
+					MessageKey:   "formError.email.email",
+					DefaultLabel: "email is invalid",
+				},
+			},
+		},
+		CorrelationID: "correlation",
+	})
+	t.decoder.On("Decode", t.context, t.request, values, postRedirectGetTestData{}).Return(postRedirectGetTestData{Email: "user@"}, nil).Once()
+
+	form := domain.NewForm(false, map[string][]domain.ValidationRule{
+		"email": {{Name: "email"}},
+	})
+	form.Data = postRedirectGetTestData{}
+	form.FormExtensionsData = map[string]interface{}{"formExtension.csrfToken": "token"}
+
+	restored, err := t.handler.restoreSubmission(t.context, t.request, &form)
+	t.NoError(err)
+	t.True(restored.IsSubmitted())
+	t.False(restored.IsValid())
+	t.Equal(postRedirectGetTestData{Email: "user@"}, restored.Data)
+	t.Equal(form.GetValidationRules(), restored.GetValidationRules())
+	t.Equal(form.FormExtensionsData, restored.FormExtensionsData)
+	t.Equal("correlation", restored.CorrelationID)
+	t.Equal([]domain.Error{
+		{
+			MessageKey:   "formError.email.email",
+			DefaultLabel: "email is invalid",
+		},
+	}, restored.GetErrorsForField("email"))
+
+	_, ok := t.session.Load("form.registration")
+	t.False(ok)
+}
+
+func (t *PostRedirectGetTestSuite) TestRestoreSubmission_WithoutSubmission() {
+	form := domain.NewForm(false, nil)
+
+	restored, err := t.handler.restoreSubmission(t.context, t.request, &form)
+	t.NoError(err)
+	t.Exactly(&form, restored)
+}
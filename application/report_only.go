@@ -0,0 +1,138 @@
+package application
+
+import (
+	"context"
+	"strings"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"flamingo.me/flamingo/v3/framework/opencensus"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// reportOnly as definition of validation rules and form extensions which run in report-only mode.
+	// Their violations are logged and counted by metric, but not added to domain.ValidationInfo,
+	// so stricter validation can be rolled out safely on live forms.
+	reportOnly struct {
+		rules      map[string]bool
+		extensions map[string]bool
+	}
+)
+
+var (
+	// reportOnlyViolations counts violations of report-only validation rules and form extensions
+	reportOnlyViolations = stats.Int64("flamingo-form/report_only_violations", "Count of violations of report-only validation rules and form extensions", stats.UnitDimensionless)
+	// reportOnlyKey tag key with name of violated validation rule or form extension
+	reportOnlyKey = tag.MustNewKey("flamingo-form.reportOnly")
+)
+
+func init() {
+	if err := opencensus.View("flamingo-form/report_only_violations", reportOnlyViolations, view.Count(), reportOnlyKey); err != nil {
+		panic(err)
+	}
+}
+
+// newReportOnly creates definition of report-only mode from names of validation rules and form extensions
+func newReportOnly(rules []string, extensions []string) *reportOnly {
+	if len(rules) == 0 && len(extensions) == 0 {
+		return nil
+	}
+
+	r := &reportOnly{
+		rules:      make(map[string]bool, len(rules)),
+		extensions: make(map[string]bool, len(extensions)),
+	}
+
+	for _, rule := range rules {
+		r.rules[rule] = true
+	}
+
+	for _, extension := range extensions {
+		r.extensions[extension] = true
+	}
+
+	return r
+}
+
+// isExtension checks if form extension runs in report-only mode
+func (r *reportOnly) isExtension(name string) bool {
+	return r != nil && r.extensions[name]
+}
+
+// isRule checks if validation rule of error runs in report-only mode.
+// Validation rule is identified by last segment of error's message key, which is validation tag for default validator.
+func (r *reportOnly) isRule(err domain.Error) bool {
+	if r == nil || len(r.rules) == 0 {
+		return false
+	}
+
+	return r.rules[errorRule(err)]
+}
+
+// errorRule returns name of validation rule of error
+func errorRule(err domain.Error) string {
+	return err.MessageKey[strings.LastIndex(err.MessageKey, ".")+1:]
+}
+
+// reportRules as method for removing errors of report-only validation rules from validation info.
+// Removed errors are reported, and validation info without them is returned.
+func (h *formHandlerImpl) reportRules(ctx context.Context, req *web.Request, validationInfo *domain.ValidationInfo) *domain.ValidationInfo {
+	if h.reportOnly == nil || len(h.reportOnly.rules) == 0 || validationInfo == nil || validationInfo.IsValid() {
+		return validationInfo
+	}
+
+	result := &domain.ValidationInfo{}
+
+	var generalErrors []domain.Error
+	for _, err := range validationInfo.GetGeneralErrors() {
+		if h.reportOnly.isRule(err) {
+			h.report(ctx, req, errorRule(err), "", err)
+			continue
+		}
+		generalErrors = append(generalErrors, err)
+	}
+	result.AppendGeneralErrors(generalErrors)
+
+	fieldErrors := map[string][]domain.Error{}
+	for fieldName, errs := range validationInfo.GetErrorsForAllFields() {
+		for _, err := range errs {
+			if h.reportOnly.isRule(err) {
+				h.report(ctx, req, errorRule(err), fieldName, err)
+				continue
+			}
+			fieldErrors[fieldName] = append(fieldErrors[fieldName], err)
+		}
+	}
+	result.AppendFieldErrors(fieldErrors)
+
+	return result
+}
+
+// reportExtension as method for reporting all errors of form extension which runs in report-only mode
+func (h *formHandlerImpl) reportExtension(ctx context.Context, req *web.Request, name string, validationInfo *domain.ValidationInfo) {
+	for _, err := range validationInfo.GetGeneralErrors() {
+		h.report(ctx, req, name, "", err)
+	}
+
+	for fieldName, errs := range validationInfo.GetErrorsForAllFields() {
+		for _, err := range errs {
+			h.report(ctx, req, name, fieldName, err)
+		}
+	}
+}
+
+// report as method for logging and counting single violation of report-only validation rule or form extension
+func (h *formHandlerImpl) report(ctx context.Context, req *web.Request, name string, fieldName string, err domain.Error) {
+	logger := h.getLogger(req, "reportOnly").WithField("reportOnly", name)
+	if fieldName != "" {
+		logger = logger.WithField("field", fieldName)
+	}
+	logger.Info("report-only violation: " + err.MessageKey)
+
+	metricCtx, _ := tag.New(ctx, tag.Upsert(reportOnlyKey, name))
+	stats.Record(metricCtx, reportOnlyViolations.M(1))
+}
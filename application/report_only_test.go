@@ -0,0 +1,101 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/form/domain"
+)
+
+type (
+	ReportOnlyTestSuite struct {
+		suite.Suite
+
+		handler *formHandlerImpl
+
+		context context.Context
+	}
+)
+
+func TestReportOnlyTestSuite(t *testing.T) {
+	suite.Run(t, &ReportOnlyTestSuite{})
+}
+
+func (t *ReportOnlyTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *ReportOnlyTestSuite) SetupTest() {
+	t.handler = &formHandlerImpl{
+		logger:     &flamingo.NullLogger{},
+		reportOnly: newReportOnly([]string{"maximumage", "strict"}, []string{"formExtension.originCheck"}),
+	}
+}
+
+func (t *ReportOnlyTestSuite) TestNewReportOnly() {
+	t.Nil(newReportOnly(nil, nil))
+	t.Equal(&reportOnly{
+		rules: map[string]bool{
+			"maximumage": true,
+			"strict":     true,
+		},
+		extensions: map[string]bool{
+			"formExtension.originCheck": true,
+		},
+	}, t.handler.reportOnly)
+}
+
+func (t *ReportOnlyTestSuite) TestIsExtension() {
+	var empty *reportOnly
+	t.False(empty.isExtension("formExtension.originCheck"))
+
+	t.True(t.handler.reportOnly.isExtension("formExtension.originCheck"))
+	t.False(t.handler.reportOnly.isExtension("formExtension.csrfToken"))
+}
+
+func (t *ReportOnlyTestSuite) TestIsRule() {
+	var empty *reportOnly
+	t.False(empty.isRule(domain.Error{MessageKey: "formError.birthday.maximumage"}))
+
+	t.True(t.handler.reportOnly.isRule(domain.Error{MessageKey: "formError.birthday.maximumage"}))
+	t.True(t.handler.reportOnly.isRule(domain.Error{MessageKey: "strict"}))
+	t.False(t.handler.reportOnly.isRule(domain.Error{MessageKey: "formError.birthday.required"}))
+}
+
+func (t *ReportOnlyTestSuite) TestReportRules() {
+	validationInfo := &domain.ValidationInfo{}
+	validationInfo.AddGeneralError("formError.strict", "strict")
+	validationInfo.AddGeneralError("formError.general", "general")
+	validationInfo.AddFieldError("birthday", "formError.birthday.maximumage", "maximumage")
+	validationInfo.AddFieldError("birthday", "formError.birthday.required", "required")
+	validationInfo.AddFieldError("address.zip", "formError.address.zip.strict", "strict")
+
+	result := t.handler.reportRules(t.context, nil, validationInfo)
+	t.Equal([]domain.Error{
+		{
+			MessageKey:   "formError.general",
+			DefaultLabel: "general",
+		},
+	}, result.GetGeneralErrors())
+	t.Equal(map[string][]domain.Error{
+		"birthday": {
+			{
+				MessageKey:   "formError.birthday.required",
+				DefaultLabel: "required",
+			},
+		},
+	}, result.GetErrorsForAllFields())
+	t.False(validationInfo.IsValid())
+}
+
+func (t *ReportOnlyTestSuite) TestReportRules_Unchanged() {
+	validationInfo := &domain.ValidationInfo{}
+	t.Exactly(validationInfo, t.handler.reportRules(t.context, nil, validationInfo))
+
+	validationInfo.AddFieldError("birthday", "formError.birthday.maximumage", "maximumage")
+	t.handler.reportOnly = newReportOnly(nil, []string{"formExtension.originCheck"})
+	t.Exactly(validationInfo, t.handler.reportRules(t.context, nil, validationInfo))
+}
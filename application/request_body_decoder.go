@@ -0,0 +1,63 @@
+package application
+
+import (
+	"mime"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/formdata"
+)
+
+// defaultRequestBodyDecoders decoders of request bodies by their media types, used if there is no decoder
+// bound for the media type
+var defaultRequestBodyDecoders = map[string]domain.RequestBodyDecoder{
+	"application/x-www-form-urlencoded": &formdata.URLEncodedBodyDecoder{},
+	"multipart/form-data":               &formdata.MultipartBodyDecoder{},
+	"application/json":                  &formdata.JSONBodyDecoder{},
+	"application/xml":                   &formdata.XMLBodyDecoder{},
+}
+
+// requestBodyDecoder as method for returning decoder of request body by its content type. Decoders of the handler
+// take precedence over default ones. Media types with structured syntax suffix (like "application/ld+json") fall back
+// to decoder of the suffix, and bodies of unknown or missing content type are decoded as url encoded body.
+func (h *formHandlerImpl) requestBodyDecoder(contentType string) domain.RequestBodyDecoder {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = ""
+	}
+
+	candidates := []string{mediaType}
+	switch {
+	case formdata.IsJSONContentType(contentType):
+		candidates = append(candidates, "application/json")
+	case formdata.IsXMLContentType(contentType):
+		candidates = append(candidates, "application/xml")
+	}
+	candidates = append(candidates, "application/x-www-form-urlencoded")
+
+	for _, candidate := range candidates {
+		if decoder, ok := h.requestBodyDecoders[candidate]; ok {
+			return decoder
+		}
+		if decoder, ok := defaultRequestBodyDecoders[candidate]; ok {
+			return decoder
+		}
+	}
+
+	return defaultRequestBodyDecoders["application/x-www-form-urlencoded"]
+}
+
+// requestBodyDecoders returns bound decoders of request bodies, together with default decoder of multipart body
+// with maximal memory, if it's set and there is no bound decoder for multipart body
+func requestBodyDecoders(decoders map[string]domain.RequestBodyDecoder, multipartMemory int64) map[string]domain.RequestBodyDecoder {
+	if _, ok := decoders["multipart/form-data"]; ok || multipartMemory <= 0 {
+		return decoders
+	}
+
+	withMultipart := make(map[string]domain.RequestBodyDecoder, len(decoders)+1)
+	for mediaType, decoder := range decoders {
+		withMultipart[mediaType] = decoder
+	}
+	withMultipart["multipart/form-data"] = &formdata.MultipartBodyDecoder{MaxMemory: multipartMemory}
+
+	return withMultipart
+}
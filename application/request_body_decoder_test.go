@@ -0,0 +1,65 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/formdata"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	RequestBodyDecoderTestSuite struct {
+		suite.Suite
+
+		handler *formHandlerImpl
+	}
+)
+
+func TestRequestBodyDecoderTestSuite(t *testing.T) {
+	suite.Run(t, &RequestBodyDecoderTestSuite{})
+}
+
+func (t *RequestBodyDecoderTestSuite) SetupTest() {
+	t.handler = &formHandlerImpl{}
+}
+
+func (t *RequestBodyDecoderTestSuite) TestRequestBodyDecoder_Defaults() {
+	t.IsType(&formdata.URLEncodedBodyDecoder{}, t.handler.requestBodyDecoder("application/x-www-form-urlencoded"))
+	t.IsType(&formdata.MultipartBodyDecoder{}, t.handler.requestBodyDecoder("multipart/form-data; boundary=xyz"))
+	t.IsType(&formdata.JSONBodyDecoder{}, t.handler.requestBodyDecoder("application/json; charset=utf-8"))
+	t.IsType(&formdata.JSONBodyDecoder{}, t.handler.requestBodyDecoder("application/merge-patch+json"))
+	t.IsType(&formdata.XMLBodyDecoder{}, t.handler.requestBodyDecoder("text/xml"))
+	t.IsType(&formdata.XMLBodyDecoder{}, t.handler.requestBodyDecoder("application/atom+xml"))
+	t.IsType(&formdata.URLEncodedBodyDecoder{}, t.handler.requestBodyDecoder("text/plain"))
+	t.IsType(&formdata.URLEncodedBodyDecoder{}, t.handler.requestBodyDecoder(""))
+}
+
+func (t *RequestBodyDecoderTestSuite) TestRequestBodyDecoder_Registered() {
+	csvDecoder := &mocks.RequestBodyDecoder{}
+	xmlDecoder := &mocks.RequestBodyDecoder{}
+	t.handler.requestBodyDecoders = map[string]domain.RequestBodyDecoder{
+		"text/csv":        csvDecoder,
+		"application/xml": xmlDecoder,
+	}
+
+	t.Same(csvDecoder, t.handler.requestBodyDecoder("text/csv; charset=utf-8"))
+	t.Same(xmlDecoder, t.handler.requestBodyDecoder("application/atom+xml"))
+	t.IsType(&formdata.JSONBodyDecoder{}, t.handler.requestBodyDecoder("application/json"))
+}
+
+func (t *RequestBodyDecoderTestSuite) TestRequestBodyDecoders() {
+	csvDecoder := &mocks.RequestBodyDecoder{}
+	multipartDecoder := &mocks.RequestBodyDecoder{}
+
+	t.Nil(requestBodyDecoders(nil, 0))
+	t.Equal(map[string]domain.RequestBodyDecoder{
+		"text/csv":            csvDecoder,
+		"multipart/form-data": &formdata.MultipartBodyDecoder{MaxMemory: 1024},
+	}, requestBodyDecoders(map[string]domain.RequestBodyDecoder{"text/csv": csvDecoder}, 1024))
+	t.Equal(map[string]domain.RequestBodyDecoder{
+		"multipart/form-data": multipartDecoder,
+	}, requestBodyDecoders(map[string]domain.RequestBodyDecoder{"multipart/form-data": multipartDecoder}, 1024))
+}
@@ -0,0 +1,134 @@
+package application
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// requiredBinding as precompiled binding of field tagged with `required_when:"ctx:flag"`
+	requiredBinding struct {
+		// index path of required field, which may cross pointers to sub structs
+		index []int
+		// fieldName name of field used for field errors
+		fieldName string
+		// label go name of required field used for default label of field errors
+		label string
+		// condition under which field is required
+		condition requiredCondition
+	}
+
+	// requiredCondition as parsed condition of `required_when` tag, evaluated against request-scoped form flags
+	requiredCondition struct {
+		// flag name of form flag supplied via domain.ContextWithFormFlags
+		flag string
+		// negated flag if field is required when form flag is not set (like "ctx:!guestCheckout")
+		negated bool
+	}
+)
+
+const (
+	// requiredWhenTag name of tag, which makes field required depending on request-scoped form flag
+	requiredWhenTag = "required_when"
+	// requiredWhenContextPrefix prefix of conditions on form flags supplied via domain.ContextWithFormFlags
+	requiredWhenContextPrefix = "ctx:"
+)
+
+// parseRequiredCondition parses condition of `required_when` tag, in format "ctx:flag" or "ctx:!flag"
+func parseRequiredCondition(fieldName string, value string) (requiredCondition, error) {
+	if !strings.HasPrefix(value, requiredWhenContextPrefix) {
+		return requiredCondition{}, domain.NewFormErrorf(`invalid required_when condition %q of field %q, expected "ctx:flag"`, value, fieldName)
+	}
+
+	condition := requiredCondition{
+		flag: strings.TrimPrefix(value, requiredWhenContextPrefix),
+	}
+
+	if strings.HasPrefix(condition.flag, "!") {
+		condition.flag = condition.flag[1:]
+		condition.negated = true
+	}
+
+	if condition.flag == "" {
+		return requiredCondition{}, domain.NewFormErrorf(`invalid required_when condition %q of field %q, expected "ctx:flag"`, value, fieldName)
+	}
+
+	return condition, nil
+}
+
+// holds checks if condition holds for request-scoped form flags of the context
+func (c requiredCondition) holds(ctx context.Context) bool {
+	return domain.FormFlagFromContext(ctx, c.flag) != c.negated
+}
+
+// resolveRequiredRules replaces `required_when` rules, which conditions hold for the request, with "required" rules
+// and removes the others, so exported validation rules describe the request. Passed rules stay unchanged.
+func resolveRequiredRules(ctx context.Context, validationRules map[string][]domain.ValidationRule) map[string][]domain.ValidationRule {
+	conditional := false
+	for _, rules := range validationRules {
+		for _, rule := range rules {
+			if rule.Name == requiredWhenTag {
+				conditional = true
+			}
+		}
+	}
+
+	if !conditional {
+		return validationRules
+	}
+
+	resolved := make(map[string][]domain.ValidationRule, len(validationRules))
+	for fieldName, rules := range validationRules {
+		var fieldRules []domain.ValidationRule
+		for _, rule := range rules {
+			if rule.Name == requiredWhenTag {
+				condition, err := parseRequiredCondition(fieldName, rule.Value)
+				if err != nil || !condition.holds(ctx) {
+					continue
+				}
+				rule = domain.ValidationRule{Name: "required"}
+			}
+			fieldRules = append(fieldRules, rule)
+		}
+
+		if len(fieldRules) > 0 {
+			resolved[fieldName] = fieldRules
+		}
+	}
+
+	return resolved
+}
+
+// requireFields as method for validating fields tagged with `required_when:"ctx:flag"`. Fields which conditions hold
+// for the request must not have zero value.
+func (h *formHandlerImpl) requireFields(ctx context.Context, formData interface{}, validationInfo *domain.ValidationInfo) {
+	plan := h.bindingPlanOf(formData)
+	if len(plan.requiredBindings) == 0 {
+		return
+	}
+
+	valueOf := reflect.ValueOf(formData)
+	if valueOf.Kind() == reflect.Ptr {
+		if valueOf.IsNil() {
+			return
+		}
+		valueOf = valueOf.Elem()
+	}
+
+	for _, binding := range plan.requiredBindings {
+		if !binding.condition.holds(ctx) {
+			continue
+		}
+
+		fieldValue, ok := fieldByIndex(valueOf, binding.index)
+		if ok && !fieldValue.IsZero() {
+			continue
+		}
+
+		validationInfo.AddFieldErrorWithParams(binding.fieldName, "formError."+binding.fieldName+".required", binding.label+" required",
+			domain.NewErrorParams(binding.label, "required", "", ""))
+	}
+}
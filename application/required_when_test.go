@@ -0,0 +1,201 @@
+package application
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	RequiredWhenTestSuite struct {
+		suite.Suite
+
+		handler *formHandlerImpl
+	}
+
+	requiredWhenTestCompany struct {
+		VatID string `form:"vatId" required_when:"ctx:business"`
+	}
+
+	requiredWhenTestData struct {
+		Email    string                   `form:"email" required_when:"ctx:guestCheckout" validate:"omitempty,email"`
+		Password string                   `form:"password" required_when:"ctx:!guestCheckout"`
+		Company  *requiredWhenTestCompany `form:"company"`
+	}
+
+	requiredWhenTestInvalidData struct {
+		Email string `required_when:"session:guestCheckout"`
+	}
+)
+
+func TestRequiredWhenTestSuite(t *testing.T) {
+	suite.Run(t, &RequiredWhenTestSuite{})
+}
+
+func (t *RequiredWhenTestSuite) SetupTest() {
+	t.handler = &formHandlerImpl{}
+}
+
+func (t *RequiredWhenTestSuite) TestParseRequiredCondition() {
+	condition, err := parseRequiredCondition("email", "ctx:guestCheckout")
+	t.NoError(err)
+	t.Equal(requiredCondition{flag: "guestCheckout"}, condition)
+
+	condition, err = parseRequiredCondition("email", "ctx:!guestCheckout")
+	t.NoError(err)
+	t.Equal(requiredCondition{flag: "guestCheckout", negated: true}, condition)
+
+	for _, value := range []string{"", "ctx:", "ctx:!", "guestCheckout", "session:guestCheckout"} {
+		_, err = parseRequiredCondition("email", value)
+		t.Error(err, value)
+	}
+}
+
+func (t *RequiredWhenTestSuite) TestLoadBindingPlan() {
+	plan := loadBindingPlan(reflect.TypeOf(requiredWhenTestData{}))
+
+	t.NoError(plan.ruleErr)
+	t.Equal([]requiredBinding{
+		{
+			index:     []int{0},
+			fieldName: "email",
+			label:     "Email",
+			condition: requiredCondition{flag: "guestCheckout"},
+		},
+		{
+			index:     []int{1},
+			fieldName: "password",
+			label:     "Password",
+			condition: requiredCondition{flag: "guestCheckout", negated: true},
+		},
+		{
+			index:     []int{2, 0},
+			fieldName: "company.vatID",
+			label:     "VatID",
+			condition: requiredCondition{flag: "business"},
+		},
+	}, plan.requiredBindings)
+	t.Equal(map[string][]domain.ValidationRule{
+		"email": {
+			{Name: "required_when", Value: "ctx:guestCheckout"},
+			{Name: "email"},
+		},
+		"password": {
+			{Name: "required_when", Value: "ctx:!guestCheckout"},
+		},
+		"company.vatId": {
+			{Name: "required_when", Value: "ctx:business"},
+		},
+	}, plan.validationRules)
+}
+
+func (t *RequiredWhenTestSuite) TestLoadBindingPlan_Error() {
+	plan := loadBindingPlan(reflect.TypeOf(requiredWhenTestInvalidData{}))
+
+	t.Error(plan.ruleErr)
+	t.Empty(plan.requiredBindings)
+}
+
+func (t *RequiredWhenTestSuite) TestResolveRequiredRules() {
+	validationRules := map[string][]domain.ValidationRule{
+		"email": {
+			{Name: "required_when", Value: "ctx:guestCheckout"},
+			{Name: "email"},
+		},
+		"password": {
+			{Name: "required_when", Value: "ctx:!guestCheckout"},
+		},
+	}
+
+	ctx := domain.ContextWithFormFlags(context.Background(), map[string]bool{"guestCheckout": true})
+	t.Equal(map[string][]domain.ValidationRule{
+		"email": {
+			{Name: "required"},
+			{Name: "email"},
+		},
+	}, resolveRequiredRules(ctx, validationRules))
+
+	t.Equal(map[string][]domain.ValidationRule{
+		"email": {
+			{Name: "email"},
+		},
+		"password": {
+			{Name: "required"},
+		},
+	}, resolveRequiredRules(context.Background(), validationRules))
+
+	t.Equal([]domain.ValidationRule{
+		{Name: "required_when", Value: "ctx:!guestCheckout"},
+	}, validationRules["password"])
+}
+
+func (t *RequiredWhenTestSuite) TestResolveRequiredRules_Unconditional() {
+	validationRules := map[string][]domain.ValidationRule{
+		"email": {
+			{Name: "required"},
+		},
+	}
+
+	t.Equal(validationRules, resolveRequiredRules(context.Background(), validationRules))
+}
+
+func (t *RequiredWhenTestSuite) TestRequireFields_GuestCheckout() {
+	ctx := domain.ContextWithFormFlags(context.Background(), map[string]bool{
+		"guestCheckout": true,
+		"business":      true,
+	})
+
+	validationInfo := &domain.ValidationInfo{}
+	t.handler.requireFields(ctx, &requiredWhenTestData{}, validationInfo)
+
+	t.Equal(map[string][]domain.Error{
+		"email": {
+			{
+				MessageKey:   "formError.email.required",
+				DefaultLabel: "Email required",
+				Params:       map[string]string{"field": "Email", "rule": "required"},
+			},
+		},
+		"company.vatID": {
+			{
+				MessageKey:   "formError.company.vatID.required",
+				DefaultLabel: "VatID required",
+				Params:       map[string]string{"field": "VatID", "rule": "required"},
+			},
+		},
+	}, validationInfo.GetErrorsForAllFields())
+}
+
+func (t *RequiredWhenTestSuite) TestRequireFields_Customer() {
+	validationInfo := &domain.ValidationInfo{}
+	t.handler.requireFields(context.Background(), requiredWhenTestData{
+		Email:   "user@example.com",
+		Company: &requiredWhenTestCompany{},
+	}, validationInfo)
+
+	t.Equal(map[string][]domain.Error{
+		"password": {
+			{
+				MessageKey:   "formError.password.required",
+				DefaultLabel: "Password required",
+				Params:       map[string]string{"field": "Password", "rule": "required"},
+			},
+		},
+	}, validationInfo.GetErrorsForAllFields())
+
+	validationInfo = &domain.ValidationInfo{}
+	t.handler.requireFields(context.Background(), requiredWhenTestData{Password: "secret"}, validationInfo)
+	t.True(validationInfo.IsValid())
+}
+
+func (t *RequiredWhenTestSuite) TestRequireFields_NotStruct() {
+	validationInfo := &domain.ValidationInfo{}
+	t.handler.requireFields(context.Background(), map[string]string{}, validationInfo)
+	t.handler.requireFields(context.Background(), (*requiredWhenTestData)(nil), validationInfo)
+
+	t.True(validationInfo.IsValid())
+}
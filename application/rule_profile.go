@@ -0,0 +1,291 @@
+package application
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+
+	validator "gopkg.in/go-playground/validator.v9"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// ruleProfiles as named rule profiles defined by configuration (like markets "DE" and "US")
+	ruleProfiles map[string]*ruleProfile
+
+	// ruleProfile as validation rules of form fields, which override or extend rules of `validate` tags.
+	// Fields are referenced by their form names (like "address.zip"), and fields which are not part of form data are ignored,
+	// so same profile can be used by different forms.
+	ruleProfile struct {
+		// Override rules per form field, which replace rules of `validate` tag
+		Override map[string]string `json:"override"`
+		// Extend rules per form field, which are validated in addition to rules of `validate` tag
+		Extend map[string]string `json:"extend"`
+	}
+
+	// formField as precompiled binding of form field by its form name, used for applying rule profiles
+	formField struct {
+		// index path of the field, which may cross pointers to sub structs
+		index []int
+		// fieldName name of field used for field errors
+		fieldName string
+		// label go name of the field used for default label of field errors
+		label string
+		// typeOf type of the field
+		typeOf reflect.Type
+		// structTypeOf type of struct which contains the field, used for resolving fields referenced by its rules
+		structTypeOf reflect.Type
+		// groups validation groups of the field defined by `validate-groups` tag, inherited from parent sub structs
+		groups []string
+		// sensitive flag if field contains data which must not be retained, like payment card data, passwords
+		// and fields tagged with `encrypt:"true"`
+		sensitive bool
+	}
+)
+
+// newRuleProfiles creates rule profiles from configuration. It returns nil if there is no profile.
+func newRuleProfiles(profiles config.Map) ruleProfiles {
+	configured := ruleProfiles{}
+	if err := profiles.MapInto(&configured); err != nil {
+		panic(err.Error())
+	}
+
+	if len(configured) == 0 {
+		return nil
+	}
+
+	return configured
+}
+
+// selected returns rule profile selected for the request via domain.ContextWithRuleProfile, or nil
+// if there is no such profile
+func (p ruleProfiles) selected(ctx context.Context) *ruleProfile {
+	if len(p) == 0 {
+		return nil
+	}
+
+	return p[domain.RuleProfileFromContext(ctx)]
+}
+
+// selectedRuleProfile returns rule profile selected for the request, or default rule profile of the form handler
+// if there is no rule profile selected for the request
+func (h *formHandlerImpl) selectedRuleProfile(ctx context.Context) *ruleProfile {
+	if h.ruleProfile != "" && domain.RuleProfileFromContext(ctx) == "" {
+		return h.ruleProfiles[h.ruleProfile]
+	}
+
+	return h.ruleProfiles.selected(ctx)
+}
+
+// validationRules returns exported validation rules with rules of the profile applied to fields of binding plan.
+// Rules of other tags (like "confirmfield") are kept for overridden fields. Passed rules stay unchanged.
+func (p *ruleProfile) validationRules(plan *bindingPlan, validationRules map[string][]domain.ValidationRule) map[string][]domain.ValidationRule {
+	if p == nil || len(plan.formFields) == 0 {
+		return validationRules
+	}
+
+	resolved := make(map[string][]domain.ValidationRule, len(validationRules))
+	for name, rules := range validationRules {
+		resolved[name] = rules
+	}
+
+	for name, tag := range p.Override {
+		field, ok := plan.formFields[name]
+		if !ok {
+			continue
+		}
+
+		var fieldRules []domain.ValidationRule
+		for _, rule := range resolved[name] {
+			if rule.Name == "confirmfield" || rule.Name == requiredWhenTag {
+				fieldRules = append(fieldRules, rule)
+			}
+		}
+
+		rules, _ := parseValidationRules(name, tag, field.typeOf, field.structTypeOf)
+		fieldRules = append(fieldRules, rules...)
+
+		if len(fieldRules) > 0 {
+			resolved[name] = fieldRules
+		} else {
+			delete(resolved, name)
+		}
+	}
+
+	for name, tag := range p.Extend {
+		field, ok := plan.formFields[name]
+		if !ok {
+			continue
+		}
+
+		rules, _ := parseValidationRules(name, tag, field.typeOf, field.structTypeOf)
+		if len(rules) > 0 {
+			resolved[name] = append(append([]domain.ValidationRule{}, resolved[name]...), rules...)
+		}
+	}
+
+	return resolved
+}
+
+// compileFormFields as function for collecting bindings of all exported fields of struct type by their form names,
+// including fields of sub structs. Sub structs which are already part of the current path are skipped.
+// Fields without `validate-groups` tag inherit groups of their parent sub struct.
+func compileFormFields(formFields map[string]formField, typeOf reflect.Type, index []int, prefix string, namespace string, groups []string, path map[reflect.Type]bool) {
+	for i := 0; i < typeOf.NumField(); i++ {
+		fieldType := typeOf.Field(i)
+		if fieldType.PkgPath != "" {
+			continue
+		}
+
+		name := fieldType.Tag.Get("form")
+		if name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name = fieldType.Name
+		}
+
+		fieldIndex := append(append([]int{}, index...), i)
+		fieldName := namespace + strings.ToLower(fieldType.Name[0:1]) + fieldType.Name[1:]
+
+		fieldGroups := groups
+		if tag, ok := fieldType.Tag.Lookup(validationGroupsTag); ok {
+			fieldGroups = parseValidationGroups(tag)
+		}
+
+		fieldTypeOf := fieldType.Type
+		if fieldTypeOf.Kind() == reflect.Ptr && fieldTypeOf.Elem().Kind() == reflect.Struct {
+			fieldTypeOf = fieldTypeOf.Elem()
+		}
+
+		if fieldTypeOf.Kind() == reflect.Struct && fieldTypeOf != timeType {
+			if !path[fieldTypeOf] {
+				path[fieldTypeOf] = true
+				compileFormFields(formFields, fieldTypeOf, fieldIndex, prefix+name+".", fieldName+".", fieldGroups, path)
+				delete(path, fieldTypeOf)
+			}
+			continue
+		}
+
+		formFields[prefix+name] = formField{
+			index:        fieldIndex,
+			fieldName:    fieldName,
+			label:        fieldType.Name,
+			typeOf:       fieldType.Type,
+			structTypeOf: typeOf,
+			groups:       fieldGroups,
+			sensitive:    typeOf == cardType || fieldType.Tag.Get("encrypt") == "true" || isPasswordField(fieldType),
+		}
+	}
+}
+
+// isPasswordField as function for detecting password fields by their name or by password validation rules
+func isPasswordField(fieldType reflect.StructField) bool {
+	if strings.Contains(strings.ToLower(fieldType.Name), "password") {
+		return true
+	}
+
+	rules := fieldType.Tag.Get("validate")
+
+	return strings.Contains(rules, "passwordhistory") || strings.Contains(rules, "notbreached")
+}
+
+// applyRuleProfile as method for validating form fields by rules of rule profile selected for the request.
+// Field errors of overridden fields are replaced by errors of their profile rules, and errors of extending rules
+// are added to existing ones.
+func (h *formHandlerImpl) applyRuleProfile(ctx context.Context, req *web.Request, formData interface{}, validationInfo *domain.ValidationInfo) error {
+	profile := h.selectedRuleProfile(ctx)
+	if profile == nil {
+		return nil
+	}
+
+	plan := h.bindingPlanOf(formData)
+	if len(plan.formFields) == 0 {
+		return nil
+	}
+
+	valueOf := reflect.ValueOf(formData)
+	if valueOf.Kind() == reflect.Ptr {
+		if valueOf.IsNil() {
+			return nil
+		}
+		valueOf = valueOf.Elem()
+	}
+
+	validate := h.validatorProvider.GetValidator()
+	reqCtx := web.ContextWithRequest(ctx, req)
+
+	for _, name := range sortedRuleNames(profile.Override) {
+		field, ok := plan.formFields[name]
+		if !ok {
+			continue
+		}
+
+		validationInfo.RemoveAllFieldError(field.fieldName)
+		if err := validateProfileField(reqCtx, validate, valueOf, field, profile.Override[name], validationInfo); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range sortedRuleNames(profile.Extend) {
+		field, ok := plan.formFields[name]
+		if !ok {
+			continue
+		}
+
+		if err := validateProfileField(reqCtx, validate, valueOf, field, profile.Extend[name], validationInfo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateProfileField validates single field of struct value by rules of rule profile.
+// Invalid rules make validator panic, so they are recovered and returned as error.
+func validateProfileField(ctx context.Context, validate *validator.Validate, valueOf reflect.Value, field formField, rules string, validationInfo *domain.ValidationInfo) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = domain.NewFormErrorf("invalid rule profile rules %q of field %q: %v", rules, field.fieldName, r)
+		}
+	}()
+
+	fieldValue, ok := fieldByIndex(valueOf, field.index)
+	if !ok {
+		fieldValue = reflect.Zero(field.typeOf)
+	}
+
+	err = validate.VarCtx(ctx, fieldValue.Interface(), rules)
+	if err == nil {
+		return nil
+	}
+
+	validationErrors, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return err
+	}
+
+	for _, validationError := range validationErrors {
+		validationInfo.AddFieldErrorWithParams(field.fieldName, "formError."+field.fieldName+"."+validationError.Tag(), field.label+" "+validationError.Tag(),
+			domain.NewErrorParams(field.label, validationError.Tag(), validationError.Param(), ""))
+	}
+
+	return nil
+}
+
+// sortedRuleNames returns names of form fields of profile rules in alphabetical order, so fields are validated
+// in the same order for each request
+func sortedRuleNames(rules map[string]string) []string {
+	names := make([]string, 0, len(rules))
+	for name := range rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
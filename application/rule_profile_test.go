@@ -0,0 +1,223 @@
+package application
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	RuleProfileTestSuite struct {
+		suite.Suite
+
+		handler *formHandlerImpl
+
+		context context.Context
+		request *web.Request
+	}
+
+	ruleProfileTestAddress struct {
+		Zip     string `form:"zip" validate:"required,numeric,len=5"`
+		State   string `form:"state"`
+		Country string `form:"country" validate:"required"`
+	}
+
+	ruleProfileTestData struct {
+		Email   string                  `form:"email" validate:"required,email"`
+		Address *ruleProfileTestAddress `form:"address"`
+	}
+)
+
+func TestRuleProfileTestSuite(t *testing.T) {
+	suite.Run(t, &RuleProfileTestSuite{})
+}
+
+func (t *RuleProfileTestSuite) SetupTest() {
+	validatorProvider := &ValidatorProviderImpl{}
+	validatorProvider.Inject(nil, nil)
+
+	t.handler = &formHandlerImpl{
+		validatorProvider: validatorProvider,
+		ruleProfiles: newRuleProfiles(config.Map{
+			"US": config.Map{
+				"override": config.Map{
+					"address.zip": "required,numeric,len=5|len=9",
+				},
+				"extend": config.Map{
+					"address.state": "required,len=2",
+					"unknown":       "required",
+				},
+			},
+			"GB": config.Map{
+				"override": config.Map{
+					"address.zip": "required,max=8",
+				},
+			},
+			"invalid": config.Map{
+				"extend": config.Map{
+					"email": "unknown",
+				},
+			},
+		}),
+	}
+
+	t.context = domain.ContextWithRuleProfile(context.Background(), "US")
+	t.request = web.CreateRequest(&http.Request{}, nil)
+}
+
+func (t *RuleProfileTestSuite) TestNewRuleProfiles() {
+	t.Nil(newRuleProfiles(config.Map{}))
+	t.Equal(&ruleProfile{
+		Override: map[string]string{
+			"address.zip": "required,max=8",
+		},
+	}, t.handler.ruleProfiles["GB"])
+}
+
+func (t *RuleProfileTestSuite) TestSelected() {
+	t.Exactly(t.handler.ruleProfiles["US"], t.handler.ruleProfiles.selected(t.context))
+	t.Nil(t.handler.ruleProfiles.selected(context.Background()))
+	t.Nil(t.handler.ruleProfiles.selected(domain.ContextWithRuleProfile(context.Background(), "DE")))
+	t.Nil(ruleProfiles(nil).selected(t.context))
+}
+
+func (t *RuleProfileTestSuite) TestSelectedRuleProfile() {
+	t.Nil(t.handler.selectedRuleProfile(context.Background()))
+
+	t.handler.ruleProfile = "GB"
+	t.Exactly(t.handler.ruleProfiles["GB"], t.handler.selectedRuleProfile(context.Background()))
+	t.Exactly(t.handler.ruleProfiles["US"], t.handler.selectedRuleProfile(t.context))
+}
+
+func (t *RuleProfileTestSuite) TestLoadBindingPlan() {
+	plan := loadBindingPlan(reflect.TypeOf(ruleProfileTestData{}))
+
+	t.Equal(map[string]formField{
+		"email": {
+			index:        []int{0},
+			fieldName:    "email",
+			label:        "Email",
+			typeOf:       reflect.TypeOf(""),
+			structTypeOf: reflect.TypeOf(ruleProfileTestData{}),
+		},
+		"address.zip": {
+			index:        []int{1, 0},
+			fieldName:    "address.zip",
+			label:        "Zip",
+			typeOf:       reflect.TypeOf(""),
+			structTypeOf: reflect.TypeOf(ruleProfileTestAddress{}),
+		},
+		"address.state": {
+			index:        []int{1, 1},
+			fieldName:    "address.state",
+			label:        "State",
+			typeOf:       reflect.TypeOf(""),
+			structTypeOf: reflect.TypeOf(ruleProfileTestAddress{}),
+		},
+		"address.country": {
+			index:        []int{1, 2},
+			fieldName:    "address.country",
+			label:        "Country",
+			typeOf:       reflect.TypeOf(""),
+			structTypeOf: reflect.TypeOf(ruleProfileTestAddress{}),
+		},
+	}, plan.formFields)
+}
+
+func (t *RuleProfileTestSuite) TestValidationRules() {
+	plan := loadBindingPlan(reflect.TypeOf(ruleProfileTestData{}))
+
+	result := t.handler.ruleProfiles["GB"].validationRules(plan, plan.validationRules)
+	t.Equal([]domain.ValidationRule{
+		{Name: "required"},
+		{Name: "max", Value: "8", ValueType: domain.RuleValueTypeLength},
+	}, result["address.zip"])
+	t.Equal(plan.validationRules["email"], result["email"])
+
+	result = t.handler.ruleProfiles["US"].validationRules(plan, plan.validationRules)
+	t.Equal([]domain.ValidationRule{
+		{Name: "required"},
+		{Name: "len", Value: "2", ValueType: domain.RuleValueTypeLength},
+	}, result["address.state"])
+	t.NotContains(result, "unknown")
+
+	t.Equal([]domain.ValidationRule{
+		{Name: "required"},
+		{Name: "numeric"},
+		{Name: "len", Value: "5", ValueType: domain.RuleValueTypeLength},
+	}, plan.validationRules["address.zip"])
+	t.Exactly(plan.validationRules, (*ruleProfile)(nil).validationRules(plan, plan.validationRules))
+}
+
+func (t *RuleProfileTestSuite) TestApplyRuleProfile() {
+	validationInfo := &domain.ValidationInfo{}
+	validationInfo.AddFieldError("address.zip", "formError.address.zip.len", "Zip len")
+
+	err := t.handler.applyRuleProfile(t.context, t.request, &ruleProfileTestData{
+		Email: "user@example.com",
+		Address: &ruleProfileTestAddress{
+			Zip:     "123456789",
+			State:   "California",
+			Country: "US",
+		},
+	}, validationInfo)
+	t.NoError(err)
+
+	t.Equal(map[string][]domain.Error{
+		"address.state": {
+			{
+				MessageKey:   "formError.address.state.len",
+				DefaultLabel: "State len",
+				Params:       map[string]string{"field": "State", "rule": "len", "value": "2"},
+			},
+		},
+	}, validationInfo.GetErrorsForAllFields())
+}
+
+func (t *RuleProfileTestSuite) TestApplyRuleProfile_NilSubForm() {
+	validationInfo := &domain.ValidationInfo{}
+
+	err := t.handler.applyRuleProfile(t.context, t.request, ruleProfileTestData{Email: "user@example.com"}, validationInfo)
+	t.NoError(err)
+
+	t.Equal(map[string][]domain.Error{
+		"address.zip": {
+			{
+				MessageKey:   "formError.address.zip.required",
+				DefaultLabel: "Zip required",
+				Params:       map[string]string{"field": "Zip", "rule": "required"},
+			},
+		},
+		"address.state": {
+			{
+				MessageKey:   "formError.address.state.required",
+				DefaultLabel: "State required",
+				Params:       map[string]string{"field": "State", "rule": "required"},
+			},
+		},
+	}, validationInfo.GetErrorsForAllFields())
+}
+
+func (t *RuleProfileTestSuite) TestApplyRuleProfile_WithoutProfile() {
+	validationInfo := &domain.ValidationInfo{}
+
+	t.NoError(t.handler.applyRuleProfile(context.Background(), t.request, ruleProfileTestData{}, validationInfo))
+	t.NoError(t.handler.applyRuleProfile(t.context, t.request, map[string]string{}, validationInfo))
+	t.NoError(t.handler.applyRuleProfile(t.context, t.request, (*ruleProfileTestData)(nil), validationInfo))
+
+	t.True(validationInfo.IsValid())
+}
+
+func (t *RuleProfileTestSuite) TestApplyRuleProfile_InvalidRules() {
+	ctx := domain.ContextWithRuleProfile(context.Background(), "invalid")
+
+	err := t.handler.applyRuleProfile(ctx, t.request, ruleProfileTestData{}, &domain.ValidationInfo{})
+	t.Error(err)
+}
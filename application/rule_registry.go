@@ -0,0 +1,95 @@
+package application
+
+import (
+	"sort"
+	"sync"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// RuleRegistryImpl as struct which implements interface RuleRegistry. It's filled with descriptions of all
+	// injected field validators, and descriptions defined by configuration.
+	RuleRegistryImpl struct {
+		mutex        sync.RWMutex
+		descriptions map[string]domain.RuleDescription
+	}
+)
+
+var _ domain.RuleRegistry = &RuleRegistryImpl{}
+
+// Inject registers descriptions of all field validators and configured rules. Field validators which don't
+// implement domain.RuleDescriber are registered just by their name.
+func (r *RuleRegistryImpl) Inject(
+	fieldValidators []domain.FieldValidator,
+	cfg *struct {
+		Rules config.Map `inject:"config:form.validator.rules"`
+	},
+) {
+	for _, fieldValidator := range fieldValidators {
+		description := domain.RuleDescription{
+			Name: fieldValidator.ValidatorName(),
+		}
+
+		if describer, ok := fieldValidator.(domain.RuleDescriber); ok {
+			description = describer.DescribeRule()
+			description.Name = fieldValidator.ValidatorName()
+		}
+
+		r.Register(description)
+	}
+
+	if cfg == nil {
+		return
+	}
+
+	rules := map[string]domain.RuleDescription{}
+	if err := cfg.Rules.MapInto(&rules); err != nil {
+		panic(err.Error())
+	}
+
+	for name, description := range rules {
+		description.Name = name
+		r.Register(description)
+	}
+}
+
+// Register adds description of validation rule, replacing already registered description with same name
+func (r *RuleRegistryImpl) Register(description domain.RuleDescription) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.descriptions == nil {
+		r.descriptions = map[string]domain.RuleDescription{}
+	}
+
+	r.descriptions[description.Name] = description
+}
+
+// Describe returns description of validation rule by its name
+func (r *RuleRegistryImpl) Describe(name string) (domain.RuleDescription, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	description, ok := r.descriptions[name]
+
+	return description, ok
+}
+
+// Descriptions returns descriptions of all registered validation rules, sorted by name
+func (r *RuleRegistryImpl) Descriptions() []domain.RuleDescription {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	descriptions := make([]domain.RuleDescription, 0, len(r.descriptions))
+	for _, description := range r.descriptions {
+		descriptions = append(descriptions, description)
+	}
+
+	sort.Slice(descriptions, func(i, j int) bool {
+		return descriptions[i].Name < descriptions[j].Name
+	})
+
+	return descriptions
+}
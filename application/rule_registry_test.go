@@ -0,0 +1,129 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	RuleRegistryTestSuite struct {
+		suite.Suite
+
+		registry *RuleRegistryImpl
+
+		plainValidator     *mocks.FieldValidator
+		describedValidator *ruleRegistryTestValidator
+	}
+
+	ruleRegistryTestValidator struct {
+		*mocks.FieldValidator
+		*mocks.RuleDescriber
+	}
+)
+
+func TestRuleRegistryTestSuite(t *testing.T) {
+	suite.Run(t, &RuleRegistryTestSuite{})
+}
+
+func (t *RuleRegistryTestSuite) SetupTest() {
+	t.plainValidator = &mocks.FieldValidator{}
+	t.plainValidator.On("ValidatorName").Return("plain")
+
+	t.describedValidator = &ruleRegistryTestValidator{
+		FieldValidator: &mocks.FieldValidator{},
+		RuleDescriber:  &mocks.RuleDescriber{},
+	}
+	t.describedValidator.FieldValidator.On("ValidatorName").Return("iban")
+	t.describedValidator.RuleDescriber.On("DescribeRule").Return(domain.RuleDescription{
+		Name:        "other",
+		Description: "IBAN with valid checksum",
+		Params: map[string]interface{}{
+			"type": "string",
+		},
+		MessageKey: "error.{field}.iban",
+	})
+
+	t.registry = &RuleRegistryImpl{}
+	t.registry.Inject([]domain.FieldValidator{t.plainValidator, t.describedValidator}, &struct {
+		Rules config.Map `inject:"config:form.validator.rules"`
+	}{
+		Rules: config.Map{
+			"plain": config.Map{
+				"description": "plain rule",
+			},
+			"uniqueemail": config.Map{
+				"description": "email which is not registered yet",
+				"messageKey":  "formError.{field}.unique",
+			},
+		},
+	})
+}
+
+func (t *RuleRegistryTestSuite) TearDownTest() {
+	t.plainValidator.AssertExpectations(t.T())
+	t.describedValidator.FieldValidator.AssertExpectations(t.T())
+	t.describedValidator.RuleDescriber.AssertExpectations(t.T())
+}
+
+func (t *RuleRegistryTestSuite) TestDescribe() {
+	description, ok := t.registry.Describe("iban")
+	t.True(ok)
+	t.Equal(domain.RuleDescription{
+		Name:        "iban",
+		Description: "IBAN with valid checksum",
+		Params: map[string]interface{}{
+			"type": "string",
+		},
+		MessageKey: "error.{field}.iban",
+	}, description)
+
+	description, ok = t.registry.Describe("plain")
+	t.True(ok)
+	t.Equal(domain.RuleDescription{
+		Name:        "plain",
+		Description: "plain rule",
+	}, description)
+
+	_, ok = t.registry.Describe("unknown")
+	t.False(ok)
+}
+
+func (t *RuleRegistryTestSuite) TestRegister() {
+	t.registry.Register(domain.RuleDescription{
+		Name:        "plain",
+		Description: "registered rule",
+	})
+
+	description, ok := t.registry.Describe("plain")
+	t.True(ok)
+	t.Equal("registered rule", description.Description)
+}
+
+func (t *RuleRegistryTestSuite) TestDescriptions() {
+	descriptions := t.registry.Descriptions()
+
+	t.Len(descriptions, 3)
+	t.Equal("iban", descriptions[0].Name)
+	t.Equal("plain", descriptions[1].Name)
+	t.Equal(domain.RuleDescription{
+		Name:        "uniqueemail",
+		Description: "email which is not registered yet",
+		MessageKey:  "formError.{field}.unique",
+	}, descriptions[2])
+}
+
+func (t *RuleRegistryTestSuite) TestInject_WithoutConfig() {
+	registry := &RuleRegistryImpl{}
+	registry.Inject([]domain.FieldValidator{t.plainValidator}, nil)
+
+	t.Equal([]domain.RuleDescription{
+		{
+			Name: "plain",
+		},
+	}, registry.Descriptions())
+}
@@ -0,0 +1,85 @@
+package application
+
+import (
+	"net/url"
+	"strings"
+
+	"flamingo.me/form/domain"
+)
+
+// applyDefaults returns copy of query values, with default values of all form fields which are not submitted
+func (p *bindingPlan) applyDefaults(query url.Values) url.Values {
+	values := make(url.Values, len(query)+len(p.fieldDefaults))
+	for key, value := range query {
+		values[key] = value
+	}
+
+	for name, defaultValue := range p.fieldDefaults {
+		if defaultValue != "" && !isSubmittedField(query, name) {
+			values.Set(name, defaultValue)
+		}
+	}
+
+	return values
+}
+
+// canonicalValues returns query values of known form fields, without empty values, values equal to defaults and
+// values of invalid fields. For form data which is not struct, all fields are known.
+func (p *bindingPlan) canonicalValues(query url.Values, form *domain.Form) url.Values {
+	canonical := url.Values{}
+
+	for key, value := range query {
+		name := fieldNameOfKey(key)
+
+		defaultValue, known := p.fieldDefaults[name]
+		if p.typeOf != nil && !known {
+			continue
+		}
+
+		if form.HasErrorForField(name) || form.HasErrorForField(key) {
+			continue
+		}
+
+		values := make([]string, 0, len(value))
+		for _, v := range value {
+			if v != "" {
+				values = append(values, v)
+			}
+		}
+
+		if len(values) == 0 || (len(values) == 1 && values[0] == defaultValue) {
+			continue
+		}
+
+		canonical[key] = values
+	}
+
+	return canonical
+}
+
+// isSubmittedField checks if there is any non empty value submitted for form field,
+// including indexed values of slices and maps (like "tags[0]")
+func isSubmittedField(query url.Values, name string) bool {
+	for key, value := range query {
+		if fieldNameOfKey(key) != name {
+			continue
+		}
+
+		for _, v := range value {
+			if v != "" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// fieldNameOfKey returns name of form field from query key, by removing index of slices and maps
+func fieldNameOfKey(key string) string {
+	if index := strings.Index(key, "["); index > 0 {
+		return key[:index]
+	}
+
+	return key
+}
@@ -0,0 +1,121 @@
+package application
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	SearchFormTestSuite struct {
+		suite.Suite
+
+		plan *bindingPlan
+	}
+
+	searchFormTestData struct {
+		Query  string   `form:"q"`
+		Sort   string   `form:"sort" default:"relevance"`
+		Page   int      `form:"page" default:"1"`
+		Tags   []string `form:"tags"`
+		Price  searchFormTestRange
+		Since  time.Time `form:"since"`
+		hidden string
+	}
+
+	searchFormTestRange struct {
+		Min int `form:"min" default:"0"`
+		Max int `form:"max"`
+	}
+)
+
+func TestSearchFormTestSuite(t *testing.T) {
+	suite.Run(t, &SearchFormTestSuite{})
+}
+
+func (t *SearchFormTestSuite) SetupTest() {
+	t.plan = loadBindingPlan(reflect.TypeOf(searchFormTestData{}))
+}
+
+func (t *SearchFormTestSuite) TestFieldDefaults() {
+	t.Equal(map[string]string{
+		"q":         "",
+		"sort":      "relevance",
+		"page":      "1",
+		"tags":      "",
+		"Price.min": "0",
+		"Price.max": "",
+		"since":     "",
+	}, t.plan.fieldDefaults)
+}
+
+func (t *SearchFormTestSuite) TestApplyDefaults() {
+	query := url.Values{
+		"q":       []string{"shoes"},
+		"page":    []string{""},
+		"tags[0]": []string{"red"},
+	}
+
+	t.Equal(url.Values{
+		"q":         []string{"shoes"},
+		"sort":      []string{"relevance"},
+		"page":      []string{"1"},
+		"tags[0]":   []string{"red"},
+		"Price.min": []string{"0"},
+	}, t.plan.applyDefaults(query))
+
+	t.Equal(url.Values{
+		"q":       []string{"shoes"},
+		"page":    []string{""},
+		"tags[0]": []string{"red"},
+	}, query)
+}
+
+func (t *SearchFormTestSuite) TestApplyDefaults_NotStruct() {
+	t.Equal(url.Values{
+		"q": []string{"shoes"},
+	}, emptyBindingPlan.applyDefaults(url.Values{
+		"q": []string{"shoes"},
+	}))
+}
+
+func (t *SearchFormTestSuite) TestCanonicalValues() {
+	form := domain.NewForm(true, nil)
+	form.ValidationInfo.AddFieldError("Price.max", "formError.Price.max.numeric", "numeric")
+
+	t.Equal(url.Values{
+		"q":         []string{"shoes"},
+		"page":      []string{"2"},
+		"tags[0]":   []string{"red"},
+		"tags[1]":   []string{"blue"},
+		"Price.min": []string{"10"},
+	}, t.plan.canonicalValues(url.Values{
+		"q":         []string{"shoes"},
+		"sort":      []string{"relevance"},
+		"page":      []string{"2"},
+		"tags[0]":   []string{"red"},
+		"tags[1]":   []string{"blue"},
+		"Price.min": []string{"10"},
+		"Price.max": []string{"x"},
+		"since":     []string{""},
+		"utm":       []string{"campaign"},
+	}, &form))
+}
+
+func (t *SearchFormTestSuite) TestCanonicalValues_NotStruct() {
+	form := domain.NewForm(true, nil)
+
+	t.Equal(url.Values{
+		"q":    []string{"shoes"},
+		"tags": []string{"red", "blue"},
+	}, emptyBindingPlan.canonicalValues(url.Values{
+		"q":     []string{"shoes"},
+		"tags":  []string{"red", "", "blue"},
+		"empty": []string{""},
+	}, &form))
+}
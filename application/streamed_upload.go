@@ -0,0 +1,70 @@
+package application
+
+import (
+	"io"
+	"io/ioutil"
+	"net/url"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+// getStreamedValues as method for extracting values of multipart body, without buffering its files. Values are read
+// until the first file part, which is stored into the request as streamed file, so it's bound to form data by decoder
+// and read directly from the body while form is handled. Parts after the first file part are not read.
+func (h *formHandlerImpl) getStreamedValues(r *web.Request) (*url.Values, error) {
+	reader, err := r.Request().MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	if r.Request().URL != nil {
+		values = r.Request().URL.Query()
+	}
+
+	// values of parts, which are not files, are kept in memory up to the same limit as for buffered multipart body
+	remaining := h.multipartMaxMemory()
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if part.FileName() != "" {
+			domain.StoreStreamedFiles(r, map[string][]*domain.File{
+				part.FormName(): {domain.NewStreamedFile(part)},
+			})
+			break
+		}
+
+		value, err := ioutil.ReadAll(io.LimitReader(part, remaining+1))
+		if err != nil {
+			return nil, err
+		}
+
+		remaining -= int64(len(value))
+		if remaining < 0 {
+			return nil, domain.NewFormError("values of multipart body are too large")
+		}
+
+		values.Add(part.FormName(), string(value))
+	}
+
+	return &values, nil
+}
+
+// closeStreamedFiles as method for closing streamed files of the request after form is handled, so rest of their
+// content, which is not read while form is handled, is discarded
+func (h *formHandlerImpl) closeStreamedFiles(req *web.Request) {
+	for _, files := range domain.StreamedFilesFromRequest(req) {
+		for _, file := range files {
+			if err := file.Close(); err != nil {
+				h.logError(req, "streamedUpload", err)
+			}
+		}
+	}
+}
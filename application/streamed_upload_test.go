@@ -0,0 +1,88 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	StreamedUploadTestSuite struct {
+		suite.Suite
+
+		handler *formHandlerImpl
+	}
+)
+
+func TestStreamedUploadTestSuite(t *testing.T) {
+	suite.Run(t, &StreamedUploadTestSuite{})
+}
+
+func (t *StreamedUploadTestSuite) SetupTest() {
+	t.handler = &formHandlerImpl{
+		logger:          &flamingo.NullLogger{},
+		streamedUploads: true,
+	}
+}
+
+func (t *StreamedUploadTestSuite) request() *web.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	t.NoError(writer.WriteField("title", "holiday"))
+	part, err := writer.CreateFormFile("video", "video.mp4")
+	t.NoError(err)
+	_, err = part.Write([]byte("content"))
+	t.NoError(err)
+	t.NoError(writer.WriteField("description", "after the file"))
+	t.NoError(writer.Close())
+
+	return web.CreateRequest(&http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{RawQuery: "page=1"},
+		Header: http.Header{"Content-Type": []string{writer.FormDataContentType()}},
+		Body:   ioutil.NopCloser(body),
+	}, nil)
+}
+
+func (t *StreamedUploadTestSuite) TestGetURLValues_Streamed() {
+	request := t.request()
+
+	values, err := t.handler.getURLValues(context.Background(), request, http.MethodPost)
+	t.NoError(err)
+	t.Equal(&url.Values{
+		"page":  []string{"1"},
+		"title": []string{"holiday"},
+	}, values)
+	t.Nil(request.Request().MultipartForm)
+
+	files := domain.StreamedFilesFromRequest(request)
+	t.Len(files["video"], 1)
+	t.True(files["video"][0].IsStreamed())
+
+	reader, err := files["video"][0].Open()
+	t.NoError(err)
+	content, err := ioutil.ReadAll(reader)
+	t.NoError(err)
+	t.Equal([]byte("content"), content)
+
+	t.handler.closeStreamedFiles(request)
+}
+
+func (t *StreamedUploadTestSuite) TestGetURLValues_NotMultipart() {
+	request := t.request()
+	request.Request().Header.Set("Content-Type", "text/plain")
+
+	values, err := t.handler.getStreamedValues(request)
+	t.Error(err)
+	t.Nil(values)
+}
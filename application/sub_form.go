@@ -0,0 +1,338 @@
+package application
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// subFormBinding defines sub form embedded into form data of parent form, as field named by prefix
+	subFormBinding struct {
+		prefix  string
+		subForm domain.SubForm
+	}
+
+	// subFormDataProvider wraps form data provider of parent form, so fields of sub forms, which are not provided by
+	// parent form, are provided by sub forms
+	subFormDataProvider struct {
+		formDataProvider domain.FormDataProvider
+		subForms         []subFormBinding
+	}
+
+	// subFormDataDecoder wraps form data decoder of parent form, so fields of sub forms are decoded by sub forms,
+	// from values of their prefixes
+	subFormDataDecoder struct {
+		formDataDecoder domain.FormDataDecoder
+		subForms        []subFormBinding
+	}
+
+	// subFormDataValidator validates field of sub form by validator of sub form, with prefixed field errors
+	subFormDataValidator struct {
+		prefix            string
+		formDataValidator domain.FormDataValidator
+	}
+
+	// prefixedFormExtension wraps form extension of sub form, so it receives values of its prefix only,
+	// and its field errors are prefixed. Provider, decoder and validator which are not implemented by form extension
+	// are replaced by default ones, same as for any other form extension.
+	prefixedFormExtension struct {
+		prefix            string
+		formExtension     domain.FormExtension
+		formDataProvider  domain.FormDataProvider
+		formDataDecoder   domain.FormDataDecoder
+		formDataValidator domain.FormDataValidator
+	}
+)
+
+var (
+	_ domain.FormDataProvider             = &subFormDataProvider{}
+	_ domain.UserSpecificFormDataProvider = &subFormDataProvider{}
+	_ domain.FormDataDecoder              = &subFormDataDecoder{}
+	_ domain.FormDataValidator            = &subFormDataValidator{}
+	_ domain.FormDataProvider             = &prefixedFormExtension{}
+	_ domain.FormDataDecoder              = &prefixedFormExtension{}
+	_ domain.FormDataValidator            = &prefixedFormExtension{}
+	_ domain.FormValidityGate             = &prefixedFormExtension{}
+	_ domain.FormResultObserver           = &prefixedFormExtension{}
+	_ domain.ReadOnlyFormExtension        = &prefixedFormExtension{}
+	_ domain.PrioritizedFormExtension     = &prefixedFormExtension{}
+	_ domain.UserSpecificFormDataProvider = &prefixedFormExtension{}
+)
+
+// GetFormData provides form data of parent form, with fields of sub forms provided by sub forms,
+// if they are not provided by parent form
+func (p *subFormDataProvider) GetFormData(ctx context.Context, req *web.Request) (interface{}, error) {
+	formData, err := p.formDataProvider.GetFormData(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, binding := range p.subForms {
+		provider, ok := binding.subForm.(domain.FormDataProvider)
+		if !ok {
+			continue
+		}
+
+		formData, err = modifySubFormField(formData, binding.prefix, func(field reflect.Value) error {
+			if !field.IsZero() {
+				return nil
+			}
+
+			subFormData, err := provider.GetFormData(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			return setSubFormField(field, binding.prefix, subFormData)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return formData, nil
+}
+
+// IsUserSpecific returns true if form data provider of parent form or any of sub forms provides user specific form data
+func (p *subFormDataProvider) IsUserSpecific() bool {
+	if isUserSpecific(p.formDataProvider) {
+		return true
+	}
+
+	for _, binding := range p.subForms {
+		if isUserSpecific(binding.subForm) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Decode decodes values into form data of parent form, and values of sub forms into their fields by sub forms
+func (d *subFormDataDecoder) Decode(ctx context.Context, req *web.Request, values url.Values, formData interface{}) (interface{}, error) {
+	formData, err := d.formDataDecoder.Decode(ctx, req, values, formData)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, binding := range d.subForms {
+		decoder, ok := binding.subForm.(domain.FormDataDecoder)
+		if !ok {
+			continue
+		}
+
+		formData, err = modifySubFormField(formData, binding.prefix, func(field reflect.Value) error {
+			subFormData, err := decoder.Decode(ctx, req, subFormValues(values, binding.prefix), field.Interface())
+			if err != nil {
+				return err
+			}
+
+			return setSubFormField(field, binding.prefix, subFormData)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return formData, nil
+}
+
+// Validate validates field of sub form by validator of sub form. Field errors are prefixed by path of the field,
+// same as field errors of nested structs reported by default validator.
+func (v *subFormDataValidator) Validate(ctx context.Context, req *web.Request, validatorProvider domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	field, path, err := subFormField(reflect.ValueOf(formData), v.prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	if field.Kind() == reflect.Ptr && field.IsNil() {
+		return nil, nil
+	}
+
+	validationInfo, err := v.formDataValidator.Validate(ctx, req, validatorProvider, field.Interface())
+	if err != nil || validationInfo == nil {
+		return validationInfo, err
+	}
+
+	return prefixValidationInfo(validationInfo, path), nil
+}
+
+// GetFormData provides form data of form extension
+func (e *prefixedFormExtension) GetFormData(ctx context.Context, req *web.Request) (interface{}, error) {
+	return e.formDataProvider.GetFormData(ctx, req)
+}
+
+// Decode decodes values of the prefix into form data of form extension
+func (e *prefixedFormExtension) Decode(ctx context.Context, req *web.Request, values url.Values, formData interface{}) (interface{}, error) {
+	return e.formDataDecoder.Decode(ctx, req, subFormValues(values, e.prefix), formData)
+}
+
+// Validate validates form data of form extension, with prefixed field errors
+func (e *prefixedFormExtension) Validate(ctx context.Context, req *web.Request, validatorProvider domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	validationInfo, err := e.formDataValidator.Validate(ctx, req, validatorProvider, formData)
+	if err != nil || validationInfo == nil {
+		return validationInfo, err
+	}
+
+	return prefixValidationInfo(validationInfo, e.prefix), nil
+}
+
+// GateFormValidity asks form extension if form can be treated as valid, if it implements domain.FormValidityGate
+func (e *prefixedFormExtension) GateFormValidity(ctx context.Context, req *web.Request, values url.Values, form *domain.Form) (*domain.Error, error) {
+	gate, ok := e.formExtension.(domain.FormValidityGate)
+	if !ok {
+		return nil, nil
+	}
+
+	return gate.GateFormValidity(ctx, req, subFormValues(values, e.prefix), form)
+}
+
+// ObserveFormResult notifies form extension about final state of the form, if it implements domain.FormResultObserver
+func (e *prefixedFormExtension) ObserveFormResult(ctx context.Context, req *web.Request, values url.Values, form *domain.Form) error {
+	observer, ok := e.formExtension.(domain.FormResultObserver)
+	if !ok {
+		return nil
+	}
+
+	return observer.ObserveFormResult(ctx, req, subFormValues(values, e.prefix), form)
+}
+
+// IsReadOnly returns true if form extension implements domain.ReadOnlyFormExtension and causes no side effects
+func (e *prefixedFormExtension) IsReadOnly() bool {
+	return isReadOnly(e.formExtension)
+}
+
+// Priority returns priority of form extension, if it implements domain.PrioritizedFormExtension
+func (e *prefixedFormExtension) Priority() int {
+	return extensionPriority(e.formExtension)
+}
+
+// IsUserSpecific returns true if form extension implements domain.UserSpecificFormDataProvider and provides
+// user specific form data
+func (e *prefixedFormExtension) IsUserSpecific() bool {
+	return isUserSpecific(e.formExtension)
+}
+
+// subFormValues returns values with names starting by the prefix, without the prefix
+func subFormValues(values url.Values, prefix string) url.Values {
+	subValues := url.Values{}
+	for key, list := range values {
+		if strings.HasPrefix(key, prefix+".") {
+			subValues[strings.TrimPrefix(key, prefix+".")] = list
+		}
+	}
+
+	return subValues
+}
+
+// prefixValidationInfo returns validation info with field names and message keys of field errors prefixed
+// by the path. General errors are kept as they are.
+func prefixValidationInfo(validationInfo *domain.ValidationInfo, path string) *domain.ValidationInfo {
+	prefixed := &domain.ValidationInfo{}
+	prefixed.AppendGeneralErrors(validationInfo.GetGeneralErrors())
+
+	for fieldName, errs := range validationInfo.GetErrorsForAllFields() {
+		for _, err := range errs {
+			messageKey := err.MessageKey
+			if strings.HasPrefix(messageKey, "formError.") {
+				messageKey = "formError." + path + "." + strings.TrimPrefix(messageKey, "formError.")
+			}
+			prefixed.AddFieldErrorWithParams(path+"."+fieldName, messageKey, err.DefaultLabel, err.Params)
+		}
+	}
+
+	if validationInfo.IsValidationStopped() {
+		prefixed.StopValidation()
+	}
+
+	return prefixed
+}
+
+// modifySubFormField modifies field of the prefix of struct form data. Form data passed as value is copied,
+// while form data passed as pointer is modified in place.
+func modifySubFormField(formData interface{}, prefix string, modify func(field reflect.Value) error) (interface{}, error) {
+	valueOf := reflect.ValueOf(formData)
+	if valueOf.Kind() != reflect.Ptr {
+		copied := reflect.New(valueOf.Type()).Elem()
+		copied.Set(valueOf)
+		valueOf = copied
+	}
+
+	field, _, err := subFormField(valueOf, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := modify(field); err != nil {
+		return nil, err
+	}
+
+	return valueOf.Interface(), nil
+}
+
+// subFormField returns field of struct value by the prefix, which is path of form field names separated by ".",
+// together with path of the field used for its field errors. It returns error if there is no such field.
+func subFormField(valueOf reflect.Value, prefix string) (reflect.Value, string, error) {
+	var path []string
+
+	for _, name := range strings.Split(prefix, ".") {
+		if valueOf.Kind() == reflect.Ptr {
+			if valueOf.IsNil() {
+				return reflect.Value{}, "", domain.NewFormErrorf("sub form %q is embedded into nil pointer", prefix)
+			}
+			valueOf = valueOf.Elem()
+		}
+
+		if valueOf.Kind() != reflect.Struct {
+			return reflect.Value{}, "", domain.NewFormErrorf("there is no field for sub form %q", prefix)
+		}
+
+		found := false
+		for i := 0; i < valueOf.NumField(); i++ {
+			fieldType := valueOf.Type().Field(i)
+			if fieldType.PkgPath != "" || formFieldName(valueOf.Type(), fieldType.Name) != name {
+				continue
+			}
+
+			valueOf = valueOf.Field(i)
+			path = append(path, strings.ToLower(fieldType.Name[0:1])+fieldType.Name[1:])
+			found = true
+			break
+		}
+
+		if !found {
+			return reflect.Value{}, "", domain.NewFormErrorf("there is no field for sub form %q", prefix)
+		}
+	}
+
+	return valueOf, strings.Join(path, "."), nil
+}
+
+// setSubFormField sets form data of sub form into its field. Form data passed as value is set into pointer field
+// and vice versa.
+func setSubFormField(field reflect.Value, prefix string, subFormData interface{}) error {
+	valueOf := reflect.ValueOf(subFormData)
+	if !valueOf.IsValid() {
+		return nil
+	}
+
+	switch {
+	case valueOf.Type().AssignableTo(field.Type()):
+		field.Set(valueOf)
+	case field.Kind() == reflect.Ptr && valueOf.Type().AssignableTo(field.Type().Elem()):
+		pointer := reflect.New(field.Type().Elem())
+		pointer.Elem().Set(valueOf)
+		field.Set(pointer)
+	case valueOf.Kind() == reflect.Ptr && !valueOf.IsNil() && valueOf.Elem().Type().AssignableTo(field.Type()):
+		field.Set(valueOf.Elem())
+	default:
+		return domain.NewFormErrorf("form data %T of sub form %q can't be set into field of type %s", subFormData, prefix, field.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,310 @@
+package application
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	SubFormTestSuite struct {
+		suite.Suite
+
+		subForm *subFormTestAddressForm
+
+		context context.Context
+		request *web.Request
+	}
+
+	subFormTestAddress struct {
+		Street string `form:"street"`
+		City   string `form:"city"`
+	}
+
+	subFormTestContact struct {
+		Address subFormTestAddress `form:"address"`
+	}
+
+	subFormTestData struct {
+		Name     string              `form:"name"`
+		Billing  subFormTestAddress  `form:"billing"`
+		Shipping *subFormTestAddress `form:"shipping"`
+		Contact  subFormTestContact  `form:"contact"`
+	}
+
+	subFormTestAddressForm struct{}
+
+	subFormTestExtension struct {
+		values url.Values
+	}
+)
+
+var (
+	_ domain.SubForm           = &subFormTestAddressForm{}
+	_ domain.FormDataProvider  = &subFormTestAddressForm{}
+	_ domain.FormDataDecoder   = &subFormTestAddressForm{}
+	_ domain.FormDataValidator = &subFormTestAddressForm{}
+)
+
+func TestSubFormTestSuite(t *testing.T) {
+	suite.Run(t, &SubFormTestSuite{})
+}
+
+func (s *subFormTestAddressForm) SubFormExtensions() []string {
+	return nil
+}
+
+func (s *subFormTestAddressForm) GetFormData(context.Context, *web.Request) (interface{}, error) {
+	return subFormTestAddress{City: "Berlin"}, nil
+}
+
+func (s *subFormTestAddressForm) Decode(_ context.Context, _ *web.Request, values url.Values, formData interface{}) (interface{}, error) {
+	address, _ := formData.(subFormTestAddress)
+	address.Street = strings.ToUpper(values.Get("street"))
+	if city := values.Get("city"); city != "" {
+		address.City = city
+	}
+
+	return address, nil
+}
+
+func (s *subFormTestAddressForm) Validate(_ context.Context, _ *web.Request, _ domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	validationInfo := &domain.ValidationInfo{}
+	if formData.(subFormTestAddress).Street == "" {
+		validationInfo.AddFieldError("street", "formError.street.required", "street is required")
+	}
+
+	return validationInfo, nil
+}
+
+func (e *subFormTestExtension) Decode(_ context.Context, _ *web.Request, values url.Values, _ interface{}) (interface{}, error) {
+	e.values = values
+
+	return values.Get("code"), nil
+}
+
+func (e *subFormTestExtension) Validate(context.Context, *web.Request, domain.ValidatorProvider, interface{}) (*domain.ValidationInfo, error) {
+	validationInfo := &domain.ValidationInfo{}
+	validationInfo.AddFieldError("code", "formError.code.required", "code is required")
+	validationInfo.AddGeneralError("formError.general", "general error")
+
+	return validationInfo, nil
+}
+
+func (t *SubFormTestSuite) SetupTest() {
+	t.subForm = &subFormTestAddressForm{}
+
+	t.context = context.Background()
+	t.request = web.CreateRequest(nil, nil)
+}
+
+func (t *SubFormTestSuite) TestSubFormDataProvider_GetFormData() {
+	parentProvider := &mocks.FormDataProvider{}
+	parentProvider.On("GetFormData", t.context, t.request).Return(subFormTestData{
+		Shipping: &subFormTestAddress{City: "Munich"},
+	}, nil).Once()
+
+	provider := &subFormDataProvider{
+		formDataProvider: parentProvider,
+		subForms: []subFormBinding{
+			{prefix: "billing", subForm: t.subForm},
+			{prefix: "shipping", subForm: t.subForm},
+		},
+	}
+
+	formData, err := provider.GetFormData(t.context, t.request)
+	t.NoError(err)
+	t.Equal(subFormTestData{
+		Billing:  subFormTestAddress{City: "Berlin"},
+		Shipping: &subFormTestAddress{City: "Munich"},
+	}, formData)
+
+	parentProvider.AssertExpectations(t.T())
+}
+
+func (t *SubFormTestSuite) TestSubFormDataProvider_GetFormDataMissingField() {
+	parentProvider := &mocks.FormDataProvider{}
+	parentProvider.On("GetFormData", t.context, t.request).Return(subFormTestData{}, nil).Once()
+
+	provider := &subFormDataProvider{
+		formDataProvider: parentProvider,
+		subForms: []subFormBinding{
+			{prefix: "invoice", subForm: t.subForm},
+		},
+	}
+
+	formData, err := provider.GetFormData(t.context, t.request)
+	t.Error(err)
+	t.Nil(formData)
+
+	parentProvider.AssertExpectations(t.T())
+}
+
+func (t *SubFormTestSuite) TestSubFormDataProvider_IsUserSpecific() {
+	provider := &subFormDataProvider{
+		formDataProvider: &mocks.FormDataProvider{},
+		subForms: []subFormBinding{
+			{prefix: "billing", subForm: t.subForm},
+		},
+	}
+	t.False(provider.IsUserSpecific())
+
+	provider.formDataProvider = &cacheHintTestProvider{userSpecific: true}
+	t.True(provider.IsUserSpecific())
+}
+
+func (t *SubFormTestSuite) TestSubFormDataDecoder_Decode() {
+	values := url.Values{
+		"name":                   []string{"John"},
+		"billing.street":         []string{"main street"},
+		"billing.city":           []string{"Hamburg"},
+		"contact.address.street": []string{"side street"},
+	}
+
+	parentDecoder := &mocks.FormDataDecoder{}
+	parentDecoder.On("Decode", t.context, t.request, values, &subFormTestData{}).Return(&subFormTestData{
+		Name: "John",
+	}, nil).Once()
+
+	decoder := &subFormDataDecoder{
+		formDataDecoder: parentDecoder,
+		subForms: []subFormBinding{
+			{prefix: "billing", subForm: t.subForm},
+			{prefix: "contact.address", subForm: t.subForm},
+		},
+	}
+
+	formData, err := decoder.Decode(t.context, t.request, values, &subFormTestData{})
+	t.NoError(err)
+	t.Equal(&subFormTestData{
+		Name:    "John",
+		Billing: subFormTestAddress{Street: "MAIN STREET", City: "Hamburg"},
+		Contact: subFormTestContact{
+			Address: subFormTestAddress{Street: "SIDE STREET"},
+		},
+	}, formData)
+
+	parentDecoder.AssertExpectations(t.T())
+}
+
+func (t *SubFormTestSuite) TestSubFormDataValidator_Validate() {
+	validator := &subFormDataValidator{
+		prefix:            "contact.address",
+		formDataValidator: t.subForm,
+	}
+
+	validationInfo, err := validator.Validate(t.context, t.request, nil, subFormTestData{})
+	t.NoError(err)
+	t.Equal(map[string][]domain.Error{
+		"contact.address.street": {
+			{
+				MessageKey:   "formError.contact.address.street.required",
+				DefaultLabel: "street is required",
+			},
+		},
+	}, validationInfo.GetErrorsForAllFields())
+}
+
+func (t *SubFormTestSuite) TestSubFormDataValidator_ValidateNilPointer() {
+	validator := &subFormDataValidator{
+		prefix:            "shipping",
+		formDataValidator: t.subForm,
+	}
+
+	validationInfo, err := validator.Validate(t.context, t.request, nil, subFormTestData{})
+	t.NoError(err)
+	t.Nil(validationInfo)
+}
+
+func (t *SubFormTestSuite) TestPrefixedFormExtension() {
+	extension := &subFormTestExtension{}
+	prefixed := &prefixedFormExtension{
+		prefix:            "billing",
+		formExtension:     extension,
+		formDataDecoder:   extension,
+		formDataValidator: extension,
+	}
+
+	formData, err := prefixed.Decode(t.context, t.request, url.Values{
+		"code":         []string{"parent"},
+		"billing.code": []string{"sub"},
+	}, nil)
+	t.NoError(err)
+	t.Equal("sub", formData)
+	t.Equal(url.Values{"code": []string{"sub"}}, extension.values)
+
+	validationInfo, err := prefixed.Validate(t.context, t.request, nil, formData)
+	t.NoError(err)
+	t.Equal([]domain.Error{
+		{
+			MessageKey:   "formError.billing.code.required",
+			DefaultLabel: "code is required",
+		},
+	}, validationInfo.GetErrorsForField("billing.code"))
+	t.Equal([]domain.Error{
+		{
+			MessageKey:   "formError.general",
+			DefaultLabel: "general error",
+		},
+	}, validationInfo.GetGeneralErrors())
+
+	gateError, err := prefixed.GateFormValidity(t.context, t.request, nil, nil)
+	t.NoError(err)
+	t.Nil(gateError)
+	t.NoError(prefixed.ObserveFormResult(t.context, t.request, nil, nil))
+	t.False(prefixed.IsReadOnly())
+	t.Equal(0, prefixed.Priority())
+	t.False(prefixed.IsUserSpecific())
+}
+
+func (t *SubFormTestSuite) TestSubFormValues() {
+	t.Equal(url.Values{
+		"street": []string{"main street"},
+		"city":   []string{"Berlin"},
+	}, subFormValues(url.Values{
+		"name":           []string{"John"},
+		"billing.street": []string{"main street"},
+		"billing.city":   []string{"Berlin"},
+		"billingcity":    []string{"Munich"},
+	}, "billing"))
+}
+
+func (t *SubFormTestSuite) TestSubFormField() {
+	formData := subFormTestData{}
+
+	field, path, err := subFormField(reflect.ValueOf(&formData), "contact.address")
+	t.NoError(err)
+	t.Equal("contact.address", path)
+	t.Equal(reflect.TypeOf(subFormTestAddress{}), field.Type())
+
+	_, _, err = subFormField(reflect.ValueOf(&formData), "contact.phone")
+	t.Error(err)
+
+	_, _, err = subFormField(reflect.ValueOf(&formData), "name.street")
+	t.Error(err)
+
+	_, _, err = subFormField(reflect.ValueOf(&formData), "shipping.street")
+	t.Error(err)
+}
+
+func (t *SubFormTestSuite) TestSetSubFormField() {
+	formData := subFormTestData{}
+	valueOf := reflect.ValueOf(&formData).Elem()
+
+	t.NoError(setSubFormField(valueOf.FieldByName("Billing"), "billing", &subFormTestAddress{City: "Berlin"}))
+	t.NoError(setSubFormField(valueOf.FieldByName("Shipping"), "shipping", subFormTestAddress{City: "Munich"}))
+	t.NoError(setSubFormField(valueOf.FieldByName("Name"), "name", nil))
+	t.Error(setSubFormField(valueOf.FieldByName("Name"), "name", 42))
+
+	t.Equal(subFormTestData{
+		Billing:  subFormTestAddress{City: "Berlin"},
+		Shipping: &subFormTestAddress{City: "Munich"},
+	}, formData)
+}
@@ -0,0 +1,55 @@
+package application
+
+import (
+	"net/http"
+	"strings"
+
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+// defaultSubmissionMethods methods of requests treated as submissions, if there are no methods set for the handler
+var defaultSubmissionMethods = []string{http.MethodPost}
+
+// normalizeSubmissionMethods returns methods in upper case, without duplicates
+func normalizeSubmissionMethods(methods []string) []string {
+	normalized := make([]string, 0, len(methods))
+	for _, method := range methods {
+		method = strings.ToUpper(method)
+		if !containsMethod(normalized, method) {
+			normalized = append(normalized, method)
+		}
+	}
+
+	return normalized
+}
+
+// containsMethod checks if method is one of the methods
+func containsMethod(methods []string, method string) bool {
+	for _, candidate := range methods {
+		if candidate == method {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSubmission as method for checking if method of the request is one of submission methods of the handler
+func (h *formHandlerImpl) isSubmission(req *web.Request) bool {
+	methods := h.submissionMethods
+	if len(methods) == 0 {
+		methods = defaultSubmissionMethods
+	}
+
+	return containsMethod(methods, req.Request().Method)
+}
+
+// submissionMethod as method for returning method, by which submitted values are read from the request. Requests with
+// submission methods of the handler are read by their method, all other ones are read as POST request.
+func (h *formHandlerImpl) submissionMethod(req *web.Request) string {
+	if req.Request().Method != http.MethodGet && h.isSubmission(req) {
+		return req.Request().Method
+	}
+
+	return http.MethodPost
+}
@@ -0,0 +1,87 @@
+package application
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+type (
+	SubmissionMethodTestSuite struct {
+		suite.Suite
+
+		handler *formHandlerImpl
+	}
+)
+
+func TestSubmissionMethodTestSuite(t *testing.T) {
+	suite.Run(t, &SubmissionMethodTestSuite{})
+}
+
+func (t *SubmissionMethodTestSuite) SetupTest() {
+	t.handler = &formHandlerImpl{}
+}
+
+func (t *SubmissionMethodTestSuite) request(method string, body string) *web.Request {
+	return web.CreateRequest(&http.Request{
+		Method: method,
+		URL:    &url.URL{RawQuery: "id=1"},
+		Header: http.Header{"Content-Type": []string{"application/x-www-form-urlencoded"}},
+		Body:   ioutil.NopCloser(strings.NewReader(body)),
+	}, nil)
+}
+
+func (t *SubmissionMethodTestSuite) TestNormalizeSubmissionMethods() {
+	t.Equal([]string{http.MethodPut, http.MethodPatch}, normalizeSubmissionMethods([]string{"put", "PATCH", "Put"}))
+	t.Equal([]string{}, normalizeSubmissionMethods(nil))
+}
+
+func (t *SubmissionMethodTestSuite) TestIsSubmission() {
+	t.True(t.handler.isSubmission(t.request(http.MethodPost, "")))
+	t.False(t.handler.isSubmission(t.request(http.MethodPut, "")))
+	t.False(t.handler.isSubmission(t.request(http.MethodGet, "")))
+
+	t.handler.submissionMethods = []string{http.MethodPut, http.MethodDelete}
+	t.False(t.handler.isSubmission(t.request(http.MethodPost, "")))
+	t.True(t.handler.isSubmission(t.request(http.MethodPut, "")))
+	t.True(t.handler.isSubmission(t.request(http.MethodDelete, "")))
+}
+
+func (t *SubmissionMethodTestSuite) TestSubmissionMethod() {
+	t.handler.submissionMethods = []string{http.MethodPost, http.MethodPatch, http.MethodGet}
+
+	t.Equal(http.MethodPatch, t.handler.submissionMethod(t.request(http.MethodPatch, "")))
+	t.Equal(http.MethodPost, t.handler.submissionMethod(t.request(http.MethodPut, "")))
+	t.Equal(http.MethodPost, t.handler.submissionMethod(t.request(http.MethodGet, "")))
+}
+
+func (t *SubmissionMethodTestSuite) TestGetURLValues_Delete() {
+	t.handler.submissionMethods = []string{http.MethodDelete}
+	request := t.request(http.MethodDelete, "reason=duplicate")
+
+	values, err := t.handler.getURLValues(context.Background(), request, t.handler.submissionMethod(request))
+	t.NoError(err)
+	t.Equal(&url.Values{
+		"id":     []string{"1"},
+		"reason": []string{"duplicate"},
+	}, values)
+}
+
+func (t *SubmissionMethodTestSuite) TestGetURLValues_Put() {
+	t.handler.submissionMethods = []string{http.MethodPut}
+	request := t.request(http.MethodPut, "name=changed")
+
+	values, err := t.handler.getURLValues(context.Background(), request, t.handler.submissionMethod(request))
+	t.NoError(err)
+	t.Equal(&url.Values{
+		"id":   []string{"1"},
+		"name": []string{"changed"},
+	}, values)
+}
@@ -0,0 +1,36 @@
+package application
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+// runSuccessPipeline as method for executing all success steps of valid submitted form, in order they are added.
+// If one of steps fails, already executed steps are compensated in reverse order, and error of failed step is returned.
+func (h *formHandlerImpl) runSuccessPipeline(ctx context.Context, req *web.Request, form *domain.Form) error {
+	if len(h.successSteps) == 0 || !form.IsValidAndSubmitted() {
+		return nil
+	}
+
+	for i, step := range h.successSteps {
+		err := step.Execute(ctx, req, form)
+		if err != nil {
+			h.compensateSuccessSteps(ctx, req, form, h.successSteps[:i])
+			return err
+		}
+	}
+
+	return nil
+}
+
+// compensateSuccessSteps as method for reverting effects of executed success steps in reverse order.
+// Errors of compensation are only logged, so all executed steps get the chance to revert their effects.
+func (h *formHandlerImpl) compensateSuccessSteps(ctx context.Context, req *web.Request, form *domain.Form, executed []domain.SuccessStep) {
+	for i := len(executed) - 1; i >= 0; i-- {
+		if err := executed[i].Compensate(ctx, req, form); err != nil {
+			h.logError(req, "successCompensation", err)
+		}
+	}
+}
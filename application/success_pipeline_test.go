@@ -0,0 +1,96 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	SuccessPipelineTestSuite struct {
+		suite.Suite
+
+		handler *formHandlerImpl
+
+		firstStep  *mocks.SuccessStep
+		secondStep *mocks.SuccessStep
+		thirdStep  *mocks.SuccessStep
+
+		context context.Context
+		request *web.Request
+	}
+)
+
+func TestSuccessPipelineTestSuite(t *testing.T) {
+	suite.Run(t, &SuccessPipelineTestSuite{})
+}
+
+func (t *SuccessPipelineTestSuite) SetupSuite() {
+	t.context = context.Background()
+	t.request = web.CreateRequest(&http.Request{}, nil)
+}
+
+func (t *SuccessPipelineTestSuite) SetupTest() {
+	t.firstStep = &mocks.SuccessStep{}
+	t.secondStep = &mocks.SuccessStep{}
+	t.thirdStep = &mocks.SuccessStep{}
+
+	t.handler = &formHandlerImpl{
+		logger:       &flamingo.NullLogger{},
+		successSteps: []domain.SuccessStep{t.firstStep, t.secondStep, t.thirdStep},
+	}
+}
+
+func (t *SuccessPipelineTestSuite) TearDownTest() {
+	t.firstStep.AssertExpectations(t.T())
+	t.secondStep.AssertExpectations(t.T())
+	t.thirdStep.AssertExpectations(t.T())
+}
+
+func (t *SuccessPipelineTestSuite) TestRunSuccessPipeline() {
+	form := domain.NewForm(true, nil)
+
+	t.firstStep.On("Execute", t.context, t.request, &form).Return(nil).Once()
+	t.secondStep.On("Execute", t.context, t.request, &form).Return(nil).Once()
+	t.thirdStep.On("Execute", t.context, t.request, &form).Return(nil).Once()
+
+	t.NoError(t.handler.runSuccessPipeline(t.context, t.request, &form))
+}
+
+func (t *SuccessPipelineTestSuite) TestRunSuccessPipeline_InvalidForm() {
+	form := domain.NewForm(true, nil)
+	form.ValidationInfo.AddGeneralError("error", "error")
+
+	t.NoError(t.handler.runSuccessPipeline(t.context, t.request, &form))
+
+	form = domain.NewForm(false, nil)
+	t.NoError(t.handler.runSuccessPipeline(t.context, t.request, &form))
+}
+
+func (t *SuccessPipelineTestSuite) TestRunSuccessPipeline_Compensation() {
+	form := domain.NewForm(true, nil)
+
+	t.firstStep.On("Execute", t.context, t.request, &form).Return(nil).Once()
+	t.secondStep.On("Execute", t.context, t.request, &form).Return(nil).Once()
+	t.thirdStep.On("Execute", t.context, t.request, &form).Return(errors.New("error")).Once()
+	t.secondStep.On("Compensate", t.context, t.request, &form).Return(errors.New("compensation")).Once()
+	t.firstStep.On("Compensate", t.context, t.request, &form).Return(nil).Once()
+
+	t.Equal(errors.New("error"), t.handler.runSuccessPipeline(t.context, t.request, &form))
+}
+
+func (t *SuccessPipelineTestSuite) TestRunSuccessPipeline_FirstStepError() {
+	form := domain.NewForm(true, nil)
+
+	t.firstStep.On("Execute", t.context, t.request, &form).Return(errors.New("error")).Once()
+
+	t.Equal(errors.New("error"), t.handler.runSuccessPipeline(t.context, t.request, &form))
+}
@@ -0,0 +1,102 @@
+package application
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// tenantFormHandler delegates handling of the form to variant of form handler of the tenant resolved for the request,
+	// or to default variant if there is no variant of the tenant. Resolved tenant is passed to variant via context.
+	tenantFormHandler struct {
+		tenantResolver domain.TenantResolver
+		defaultVariant tenantFormHandlerVariant
+		variants       map[string]tenantFormHandlerVariant
+	}
+
+	// tenantFormHandlerVariant defines form handler of single tenant, together with default labels of error messages,
+	// which override default labels of its validation errors
+	tenantFormHandlerVariant struct {
+		formHandler domain.FormHandler
+		messages    map[string]string
+	}
+)
+
+var _ domain.FormHandler = &tenantFormHandler{}
+
+// HandleUnsubmittedForm returns Form instance which is not submitted, by form handler of the tenant
+func (h *tenantFormHandler) HandleUnsubmittedForm(ctx context.Context, req *web.Request) (*domain.Form, error) {
+	ctx, variant := h.variant(ctx, req)
+	form, err := variant.formHandler.HandleUnsubmittedForm(ctx, req)
+
+	return variant.relabel(form), err
+}
+
+// HandleSubmittedForm returns Form instance which is submitted via POST request, by form handler of the tenant
+func (h *tenantFormHandler) HandleSubmittedForm(ctx context.Context, req *web.Request) (*domain.Form, error) {
+	ctx, variant := h.variant(ctx, req)
+	form, err := variant.formHandler.HandleSubmittedForm(ctx, req)
+
+	return variant.relabel(form), err
+}
+
+// HandleSubmittedFormDryRun returns Form instance which is submitted via POST request, decoded and validated
+// without side effects, by form handler of the tenant
+func (h *tenantFormHandler) HandleSubmittedFormDryRun(ctx context.Context, req *web.Request) (*domain.Form, error) {
+	ctx, variant := h.variant(ctx, req)
+	form, err := variant.formHandler.HandleSubmittedFormDryRun(ctx, req)
+
+	return variant.relabel(form), err
+}
+
+// HandleSubmittedGETForm returns Form instance which is submitted via GET request, by form handler of the tenant
+func (h *tenantFormHandler) HandleSubmittedGETForm(ctx context.Context, req *web.Request) (*domain.Form, error) {
+	ctx, variant := h.variant(ctx, req)
+	form, err := variant.formHandler.HandleSubmittedGETForm(ctx, req)
+
+	return variant.relabel(form), err
+}
+
+// HandleForm returns Form instance with state depending on fact if there was form submission or not,
+// by form handler of the tenant
+func (h *tenantFormHandler) HandleForm(ctx context.Context, req *web.Request) (*domain.Form, error) {
+	ctx, variant := h.variant(ctx, req)
+	form, err := variant.formHandler.HandleForm(ctx, req)
+
+	return variant.relabel(form), err
+}
+
+// HandleFormResult returns FormResult with explicit state, by form handler of the tenant
+func (h *tenantFormHandler) HandleFormResult(ctx context.Context, req *web.Request) domain.FormResult {
+	ctx, variant := h.variant(ctx, req)
+	result := variant.formHandler.HandleFormResult(ctx, req)
+	variant.relabel(result.Form())
+
+	return result
+}
+
+// variant returns context with resolved tenant, and variant of form handler of the tenant
+func (h *tenantFormHandler) variant(ctx context.Context, req *web.Request) (context.Context, tenantFormHandlerVariant) {
+	tenant := h.tenantResolver.ResolveTenant(ctx, req)
+	if tenant == "" {
+		return ctx, h.defaultVariant
+	}
+
+	ctx = domain.ContextWithTenant(ctx, tenant)
+	if variant, ok := h.variants[tenant]; ok {
+		return ctx, variant
+	}
+
+	return ctx, h.defaultVariant
+}
+
+// relabel overrides default labels of validation errors of the form by messages of the variant
+func (v tenantFormHandlerVariant) relabel(form *domain.Form) *domain.Form {
+	if form != nil && len(v.messages) > 0 {
+		form.ValidationInfo.OverrideDefaultLabels(v.messages)
+	}
+
+	return form
+}
@@ -0,0 +1,143 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	TenantFormHandlerTestSuite struct {
+		suite.Suite
+
+		tenantResolver *mocks.TenantResolver
+		defaultHandler *mocks.FormHandler
+		brandHandler   *mocks.FormHandler
+		handler        *tenantFormHandler
+
+		context context.Context
+		request *web.Request
+	}
+)
+
+func TestTenantFormHandlerTestSuite(t *testing.T) {
+	suite.Run(t, &TenantFormHandlerTestSuite{})
+}
+
+func (t *TenantFormHandlerTestSuite) SetupTest() {
+	t.tenantResolver = &mocks.TenantResolver{}
+	t.defaultHandler = &mocks.FormHandler{}
+	t.brandHandler = &mocks.FormHandler{}
+	t.handler = &tenantFormHandler{
+		tenantResolver: t.tenantResolver,
+		defaultVariant: tenantFormHandlerVariant{
+			formHandler: t.defaultHandler,
+		},
+		variants: map[string]tenantFormHandlerVariant{
+			"brandA": {
+				formHandler: t.brandHandler,
+				messages: map[string]string{
+					"formError.email.required": "Please tell us your email",
+				},
+			},
+		},
+	}
+
+	t.context = context.Background()
+	t.request = web.CreateRequest(&http.Request{}, nil)
+}
+
+func (t *TenantFormHandlerTestSuite) TearDownTest() {
+	t.tenantResolver.AssertExpectations(t.T())
+	t.defaultHandler.AssertExpectations(t.T())
+	t.brandHandler.AssertExpectations(t.T())
+}
+
+func (t *TenantFormHandlerTestSuite) invalidForm() *domain.Form {
+	form := domain.NewForm(true, nil)
+	form.ValidationInfo.AddFieldError("email", "formError.email.required", "email is required")
+
+	return &form
+}
+
+func (t *TenantFormHandlerTestSuite) TestHandleForm_Tenant() {
+	t.tenantResolver.On("ResolveTenant", t.context, t.request).Return("brandA").Once()
+	t.brandHandler.On("HandleForm", domain.ContextWithTenant(t.context, "brandA"), t.request).Return(t.invalidForm(), nil).Once()
+
+	form, err := t.handler.HandleForm(t.context, t.request)
+	t.NoError(err)
+	t.Equal([]domain.Error{
+		{
+			MessageKey:   "formError.email.required",
+			DefaultLabel: "Please tell us your email",
+		},
+	}, form.GetErrorsForField("email"))
+}
+
+func (t *TenantFormHandlerTestSuite) TestHandleForm_UnknownTenant() {
+	t.tenantResolver.On("ResolveTenant", t.context, t.request).Return("brandB").Once()
+	t.defaultHandler.On("HandleForm", domain.ContextWithTenant(t.context, "brandB"), t.request).Return(t.invalidForm(), nil).Once()
+
+	form, err := t.handler.HandleForm(t.context, t.request)
+	t.NoError(err)
+	t.Equal([]domain.Error{
+		{
+			MessageKey:   "formError.email.required",
+			DefaultLabel: "email is required",
+		},
+	}, form.GetErrorsForField("email"))
+}
+
+func (t *TenantFormHandlerTestSuite) TestHandleUnsubmittedForm_DefaultTenant() {
+	form := domain.NewForm(false, nil)
+	t.tenantResolver.On("ResolveTenant", t.context, t.request).Return("").Once()
+	t.defaultHandler.On("HandleUnsubmittedForm", t.context, t.request).Return(&form, nil).Once()
+
+	result, err := t.handler.HandleUnsubmittedForm(t.context, t.request)
+	t.NoError(err)
+	t.Exactly(&form, result)
+}
+
+func (t *TenantFormHandlerTestSuite) TestHandleSubmittedForm_Error() {
+	t.tenantResolver.On("ResolveTenant", t.context, t.request).Return("brandA").Once()
+	t.brandHandler.On("HandleSubmittedForm", mock.Anything, t.request).Return(nil, errors.New("error")).Once()
+
+	form, err := t.handler.HandleSubmittedForm(t.context, t.request)
+	t.Error(err)
+	t.Nil(form)
+}
+
+func (t *TenantFormHandlerTestSuite) TestHandleSubmittedFormDryRun() {
+	t.tenantResolver.On("ResolveTenant", t.context, t.request).Return("brandA").Once()
+	t.brandHandler.On("HandleSubmittedFormDryRun", mock.Anything, t.request).Return(t.invalidForm(), nil).Once()
+
+	form, err := t.handler.HandleSubmittedFormDryRun(t.context, t.request)
+	t.NoError(err)
+	t.Equal("Please tell us your email", form.GetErrorsForField("email")[0].DefaultLabel)
+}
+
+func (t *TenantFormHandlerTestSuite) TestHandleSubmittedGETForm() {
+	t.tenantResolver.On("ResolveTenant", t.context, t.request).Return("brandA").Once()
+	t.brandHandler.On("HandleSubmittedGETForm", mock.Anything, t.request).Return(t.invalidForm(), nil).Once()
+
+	form, err := t.handler.HandleSubmittedGETForm(t.context, t.request)
+	t.NoError(err)
+	t.Equal("Please tell us your email", form.GetErrorsForField("email")[0].DefaultLabel)
+}
+
+func (t *TenantFormHandlerTestSuite) TestHandleFormResult() {
+	t.tenantResolver.On("ResolveTenant", t.context, t.request).Return("brandA").Once()
+	t.brandHandler.On("HandleFormResult", mock.Anything, t.request).Return(domain.NewFormResult(t.invalidForm(), nil)).Once()
+
+	result := t.handler.HandleFormResult(t.context, t.request)
+	t.True(result.IsInvalid())
+	t.Equal("Please tell us your email", result.Form().GetErrorsForField("email")[0].DefaultLabel)
+}
@@ -0,0 +1,68 @@
+//go:build go1.21
+// +build go1.21
+
+package application
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// TypedFormHandler as form handler which returns forms with form data of type T, by wrapping any domain.FormHandler
+	TypedFormHandler[T any] struct {
+		formHandler domain.FormHandler
+	}
+)
+
+// NewTypedFormHandler returns typed form handler, which returns forms of passed form handler with form data of type T.
+// Form data type of passed form handler (like set by FormHandlerBuilder.SetFormDataType) should be T or *T.
+func NewTypedFormHandler[T any](formHandler domain.FormHandler) *TypedFormHandler[T] {
+	return &TypedFormHandler[T]{
+		formHandler: formHandler,
+	}
+}
+
+// FormHandler returns wrapped form handler
+func (h *TypedFormHandler[T]) FormHandler() domain.FormHandler {
+	return h.formHandler
+}
+
+// HandleUnsubmittedForm as method for returning TypedForm instance which is not submitted
+func (h *TypedFormHandler[T]) HandleUnsubmittedForm(ctx context.Context, req *web.Request) (*domain.TypedForm[T], error) {
+	return typedForm[T](h.formHandler.HandleUnsubmittedForm(ctx, req))
+}
+
+// HandleSubmittedForm as method for returning TypedForm instance which is submitted via POST request
+func (h *TypedFormHandler[T]) HandleSubmittedForm(ctx context.Context, req *web.Request) (*domain.TypedForm[T], error) {
+	return typedForm[T](h.formHandler.HandleSubmittedForm(ctx, req))
+}
+
+// HandleSubmittedFormDryRun as method for returning TypedForm instance which is submitted via POST request,
+// decoded and validated without side effects
+func (h *TypedFormHandler[T]) HandleSubmittedFormDryRun(ctx context.Context, req *web.Request) (*domain.TypedForm[T], error) {
+	return typedForm[T](h.formHandler.HandleSubmittedFormDryRun(ctx, req))
+}
+
+// HandleSubmittedGETForm as method for returning TypedForm instance which is submitted via GET request
+func (h *TypedFormHandler[T]) HandleSubmittedGETForm(ctx context.Context, req *web.Request) (*domain.TypedForm[T], error) {
+	return typedForm[T](h.formHandler.HandleSubmittedGETForm(ctx, req))
+}
+
+// HandleForm as method for returning TypedForm instance with state depending on fact if there was form submission or not, via POST request
+func (h *TypedFormHandler[T]) HandleForm(ctx context.Context, req *web.Request) (*domain.TypedForm[T], error) {
+	return typedForm[T](h.formHandler.HandleForm(ctx, req))
+}
+
+// typedForm returns typed form of handled form. Form is returned together with error of form handler,
+// same as by form handler itself.
+func typedForm[T any](form *domain.Form, err error) (*domain.TypedForm[T], error) {
+	typed, typeErr := domain.NewTypedForm[T](form)
+	if typeErr != nil {
+		return nil, typeErr
+	}
+
+	return typed, err
+}
@@ -0,0 +1,105 @@
+//go:build go1.21
+// +build go1.21
+
+package application
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	TypedFormHandlerTestSuite struct {
+		suite.Suite
+
+		formHandler *mocks.FormHandler
+		handler     *TypedFormHandler[typedFormHandlerTestData]
+
+		context context.Context
+		request *web.Request
+	}
+
+	typedFormHandlerTestData struct {
+		Email string
+	}
+)
+
+func TestTypedFormHandlerTestSuite(t *testing.T) {
+	suite.Run(t, &TypedFormHandlerTestSuite{})
+}
+
+func (t *TypedFormHandlerTestSuite) SetupTest() {
+	t.formHandler = &mocks.FormHandler{}
+	t.handler = NewTypedFormHandler[typedFormHandlerTestData](t.formHandler)
+
+	t.context = context.Background()
+	t.request = web.CreateRequest(&http.Request{}, nil)
+}
+
+func (t *TypedFormHandlerTestSuite) TearDownTest() {
+	t.formHandler.AssertExpectations(t.T())
+}
+
+func (t *TypedFormHandlerTestSuite) TestFormHandler() {
+	t.Exactly(t.formHandler, t.handler.FormHandler())
+}
+
+func (t *TypedFormHandlerTestSuite) TestHandleForm() {
+	form := domain.NewForm(true, nil)
+	form.Data = &typedFormHandlerTestData{Email: "user@example.com"}
+	t.formHandler.On("HandleForm", t.context, t.request).Return(&form, nil).Once()
+
+	typed, err := t.handler.HandleForm(t.context, t.request)
+	t.NoError(err)
+	t.Equal(typedFormHandlerTestData{Email: "user@example.com"}, typed.Data)
+	t.True(typed.IsSubmitted())
+}
+
+func (t *TypedFormHandlerTestSuite) TestHandleUnsubmittedForm() {
+	form := domain.NewForm(false, nil)
+	form.Data = typedFormHandlerTestData{Email: "user@example.com"}
+	t.formHandler.On("HandleUnsubmittedForm", t.context, t.request).Return(&form, nil).Once()
+
+	typed, err := t.handler.HandleUnsubmittedForm(t.context, t.request)
+	t.NoError(err)
+	t.Equal(typedFormHandlerTestData{Email: "user@example.com"}, typed.Data)
+	t.False(typed.IsSubmitted())
+}
+
+func (t *TypedFormHandlerTestSuite) TestHandleSubmittedForm_Error() {
+	t.formHandler.On("HandleSubmittedForm", t.context, t.request).Return(nil, errors.New("error")).Once()
+
+	typed, err := t.handler.HandleSubmittedForm(t.context, t.request)
+	t.Error(err)
+	t.Nil(typed)
+}
+
+func (t *TypedFormHandlerTestSuite) TestHandleSubmittedFormDryRun() {
+	form := domain.NewForm(true, nil)
+	form.Data = &typedFormHandlerTestData{Email: "user@example.com"}
+	form.DryRun = true
+	t.formHandler.On("HandleSubmittedFormDryRun", t.context, t.request).Return(&form, nil).Once()
+
+	typed, err := t.handler.HandleSubmittedFormDryRun(t.context, t.request)
+	t.NoError(err)
+	t.Equal(typedFormHandlerTestData{Email: "user@example.com"}, typed.Data)
+	t.True(typed.DryRun)
+}
+
+func (t *TypedFormHandlerTestSuite) TestHandleSubmittedGETForm_WrongType() {
+	form := domain.NewForm(true, nil)
+	form.Data = map[string]string{}
+	t.formHandler.On("HandleSubmittedGETForm", t.context, t.request).Return(&form, nil).Once()
+
+	typed, err := t.handler.HandleSubmittedGETForm(t.context, t.request)
+	t.Error(err)
+	t.Nil(typed)
+}
@@ -0,0 +1,135 @@
+package application
+
+import (
+	"sort"
+	"strings"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// groupExclusion as form fields excluded from validation, as they don't belong to validation group of form handler
+	groupExclusion struct {
+		// formNames form names of excluded fields, used for filtering validation rules
+		formNames []string
+		// fieldNames names of excluded fields used for field errors
+		fieldNames []string
+	}
+)
+
+// validationGroupsTag as tag of form data fields, which defines comma separated validation groups the field belongs to
+// (like `validate-groups:"create,update"`)
+const validationGroupsTag = "validate-groups"
+
+// parseValidationGroups returns trimmed names of comma separated validation groups. Returned slice is never nil,
+// so fields with empty tag are distinguished from fields without tag.
+func parseValidationGroups(tag string) []string {
+	groups := []string{}
+	for _, group := range strings.Split(tag, ",") {
+		if group = strings.TrimSpace(group); group != "" {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
+// groupExclusion returns form fields of the plan which don't belong to validation group of form handler, or nil if
+// there is no validation group set for form handler. Fields without `validate-groups` tag belong to all groups,
+// while fields with empty tag belong to none of them.
+func (h *formHandlerImpl) groupExclusion(formData interface{}) *groupExclusion {
+	if h.validationGroup == "" {
+		return nil
+	}
+
+	plan := h.bindingPlanOf(formData)
+
+	var exclusion *groupExclusion
+	for _, name := range sortedFormFieldNames(plan.formFields) {
+		field := plan.formFields[name]
+		if field.groups == nil || containsGroup(field.groups, h.validationGroup) {
+			continue
+		}
+
+		if exclusion == nil {
+			exclusion = &groupExclusion{}
+		}
+		exclusion.formNames = append(exclusion.formNames, name)
+		exclusion.fieldNames = append(exclusion.fieldNames, field.fieldName)
+	}
+
+	return exclusion
+}
+
+// filterValidationRules returns copy of validation rules without rules of excluded fields
+func (e *groupExclusion) filterValidationRules(validationRules map[string][]domain.ValidationRule) map[string][]domain.ValidationRule {
+	if e == nil {
+		return validationRules
+	}
+
+	filtered := make(map[string][]domain.ValidationRule, len(validationRules))
+	for name, rules := range validationRules {
+		if !isExcludedName(e.formNames, name) {
+			filtered[name] = rules
+		}
+	}
+
+	return filtered
+}
+
+// filterValidationInfo returns validation info without errors of excluded fields. General errors are kept.
+func (e *groupExclusion) filterValidationInfo(validationInfo *domain.ValidationInfo) *domain.ValidationInfo {
+	if e == nil || validationInfo == nil || validationInfo.IsValid() {
+		return validationInfo
+	}
+
+	result := &domain.ValidationInfo{}
+	result.AppendGeneralErrors(validationInfo.GetGeneralErrors())
+
+	fieldErrors := map[string][]domain.Error{}
+	for fieldName, errs := range validationInfo.GetErrorsForAllFields() {
+		if !isExcludedName(e.fieldNames, fieldName) {
+			fieldErrors[fieldName] = errs
+		}
+	}
+	result.AppendFieldErrors(fieldErrors)
+
+	if validationInfo.IsValidationStopped() {
+		result.StopValidation()
+	}
+
+	return result
+}
+
+// isExcludedName checks if name is one of excluded names, or element of one of them (like "items[0].name")
+func isExcludedName(excluded []string, name string) bool {
+	for _, excludedName := range excluded {
+		if name == excludedName || strings.HasPrefix(name, excludedName+".") || strings.HasPrefix(name, excludedName+"[") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// containsGroup checks if validation group is one of the groups
+func containsGroup(groups []string, group string) bool {
+	for _, g := range groups {
+		if g == group {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sortedFormFieldNames returns form names of form fields in alphabetical order
+func sortedFormFieldNames(formFields map[string]formField) []string {
+	names := make([]string, 0, len(formFields))
+	for name := range formFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
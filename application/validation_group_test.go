@@ -0,0 +1,114 @@
+package application
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	ValidationGroupTestSuite struct {
+		suite.Suite
+
+		handler *formHandlerImpl
+	}
+
+	validationGroupTestAddress struct {
+		Street string `form:"street" validate:"required"`
+		Notes  string `form:"notes" validate:"max=5" validate-groups:"create"`
+	}
+
+	validationGroupTestData struct {
+		ID       string                       `form:"id" validate:"required" validate-groups:"update"`
+		Password string                       `form:"password" validate:"required" validate-groups:"create, register"`
+		Email    string                       `form:"email" validate:"required"`
+		Internal string                       `form:"internal" validate:"required" validate-groups:""`
+		Address  validationGroupTestAddress   `form:"address" validate-groups:"update"`
+		Phones   []validationGroupTestAddress `form:"phones" validate:"dive" validate-groups:"create"`
+	}
+)
+
+func TestValidationGroupTestSuite(t *testing.T) {
+	suite.Run(t, &ValidationGroupTestSuite{})
+}
+
+func (t *ValidationGroupTestSuite) SetupTest() {
+	t.handler = &formHandlerImpl{
+		validationGroup: "update",
+	}
+}
+
+func (t *ValidationGroupTestSuite) TestParseValidationGroups() {
+	t.Equal([]string{"create", "update"}, parseValidationGroups(" create, ,update "))
+	t.Equal([]string{}, parseValidationGroups(""))
+}
+
+func (t *ValidationGroupTestSuite) TestFormFieldGroups() {
+	plan := loadBindingPlan(reflect.TypeOf(validationGroupTestData{}))
+
+	t.Nil(plan.formFields["email"].groups)
+	t.Equal([]string{"create", "register"}, plan.formFields["password"].groups)
+	t.Equal([]string{}, plan.formFields["internal"].groups)
+	t.Equal([]string{"update"}, plan.formFields["address.street"].groups, "tag of sub struct is inherited")
+	t.Equal([]string{"create"}, plan.formFields["address.notes"].groups, "tag of field overrides sub struct")
+}
+
+func (t *ValidationGroupTestSuite) TestGroupExclusion() {
+	exclusion := t.handler.groupExclusion(&validationGroupTestData{})
+	t.Equal(&groupExclusion{
+		formNames:  []string{"address.notes", "internal", "password", "phones"},
+		fieldNames: []string{"address.notes", "internal", "password", "phones"},
+	}, exclusion)
+
+	t.handler.validationGroup = ""
+	t.Nil(t.handler.groupExclusion(validationGroupTestData{}))
+
+	t.handler.validationGroup = "update"
+	t.Nil(t.handler.groupExclusion(map[string]string{}))
+}
+
+func (t *ValidationGroupTestSuite) TestFilterValidationRules() {
+	validationRules := map[string][]domain.ValidationRule{
+		"id":             {{Name: "required"}},
+		"password":       {{Name: "required"}},
+		"email":          {{Name: "required"}},
+		"phones[].notes": {{Name: "max", Value: "5"}},
+	}
+
+	exclusion := t.handler.groupExclusion(validationGroupTestData{})
+	t.Equal(map[string][]domain.ValidationRule{
+		"id":    {{Name: "required"}},
+		"email": {{Name: "required"}},
+	}, exclusion.filterValidationRules(validationRules))
+	t.Len(validationRules, 4)
+
+	t.Exactly(validationRules, (*groupExclusion)(nil).filterValidationRules(validationRules))
+}
+
+func (t *ValidationGroupTestSuite) TestFilterValidationInfo() {
+	validationInfo := &domain.ValidationInfo{}
+	validationInfo.AddGeneralError("formError.general", "general error")
+	validationInfo.AddFieldError("id", "formError.id.required", "id is required")
+	validationInfo.AddFieldError("password", "formError.password.required", "password is required")
+	validationInfo.AddFieldError("phones[0].notes", "formError.phones[0].notes.max", "notes are too long")
+	validationInfo.StopValidation()
+
+	exclusion := t.handler.groupExclusion(validationGroupTestData{})
+	result := exclusion.filterValidationInfo(validationInfo)
+	t.Equal(map[string][]domain.Error{
+		"id": {
+			{
+				MessageKey:   "formError.id.required",
+				DefaultLabel: "id is required",
+			},
+		},
+	}, result.GetErrorsForAllFields())
+	t.Len(result.GetGeneralErrors(), 1)
+	t.True(result.IsValidationStopped())
+
+	t.Exactly(validationInfo, (*groupExclusion)(nil).filterValidationInfo(validationInfo))
+	t.Nil(exclusion.filterValidationInfo(nil))
+}
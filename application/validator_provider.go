@@ -13,11 +13,22 @@ import (
 type (
 	// ValidatorProviderImpl as struct which implements interface ValidatorProvider
 	ValidatorProviderImpl struct {
-		validate *validator.Validate
+		validate          *validator.Validate
+		ruleMetaResolvers map[string]domain.RuleMetaResolver
+		failureReasoners  map[string]domain.FailureReasoner
+	}
+
+	// validationRulesResolver as optional interface for validator providers, which resolve request dependent
+	// metadata of exported validation rules
+	validationRulesResolver interface {
+		ResolveValidationRules(ctx context.Context, req *web.Request, formData interface{}, validationRules map[string][]domain.ValidationRule) map[string][]domain.ValidationRule
 	}
 )
 
-var _ domain.ValidatorProvider = &ValidatorProviderImpl{}
+var (
+	_ domain.ValidatorProvider = &ValidatorProviderImpl{}
+	_ validationRulesResolver  = &ValidatorProviderImpl{}
+)
 
 // Inject initialize instance of validator.Validate struct
 func (p *ValidatorProviderImpl) Inject(fieldValidators []domain.FieldValidator, structValidators []domain.StructValidator) {
@@ -29,7 +40,7 @@ func (p *ValidatorProviderImpl) Inject(fieldValidators []domain.FieldValidator,
 
 // Validate method which validates any struct and returns domain.ValidationInfo as a result of validation
 func (p *ValidatorProviderImpl) Validate(ctx context.Context, req *web.Request, value interface{}) domain.ValidationInfo {
-	reqCtx := web.ContextWithRequest(ctx, req)
+	reqCtx := web.ContextWithRequest(contextWithLatencyForm(ctx, value), req)
 	validate := p.GetValidator()
 	err := validate.StructCtx(reqCtx, value)
 
@@ -41,7 +52,44 @@ func (p *ValidatorProviderImpl) GetValidator() *validator.Validate {
 	return p.validate
 }
 
-// ErrorsToValidationInfo method which transforms errors into domain.ValidationInfo
+// ResolveValidationRules method which adds metadata resolved for the request by field validators, which implement
+// domain.RuleMetaResolver, to exported validation rules. Passed rules stay unchanged.
+func (p *ValidatorProviderImpl) ResolveValidationRules(ctx context.Context, req *web.Request, formData interface{}, validationRules map[string][]domain.ValidationRule) map[string][]domain.ValidationRule {
+	if len(p.ruleMetaResolvers) == 0 {
+		return validationRules
+	}
+
+	resolved := make(map[string][]domain.ValidationRule, len(validationRules))
+	for fieldName, rules := range validationRules {
+		fieldRules := make([]domain.ValidationRule, len(rules))
+		copy(fieldRules, rules)
+
+		for i, rule := range fieldRules {
+			resolver, ok := p.ruleMetaResolvers[rule.Name]
+			if !ok {
+				continue
+			}
+
+			meta := map[string]string{}
+			for key, value := range rule.Meta {
+				meta[key] = value
+			}
+			for key, value := range resolver.ResolveRuleMeta(ctx, req, formData, fieldName, rule) {
+				meta[key] = value
+			}
+
+			fieldRules[i].Meta = meta
+		}
+
+		resolved[fieldName] = fieldRules
+	}
+
+	return resolved
+}
+
+// ErrorsToValidationInfo method which transforms errors into domain.ValidationInfo. Reason codes of field validators,
+// which implement domain.FailureReasoner, are appended to message keys (like "formError.username.handle.reserved").
+// Label of the field, name, value and reason code of failed rule are passed as parameters of field errors.
 func (p *ValidatorProviderImpl) ErrorsToValidationInfo(err error) domain.ValidationInfo {
 	validationInfo := domain.ValidationInfo{}
 
@@ -52,7 +100,16 @@ func (p *ValidatorProviderImpl) ErrorsToValidationInfo(err error) domain.Validat
 	if validationErrors, ok := err.(validator.ValidationErrors); ok {
 		for _, err := range validationErrors {
 			fieldName := p.getRelativeFieldNameFromValidationError(err)
-			validationInfo.AddFieldError(fieldName, "formError."+fieldName+"."+err.Tag(), err.Field()+" "+err.Tag())
+			tag := err.Tag()
+			messageKey := "formError." + fieldName + "." + tag
+			defaultLabel := err.Field() + " " + tag
+			params := domain.NewErrorParams(err.Field(), tag, err.Param(), "")
+			if reason := p.failureReason(err); reason != "" {
+				messageKey += "." + reason
+				defaultLabel += " " + reason
+				params["reason"] = reason
+			}
+			validationInfo.AddFieldErrorWithParams(fieldName, messageKey, defaultLabel, params)
 		}
 	} else {
 		validationInfo.AddGeneralError("formError.invalidValidation", err.Error())
@@ -61,10 +118,40 @@ func (p *ValidatorProviderImpl) ErrorsToValidationInfo(err error) domain.Validat
 	return validationInfo
 }
 
+// failureReason method which returns reason code of field error, if its field validator implements domain.FailureReasoner
+func (p *ValidatorProviderImpl) failureReason(err validator.FieldError) string {
+	if len(p.failureReasoners) == 0 {
+		return ""
+	}
+
+	reasoner, ok := p.failureReasoners[err.Tag()]
+	if !ok {
+		return ""
+	}
+
+	return reasoner.FailureReason(err.Value(), err.Param())
+}
+
 // attachFieldValidators method which attach all injected instances of FieldValidator interface into validator.Validate instance
 func (p *ValidatorProviderImpl) attachFieldValidators(validate *validator.Validate, fieldValidators []domain.FieldValidator) {
 	for _, fieldValidator := range fieldValidators {
-		validate.RegisterValidationCtx(fieldValidator.ValidatorName(), fieldValidator.ValidateField)
+		nilValidator, ok := fieldValidator.(domain.NilFieldValidator)
+		validatesNil := ok && nilValidator.ValidatesNilFields()
+		validate.RegisterValidationCtx(fieldValidator.ValidatorName(), timedFieldValidation(fieldValidator.ValidatorName(), fieldValidator.ValidateField), validatesNil)
+
+		if resolver, ok := fieldValidator.(domain.RuleMetaResolver); ok {
+			if p.ruleMetaResolvers == nil {
+				p.ruleMetaResolvers = map[string]domain.RuleMetaResolver{}
+			}
+			p.ruleMetaResolvers[fieldValidator.ValidatorName()] = resolver
+		}
+
+		if reasoner, ok := fieldValidator.(domain.FailureReasoner); ok {
+			if p.failureReasoners == nil {
+				p.failureReasoners = map[string]domain.FailureReasoner{}
+			}
+			p.failureReasoners[fieldValidator.ValidatorName()] = reasoner
+		}
 	}
 }
 
@@ -27,6 +27,16 @@ type (
 		structValidator *mocks.StructValidator
 	}
 
+	validatorProviderTestResolver struct {
+		*mocks.FieldValidator
+		*mocks.RuleMetaResolver
+	}
+
+	validatorProviderTestReasoner struct {
+		*mocks.FieldValidator
+		*mocks.FailureReasoner
+	}
+
 	validatorProviderTestData struct {
 		First  string `validate:"firstfield"`
 		Second string `validate:"secondfield"`
@@ -77,8 +87,9 @@ func (t *ValidatorProviderTestSuite) TestErrorsToValidationInfo_Empty() {
 func (t *ValidatorProviderTestSuite) TestErrorsToValidationInfo_FieldError() {
 	err := &mocks.FieldError{}
 	err.On("Namespace").Return("formData.fieldName1").Once()
-	err.On("Tag").Return("firstfield").Twice()
-	err.On("Field").Return("FieldName1").Once()
+	err.On("Tag").Return("firstfield").Once()
+	err.On("Field").Return("FieldName1").Twice()
+	err.On("Param").Return("").Once()
 
 	validationInfo := t.provider.ErrorsToValidationInfo(validator.ValidationErrors{
 		err,
@@ -89,6 +100,10 @@ func (t *ValidatorProviderTestSuite) TestErrorsToValidationInfo_FieldError() {
 			{
 				MessageKey:   "formError.fieldName1.firstfield",
 				DefaultLabel: "FieldName1 firstfield",
+				Params: map[string]string{
+					"field": "FieldName1",
+					"rule":  "firstfield",
+				},
 			},
 		},
 	}, validationInfo.GetErrorsForAllFields())
@@ -96,6 +111,67 @@ func (t *ValidatorProviderTestSuite) TestErrorsToValidationInfo_FieldError() {
 	err.AssertExpectations(t.T())
 }
 
+func (t *ValidatorProviderTestSuite) TestErrorsToValidationInfo_FailureReason() {
+	reasoner := &validatorProviderTestReasoner{
+		FieldValidator:  &mocks.FieldValidator{},
+		FailureReasoner: &mocks.FailureReasoner{},
+	}
+	reasoner.FieldValidator.On("ValidatorName").Return("handle").Twice()
+
+	provider := &ValidatorProviderImpl{}
+	provider.Inject([]domain.FieldValidator{reasoner}, nil)
+
+	reasoned := &mocks.FieldError{}
+	reasoned.On("Namespace").Return("formData.username").Once()
+	reasoned.On("Tag").Return("handle").Twice()
+	reasoned.On("Field").Return("Username").Twice()
+	reasoned.On("Value").Return("admin").Once()
+	reasoned.On("Param").Return("").Twice()
+
+	unreasoned := &mocks.FieldError{}
+	unreasoned.On("Namespace").Return("formData.nickname").Once()
+	unreasoned.On("Tag").Return("handle").Twice()
+	unreasoned.On("Field").Return("Nickname").Twice()
+	unreasoned.On("Value").Return(10).Once()
+	unreasoned.On("Param").Return("").Twice()
+
+	reasoner.FailureReasoner.On("FailureReason", "admin", "").Return("reserved").Once()
+	reasoner.FailureReasoner.On("FailureReason", 10, "").Return("").Once()
+
+	validationInfo := provider.ErrorsToValidationInfo(validator.ValidationErrors{
+		reasoned,
+		unreasoned,
+	})
+	t.Equal(map[string][]domain.Error{
+		"username": {
+			{
+				MessageKey:   "formError.username.handle.reserved",
+				DefaultLabel: "Username handle reserved",
+				Params: map[string]string{
+					"field":  "Username",
+					"rule":   "handle",
+					"reason": "reserved",
+				},
+			},
+		},
+		"nickname": {
+			{
+				MessageKey:   "formError.nickname.handle",
+				DefaultLabel: "Nickname handle",
+				Params: map[string]string{
+					"field": "Nickname",
+					"rule":  "handle",
+				},
+			},
+		},
+	}, validationInfo.GetErrorsForAllFields())
+
+	reasoned.AssertExpectations(t.T())
+	unreasoned.AssertExpectations(t.T())
+	reasoner.FieldValidator.AssertExpectations(t.T())
+	reasoner.FailureReasoner.AssertExpectations(t.T())
+}
+
 func (t *ValidatorProviderTestSuite) TestErrorsToValidationInfo_GeneralError() {
 	err := errors.New("error")
 	validationInfo := t.provider.ErrorsToValidationInfo(err)
@@ -159,3 +235,57 @@ func (t *ValidatorProviderTestSuite) TestValidate() {
 		},
 	}, validationInfo.GetErrorsForAllFields())
 }
+
+func (t *ValidatorProviderTestSuite) TestResolveValidationRules() {
+	ctx := context.Background()
+	request := &web.Request{}
+	formData := validatorProviderTestData{}
+
+	resolver := &validatorProviderTestResolver{
+		FieldValidator:   &mocks.FieldValidator{},
+		RuleMetaResolver: &mocks.RuleMetaResolver{},
+	}
+	resolver.FieldValidator.On("ValidatorName").Return("amount").Twice()
+
+	provider := &ValidatorProviderImpl{}
+	provider.Inject([]domain.FieldValidator{resolver}, nil)
+
+	validationRules := map[string][]domain.ValidationRule{
+		"first": {
+			{Name: "required"},
+			{Name: "amount", Value: "Currency", Meta: map[string]string{"unit": "cent"}},
+		},
+		"second": {
+			{Name: "required"},
+		},
+	}
+
+	resolver.RuleMetaResolver.On("ResolveRuleMeta", ctx, request, formData, "first", validationRules["first"][1]).Return(map[string]string{
+		"currency": "EUR",
+		"decimals": "2",
+	}).Once()
+
+	t.Equal(map[string][]domain.ValidationRule{
+		"first": {
+			{Name: "required"},
+			{Name: "amount", Value: "Currency", Meta: map[string]string{"unit": "cent", "currency": "EUR", "decimals": "2"}},
+		},
+		"second": {
+			{Name: "required"},
+		},
+	}, provider.ResolveValidationRules(ctx, request, formData, validationRules))
+	t.Equal(map[string]string{"unit": "cent"}, validationRules["first"][1].Meta)
+
+	resolver.FieldValidator.AssertExpectations(t.T())
+	resolver.RuleMetaResolver.AssertExpectations(t.T())
+}
+
+func (t *ValidatorProviderTestSuite) TestResolveValidationRules_WithoutResolvers() {
+	validationRules := map[string][]domain.ValidationRule{
+		"first": {
+			{Name: "firstfield"},
+		},
+	}
+
+	t.Equal(validationRules, t.provider.ResolveValidationRules(context.Background(), &web.Request{}, validatorProviderTestData{}, validationRules))
+}
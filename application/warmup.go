@@ -0,0 +1,121 @@
+package application
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/formdata"
+)
+
+type (
+	// warmupRegistry collects form handlers built by builders of form handler factory, so they are warmed up together
+	// at boot. Registry is sealed by the first warm-up, so form handlers built per request are not collected.
+	warmupRegistry struct {
+		mutex    sync.Mutex
+		handlers []*formHandlerImpl
+		sealed   bool
+	}
+)
+
+// register adds form handler to the registry, unless registry is already sealed
+func (r *warmupRegistry) register(handler *formHandlerImpl) {
+	if r == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !r.sealed {
+		r.handlers = append(r.handlers, handler)
+	}
+}
+
+// seal returns all registered form handlers and stops registering of further form handlers
+func (r *warmupRegistry) seal() []*formHandlerImpl {
+	if r == nil {
+		return nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	handlers := r.handlers
+	r.handlers = nil
+	r.sealed = true
+
+	return handlers
+}
+
+// warmup prepares form handler for the first request. Binding plan of known form data type, including its pattern
+// rules, is compiled, caches of default form data decoder are primed for the type, and form services, sub forms
+// and form extensions which implement domain.WarmableFormService prepare their resources.
+func (h *formHandlerImpl) warmup(ctx context.Context) error {
+	if h.bindingPlan != nil {
+		if h.bindingPlan.ruleErr != nil {
+			return domain.NewFormErrorWithParent(h.bindingPlan.ruleErr)
+		}
+
+		if err := formdata.WarmupFormDataType(h.bindingPlan.typeOf); err != nil {
+			return domain.NewFormErrorWithParent(err)
+		}
+	}
+
+	for _, service := range h.warmableServices() {
+		if err := service.Warmup(ctx); err != nil {
+			return domain.NewFormErrorWithParent(err)
+		}
+	}
+
+	return nil
+}
+
+// warmableServices returns form services, sub forms and form extensions of form handler, which implement
+// domain.WarmableFormService. Services wrapped for sub forms are unwrapped, and services used in multiple roles
+// (like complete form service) are returned only once.
+func (h *formHandlerImpl) warmableServices() []domain.WarmableFormService {
+	services := []interface{}{h.formDataProvider, h.formDataDecoder, h.formDataValidator}
+	for _, name := range h.extensionNames() {
+		services = append(services, h.formExtensions[name])
+	}
+
+	var warmable []domain.WarmableFormService
+	seen := map[interface{}]bool{}
+	for len(services) > 0 {
+		service := services[0]
+		services = services[1:]
+
+		switch wrapper := service.(type) {
+		case *subFormDataProvider:
+			services = append(services, wrapper.formDataProvider)
+			for _, binding := range wrapper.subForms {
+				services = append(services, binding.subForm)
+			}
+			continue
+		case *subFormDataDecoder:
+			services = append(services, wrapper.formDataDecoder)
+			continue
+		case *prefixedFormExtension:
+			services = append(services, wrapper.formExtension)
+			continue
+		}
+
+		warmableService, ok := service.(domain.WarmableFormService)
+		if !ok {
+			continue
+		}
+
+		if reflect.TypeOf(warmableService).Comparable() {
+			if seen[warmableService] {
+				continue
+			}
+			seen[warmableService] = true
+		}
+
+		warmable = append(warmable, warmableService)
+	}
+
+	return warmable
+}
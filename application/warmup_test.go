@@ -0,0 +1,121 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	WarmupTestSuite struct {
+		suite.Suite
+
+		context context.Context
+	}
+
+	warmupTestService struct {
+		warmups int
+		err     error
+	}
+
+	warmupTestFormData struct {
+		Name  string `form:"name" validate:"required"`
+		Email string `form:"email" validate:"pattern=^[^@]+@[^@]+$"`
+	}
+)
+
+var (
+	_ domain.WarmableFormService = &warmupTestService{}
+	_ domain.CompleteFormService = &warmupTestService{}
+	_ domain.SubForm             = &warmupTestService{}
+)
+
+func TestWarmupTestSuite(t *testing.T) {
+	suite.Run(t, &WarmupTestSuite{})
+}
+
+func (s *warmupTestService) GetFormData(context.Context, *web.Request) (interface{}, error) {
+	return warmupTestFormData{}, nil
+}
+
+func (s *warmupTestService) Decode(context.Context, *web.Request, url.Values, interface{}) (interface{}, error) {
+	return warmupTestFormData{}, nil
+}
+
+func (s *warmupTestService) Validate(context.Context, *web.Request, domain.ValidatorProvider, interface{}) (*domain.ValidationInfo, error) {
+	return &domain.ValidationInfo{}, nil
+}
+
+func (s *warmupTestService) SubFormExtensions() []string {
+	return nil
+}
+
+func (s *warmupTestService) Warmup(context.Context) error {
+	s.warmups++
+
+	return s.err
+}
+
+func (t *WarmupTestSuite) SetupTest() {
+	t.context = context.Background()
+}
+
+func (t *WarmupTestSuite) TestWarmupRegistry() {
+	registry := &warmupRegistry{}
+	first := &formHandlerImpl{}
+	second := &formHandlerImpl{}
+
+	registry.register(first)
+	registry.register(second)
+	t.Equal([]*formHandlerImpl{first, second}, registry.seal())
+
+	registry.register(&formHandlerImpl{})
+	t.Empty(registry.seal())
+
+	var missing *warmupRegistry
+	missing.register(first)
+	t.Nil(missing.seal())
+}
+
+func (t *WarmupTestSuite) TestWarmup() {
+	service := &warmupTestService{}
+	subForm := &warmupTestService{}
+	extension := &warmupTestService{}
+
+	handler := &formHandlerImpl{
+		formDataProvider: &subFormDataProvider{
+			formDataProvider: service,
+			subForms: []subFormBinding{
+				{prefix: "billing", subForm: subForm},
+			},
+		},
+		formDataDecoder:   &subFormDataDecoder{formDataDecoder: service},
+		formDataValidator: service,
+		formExtensions: map[string]domain.FormExtension{
+			"billing.extension": &prefixedFormExtension{prefix: "billing", formExtension: extension},
+			"provider":          &mocks.FormDataProvider{},
+		},
+		bindingPlan: loadBindingPlan(reflect.TypeOf(warmupTestFormData{})),
+	}
+
+	t.NoError(handler.warmup(t.context))
+	t.Equal(1, service.warmups, "service used in multiple roles is warmed up once")
+	t.Equal(1, subForm.warmups)
+	t.Equal(1, extension.warmups)
+}
+
+func (t *WarmupTestSuite) TestWarmup_Error() {
+	handler := &formHandlerImpl{
+		formDataProvider: &warmupTestService{err: errors.New("list not available")},
+	}
+
+	t.Equal(domain.NewFormErrorWithParent(errors.New("list not available")), handler.warmup(t.context))
+}
@@ -0,0 +1,199 @@
+package address
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"strings"
+
+	validator "gopkg.in/go-playground/validator.v9"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// Address defines reusable address sub form, which can be embedded into any form data.
+	// Besides rules defined by tags, it's validated by Validator with country dependent rules and AddressVerifier.
+	//
+	// Data struct {
+	//	 Shipping *address.Address `form:"shipping"`
+	// }
+	Address struct {
+		Street         string `form:"street" validate:"required" conform:"trim"`
+		HouseNumber    string `form:"houseNumber" conform:"trim"`
+		AdditionalLine string `form:"additionalLine" conform:"trim"`
+		PostalCode     string `form:"postalCode" conform:"trim,upper"`
+		City           string `form:"city" validate:"required" conform:"trim"`
+		State          string `form:"state" conform:"trim"`
+		Country        string `form:"country" validate:"required,len=2" conform:"trim,upper"`
+	}
+
+	// AddressVerifier defines external verification of addresses (like address validation service)
+	AddressVerifier interface {
+		// VerifyAddress verifies address and returns its normalized form
+		VerifyAddress(ctx context.Context, address Address) (*Verification, error)
+	}
+
+	// Verification defines result of address verification
+	Verification struct {
+		// Verified flag if address exists
+		Verified bool
+		// Address normalized form of verified address
+		Address Address
+	}
+
+	// CountryRule defines country dependent rules of address
+	CountryRule struct {
+		// PostalCode regular expression of valid postal codes. Postal code is required if it's defined.
+		PostalCode string `json:"postalCode"`
+		// StateRequired flag if state is required
+		StateRequired bool `json:"stateRequired"`
+	}
+
+	// Validator defines struct validator of Address, which applies country dependent rules and verifies address
+	// via AddressVerifier. If address is embedded as pointer, it's replaced with its normalized form. Errors of
+	// AddressVerifier itself don't invalidate address, so forms stay usable while verification service is down.
+	Validator struct {
+		verifier AddressVerifier
+		verify   bool
+		rules    map[string]countryRule
+	}
+
+	// countryRule defines compiled country dependent rules of address
+	countryRule struct {
+		postalCode    *regexp.Regexp
+		stateRequired bool
+	}
+)
+
+var (
+	_ domain.StructValidator = &Validator{}
+
+	// DefaultCountryRules defines country dependent rules for common countries, by ISO 3166-1 alpha-2 country codes
+	DefaultCountryRules = map[string]CountryRule{
+		"AT": {PostalCode: `^[0-9]{4}$`},
+		"AU": {PostalCode: `^[0-9]{4}$`, StateRequired: true},
+		"BE": {PostalCode: `^[0-9]{4}$`},
+		"CA": {PostalCode: `^[A-Z][0-9][A-Z] ?[0-9][A-Z][0-9]$`, StateRequired: true},
+		"CH": {PostalCode: `^[0-9]{4}$`},
+		"DE": {PostalCode: `^[0-9]{5}$`},
+		"DK": {PostalCode: `^[0-9]{4}$`},
+		"ES": {PostalCode: `^[0-9]{5}$`},
+		"FR": {PostalCode: `^[0-9]{5}$`},
+		"GB": {PostalCode: `^[A-Z]{1,2}[0-9][A-Z0-9]? ?[0-9][A-Z]{2}$`},
+		"IT": {PostalCode: `^[0-9]{5}$`},
+		"NL": {PostalCode: `^[0-9]{4} ?[A-Z]{2}$`},
+		"PL": {PostalCode: `^[0-9]{2}-[0-9]{3}$`},
+		"SE": {PostalCode: `^[0-9]{3} ?[0-9]{2}$`},
+		"US": {PostalCode: `^[0-9]{5}(-[0-9]{4})?$`, StateRequired: true},
+	}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (v *Validator) Inject(
+	verifier AddressVerifier,
+	cfg *struct {
+		Verify    bool       `inject:"config:form.address.verify"`
+		Countries config.Map `inject:"config:form.address.countries"`
+	},
+) {
+	v.verifier = verifier
+
+	countries := map[string]CountryRule{}
+	if cfg != nil {
+		v.verify = cfg.Verify
+		if err := cfg.Countries.MapInto(&countries); err != nil {
+			panic(err.Error())
+		}
+	}
+
+	v.rules = compileCountryRules(countries)
+}
+
+// StructType defines Address as type validated by this validator
+func (v *Validator) StructType() interface{} {
+	return Address{}
+}
+
+// ValidateStruct validates address by country dependent rules, and verifies it if all rules are fulfilled
+func (v *Validator) ValidateStruct(ctx context.Context, sl validator.StructLevel) {
+	current := sl.Current()
+	address, ok := current.Interface().(Address)
+	if !ok {
+		return
+	}
+
+	if !v.validateCountryRules(sl, address) || !v.verify || v.verifier == nil {
+		return
+	}
+
+	verification, err := v.verifier.VerifyAddress(ctx, address)
+	if err != nil || verification == nil {
+		return
+	}
+
+	if !verification.Verified {
+		sl.ReportError(address.Street, "Street", "Street", "verified", "")
+		return
+	}
+
+	if current.CanSet() {
+		current.Set(reflect.ValueOf(verification.Address))
+	}
+}
+
+// validateCountryRules validates address by rules of its country and returns if they are fulfilled
+func (v *Validator) validateCountryRules(sl validator.StructLevel, address Address) bool {
+	rule, ok := v.rules[strings.ToUpper(address.Country)]
+	if !ok {
+		return true
+	}
+
+	valid := true
+
+	if rule.postalCode != nil {
+		if address.PostalCode == "" {
+			sl.ReportError(address.PostalCode, "PostalCode", "PostalCode", "required", "")
+			valid = false
+		} else if !rule.postalCode.MatchString(strings.ToUpper(address.PostalCode)) {
+			sl.ReportError(address.PostalCode, "PostalCode", "PostalCode", "postalcode", address.Country)
+			valid = false
+		}
+	}
+
+	if rule.stateRequired && address.State == "" {
+		sl.ReportError(address.State, "State", "State", "required", "")
+		valid = false
+	}
+
+	return valid
+}
+
+// compileCountryRules compiles default country rules, overridden by configured ones
+func compileCountryRules(countries map[string]CountryRule) map[string]countryRule {
+	rules := make(map[string]countryRule, len(DefaultCountryRules)+len(countries))
+
+	for country, rule := range DefaultCountryRules {
+		rules[country] = compileCountryRule(rule)
+	}
+
+	for country, rule := range countries {
+		rules[strings.ToUpper(country)] = compileCountryRule(rule)
+	}
+
+	return rules
+}
+
+// compileCountryRule compiles single country rule
+func compileCountryRule(rule CountryRule) countryRule {
+	compiled := countryRule{
+		stateRequired: rule.StateRequired,
+	}
+
+	if rule.PostalCode != "" {
+		compiled.postalCode = regexp.MustCompile(rule.PostalCode)
+	}
+
+	return compiled
+}
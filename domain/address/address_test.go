@@ -0,0 +1,162 @@
+package address
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	ValidatorTestSuite struct {
+		suite.Suite
+
+		validator   *Validator
+		verifier    *addressTestVerifier
+		structLevel *mocks.StructLevel
+
+		context context.Context
+	}
+
+	addressTestVerifier struct {
+		verification *Verification
+		err          error
+		addresses    []Address
+	}
+)
+
+func (v *addressTestVerifier) VerifyAddress(_ context.Context, address Address) (*Verification, error) {
+	v.addresses = append(v.addresses, address)
+	return v.verification, v.err
+}
+
+func TestValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &ValidatorTestSuite{})
+}
+
+func (t *ValidatorTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *ValidatorTestSuite) SetupTest() {
+	t.verifier = &addressTestVerifier{}
+	t.validator = &Validator{}
+	t.validator.Inject(t.verifier, &struct {
+		Verify    bool       `inject:"config:form.address.verify"`
+		Countries config.Map `inject:"config:form.address.countries"`
+	}{
+		Verify: true,
+		Countries: config.Map{
+			"de": config.Map{
+				"postalCode":    `^[0-9]{5}$`,
+				"stateRequired": true,
+			},
+		},
+	})
+
+	t.structLevel = &mocks.StructLevel{}
+}
+
+func (t *ValidatorTestSuite) TearDownTest() {
+	t.structLevel.AssertExpectations(t.T())
+}
+
+func (t *ValidatorTestSuite) TestStructType() {
+	t.Equal(Address{}, t.validator.StructType())
+}
+
+func (t *ValidatorTestSuite) TestValidateStruct_CountryRules() {
+	address := Address{
+		Street:     "Main Street",
+		PostalCode: "1234",
+		City:       "Springfield",
+		Country:    "US",
+	}
+
+	t.structLevel.On("Current").Return(reflect.ValueOf(address)).Once()
+	t.structLevel.On("ReportError", "1234", "PostalCode", "PostalCode", "postalcode", "US").Once()
+	t.structLevel.On("ReportError", "", "State", "State", "required", "").Once()
+
+	t.validator.ValidateStruct(t.context, t.structLevel)
+	t.Empty(t.verifier.addresses)
+}
+
+func (t *ValidatorTestSuite) TestValidateStruct_ConfiguredCountryRules() {
+	address := Address{
+		Street:  "Hauptstraße",
+		City:    "Berlin",
+		Country: "DE",
+	}
+
+	t.structLevel.On("Current").Return(reflect.ValueOf(address)).Once()
+	t.structLevel.On("ReportError", "", "PostalCode", "PostalCode", "required", "").Once()
+	t.structLevel.On("ReportError", "", "State", "State", "required", "").Once()
+
+	t.validator.ValidateStruct(t.context, t.structLevel)
+}
+
+func (t *ValidatorTestSuite) TestValidateStruct_NotVerified() {
+	address := Address{
+		Street:  "Unknown Street",
+		City:    "Nowhere",
+		Country: "XX",
+	}
+	t.verifier.verification = &Verification{Verified: false}
+
+	t.structLevel.On("Current").Return(reflect.ValueOf(address)).Once()
+	t.structLevel.On("ReportError", "Unknown Street", "Street", "Street", "verified", "").Once()
+
+	t.validator.ValidateStruct(t.context, t.structLevel)
+	t.Equal([]Address{address}, t.verifier.addresses)
+}
+
+func (t *ValidatorTestSuite) TestValidateStruct_Normalized() {
+	address := &Address{
+		Street:     "main st",
+		PostalCode: "62704",
+		City:       "springfield",
+		State:      "IL",
+		Country:    "US",
+	}
+	normalized := Address{
+		Street:     "Main St",
+		PostalCode: "62704-1234",
+		City:       "Springfield",
+		State:      "IL",
+		Country:    "US",
+	}
+	t.verifier.verification = &Verification{Verified: true, Address: normalized}
+
+	t.structLevel.On("Current").Return(reflect.ValueOf(address).Elem()).Once()
+
+	t.validator.ValidateStruct(t.context, t.structLevel)
+	t.Equal(normalized, *address)
+}
+
+func (t *ValidatorTestSuite) TestValidateStruct_VerifierError() {
+	address := &Address{
+		Street:  "Main Street",
+		City:    "Springfield",
+		Country: "XX",
+	}
+	t.verifier.err = errors.New("error")
+
+	t.structLevel.On("Current").Return(reflect.ValueOf(address).Elem()).Once()
+
+	t.validator.ValidateStruct(t.context, t.structLevel)
+	t.Equal("Main Street", address.Street)
+}
+
+func (t *ValidatorTestSuite) TestValidateStruct_VerificationDisabled() {
+	t.validator.Inject(t.verifier, nil)
+
+	t.structLevel.On("Current").Return(reflect.ValueOf(Address{Country: "XX"})).Once()
+
+	t.validator.ValidateStruct(t.context, t.structLevel)
+	t.Empty(t.verifier.addresses)
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "context"
+
+type (
+	// AvailabilityChecker as interface for checking availability of field value (like delivery slot which is still free,
+	// or date which is no holiday), used by "available" rule. Checkers are bound by their name via injector.BindMap,
+	// and receive parameters from the tag (like "north" for `validate:"available=deliverySlot north"`).
+	AvailabilityChecker interface {
+		// IsAvailable checks if value of the field is available. Request is part of the context.
+		IsAvailable(ctx context.Context, value interface{}, params []string) bool
+	}
+
+	// AvailabilityCheckerFunc as function, which can be bound as AvailabilityChecker
+	AvailabilityCheckerFunc func(ctx context.Context, value interface{}, params []string) bool
+)
+
+var _ AvailabilityChecker = AvailabilityCheckerFunc(nil)
+
+// IsAvailable checks availability of the value by calling the function
+func (f AvailabilityCheckerFunc) IsAvailable(ctx context.Context, value interface{}, params []string) bool {
+	return f(ctx, value, params)
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	AvailabilityCheckerFuncTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestAvailabilityCheckerFuncTestSuite(t *testing.T) {
+	suite.Run(t, &AvailabilityCheckerFuncTestSuite{})
+}
+
+func (t *AvailabilityCheckerFuncTestSuite) TestIsAvailable() {
+	checker := AvailabilityCheckerFunc(func(ctx context.Context, value interface{}, params []string) bool {
+		return value == "2024-03-01" && len(params) == 1 && params[0] == "north"
+	})
+
+	t.True(checker.IsAvailable(context.Background(), "2024-03-01", []string{"north"}))
+	t.False(checker.IsAvailable(context.Background(), "2024-03-02", []string{"north"}))
+	t.False(checker.IsAvailable(context.Background(), "2024-03-01", nil))
+}
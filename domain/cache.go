@@ -0,0 +1,246 @@
+package domain
+
+import (
+	"container/list"
+	"sort"
+	"sync"
+)
+
+type (
+	// Cache as concurrency safe cache of values, which are compiled once and shared between all form handlers
+	// (like binding plans or compiled patterns). If limit is set, least recently used entries are evicted
+	// when it's exceeded, so memory of deployments with many form data types stays bounded.
+	Cache struct {
+		name      string
+		mutex     sync.Mutex
+		limit     int
+		entries   map[interface{}]*list.Element
+		order     *list.List
+		hits      int64
+		misses    int64
+		evictions int64
+	}
+
+	// cacheEntry as single entry of cache, stored in order of recent usage
+	cacheEntry struct {
+		key   interface{}
+		value interface{}
+	}
+
+	// CacheStats as struct for storing statistics of single cache, used for monitoring of memory usage
+	CacheStats struct {
+		// Name of the cache, like "bindingPlans"
+		Name string
+		// Size count of entries stored in the cache
+		Size int
+		// Limit maximal count of entries, 0 if cache is not bounded
+		Limit int
+		// Hits count of lookups which found the entry
+		Hits int64
+		// Misses count of lookups which didn't find the entry
+		Misses int64
+		// Evictions count of entries evicted because of the limit
+		Evictions int64
+	}
+
+	// CacheObserver as function which is notified about statistics of the cache after entries are stored,
+	// together with count of entries evicted by storing
+	CacheObserver func(stats CacheStats, evicted int)
+
+	// cacheRegistry contains all caches by their names, together with configured limits
+	cacheRegistry struct {
+		mutex    sync.Mutex
+		caches   map[string]*Cache
+		limits   map[string]int
+		observer CacheObserver
+	}
+)
+
+var caches = &cacheRegistry{
+	caches: map[string]*Cache{},
+	limits: map[string]int{},
+}
+
+// NewCache creates cache with the name and registers it, so its statistics are reported by AllCacheStats and its
+// limit can be configured by SetCacheLimit. Cache created with name of already registered cache replaces it.
+func NewCache(name string) *Cache {
+	cache := &Cache{
+		name:    name,
+		entries: map[interface{}]*list.Element{},
+		order:   list.New(),
+	}
+
+	caches.mutex.Lock()
+	defer caches.mutex.Unlock()
+
+	cache.limit = caches.limits[name]
+	caches.caches[name] = cache
+
+	return cache
+}
+
+// SetCacheLimit sets maximal count of entries of the cache with the name, 0 or less disables the limit.
+// Limit is applied to cache created later as well.
+func SetCacheLimit(name string, limit int) {
+	if limit < 0 {
+		limit = 0
+	}
+
+	caches.mutex.Lock()
+	caches.limits[name] = limit
+	cache := caches.caches[name]
+	caches.mutex.Unlock()
+
+	if cache != nil {
+		cache.SetLimit(limit)
+	}
+}
+
+// AllCacheStats returns statistics of all registered caches, ordered by their names
+func AllCacheStats() []CacheStats {
+	caches.mutex.Lock()
+	registered := make([]*Cache, 0, len(caches.caches))
+	for _, cache := range caches.caches {
+		registered = append(registered, cache)
+	}
+	caches.mutex.Unlock()
+
+	all := make([]CacheStats, 0, len(registered))
+	for _, cache := range registered {
+		all = append(all, cache.Stats())
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Name < all[j].Name
+	})
+
+	return all
+}
+
+// ObserveCaches sets observer notified about statistics of all caches (like for recording metrics)
+func ObserveCaches(observer CacheObserver) {
+	caches.mutex.Lock()
+	defer caches.mutex.Unlock()
+
+	caches.observer = observer
+}
+
+// Load returns value stored in the cache by the key, and marks it as recently used
+func (c *Cache) Load(key interface{}) (interface{}, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		return nil, false
+	}
+
+	c.hits++
+	c.order.MoveToFront(element)
+
+	return element.Value.(*cacheEntry).value, true
+}
+
+// LoadOrStore returns value stored in the cache by the key, if it exists. Otherwise it stores the value
+// and returns it. Loaded flag is true if value was already stored.
+func (c *Cache) LoadOrStore(key interface{}, value interface{}) (interface{}, bool) {
+	c.mutex.Lock()
+
+	if element, ok := c.entries[key]; ok {
+		c.order.MoveToFront(element)
+		c.mutex.Unlock()
+
+		return element.Value.(*cacheEntry).value, true
+	}
+
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, value: value})
+	evicted := c.evict()
+	stats := c.stats()
+	c.mutex.Unlock()
+
+	c.observe(stats, evicted)
+
+	return value, false
+}
+
+// Store stores the value in the cache by the key, replacing already stored one
+func (c *Cache) Store(key interface{}, value interface{}) {
+	c.mutex.Lock()
+
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*cacheEntry).value = value
+		c.order.MoveToFront(element)
+		c.mutex.Unlock()
+
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, value: value})
+	evicted := c.evict()
+	stats := c.stats()
+	c.mutex.Unlock()
+
+	c.observe(stats, evicted)
+}
+
+// SetLimit sets maximal count of entries, 0 or less disables the limit. Entries exceeding the limit are evicted.
+func (c *Cache) SetLimit(limit int) {
+	if limit < 0 {
+		limit = 0
+	}
+
+	c.mutex.Lock()
+	c.limit = limit
+	evicted := c.evict()
+	stats := c.stats()
+	c.mutex.Unlock()
+
+	c.observe(stats, evicted)
+}
+
+// Stats returns statistics of the cache
+func (c *Cache) Stats() CacheStats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.stats()
+}
+
+// evict removes least recently used entries exceeding the limit, and returns their count
+func (c *Cache) evict() int {
+	evicted := 0
+	for c.limit > 0 && c.order.Len() > c.limit {
+		element := c.order.Back()
+		c.order.Remove(element)
+		delete(c.entries, element.Value.(*cacheEntry).key)
+		evicted++
+	}
+
+	c.evictions += int64(evicted)
+
+	return evicted
+}
+
+// stats returns statistics of the cache, while the cache is locked
+func (c *Cache) stats() CacheStats {
+	return CacheStats{
+		Name:      c.name,
+		Size:      c.order.Len(),
+		Limit:     c.limit,
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+	}
+}
+
+// observe notifies registered observer about statistics of the cache
+func (c *Cache) observe(stats CacheStats, evicted int) {
+	caches.mutex.Lock()
+	observer := caches.observer
+	caches.mutex.Unlock()
+
+	if observer != nil {
+		observer(stats, evicted)
+	}
+}
@@ -0,0 +1,11 @@
+package domain
+
+// CacheHint as struct for storing cache metadata of unsubmitted form, which can be honored by response caching
+// of the page rendering the form
+type CacheHint struct {
+	// Cacheable flag if rendered form contains no user specific data, so response can be shared between users
+	Cacheable bool
+	// Reasons names of subsystems which make form not cacheable, like names of form extensions which provide
+	// user specific form data ("formExtension.csrfToken")
+	Reasons []string
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	CacheTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestCacheTestSuite(t *testing.T) {
+	suite.Run(t, &CacheTestSuite{})
+}
+
+func (t *CacheTestSuite) TearDownTest() {
+	ObserveCaches(nil)
+}
+
+func (t *CacheTestSuite) TestLoadOrStore() {
+	cache := NewCache("cacheTest.loadOrStore")
+
+	value, ok := cache.Load("a")
+	t.False(ok)
+	t.Nil(value)
+
+	value, loaded := cache.LoadOrStore("a", 1)
+	t.False(loaded)
+	t.Equal(1, value)
+
+	value, loaded = cache.LoadOrStore("a", 2)
+	t.True(loaded)
+	t.Equal(1, value)
+
+	cache.Store("a", 3)
+	value, ok = cache.Load("a")
+	t.True(ok)
+	t.Equal(3, value)
+
+	t.Equal(CacheStats{
+		Name:   "cacheTest.loadOrStore",
+		Size:   1,
+		Hits:   1,
+		Misses: 1,
+	}, cache.Stats())
+}
+
+func (t *CacheTestSuite) TestLimit() {
+	var observed []CacheStats
+	ObserveCaches(func(stats CacheStats, evicted int) {
+		observed = append(observed, stats)
+	})
+
+	cache := NewCache("cacheTest.limit")
+	cache.SetLimit(2)
+
+	cache.Store("a", 1)
+	cache.Store("b", 2)
+	// "a" is used recently, so "b" is evicted
+	cache.Load("a")
+	cache.Store("c", 3)
+
+	_, ok := cache.Load("b")
+	t.False(ok)
+	_, ok = cache.Load("a")
+	t.True(ok)
+	_, ok = cache.Load("c")
+	t.True(ok)
+
+	t.Equal(CacheStats{
+		Name:      "cacheTest.limit",
+		Size:      2,
+		Limit:     2,
+		Hits:      3,
+		Misses:    1,
+		Evictions: 1,
+	}, cache.Stats())
+	t.Len(observed, 4)
+
+	cache.SetLimit(1)
+	t.Equal(1, cache.Stats().Size)
+
+	cache.SetLimit(0)
+	cache.Store("d", 4)
+	t.Equal(2, cache.Stats().Size)
+}
+
+func (t *CacheTestSuite) TestSetCacheLimit() {
+	SetCacheLimit("cacheTest.configured", 1)
+	cache := NewCache("cacheTest.configured")
+	t.Equal(1, cache.Stats().Limit)
+
+	SetCacheLimit("cacheTest.configured", -1)
+	t.Equal(0, cache.Stats().Limit)
+}
+
+func (t *CacheTestSuite) TestAllCacheStats() {
+	NewCache("cacheTest.b")
+	NewCache("cacheTest.a").Store("key", "value")
+
+	var names []string
+	for _, stats := range AllCacheStats() {
+		names = append(names, stats.Name)
+		if stats.Name == "cacheTest.a" {
+			t.Equal(1, stats.Size)
+		}
+	}
+
+	t.Subset(names, []string{"cacheTest.a", "cacheTest.b"})
+}
@@ -0,0 +1,78 @@
+package card
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// Card defines reusable payment card sub form, which can be embedded into any form data.
+	// Card number and CVC are masked whenever they are formatted or encoded as JSON, so they don't leak into logs,
+	// debug info or other retained data. If Tokenizer is defined, form handler swaps number of valid card for token
+	// and strips number and CVC, so raw card data never reaches controllers.
+	//
+	// Data struct {
+	//	 Payment card.Card `form:"payment"`
+	// }
+	Card struct {
+		Number Number `form:"number" validate:"required,luhn" conform:"num"`
+		Expiry string `form:"expiry" validate:"required,cardexpiry" conform:"trim"`
+		CVC    CVC    `form:"cvc" validate:"required,numeric,min=3,max=4" conform:"trim"`
+		Token  string `form:"-"`
+	}
+
+	// Number defines card number (primary account number), which is masked when formatted or encoded
+	Number string
+
+	// CVC defines card verification code, which is masked when formatted or encoded
+	CVC string
+
+	// Tokenizer defines exchange of card data for token (like token of payment service provider)
+	Tokenizer interface {
+		// Tokenize returns token of valid card. If token is empty, card is left as it is.
+		Tokenize(ctx context.Context, card Card) (string, error)
+	}
+)
+
+// Masked returns card number with all digits masked, except last four
+func (n Number) Masked() string {
+	return domain.MaskCardNumber(strings.NewReplacer(" ", "", "-", "").Replace(string(n)))
+}
+
+// String returns masked card number
+func (n Number) String() string {
+	return n.Masked()
+}
+
+// GoString returns masked card number
+func (n Number) GoString() string {
+	return `"` + n.Masked() + `"`
+}
+
+// MarshalJSON encodes masked card number
+func (n Number) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.Masked())
+}
+
+// Masked returns CVC with all digits masked
+func (c CVC) Masked() string {
+	return strings.Repeat("*", len(c))
+}
+
+// String returns masked CVC
+func (c CVC) String() string {
+	return c.Masked()
+}
+
+// GoString returns masked CVC
+func (c CVC) GoString() string {
+	return `"` + c.Masked() + `"`
+}
+
+// MarshalJSON encodes masked CVC
+func (c CVC) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Masked())
+}
@@ -0,0 +1,45 @@
+package card
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	CardTestSuite struct {
+		suite.Suite
+
+		card Card
+	}
+)
+
+func TestCardTestSuite(t *testing.T) {
+	suite.Run(t, &CardTestSuite{})
+}
+
+func (t *CardTestSuite) SetupTest() {
+	t.card = Card{
+		Number: "4111 1111 1111 1111",
+		Expiry: "12/25",
+		CVC:    "123",
+	}
+}
+
+func (t *CardTestSuite) TestMasked() {
+	t.Equal("************1111", t.card.Number.Masked())
+	t.Equal("***", t.card.CVC.Masked())
+}
+
+func (t *CardTestSuite) TestFormat() {
+	t.Equal("{************1111 12/25 *** }", fmt.Sprintf("%v", t.card))
+	t.Equal(`card.Card{Number:"************1111", Expiry:"12/25", CVC:"***", Token:""}`, fmt.Sprintf("%#v", t.card))
+}
+
+func (t *CardTestSuite) TestMarshalJSON() {
+	encoded, err := json.Marshal(t.card)
+	t.NoError(err)
+	t.JSONEq(`{"Number":"************1111","Expiry":"12/25","CVC":"***","Token":""}`, string(encoded))
+}
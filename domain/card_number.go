@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// cardNumberCandidateRegex matches sequences of digits, optionally separated by spaces or dashes, which may be card numbers
+	cardNumberCandidateRegex = regexp.MustCompile(`[0-9](?:[ -]?[0-9]){12,18}`)
+)
+
+// MaskCardNumbers replaces all card numbers (primary account numbers) in text with masked ones, which keep only last
+// four digits. Card number is any sequence of 13 to 19 digits which passes Luhn check, so text can be safely retained
+// or logged.
+func MaskCardNumbers(text string) string {
+	return cardNumberCandidateRegex.ReplaceAllStringFunc(text, func(candidate string) string {
+		digits := strings.NewReplacer(" ", "", "-", "").Replace(candidate)
+		if !CheckLuhn(digits) {
+			return candidate
+		}
+
+		return MaskCardNumber(digits)
+	})
+}
+
+// MaskCardNumber masks all digits of card number, except last four
+func MaskCardNumber(number string) string {
+	if len(number) <= 4 {
+		return strings.Repeat("*", len(number))
+	}
+
+	return strings.Repeat("*", len(number)-4) + number[len(number)-4:]
+}
+
+// CheckLuhn checks if number consists only of digits and passes Luhn check
+func CheckLuhn(number string) bool {
+	if number == "" {
+		return false
+	}
+
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		digit := int(number[i] - '0')
+		if digit < 0 || digit > 9 {
+			return false
+		}
+
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+
+		sum += digit
+		double = !double
+	}
+
+	return sum%10 == 0
+}
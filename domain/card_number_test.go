@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	CardNumberTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestCardNumberTestSuite(t *testing.T) {
+	suite.Run(t, &CardNumberTestSuite{})
+}
+
+func (t *CardNumberTestSuite) TestCheckLuhn() {
+	t.True(CheckLuhn("4111111111111111"))
+	t.True(CheckLuhn("378282246310005"))
+	t.False(CheckLuhn("4111111111111112"))
+	t.False(CheckLuhn("4111 1111 1111 1111"))
+	t.False(CheckLuhn(""))
+}
+
+func (t *CardNumberTestSuite) TestMaskCardNumber() {
+	t.Equal("************1111", MaskCardNumber("4111111111111111"))
+	t.Equal("***", MaskCardNumber("123"))
+}
+
+func (t *CardNumberTestSuite) TestMaskCardNumbers() {
+	t.Equal("card ************1111 declined", MaskCardNumbers("card 4111111111111111 declined"))
+	t.Equal("card ************1111", MaskCardNumbers("card 4111 1111 1111 1111"))
+	t.Equal("card ***********0005", MaskCardNumbers("card 3782-822463-10005"))
+	t.Equal("order 4111111111111112", MaskCardNumbers("order 4111111111111112"))
+	t.Equal("phone 0049 30 1234567", MaskCardNumbers("phone 0049 30 1234567"))
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"crypto/rand"
+	"time"
+)
+
+type (
+	// Clock as interface for source of current time of time dependent features (like minimal fill time or expiry
+	// of confirmation snapshots), so tests can control time instead of sleeping
+	Clock interface {
+		// Now returns current time
+		Now() time.Time
+	}
+
+	// TokenSource as interface for source of random bytes of generated tokens and secrets (like CSRF tokens),
+	// so tests can predict tokens instead of matching them by regular expressions
+	TokenSource interface {
+		// Read fills bytes with random data, same as crypto/rand.Read
+		Read(b []byte) (int, error)
+	}
+)
+
+// CurrentTime returns current time of the clock, or of the system if there is no clock
+func CurrentTime(clock Clock) time.Time {
+	if clock == nil {
+		return time.Now()
+	}
+
+	return clock.Now()
+}
+
+// ReadToken fills token with random bytes of token source, or of crypto/rand if there is no token source
+func ReadToken(tokenSource TokenSource, token []byte) error {
+	if tokenSource == nil {
+		_, err := rand.Read(token)
+		return err
+	}
+
+	_, err := tokenSource.Read(token)
+
+	return err
+}
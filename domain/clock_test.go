@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	ClockTestSuite struct {
+		suite.Suite
+	}
+
+	clockTestTokenSource struct {
+		err error
+	}
+
+	clockTestClock struct {
+		now time.Time
+	}
+)
+
+func TestClockTestSuite(t *testing.T) {
+	suite.Run(t, &ClockTestSuite{})
+}
+
+func (s *clockTestTokenSource) Read(b []byte) (int, error) {
+	for i := range b {
+		b[i] = 7
+	}
+
+	return len(b), s.err
+}
+
+func (c *clockTestClock) Now() time.Time {
+	return c.now
+}
+
+func (t *ClockTestSuite) TestCurrentTime() {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Equal(now, CurrentTime(&clockTestClock{now: now}))
+	t.WithinDuration(time.Now(), CurrentTime(nil), time.Minute)
+}
+
+func (t *ClockTestSuite) TestReadToken() {
+	token := make([]byte, 4)
+
+	t.NoError(ReadToken(&clockTestTokenSource{}, token))
+	t.Equal([]byte{7, 7, 7, 7}, token)
+}
+
+func (t *ClockTestSuite) TestReadToken_Error() {
+	t.EqualError(ReadToken(&clockTestTokenSource{err: errors.New("no randomness")}, make([]byte, 4)), "no randomness")
+}
+
+func (t *ClockTestSuite) TestReadToken_NoTokenSource() {
+	first := make([]byte, 16)
+	second := make([]byte, 16)
+
+	t.NoError(ReadToken(nil, first))
+	t.NoError(ReadToken(nil, second))
+	t.NotEqual(first, second)
+}
@@ -0,0 +1,25 @@
+package domain
+
+type (
+	// ConfirmForm as struct for storing result of two-phase submission with confirmation step.
+	// Beside the Form itself, it contains encrypted snapshot of reviewed submission, which is submitted again to confirm it.
+	ConfirmForm struct {
+		Form
+		// FieldName name of the hidden form field, which carries snapshot token from review to confirmation step
+		FieldName string
+		// Token encrypted single-use snapshot of submitted values, empty if submission is not reviewed as valid
+		Token string
+		// Confirmed flag if submission is handled as confirmation step, from values of the snapshot
+		Confirmed bool
+	}
+)
+
+// IsAwaitingConfirmation defines if valid submission is reviewed, and it should be confirmed by submitting its token
+func (f ConfirmForm) IsAwaitingConfirmation() bool {
+	return f.Token != ""
+}
+
+// IsConfirmed defines if confirmation of reviewed submission is valid, so submission is executed
+func (f ConfirmForm) IsConfirmed() bool {
+	return f.Confirmed && f.IsValidAndSubmitted()
+}
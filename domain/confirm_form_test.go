@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	ConfirmFormTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestConfirmFormTestSuite(t *testing.T) {
+	suite.Run(t, &ConfirmFormTestSuite{})
+}
+
+func (t *ConfirmFormTestSuite) TestIsAwaitingConfirmation() {
+	t.False(ConfirmForm{}.IsAwaitingConfirmation())
+	t.True(ConfirmForm{Token: "token"}.IsAwaitingConfirmation())
+}
+
+func (t *ConfirmFormTestSuite) TestIsConfirmed() {
+	valid := NewForm(true, nil)
+	invalid := NewForm(true, nil)
+	invalid.ValidationInfo.AddGeneralError("formError.confirmation.invalid", "invalid")
+
+	t.False(ConfirmForm{Form: valid}.IsConfirmed())
+	t.False(ConfirmForm{Form: invalid, Confirmed: true}.IsConfirmed())
+	t.True(ConfirmForm{Form: valid, Confirmed: true}.IsConfirmed())
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"encoding/hex"
+
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+const (
+	// CorrelationIDHeader defines header of incoming request, which carries correlation ID assigned by upstream
+	// systems (like API gateway), so the submission can be traced across systems by the same ID
+	CorrelationIDHeader = "X-Correlation-ID"
+
+	// maxCorrelationIDLength defines maximal length of correlation ID accepted from incoming request
+	maxCorrelationIDLength = 128
+)
+
+type (
+	// correlationIDKey as key of request value, under which correlation ID of handled submission is stored
+	correlationIDKey struct{}
+)
+
+// StartCorrelation assigns correlation ID to the submission which is handled next, and stores it into the request.
+// Valid correlation ID of incoming request header is reused, otherwise new random ID is read from token source.
+func StartCorrelation(req *web.Request, tokenSource TokenSource) string {
+	if req == nil {
+		return ""
+	}
+
+	correlationID := ""
+	if httpRequest := req.Request(); httpRequest != nil {
+		correlationID = httpRequest.Header.Get(CorrelationIDHeader)
+	}
+
+	if !isValidCorrelationID(correlationID) {
+		correlationID = generateCorrelationID(tokenSource)
+	}
+
+	req.Values.Store(correlationIDKey{}, correlationID)
+
+	return correlationID
+}
+
+// CorrelationIDFromRequest returns correlation ID of the submission handled by the request,
+// or empty string if there is none
+func CorrelationIDFromRequest(req *web.Request) string {
+	if req == nil {
+		return ""
+	}
+
+	value, _ := req.Values.Load(correlationIDKey{})
+	correlationID, _ := value.(string)
+
+	return correlationID
+}
+
+// isValidCorrelationID checks if correlation ID contains only letters, digits and separators,
+// so it can be safely written into logs and headers of other systems
+func isValidCorrelationID(correlationID string) bool {
+	if correlationID == "" || len(correlationID) > maxCorrelationIDLength {
+		return false
+	}
+
+	for _, char := range correlationID {
+		switch {
+		case char >= 'a' && char <= 'z', char >= 'A' && char <= 'Z', char >= '0' && char <= '9':
+		case char == '-', char == '_', char == '.', char == ':':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// generateCorrelationID returns random correlation ID, or empty string if there is no source of randomness
+func generateCorrelationID(tokenSource TokenSource) string {
+	id := make([]byte, 16)
+	if err := ReadToken(tokenSource, id); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+type (
+	CorrelationTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestCorrelationTestSuite(t *testing.T) {
+	suite.Run(t, &CorrelationTestSuite{})
+}
+
+func (t *CorrelationTestSuite) TestStartCorrelation() {
+	request := web.CreateRequest(&http.Request{Header: http.Header{}}, nil)
+	t.Empty(CorrelationIDFromRequest(request))
+
+	tokenSource := strings.NewReader(strings.Repeat("\x01", 16) + strings.Repeat("\x02", 16))
+
+	correlationID := StartCorrelation(request, tokenSource)
+	t.Equal(strings.Repeat("01", 16), correlationID)
+	t.Equal(correlationID, CorrelationIDFromRequest(request))
+
+	t.Equal(strings.Repeat("02", 16), StartCorrelation(request, tokenSource))
+	t.Empty(StartCorrelation(request, tokenSource), "exhausted token source")
+}
+
+func (t *CorrelationTestSuite) TestStartCorrelation_Header() {
+	request := web.CreateRequest(&http.Request{Header: http.Header{
+		http.CanonicalHeaderKey(CorrelationIDHeader): []string{"gateway-4711"},
+	}}, nil)
+
+	t.Equal("gateway-4711", StartCorrelation(request, nil))
+	t.Equal("gateway-4711", CorrelationIDFromRequest(request))
+}
+
+func (t *CorrelationTestSuite) TestStartCorrelation_InvalidHeader() {
+	for _, header := range []string{"line\nbreak", "<script>", strings.Repeat("a", 129)} {
+		request := web.CreateRequest(&http.Request{Header: http.Header{
+			http.CanonicalHeaderKey(CorrelationIDHeader): []string{header},
+		}}, nil)
+
+		t.Regexp(`^[a-f0-9]{32}$`, StartCorrelation(request, nil))
+	}
+}
+
+func (t *CorrelationTestSuite) TestStartCorrelation_NilRequest() {
+	t.Empty(StartCorrelation(nil, nil))
+	t.Empty(CorrelationIDFromRequest(nil))
+}
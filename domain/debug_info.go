@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+type (
+	// DebugInfo as struct for storing inputs and outputs of each form processing stage, captured in debug mode.
+	// It's meant for development tooling and diagnosis of failing tests, and should not be used in production.
+	DebugInfo struct {
+		// RawValues submitted values, before decoding, with values of sensitive fields redacted and card numbers masked
+		RawValues url.Values
+		// DecodedData snapshot of form data right after decoding
+		DecodedData string
+		// ValidationRules all validation rules applied to form
+		ValidationRules map[string][]ValidationRule
+		// ValidationInfo result of form data validation, before form extensions are processed
+		ValidationInfo ValidationInfo
+		// Extensions contributions of all form extensions by their names
+		Extensions map[string]ExtensionDebugInfo
+	}
+
+	// ExtensionDebugInfo as struct for storing contribution of single form extension, captured in debug mode
+	ExtensionDebugInfo struct {
+		// ProvidedData snapshot of form extension data provided before decoding
+		ProvidedData string
+		// DecodedData snapshot of form extension data right after decoding
+		DecodedData string
+		// ValidationInfo result of form extension data validation
+		ValidationInfo ValidationInfo
+	}
+)
+
+// NewDebugInfo returns new instance of DebugInfo struct with validation rules applied to form
+func NewDebugInfo(validationRules map[string][]ValidationRule) *DebugInfo {
+	rules := make(map[string][]ValidationRule, len(validationRules))
+	for name, fieldRules := range validationRules {
+		rules[name] = append([]ValidationRule(nil), fieldRules...)
+	}
+
+	return &DebugInfo{
+		ValidationRules: rules,
+		Extensions:      map[string]ExtensionDebugInfo{},
+	}
+}
+
+// DebugSnapshot returns snapshot of value at the current processing stage, so later changes (like field encryption)
+// don't affect it. Value is encoded as JSON, or as Go syntax representation, if it can't be encoded.
+// Card numbers are masked in snapshot.
+func DebugSnapshot(value interface{}) string {
+	snapshot, err := json.Marshal(value)
+	if err != nil {
+		return MaskCardNumbers(fmt.Sprintf("%#v", value))
+	}
+
+	return MaskCardNumbers(string(snapshot))
+}
+
+// DebugValidationInfo returns copy of validation info at the current processing stage, so errors attached later
+// (like errors from form extensions) don't affect it
+func DebugValidationInfo(validationInfo ValidationInfo) ValidationInfo {
+	snapshot := ValidationInfo{}
+	snapshot.AppendGeneralErrors(validationInfo.GetGeneralErrors())
+	snapshot.AppendFieldErrors(validationInfo.GetErrorsForAllFields())
+
+	return snapshot
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	DebugInfoTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestDebugInfoTestSuite(t *testing.T) {
+	suite.Run(t, &DebugInfoTestSuite{})
+}
+
+func (t *DebugInfoTestSuite) TestNewDebugInfo() {
+	validationRules := map[string][]ValidationRule{
+		"email": {
+			{
+				Name: "required",
+			},
+		},
+	}
+
+	debugInfo := NewDebugInfo(validationRules)
+	validationRules["email"][0].Name = "email"
+
+	t.Equal(&DebugInfo{
+		ValidationRules: map[string][]ValidationRule{
+			"email": {
+				{
+					Name: "required",
+				},
+			},
+		},
+		Extensions: map[string]ExtensionDebugInfo{},
+	}, debugInfo)
+}
+
+func (t *DebugInfoTestSuite) TestDebugSnapshot() {
+	t.Equal(`{"Name":"name"}`, DebugSnapshot(struct{ Name string }{Name: "name"}))
+	t.Equal(`{"first":"first"}`, DebugSnapshot(map[string]string{"first": "first"}))
+	t.Equal(`{"card":"************1111"}`, DebugSnapshot(map[string]string{"card": "4111111111111111"}))
+	t.Equal("null", DebugSnapshot(nil))
+	t.Equal("(chan int)(nil)", DebugSnapshot((chan int)(nil)))
+}
+
+func (t *DebugInfoTestSuite) TestDebugValidationInfo() {
+	validationInfo := ValidationInfo{}
+	validationInfo.AddFieldError("email", "formError.email.required", "required")
+
+	snapshot := DebugValidationInfo(validationInfo)
+	validationInfo.AddFieldError("email", "formError.email.email", "email")
+	validationInfo.AddGeneralError("formError.general", "general")
+
+	t.Equal([]Error{
+		{
+			MessageKey:   "formError.email.required",
+			DefaultLabel: "required",
+		},
+	}, snapshot.GetErrorsForField("email"))
+	t.False(snapshot.HasGeneralErrors())
+}
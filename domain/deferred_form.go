@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type (
+	// DeferredForm as struct for storing result of deferred form handling.
+	// Beside the Form itself, it contains acknowledgment token of submission pushed to SubmissionQueue.
+	DeferredForm struct {
+		Form
+		// Token acknowledgment token of queued submission, empty if submission is not queued
+		Token string
+	}
+
+	// QueuedSubmission as struct for storing valid submission, which is processed later by consumer of SubmissionQueue
+	QueuedSubmission struct {
+		// Token acknowledgment token of the submission, returned to the user
+		Token string
+		// CorrelationID identifier of the handled submission, same as in logs of the form handler
+		CorrelationID string
+		// Type package qualified name of form data type, so consumers can distinguish submissions of different forms
+		Type string
+		// Timestamp of the submission
+		Timestamp time.Time
+		// Path of the form
+		Path string
+		// Data final form data encoded as JSON
+		Data json.RawMessage
+	}
+)
+
+// IsQueued defines if valid submission is pushed to SubmissionQueue for later processing
+func (f DeferredForm) IsQueued() bool {
+	return f.Token != ""
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	DeferredFormTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestDeferredFormTestSuite(t *testing.T) {
+	suite.Run(t, &DeferredFormTestSuite{})
+}
+
+func (t *DeferredFormTestSuite) TestIsQueued() {
+	t.False(DeferredForm{}.IsQueued())
+	t.True(DeferredForm{Token: "token"}.IsQueued())
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"sync"
+
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+type (
+	// Degradation as struct for storing optional subsystem which was skipped during form handling (like captcha
+	// verification while captcha service is down, or non-fatal failure of form data enrichment), so controllers
+	// and monitoring can distinguish clean results from degraded ones
+	Degradation struct {
+		// Subsystem name of the skipped subsystem, like name of form extension ("formExtension.captcha")
+		Subsystem string
+		// Reason describes why the subsystem was skipped
+		Reason string
+	}
+
+	// degradationsKey as key of request value, under which collector of degradations is stored
+	degradationsKey struct{}
+
+	// degradationCollector collects degradations reported during handling of single form
+	degradationCollector struct {
+		mutex        sync.Mutex
+		degradations []Degradation
+	}
+)
+
+// StartDegradations stores new collector of degradations into the request, so degradations reported
+// by ReportDegradation are collected for the form which is handled next. Degradations collected for previously
+// handled form of the same request are discarded.
+func StartDegradations(req *web.Request) {
+	if req == nil {
+		return
+	}
+
+	req.Values.Store(degradationsKey{}, &degradationCollector{})
+}
+
+// ReportDegradation reports that optional subsystem was skipped during form handling, so the degradation is
+// recorded on the handled Form. Degradations reported without collector in the request are ignored.
+func ReportDegradation(req *web.Request, subsystem string, reason string) {
+	collector := degradationCollectorFromRequest(req)
+	if collector == nil {
+		return
+	}
+
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+
+	collector.degradations = append(collector.degradations, Degradation{
+		Subsystem: subsystem,
+		Reason:    reason,
+	})
+}
+
+// DegradationsFromRequest returns all degradations reported for the request, or nil if there is none
+func DegradationsFromRequest(req *web.Request) []Degradation {
+	collector := degradationCollectorFromRequest(req)
+	if collector == nil {
+		return nil
+	}
+
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+
+	if len(collector.degradations) == 0 {
+		return nil
+	}
+
+	return append([]Degradation{}, collector.degradations...)
+}
+
+// degradationCollectorFromRequest returns collector of degradations stored in the request, or nil if there is none
+func degradationCollectorFromRequest(req *web.Request) *degradationCollector {
+	if req == nil {
+		return nil
+	}
+
+	value, _ := req.Values.Load(degradationsKey{})
+	collector, _ := value.(*degradationCollector)
+
+	return collector
+}
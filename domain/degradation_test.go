@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+type (
+	DegradationTestSuite struct {
+		suite.Suite
+
+		request *web.Request
+	}
+)
+
+func TestDegradationTestSuite(t *testing.T) {
+	suite.Run(t, &DegradationTestSuite{})
+}
+
+func (t *DegradationTestSuite) SetupTest() {
+	t.request = web.CreateRequest(&http.Request{}, nil)
+}
+
+func (t *DegradationTestSuite) TestReportDegradation() {
+	StartDegradations(t.request)
+	t.Nil(DegradationsFromRequest(t.request))
+
+	ReportDegradation(t.request, "formExtension.captcha", "captcha service unavailable")
+	ReportDegradation(t.request, "formEnrichment", "geo-coding failed")
+
+	t.Equal([]Degradation{
+		{
+			Subsystem: "formExtension.captcha",
+			Reason:    "captcha service unavailable",
+		},
+		{
+			Subsystem: "formEnrichment",
+			Reason:    "geo-coding failed",
+		},
+	}, DegradationsFromRequest(t.request))
+
+	StartDegradations(t.request)
+	t.Nil(DegradationsFromRequest(t.request))
+}
+
+func (t *DegradationTestSuite) TestReportDegradation_WithoutCollector() {
+	ReportDegradation(t.request, "formExtension.captcha", "captcha service unavailable")
+	ReportDegradation(nil, "formExtension.captcha", "captcha service unavailable")
+	StartDegradations(nil)
+
+	t.Nil(DegradationsFromRequest(t.request))
+	t.Nil(DegradationsFromRequest(nil))
+}
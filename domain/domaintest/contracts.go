@@ -0,0 +1,325 @@
+// Package domaintest provides conformance tests for custom implementations of form components (like providers,
+// decoders, validators and form extensions), which verify contracts of domain interfaces the form handler relies on
+package domaintest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+// concurrentCalls number of concurrent calls of component, which verify that it can be shared between requests
+const concurrentCalls = 8
+
+// unknownValueName name of submitted value, which is not part of any form data
+const unknownValueName = "domaintest.unknown"
+
+// RunFormDataProviderTests verifies that form data provider returns form data without error, and that it returns new
+// instance of form data for each call, so form data decoded for one request doesn't leak into another one.
+// Provider must be safe for concurrent use, and providers implementing domain.UserSpecificFormDataProvider
+// must report the same hint for each call.
+func RunFormDataProviderTests(t *testing.T, provider domain.FormDataProvider) {
+	t.Helper()
+
+	t.Run("GetFormData", func(t *testing.T) {
+		formData, err := provider.GetFormData(context.Background(), newRequest(nil))
+		assert.NoError(t, err)
+		assert.NotNil(t, formData, "form data must not be nil")
+	})
+
+	t.Run("NewInstances", func(t *testing.T) {
+		first, err := provider.GetFormData(context.Background(), newRequest(nil))
+		assert.NoError(t, err)
+
+		second, err := provider.GetFormData(context.Background(), newRequest(nil))
+		assert.NoError(t, err)
+
+		assert.False(t, isSameInstance(first, second), "form data must not be shared between calls")
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		runConcurrently(t, func() error {
+			_, err := provider.GetFormData(context.Background(), newRequest(nil))
+			return err
+		})
+	})
+
+	if userSpecific, ok := provider.(domain.UserSpecificFormDataProvider); ok {
+		t.Run("IsUserSpecific", func(t *testing.T) {
+			assert.Equal(t, userSpecific.IsUserSpecific(), userSpecific.IsUserSpecific(), "hint must not change")
+		})
+	}
+}
+
+// RunFormDataDecoderTests verifies that form data decoder decodes submitted values into form data of the same type,
+// as it's passed form data returned by provider. Decoder must accept empty and unknown values, must not modify
+// submitted values, and must return the same result for the same values. Decoder must be safe for concurrent use,
+// which is verified for form data passed by value.
+func RunFormDataDecoderTests(t *testing.T, decoder domain.FormDataDecoder, formData interface{}, values url.Values) {
+	t.Helper()
+
+	t.Run("Decode", func(t *testing.T) {
+		decoded, err := decoder.Decode(context.Background(), newRequest(values), copyValues(values), formData)
+		assert.NoError(t, err)
+		assert.Equal(t, reflect.TypeOf(formData), reflect.TypeOf(decoded), "decoded form data must be of the same type")
+	})
+
+	t.Run("EmptyValues", func(t *testing.T) {
+		_, err := decoder.Decode(context.Background(), newRequest(nil), url.Values{}, formData)
+		assert.NoError(t, err)
+	})
+
+	t.Run("UnknownValues", func(t *testing.T) {
+		unknown := copyValues(values)
+		unknown.Set(unknownValueName, "value")
+
+		_, err := decoder.Decode(context.Background(), newRequest(unknown), unknown, formData)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ValuesUnchanged", func(t *testing.T) {
+		submitted := copyValues(values)
+
+		_, err := decoder.Decode(context.Background(), newRequest(values), submitted, formData)
+		assert.NoError(t, err)
+		assert.Equal(t, copyValues(values), submitted, "submitted values must not be modified")
+	})
+
+	t.Run("Deterministic", func(t *testing.T) {
+		first, err := decoder.Decode(context.Background(), newRequest(values), copyValues(values), formData)
+		assert.NoError(t, err)
+
+		second, err := decoder.Decode(context.Background(), newRequest(values), copyValues(values), formData)
+		assert.NoError(t, err)
+
+		assert.Equal(t, first, second, "same values must be decoded into same form data")
+	})
+
+	if reflect.ValueOf(formData).Kind() != reflect.Ptr {
+		t.Run("Concurrent", func(t *testing.T) {
+			runConcurrently(t, func() error {
+				_, err := decoder.Decode(context.Background(), newRequest(values), copyValues(values), formData)
+				return err
+			})
+		})
+	}
+}
+
+// RunFormDataValidatorTests verifies that form data validator returns validation info without error, as validation
+// info of form extensions is attached to the form without nil checks. Field errors must have field names and message
+// keys, and general errors must have message keys. Validator must not modify form data, must return the same result
+// for the same form data and must be safe for concurrent use.
+func RunFormDataValidatorTests(t *testing.T, validator domain.FormDataValidator, validatorProvider domain.ValidatorProvider, formData interface{}) {
+	t.Helper()
+
+	t.Run("Validate", func(t *testing.T) {
+		validationInfo, err := validator.Validate(context.Background(), newRequest(nil), validatorProvider, formData)
+		assert.NoError(t, err)
+		if !assert.NotNil(t, validationInfo, "validation info must not be nil") {
+			return
+		}
+
+		for fieldName, errs := range validationInfo.GetErrorsForAllFields() {
+			assert.NotEmpty(t, fieldName, "field errors must have field name")
+			for _, fieldError := range errs {
+				assert.NotEmpty(t, fieldError.MessageKey, "field error of %q must have message key", fieldName)
+			}
+		}
+
+		for _, generalError := range validationInfo.GetGeneralErrors() {
+			assert.NotEmpty(t, generalError.MessageKey, "general error must have message key")
+		}
+	})
+
+	t.Run("FormDataUnchanged", func(t *testing.T) {
+		snapshot := shallowCopy(formData)
+
+		_, err := validator.Validate(context.Background(), newRequest(nil), validatorProvider, formData)
+		assert.NoError(t, err)
+		assert.Equal(t, snapshot, shallowCopy(formData), "form data must not be modified")
+	})
+
+	t.Run("Deterministic", func(t *testing.T) {
+		first, err := validator.Validate(context.Background(), newRequest(nil), validatorProvider, formData)
+		assert.NoError(t, err)
+
+		second, err := validator.Validate(context.Background(), newRequest(nil), validatorProvider, formData)
+		assert.NoError(t, err)
+
+		if first != nil && second != nil {
+			assert.Equal(t, first.GetErrorsForAllFields(), second.GetErrorsForAllFields())
+			assert.Equal(t, first.GetGeneralErrors(), second.GetGeneralErrors())
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		runConcurrently(t, func() error {
+			_, err := validator.Validate(context.Background(), newRequest(nil), validatorProvider, formData)
+			return err
+		})
+	})
+}
+
+// RunFormExtensionTests verifies that form extension implements at least one interface of form extensions, and runs
+// conformance tests of all implemented ones. Decoder receives form data of provider (or map[string]string like
+// default provider), and validator receives form data decoded from submitted values. Validity gate must not return
+// veto together with error, and veto must have message key. Result observer must accept valid and invalid forms.
+// Priority and read-only hint must not change.
+func RunFormExtensionTests(t *testing.T, formExtension domain.FormExtension, validatorProvider domain.ValidatorProvider, values url.Values) {
+	t.Helper()
+
+	provider, isProvider := formExtension.(domain.FormDataProvider)
+	decoder, isDecoder := formExtension.(domain.FormDataDecoder)
+	validator, isValidator := formExtension.(domain.FormDataValidator)
+	gate, isGate := formExtension.(domain.FormValidityGate)
+	observer, isObserver := formExtension.(domain.FormResultObserver)
+
+	t.Run("Interfaces", func(t *testing.T) {
+		assert.True(t, isProvider || isDecoder || isValidator || isGate || isObserver,
+			"form extension must implement FormDataProvider, FormDataDecoder, FormDataValidator, FormValidityGate or FormResultObserver")
+	})
+
+	var formData interface{} = map[string]string{}
+	if isProvider {
+		t.Run("FormDataProvider", func(t *testing.T) {
+			RunFormDataProviderTests(t, provider)
+		})
+
+		if provided, err := provider.GetFormData(context.Background(), newRequest(nil)); err == nil {
+			formData = provided
+		}
+	}
+
+	if isDecoder {
+		providedData := formData
+		t.Run("FormDataDecoder", func(t *testing.T) {
+			RunFormDataDecoderTests(t, decoder, providedData, values)
+		})
+
+		if decoded, err := decoder.Decode(context.Background(), newRequest(values), copyValues(values), formData); err == nil {
+			formData = decoded
+		}
+	}
+
+	if isValidator {
+		decodedData := formData
+		t.Run("FormDataValidator", func(t *testing.T) {
+			RunFormDataValidatorTests(t, validator, validatorProvider, decodedData)
+		})
+	}
+
+	if isGate {
+		t.Run("GateFormValidity", func(t *testing.T) {
+			form := domain.NewForm(true, nil)
+			form.Data = formData
+
+			veto, err := gate.GateFormValidity(context.Background(), newRequest(values), copyValues(values), &form)
+			if err != nil {
+				assert.Nil(t, veto, "veto must not be returned together with error")
+			}
+			if veto != nil {
+				assert.NotEmpty(t, veto.MessageKey, "veto must have message key")
+			}
+		})
+	}
+
+	if isObserver {
+		t.Run("ObserveFormResult", func(t *testing.T) {
+			valid := domain.NewForm(true, nil)
+			valid.Data = formData
+			assert.NoError(t, observer.ObserveFormResult(context.Background(), newRequest(values), copyValues(values), &valid))
+
+			invalid := domain.NewForm(true, nil)
+			invalid.Data = formData
+			invalid.ValidationInfo.AddGeneralError("formError.domaintest", "invalid form")
+			assert.NoError(t, observer.ObserveFormResult(context.Background(), newRequest(values), copyValues(values), &invalid))
+		})
+	}
+
+	if prioritized, ok := formExtension.(domain.PrioritizedFormExtension); ok {
+		t.Run("Priority", func(t *testing.T) {
+			assert.Equal(t, prioritized.Priority(), prioritized.Priority(), "priority must not change")
+		})
+	}
+
+	if readOnly, ok := formExtension.(domain.ReadOnlyFormExtension); ok {
+		t.Run("IsReadOnly", func(t *testing.T) {
+			assert.Equal(t, readOnly.IsReadOnly(), readOnly.IsReadOnly(), "read-only hint must not change")
+		})
+	}
+}
+
+// newRequest creates POST request with url encoded values
+func newRequest(values url.Values) *web.Request {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(values.Encode()))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return web.CreateRequest(request, nil)
+}
+
+// copyValues returns deep copy of values, which is never nil
+func copyValues(values url.Values) url.Values {
+	copied := make(url.Values, len(values))
+	for key, list := range values {
+		copied[key] = append([]string{}, list...)
+	}
+
+	return copied
+}
+
+// isSameInstance checks if both form data are the same pointer, map or slice
+func isSameInstance(first interface{}, second interface{}) bool {
+	firstValue, secondValue := reflect.ValueOf(first), reflect.ValueOf(second)
+	if !firstValue.IsValid() || !secondValue.IsValid() || firstValue.Type() != secondValue.Type() {
+		return false
+	}
+
+	switch firstValue.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice:
+		return !firstValue.IsNil() && firstValue.Pointer() == secondValue.Pointer()
+	}
+
+	return false
+}
+
+// shallowCopy returns copy of value which form data pointer points to, or form data itself for other kinds
+func shallowCopy(formData interface{}) interface{} {
+	valueOf := reflect.ValueOf(formData)
+	if valueOf.Kind() != reflect.Ptr || valueOf.IsNil() {
+		return formData
+	}
+
+	return valueOf.Elem().Interface()
+}
+
+// runConcurrently calls function concurrently, and reports errors of all calls
+func runConcurrently(t *testing.T, call func() error) {
+	t.Helper()
+
+	errs := make(chan error, concurrentCalls)
+
+	var group sync.WaitGroup
+	for i := 0; i < concurrentCalls; i++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			errs <- call()
+		}()
+	}
+	group.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+}
@@ -0,0 +1,103 @@
+package domaintest
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/formdata"
+)
+
+type (
+	ContractsTestSuite struct {
+		suite.Suite
+	}
+
+	contractsTestExtension struct{}
+
+	contractsTestFormData struct {
+		Name string `form:"name"`
+	}
+)
+
+var (
+	_ domain.FormDataProvider         = &contractsTestExtension{}
+	_ domain.FormDataValidator        = &contractsTestExtension{}
+	_ domain.FormValidityGate         = &contractsTestExtension{}
+	_ domain.FormResultObserver       = &contractsTestExtension{}
+	_ domain.PrioritizedFormExtension = &contractsTestExtension{}
+)
+
+func TestContractsTestSuite(t *testing.T) {
+	suite.Run(t, &ContractsTestSuite{})
+}
+
+func (e *contractsTestExtension) GetFormData(context.Context, *web.Request) (interface{}, error) {
+	return &contractsTestFormData{}, nil
+}
+
+func (e *contractsTestExtension) Validate(_ context.Context, _ *web.Request, _ domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	validationInfo := &domain.ValidationInfo{}
+	if formData.(*contractsTestFormData).Name == "" {
+		validationInfo.AddFieldError("name", "formError.name.required", "name is required")
+	}
+
+	return validationInfo, nil
+}
+
+func (e *contractsTestExtension) GateFormValidity(context.Context, *web.Request, url.Values, *domain.Form) (*domain.Error, error) {
+	return &domain.Error{MessageKey: "formError.gate", DefaultLabel: "gate"}, nil
+}
+
+func (e *contractsTestExtension) ObserveFormResult(context.Context, *web.Request, url.Values, *domain.Form) error {
+	return nil
+}
+
+func (e *contractsTestExtension) Priority() int {
+	return 10
+}
+
+func (t *ContractsTestSuite) TestRunFormDataProviderTests() {
+	RunFormDataProviderTests(t.T(), &formdata.DefaultFormDataProviderImpl{})
+}
+
+func (t *ContractsTestSuite) TestRunFormDataDecoderTests() {
+	values := url.Values{"name": []string{"John"}}
+
+	RunFormDataDecoderTests(t.T(), &formdata.DefaultFormDataDecoderImpl{}, map[string]string{}, values)
+	RunFormDataDecoderTests(t.T(), &formdata.DefaultFormDataDecoderImpl{}, contractsTestFormData{}, values)
+}
+
+func (t *ContractsTestSuite) TestRunFormDataValidatorTests() {
+	RunFormDataValidatorTests(t.T(), &formdata.DefaultFormDataValidatorImpl{}, nil, map[string]string{})
+}
+
+func (t *ContractsTestSuite) TestRunFormExtensionTests() {
+	RunFormExtensionTests(t.T(), &contractsTestExtension{}, nil, url.Values{"name": []string{"John"}})
+}
+
+func (t *ContractsTestSuite) TestIsSameInstance() {
+	formData := &contractsTestFormData{}
+	values := map[string]string{}
+
+	t.True(isSameInstance(formData, formData))
+	t.False(isSameInstance(formData, &contractsTestFormData{}))
+	t.True(isSameInstance(values, values))
+	t.False(isSameInstance(values, map[string]string{}))
+	t.False(isSameInstance(contractsTestFormData{}, contractsTestFormData{}))
+	t.False(isSameInstance(nil, nil))
+}
+
+func (t *ContractsTestSuite) TestCopyValues() {
+	values := url.Values{"name": []string{"John"}}
+
+	copied := copyValues(values)
+	copied["name"][0] = "Jane"
+
+	t.Equal(url.Values{"name": []string{"John"}}, values)
+	t.Equal(url.Values{}, copyValues(nil))
+}
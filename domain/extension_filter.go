@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"sync"
+
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+type (
+	// extensionFilterKey as key of request value, under which form extensions skipped or enabled for the request are stored
+	extensionFilterKey struct{}
+
+	// extensionFilter collects form extensions skipped or enabled for single request
+	extensionFilter struct {
+		mutex   sync.Mutex
+		enabled map[string]bool
+	}
+)
+
+// SkipFormExtensions marks named form extensions to be skipped by all form handlers for the rest of the request
+// (like captcha for authenticated users). Skipped form extensions are not processed at all, same as form extensions
+// disabled by feature flags.
+func SkipFormExtensions(req *web.Request, names ...string) {
+	filterFormExtensions(req, false, names)
+}
+
+// EnableFormExtensions marks named form extensions to be processed by all form handlers for the rest of the request,
+// even if they are disabled by feature flags or skipped before. Form extensions which are not added to form handler
+// are not added by enabling them.
+func EnableFormExtensions(req *web.Request, names ...string) {
+	filterFormExtensions(req, true, names)
+}
+
+// FormExtensionOverride returns if named form extension is enabled or skipped for the request, and false as second
+// value if it's neither enabled nor skipped
+func FormExtensionOverride(req *web.Request, name string) (enabled bool, ok bool) {
+	if req == nil {
+		return false, false
+	}
+
+	stored, found := req.Values.Load(extensionFilterKey{})
+	if !found {
+		return false, false
+	}
+
+	filter := stored.(*extensionFilter)
+	filter.mutex.Lock()
+	defer filter.mutex.Unlock()
+
+	enabled, ok = filter.enabled[name]
+
+	return enabled, ok
+}
+
+// filterFormExtensions stores named form extensions as enabled or skipped into the request
+func filterFormExtensions(req *web.Request, enabled bool, names []string) {
+	if req == nil {
+		return
+	}
+
+	stored, _ := req.Values.LoadOrStore(extensionFilterKey{}, &extensionFilter{enabled: map[string]bool{}})
+	filter := stored.(*extensionFilter)
+	filter.mutex.Lock()
+	defer filter.mutex.Unlock()
+
+	for _, name := range names {
+		filter.enabled[name] = enabled
+	}
+}
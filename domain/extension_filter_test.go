@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+type (
+	ExtensionFilterTestSuite struct {
+		suite.Suite
+
+		request *web.Request
+	}
+)
+
+func TestExtensionFilterTestSuite(t *testing.T) {
+	suite.Run(t, &ExtensionFilterTestSuite{})
+}
+
+func (t *ExtensionFilterTestSuite) SetupTest() {
+	t.request = web.CreateRequest(&http.Request{}, nil)
+}
+
+func (t *ExtensionFilterTestSuite) TestFormExtensionOverride() {
+	_, ok := FormExtensionOverride(t.request, "formExtension.captcha")
+	t.False(ok)
+
+	SkipFormExtensions(t.request, "formExtension.captcha", "formExtension.rateLimit")
+	EnableFormExtensions(t.request, "formExtension.newsletter", "formExtension.rateLimit")
+
+	enabled, ok := FormExtensionOverride(t.request, "formExtension.captcha")
+	t.True(ok)
+	t.False(enabled)
+
+	enabled, ok = FormExtensionOverride(t.request, "formExtension.rateLimit")
+	t.True(ok)
+	t.True(enabled)
+
+	enabled, ok = FormExtensionOverride(t.request, "formExtension.newsletter")
+	t.True(ok)
+	t.True(enabled)
+
+	_, ok = FormExtensionOverride(t.request, "formExtension.csrfToken")
+	t.False(ok)
+}
+
+func (t *ExtensionFilterTestSuite) TestFormExtensionOverride_NilRequest() {
+	SkipFormExtensions(nil, "formExtension.captcha")
+	EnableFormExtensions(nil, "formExtension.captcha")
+
+	_, ok := FormExtensionOverride(nil, "formExtension.captcha")
+	t.False(ok)
+}
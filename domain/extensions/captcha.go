@@ -0,0 +1,208 @@
+package extensions
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// CaptchaVerifier defines verification of captcha responses by captcha service
+	CaptchaVerifier interface {
+		// VerifyCaptcha returns if captcha response submitted from the client IP is valid
+		VerifyCaptcha(ctx context.Context, response string, remoteIP string) (bool, error)
+	}
+
+	// CaptchaProvider defines captcha service with "siteverify" API (like Google reCAPTCHA or hCaptcha),
+	// selected by configured name of the provider
+	CaptchaProvider struct {
+		// FieldName name of the form field, which carries captcha response submitted by widget of the service
+		FieldName string
+		// VerifyURL URL of "siteverify" API of the service
+		VerifyURL string
+	}
+
+	// CaptchaExtension defines form extension which requires valid captcha response with submission.
+	// Captcha widget is rendered by using site key and it submits response via form field of configured provider,
+	// unless form field is configured explicitly.
+	// If captcha service is not available, submission is rejected with general error. In fail-open mode, verification
+	// is skipped instead, and recorded as degradation of the form. Verifier which implements domain.DependencyStatus
+	// (like circuit breaker) and reports unavailability is not called at all.
+	//
+	// formHandler := c.formHandlerFactory.CreateFormHandlerWithFormService(c.formService, "formExtension.captcha")
+	//
+	// ...
+	//
+	// <div class="g-recaptcha" data-sitekey="{{ form.FormExtensionsData["formExtension.captcha"].SiteKey }}"></div>
+	//
+	CaptchaExtension struct {
+		verifier  CaptchaVerifier
+		provider  string
+		fieldName string
+		siteKey   string
+		failOpen  bool
+	}
+
+	// CaptchaFormData defines form data provided by CaptchaExtension
+	CaptchaFormData struct {
+		// Provider name of captcha provider, used for rendering of matching widget
+		Provider string
+		// FieldName name of the form field which carries captcha response
+		FieldName string
+		// SiteKey public key of the captcha widget
+		SiteKey string
+		// response captcha response received via form submission
+		response string
+		// remoteIP client IP of the submission
+		remoteIP string
+	}
+)
+
+const (
+	// CaptchaProviderReCaptcha defines name of Google reCAPTCHA provider, for both v2 and v3
+	CaptchaProviderReCaptcha = "recaptcha"
+	// CaptchaProviderHCaptcha defines name of hCaptcha provider
+	CaptchaProviderHCaptcha = "hcaptcha"
+	// CaptchaProviderTurnstile defines name of Cloudflare Turnstile provider
+	CaptchaProviderTurnstile = "turnstile"
+)
+
+var (
+	captchaProviders = map[string]CaptchaProvider{
+		CaptchaProviderReCaptcha: {
+			FieldName: "g-recaptcha-response",
+			VerifyURL: "https://www.google.com/recaptcha/api/siteverify",
+		},
+		CaptchaProviderHCaptcha: {
+			FieldName: "h-captcha-response",
+			VerifyURL: "https://api.hcaptcha.com/siteverify",
+		},
+		CaptchaProviderTurnstile: {
+			FieldName: "cf-turnstile-response",
+			VerifyURL: "https://challenges.cloudflare.com/turnstile/v0/siteverify",
+		},
+	}
+)
+
+var (
+	_ domain.FormDataProvider  = &CaptchaExtension{}
+	_ domain.FormDataDecoder   = &CaptchaExtension{}
+	_ domain.FormDataValidator = &CaptchaExtension{}
+	_ domain.DependencyStatus  = &CaptchaExtension{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (e *CaptchaExtension) Inject(
+	verifier CaptchaVerifier,
+	cfg *struct {
+		Provider  string `inject:"config:form.captcha.provider"`
+		FieldName string `inject:"config:form.captcha.fieldName"`
+		SiteKey   string `inject:"config:form.captcha.siteKey"`
+		FailOpen  bool   `inject:"config:form.captcha.failOpen"`
+	},
+) {
+	provider, err := GetCaptchaProvider(cfg.Provider)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	e.verifier = verifier
+	e.provider = cfg.Provider
+	e.fieldName = provider.FieldName
+	if cfg.FieldName != "" {
+		e.fieldName = cfg.FieldName
+	}
+	e.siteKey = cfg.SiteKey
+	e.failOpen = cfg.FailOpen
+}
+
+// GetCaptchaProvider returns captcha provider of the name. Empty name stands for custom captcha service,
+// which is defined by configured form field and verification URL only.
+func GetCaptchaProvider(name string) (CaptchaProvider, error) {
+	if name == "" {
+		return CaptchaProvider{}, nil
+	}
+
+	provider, ok := captchaProviders[name]
+	if !ok {
+		return CaptchaProvider{}, domain.NewFormErrorf("unknown captcha provider %q", name)
+	}
+
+	return provider, nil
+}
+
+// GetFormData provides name of captcha provider and response field, and site key of captcha widget
+func (e *CaptchaExtension) GetFormData(context.Context, *web.Request) (interface{}, error) {
+	return CaptchaFormData{
+		Provider:  e.provider,
+		FieldName: e.fieldName,
+		SiteKey:   e.siteKey,
+	}, nil
+}
+
+// Decode extracts submitted captcha response and client IP
+func (e *CaptchaExtension) Decode(_ context.Context, req *web.Request, values url.Values, _ interface{}) (interface{}, error) {
+	data := CaptchaFormData{
+		Provider:  e.provider,
+		FieldName: e.fieldName,
+		SiteKey:   e.siteKey,
+		response:  strings.TrimSpace(values.Get(e.fieldName)),
+	}
+
+	if req != nil {
+		data.remoteIP = clientIP(req)
+	}
+
+	return data, nil
+}
+
+// Validate verifies submitted captcha response via CaptchaVerifier
+func (e *CaptchaExtension) Validate(ctx context.Context, req *web.Request, _ domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	data, ok := formData.(CaptchaFormData)
+	if !ok {
+		return nil, domain.NewFormErrorf("unexpected captcha form data: %#v", formData)
+	}
+
+	validationInfo := &domain.ValidationInfo{}
+
+	if data.response == "" {
+		validationInfo.AddFieldError(data.FieldName, "formError.captcha.required", "Captcha is required")
+		return validationInfo, nil
+	}
+
+	if alive, details := dependencyStatus(e.verifier); !alive {
+		return e.unavailable(req, validationInfo, details), nil
+	}
+
+	valid, err := e.verifier.VerifyCaptcha(ctx, data.response, data.remoteIP)
+	if err != nil {
+		return e.unavailable(req, validationInfo, err.Error()), nil
+	}
+
+	if !valid {
+		validationInfo.AddFieldError(data.FieldName, "formError.captcha.invalid", "Captcha is not valid")
+	}
+
+	return validationInfo, nil
+}
+
+// unavailable rejects submission with general error if captcha service is not available,
+// or records degradation of the form in fail-open mode
+func (e *CaptchaExtension) unavailable(req *web.Request, validationInfo *domain.ValidationInfo, reason string) *domain.ValidationInfo {
+	if e.failOpen {
+		domain.ReportDegradation(req, "formExtension.captcha", "captcha verification skipped: "+reason)
+		return validationInfo
+	}
+
+	validationInfo.AddGeneralError("formError.captcha.unavailable", "Captcha can't be verified, please try again later")
+
+	return validationInfo
+}
+
+// Status reports availability of captcha service
+func (e *CaptchaExtension) Status() (bool, string) {
+	return dependencyStatus(e.verifier)
+}
@@ -0,0 +1,251 @@
+package extensions
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	CaptchaExtensionTestSuite struct {
+		suite.Suite
+
+		extension *CaptchaExtension
+		verifier  *captchaTestVerifier
+
+		context context.Context
+		request *web.Request
+	}
+
+	captchaTestVerifier struct {
+		valid     bool
+		err       error
+		responses []string
+		remoteIPs []string
+	}
+
+	captchaTestCircuitBreaker struct {
+		captchaTestVerifier
+		open bool
+	}
+)
+
+func (v *captchaTestCircuitBreaker) Status() (bool, string) {
+	if v.open {
+		return false, "circuit is open"
+	}
+
+	return true, ""
+}
+
+func (v *captchaTestVerifier) VerifyCaptcha(_ context.Context, response string, remoteIP string) (bool, error) {
+	v.responses = append(v.responses, response)
+	v.remoteIPs = append(v.remoteIPs, remoteIP)
+	return v.valid, v.err
+}
+
+func TestCaptchaExtensionTestSuite(t *testing.T) {
+	suite.Run(t, &CaptchaExtensionTestSuite{})
+}
+
+func (t *CaptchaExtensionTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *CaptchaExtensionTestSuite) SetupTest() {
+	t.verifier = &captchaTestVerifier{}
+	t.extension = &CaptchaExtension{}
+	t.extension.Inject(t.verifier, &struct {
+		Provider  string `inject:"config:form.captcha.provider"`
+		FieldName string `inject:"config:form.captcha.fieldName"`
+		SiteKey   string `inject:"config:form.captcha.siteKey"`
+		FailOpen  bool   `inject:"config:form.captcha.failOpen"`
+	}{
+		FieldName: "g-recaptcha-response",
+		SiteKey:   "site",
+	})
+	t.request = web.CreateRequest(&http.Request{
+		RemoteAddr: "10.0.0.1:52000",
+	}, nil)
+}
+
+func (t *CaptchaExtensionTestSuite) TestGetFormData() {
+	result, err := t.extension.GetFormData(t.context, t.request)
+	t.NoError(err)
+	t.Equal(CaptchaFormData{
+		FieldName: "g-recaptcha-response",
+		SiteKey:   "site",
+	}, result)
+}
+
+func (t *CaptchaExtensionTestSuite) TestGetFormData_Provider() {
+	t.extension.Inject(t.verifier, &struct {
+		Provider  string `inject:"config:form.captcha.provider"`
+		FieldName string `inject:"config:form.captcha.fieldName"`
+		SiteKey   string `inject:"config:form.captcha.siteKey"`
+		FailOpen  bool   `inject:"config:form.captcha.failOpen"`
+	}{
+		Provider: CaptchaProviderHCaptcha,
+		SiteKey:  "site",
+	})
+
+	result, err := t.extension.GetFormData(t.context, t.request)
+	t.NoError(err)
+	t.Equal(CaptchaFormData{
+		Provider:  "hcaptcha",
+		FieldName: "h-captcha-response",
+		SiteKey:   "site",
+	}, result)
+}
+
+func (t *CaptchaExtensionTestSuite) TestInject_UnknownProvider() {
+	t.Panics(func() {
+		t.extension.Inject(t.verifier, &struct {
+			Provider  string `inject:"config:form.captcha.provider"`
+			FieldName string `inject:"config:form.captcha.fieldName"`
+			SiteKey   string `inject:"config:form.captcha.siteKey"`
+			FailOpen  bool   `inject:"config:form.captcha.failOpen"`
+		}{
+			Provider: "unknown",
+		})
+	})
+}
+
+func (t *CaptchaExtensionTestSuite) TestGetCaptchaProvider() {
+	provider, err := GetCaptchaProvider(CaptchaProviderTurnstile)
+	t.NoError(err)
+	t.Equal(CaptchaProvider{
+		FieldName: "cf-turnstile-response",
+		VerifyURL: "https://challenges.cloudflare.com/turnstile/v0/siteverify",
+	}, provider)
+
+	provider, err = GetCaptchaProvider("")
+	t.NoError(err)
+	t.Equal(CaptchaProvider{}, provider)
+
+	_, err = GetCaptchaProvider("unknown")
+	t.Error(err)
+}
+
+func (t *CaptchaExtensionTestSuite) TestValidate() {
+	t.verifier.valid = true
+
+	validationInfo := t.validate(url.Values{
+		"g-recaptcha-response": []string{"response"},
+	})
+	t.True(validationInfo.IsValid())
+	t.Equal([]string{"response"}, t.verifier.responses)
+	t.Equal([]string{"10.0.0.1"}, t.verifier.remoteIPs)
+}
+
+func (t *CaptchaExtensionTestSuite) TestValidate_Required() {
+	validationInfo := t.validate(url.Values{})
+	t.Equal(map[string][]domain.Error{
+		"g-recaptcha-response": {
+			{
+				MessageKey:   "formError.captcha.required",
+				DefaultLabel: "Captcha is required",
+			},
+		},
+	}, validationInfo.GetErrorsForAllFields())
+	t.Empty(t.verifier.responses)
+}
+
+func (t *CaptchaExtensionTestSuite) TestValidate_Invalid() {
+	validationInfo := t.validate(url.Values{
+		"g-recaptcha-response": []string{"response"},
+	})
+	t.Equal(map[string][]domain.Error{
+		"g-recaptcha-response": {
+			{
+				MessageKey:   "formError.captcha.invalid",
+				DefaultLabel: "Captcha is not valid",
+			},
+		},
+	}, validationInfo.GetErrorsForAllFields())
+}
+
+func (t *CaptchaExtensionTestSuite) TestValidate_VerifierError() {
+	t.verifier.err = errors.New("error")
+
+	validationInfo := t.validate(url.Values{
+		"g-recaptcha-response": []string{"response"},
+	})
+	t.Equal([]domain.Error{
+		{
+			MessageKey:   "formError.captcha.unavailable",
+			DefaultLabel: "Captcha can't be verified, please try again later",
+		},
+	}, validationInfo.GetGeneralErrors())
+}
+
+func (t *CaptchaExtensionTestSuite) TestValidate_VerifierErrorFailOpen() {
+	t.verifier.err = errors.New("error")
+	t.extension.failOpen = true
+	domain.StartDegradations(t.request)
+
+	validationInfo := t.validate(url.Values{
+		"g-recaptcha-response": []string{"response"},
+	})
+	t.True(validationInfo.IsValid())
+	t.Equal([]domain.Degradation{
+		{
+			Subsystem: "formExtension.captcha",
+			Reason:    "captcha verification skipped: error",
+		},
+	}, domain.DegradationsFromRequest(t.request))
+}
+
+func (t *CaptchaExtensionTestSuite) TestValidate_CircuitOpen() {
+	verifier := &captchaTestCircuitBreaker{open: true}
+	t.extension.verifier = verifier
+
+	validationInfo := t.validate(url.Values{
+		"g-recaptcha-response": []string{"response"},
+	})
+	t.Empty(verifier.responses)
+	t.Equal([]domain.Error{
+		{
+			MessageKey:   "formError.captcha.unavailable",
+			DefaultLabel: "Captcha can't be verified, please try again later",
+		},
+	}, validationInfo.GetGeneralErrors())
+
+	t.extension.failOpen = true
+	domain.StartDegradations(t.request)
+
+	validationInfo = t.validate(url.Values{
+		"g-recaptcha-response": []string{"response"},
+	})
+	t.Empty(verifier.responses)
+	t.True(validationInfo.IsValid())
+	t.Equal([]domain.Degradation{
+		{
+			Subsystem: "formExtension.captcha",
+			Reason:    "captcha verification skipped: circuit is open",
+		},
+	}, domain.DegradationsFromRequest(t.request))
+}
+
+func (t *CaptchaExtensionTestSuite) TestValidate_WrongFormData() {
+	validationInfo, err := t.extension.Validate(t.context, t.request, nil, map[string]string{})
+	t.Error(err)
+	t.Nil(validationInfo)
+}
+
+func (t *CaptchaExtensionTestSuite) validate(values url.Values) *domain.ValidationInfo {
+	formData, err := t.extension.Decode(t.context, t.request, values, nil)
+	t.NoError(err)
+
+	validationInfo, err := t.extension.Validate(t.context, t.request, nil, formData)
+	t.NoError(err)
+
+	return validationInfo
+}
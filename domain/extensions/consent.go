@@ -0,0 +1,217 @@
+package extensions
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// ConsentStore defines storage for consents captured by ConsentExtension
+	ConsentStore interface {
+		// StoreConsents stores state of all consents captured with a single successful form submission
+		StoreConsents(ctx context.Context, req *web.Request, consents []Consent) error
+	}
+
+	// Consent defines captured state of single consent
+	Consent struct {
+		// Name of the consent, like "privacyPolicy"
+		Name string
+		// Version of the policy which was presented to the user
+		Version string
+		// Granted flag if user gave the consent
+		Granted bool
+		// Locale in which the form was submitted
+		Locale string
+		// Timestamp of the submission
+		Timestamp time.Time
+	}
+
+	// ConsentExtension defines form extension which captures consent checkboxes (like privacy policy acceptance).
+	// Checkbox value must carry version of the policy presented to the user. Required consents are valid only
+	// if they are granted for the current policy version. State of all consents is recorded via ConsentStore
+	// after successful form submission.
+	//
+	// formHandler := c.formHandlerFactory.CreateFormHandlerWithFormService(c.formService, "formExtension.consent")
+	//
+	// ...
+	//
+	// {{ consent := form.FormExtensionsData["formExtension.consent"].Consents.privacyPolicy }}
+	// <input type="checkbox" name="{{ consent.FieldName }}" value="{{ consent.Version }}">
+	//
+	ConsentExtension struct {
+		store       ConsentStore
+		clock       domain.Clock
+		fieldPrefix string
+		consents    map[string]consentDefinition
+	}
+
+	// ConsentFormData defines form data provided by ConsentExtension
+	ConsentFormData struct {
+		// Consents all configured consents by their names
+		Consents map[string]ConsentFormField
+	}
+
+	// ConsentFormField defines state of single consent checkbox
+	ConsentFormField struct {
+		// FieldName name of the checkbox field
+		FieldName string
+		// Version current version of the policy, which should be used as checkbox value
+		Version string
+		// Required flag if consent must be granted
+		Required bool
+		// Granted flag if consent was granted with submission
+		Granted bool
+		// SubmittedVersion policy version submitted as checkbox value
+		SubmittedVersion string
+	}
+
+	consentDefinition struct {
+		Version  string `json:"version"`
+		Required bool   `json:"required"`
+	}
+)
+
+var (
+	_ domain.FormDataProvider      = &ConsentExtension{}
+	_ domain.FormDataDecoder       = &ConsentExtension{}
+	_ domain.FormDataValidator     = &ConsentExtension{}
+	_ domain.FormResultObserver    = &ConsentExtension{}
+	_ domain.ReadOnlyFormExtension = &ConsentExtension{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (e *ConsentExtension) Inject(
+	store ConsentStore,
+	clock domain.Clock,
+	cfg *struct {
+		FieldPrefix string     `inject:"config:form.consent.fieldPrefix"`
+		Consents    config.Map `inject:"config:form.consent.consents"`
+	},
+) {
+	e.store = store
+	e.clock = clock
+	e.fieldPrefix = cfg.FieldPrefix
+
+	consents := map[string]consentDefinition{}
+	if err := cfg.Consents.MapInto(&consents); err != nil {
+		panic(err.Error())
+	}
+	e.consents = consents
+}
+
+// GetFormData provides all configured consents with their current versions
+func (e *ConsentExtension) GetFormData(context.Context, *web.Request) (interface{}, error) {
+	return e.formData(url.Values{}), nil
+}
+
+// Decode captures state of consent checkboxes from submitted values
+func (e *ConsentExtension) Decode(_ context.Context, _ *web.Request, values url.Values, _ interface{}) (interface{}, error) {
+	return e.formData(values), nil
+}
+
+// Validate checks that all required consents are granted for the current policy versions
+func (e *ConsentExtension) Validate(_ context.Context, _ *web.Request, _ domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	data, ok := formData.(ConsentFormData)
+	if !ok {
+		return nil, domain.NewFormErrorf("unexpected consent form data: %#v", formData)
+	}
+
+	validationInfo := &domain.ValidationInfo{}
+
+	for name, field := range data.Consents {
+		if !field.Required {
+			continue
+		}
+
+		if !field.Granted {
+			validationInfo.AddFieldError(field.FieldName, "formError.consent."+name+".required", "Consent "+name+" is required")
+			continue
+		}
+
+		if field.SubmittedVersion != field.Version {
+			validationInfo.AddFieldError(field.FieldName, "formError.consent."+name+".outdated", "Consent "+name+" is given for outdated version")
+		}
+	}
+
+	return validationInfo, nil
+}
+
+// IsReadOnly reports that consents are only recorded by form result observer, so submitted consents are validated
+// by dry-run submissions as well
+func (e *ConsentExtension) IsReadOnly() bool {
+	return true
+}
+
+// ObserveFormResult records state of all consents after successful form submission
+func (e *ConsentExtension) ObserveFormResult(ctx context.Context, req *web.Request, values url.Values, form *domain.Form) error {
+	if !form.IsValidAndSubmitted() {
+		return nil
+	}
+
+	data := e.formData(values)
+	timestamp := domain.CurrentTime(e.clock)
+	locale := requestLocale(req)
+
+	names := make([]string, 0, len(data.Consents))
+	for name := range data.Consents {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	consents := make([]Consent, 0, len(names))
+	for _, name := range names {
+		field := data.Consents[name]
+		consents = append(consents, Consent{
+			Name:      name,
+			Version:   field.Version,
+			Granted:   field.Granted && field.SubmittedVersion == field.Version,
+			Locale:    locale,
+			Timestamp: timestamp,
+		})
+	}
+
+	return e.store.StoreConsents(ctx, req, consents)
+}
+
+// formData creates consent form data from configured consents and submitted values
+func (e *ConsentExtension) formData(values url.Values) ConsentFormData {
+	data := ConsentFormData{
+		Consents: make(map[string]ConsentFormField, len(e.consents)),
+	}
+
+	for name, definition := range e.consents {
+		fieldName := e.fieldPrefix + name
+		submitted := strings.TrimSpace(values.Get(fieldName))
+
+		data.Consents[name] = ConsentFormField{
+			FieldName:        fieldName,
+			Version:          definition.Version,
+			Required:         definition.Required,
+			Granted:          submitted != "",
+			SubmittedVersion: submitted,
+		}
+	}
+
+	return data
+}
+
+// requestLocale returns primary language requested by the client
+func requestLocale(req *web.Request) string {
+	if req == nil {
+		return ""
+	}
+
+	language := req.Request().Header.Get("Accept-Language")
+	if index := strings.IndexAny(language, ",;"); index >= 0 {
+		language = language[:index]
+	}
+
+	return strings.TrimSpace(language)
+}
@@ -0,0 +1,198 @@
+package extensions
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	ConsentExtensionTestSuite struct {
+		suite.Suite
+
+		extension *ConsentExtension
+		store     *consentTestStore
+
+		context context.Context
+		request *web.Request
+	}
+
+	consentTestStore struct {
+		consents []Consent
+		err      error
+	}
+)
+
+func (s *consentTestStore) StoreConsents(_ context.Context, _ *web.Request, consents []Consent) error {
+	s.consents = append(s.consents, consents...)
+	return s.err
+}
+
+func TestConsentExtensionTestSuite(t *testing.T) {
+	suite.Run(t, &ConsentExtensionTestSuite{})
+}
+
+func (t *ConsentExtensionTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *ConsentExtensionTestSuite) SetupTest() {
+	t.store = &consentTestStore{}
+	t.extension = &ConsentExtension{
+		store:       t.store,
+		fieldPrefix: "consent.",
+		consents: map[string]consentDefinition{
+			"privacyPolicy": {
+				Version:  "2020-05",
+				Required: true,
+			},
+			"newsletter": {
+				Version: "1",
+			},
+		},
+	}
+	t.request = web.CreateRequest(&http.Request{
+		Header: http.Header{
+			"Accept-Language": []string{"de-DE,de;q=0.9,en;q=0.8"},
+		},
+	}, nil)
+}
+
+func (t *ConsentExtensionTestSuite) TestGetFormData() {
+	result, err := t.extension.GetFormData(t.context, t.request)
+	t.NoError(err)
+	t.Equal(ConsentFormData{
+		Consents: map[string]ConsentFormField{
+			"privacyPolicy": {
+				FieldName: "consent.privacyPolicy",
+				Version:   "2020-05",
+				Required:  true,
+			},
+			"newsletter": {
+				FieldName: "consent.newsletter",
+				Version:   "1",
+			},
+		},
+	}, result)
+}
+
+func (t *ConsentExtensionTestSuite) TestDecode() {
+	result, err := t.extension.Decode(t.context, t.request, url.Values{
+		"consent.privacyPolicy": []string{"2020-05"},
+	}, nil)
+	t.NoError(err)
+	t.Equal(ConsentFormData{
+		Consents: map[string]ConsentFormField{
+			"privacyPolicy": {
+				FieldName:        "consent.privacyPolicy",
+				Version:          "2020-05",
+				Required:         true,
+				Granted:          true,
+				SubmittedVersion: "2020-05",
+			},
+			"newsletter": {
+				FieldName: "consent.newsletter",
+				Version:   "1",
+			},
+		},
+	}, result)
+}
+
+func (t *ConsentExtensionTestSuite) TestValidate() {
+	testCases := []struct {
+		values url.Values
+		errors map[string][]domain.Error
+	}{
+		{
+			values: url.Values{
+				"consent.privacyPolicy": []string{"2020-05"},
+			},
+		},
+		{
+			values: url.Values{
+				"consent.newsletter": []string{"1"},
+			},
+			errors: map[string][]domain.Error{
+				"consent.privacyPolicy": {
+					{
+						MessageKey:   "formError.consent.privacyPolicy.required",
+						DefaultLabel: "Consent privacyPolicy is required",
+					},
+				},
+			},
+		},
+		{
+			values: url.Values{
+				"consent.privacyPolicy": []string{"2019-01"},
+			},
+			errors: map[string][]domain.Error{
+				"consent.privacyPolicy": {
+					{
+						MessageKey:   "formError.consent.privacyPolicy.outdated",
+						DefaultLabel: "Consent privacyPolicy is given for outdated version",
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		formData, err := t.extension.Decode(t.context, t.request, testCase.values, nil)
+		t.NoError(err)
+
+		validationInfo, err := t.extension.Validate(t.context, t.request, nil, formData)
+		t.NoError(err)
+		t.Equal(testCase.errors, validationInfo.GetErrorsForAllFields())
+	}
+}
+
+func (t *ConsentExtensionTestSuite) TestValidate_WrongFormData() {
+	validationInfo, err := t.extension.Validate(t.context, t.request, nil, map[string]string{})
+	t.Error(err)
+	t.Nil(validationInfo)
+}
+
+func (t *ConsentExtensionTestSuite) TestObserveFormResult() {
+	values := url.Values{
+		"consent.privacyPolicy": []string{"2020-05"},
+		"consent.newsletter":    []string{"0"},
+	}
+
+	form := domain.NewForm(true, nil)
+	form.ValidationInfo.AddGeneralError("error", "error")
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, values, &form))
+	t.Empty(t.store.consents)
+
+	form = domain.NewForm(true, nil)
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, values, &form))
+	t.Len(t.store.consents, 2)
+
+	t.Equal("newsletter", t.store.consents[0].Name)
+	t.Equal("1", t.store.consents[0].Version)
+	t.False(t.store.consents[0].Granted)
+	t.Equal("de-DE", t.store.consents[0].Locale)
+
+	t.Equal("privacyPolicy", t.store.consents[1].Name)
+	t.Equal("2020-05", t.store.consents[1].Version)
+	t.True(t.store.consents[1].Granted)
+	t.Equal("de-DE", t.store.consents[1].Locale)
+	t.False(t.store.consents[1].Timestamp.IsZero())
+}
+
+func (t *ConsentExtensionTestSuite) TestObserveFormResult_Error() {
+	t.store.err = errors.New("error")
+
+	form := domain.NewForm(true, nil)
+	t.Equal(errors.New("error"), t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+}
+
+func (t *ConsentExtensionTestSuite) TestIsReadOnly() {
+	t.True(t.extension.IsReadOnly())
+}
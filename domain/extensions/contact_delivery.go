@@ -0,0 +1,94 @@
+package extensions
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// ContactSink defines delivery of contact messages (like mail or message queue)
+	ContactSink interface {
+		// Deliver delivers single contact message
+		Deliver(ctx context.Context, message ContactMessage) error
+	}
+
+	// ContactMessage defines single message submitted via contact form
+	ContactMessage struct {
+		// Name of the sender
+		Name string `json:"name"`
+		// Email address of the sender
+		Email string `json:"email"`
+		// Subject of the message
+		Subject string `json:"subject"`
+		// Message text of the message
+		Message string `json:"message"`
+		// Locale in which the form was submitted
+		Locale string `json:"locale"`
+		// Timestamp of the submission
+		Timestamp time.Time `json:"timestamp"`
+	}
+
+	// ContactDeliveryExtension defines form extension which delivers contact message via ContactSink, after
+	// successful form submission. Message is resolved from submitted values of the main form, by configured field names.
+	//
+	// formHandler := c.formHandlerFactory.CreateFormHandlerWithFormService(c.formService, "formExtension.contactDelivery")
+	//
+	ContactDeliveryExtension struct {
+		sink         ContactSink
+		nameField    string
+		emailField   string
+		subjectField string
+		messageField string
+		clock        domain.Clock
+	}
+)
+
+var (
+	_ domain.FormResultObserver = &ContactDeliveryExtension{}
+	_ domain.DependencyStatus   = &ContactDeliveryExtension{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (e *ContactDeliveryExtension) Inject(
+	sink ContactSink,
+	clock domain.Clock,
+	cfg *struct {
+		NameField    string `inject:"config:form.contact.fields.name"`
+		EmailField   string `inject:"config:form.contact.fields.email"`
+		SubjectField string `inject:"config:form.contact.fields.subject"`
+		MessageField string `inject:"config:form.contact.fields.message"`
+	},
+) {
+	e.sink = sink
+	e.clock = clock
+	e.nameField = cfg.NameField
+	e.emailField = cfg.EmailField
+	e.subjectField = cfg.SubjectField
+	e.messageField = cfg.MessageField
+}
+
+// ObserveFormResult delivers contact message after successful form submission
+func (e *ContactDeliveryExtension) ObserveFormResult(ctx context.Context, req *web.Request, values url.Values, form *domain.Form) error {
+	if !form.IsValidAndSubmitted() {
+		return nil
+	}
+
+	return e.sink.Deliver(ctx, ContactMessage{
+		Name:      strings.TrimSpace(values.Get(e.nameField)),
+		Email:     strings.TrimSpace(values.Get(e.emailField)),
+		Subject:   strings.TrimSpace(values.Get(e.subjectField)),
+		Message:   strings.TrimSpace(values.Get(e.messageField)),
+		Locale:    requestLocale(req),
+		Timestamp: domain.CurrentTime(e.clock),
+	})
+}
+
+// Status reports availability of contact message delivery
+func (e *ContactDeliveryExtension) Status() (bool, string) {
+	return dependencyStatus(e.sink)
+}
@@ -0,0 +1,101 @@
+package extensions
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/formtest"
+)
+
+type (
+	ContactDeliveryExtensionTestSuite struct {
+		suite.Suite
+
+		extension *ContactDeliveryExtension
+		sink      *contactTestSink
+
+		context context.Context
+		request *web.Request
+	}
+
+	contactTestSink struct {
+		messages []ContactMessage
+		err      error
+	}
+)
+
+func (s *contactTestSink) Deliver(_ context.Context, message ContactMessage) error {
+	s.messages = append(s.messages, message)
+	return s.err
+}
+
+func TestContactDeliveryExtensionTestSuite(t *testing.T) {
+	suite.Run(t, &ContactDeliveryExtensionTestSuite{})
+}
+
+func (t *ContactDeliveryExtensionTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *ContactDeliveryExtensionTestSuite) SetupTest() {
+	t.sink = &contactTestSink{}
+	t.extension = &ContactDeliveryExtension{}
+	t.extension.Inject(t.sink, formtest.NewFakeClock(time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)), &struct {
+		NameField    string `inject:"config:form.contact.fields.name"`
+		EmailField   string `inject:"config:form.contact.fields.email"`
+		SubjectField string `inject:"config:form.contact.fields.subject"`
+		MessageField string `inject:"config:form.contact.fields.message"`
+	}{
+		NameField:    "name",
+		EmailField:   "email",
+		SubjectField: "subject",
+		MessageField: "message",
+	})
+	t.request = web.CreateRequest(&http.Request{
+		Header: http.Header{
+			"Accept-Language": []string{"de-DE,de;q=0.9"},
+		},
+	}, nil)
+}
+
+func (t *ContactDeliveryExtensionTestSuite) TestObserveFormResult() {
+	values := url.Values{
+		"name":    []string{" Max Mustermann "},
+		"email":   []string{"max@example.com"},
+		"subject": []string{"Question"},
+		"message": []string{"Hello\nWorld"},
+	}
+
+	form := domain.NewForm(true, nil)
+	form.ValidationInfo.AddGeneralError("error", "error")
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, values, &form))
+	t.Empty(t.sink.messages)
+
+	form = domain.NewForm(true, nil)
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, values, &form))
+	t.Equal([]ContactMessage{
+		{
+			Name:      "Max Mustermann",
+			Email:     "max@example.com",
+			Subject:   "Question",
+			Message:   "Hello\nWorld",
+			Locale:    "de-DE",
+			Timestamp: time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}, t.sink.messages)
+}
+
+func (t *ContactDeliveryExtensionTestSuite) TestObserveFormResult_Error() {
+	t.sink.err = errors.New("error")
+
+	form := domain.NewForm(true, nil)
+	t.Equal(errors.New("error"), t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+}
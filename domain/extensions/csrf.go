@@ -0,0 +1,239 @@
+package extensions
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// CSRFTokenExtension defines form extension which protects form submissions against cross site request forgery.
+	// It uses double submit cookie pattern: token is delivered to the client as cookie and as form data,
+	// and on submission both values must match.
+	//
+	// formHandler := c.formHandlerFactory.CreateFormHandlerWithFormService(c.formService, "formExtension.csrfToken")
+	//
+	// ...
+	//
+	// <input type="hidden" name="{{ form.FormExtensionsData["formExtension.csrfToken"].FieldName }}" value="{{ form.FormExtensionsData["formExtension.csrfToken"].Token }}">
+	//
+	CSRFTokenExtension struct {
+		fieldName    string
+		rotate       bool
+		exemptRoutes []string
+		cookie       http.Cookie
+		tokenSource  domain.TokenSource
+	}
+
+	// CSRFTokenFormData defines form data provided by CSRFTokenExtension
+	CSRFTokenFormData struct {
+		// FieldName name of the form field which should carry the token
+		FieldName string
+		// Token which should be rendered as value of the form field
+		Token string
+		// submittedToken token received via form submission
+		submittedToken string
+	}
+
+	csrfRequestKey string
+)
+
+const (
+	csrfTokenRequestKey  csrfRequestKey = "form.csrf.token"
+	csrfCookieRequestKey csrfRequestKey = "form.csrf.cookie"
+	csrfTokenLength                     = 32
+)
+
+var (
+	_ domain.FormDataProvider             = &CSRFTokenExtension{}
+	_ domain.FormDataDecoder              = &CSRFTokenExtension{}
+	_ domain.FormDataValidator            = &CSRFTokenExtension{}
+	_ domain.ReadOnlyFormExtension        = &CSRFTokenExtension{}
+	_ domain.UserSpecificFormDataProvider = &CSRFTokenExtension{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (e *CSRFTokenExtension) Inject(tokenSource domain.TokenSource, cfg *struct {
+	FieldName      string       `inject:"config:form.csrf.fieldName"`
+	Rotate         bool         `inject:"config:form.csrf.rotate"`
+	ExemptRoutes   config.Slice `inject:"config:form.csrf.exemptRoutes"`
+	CookieName     string       `inject:"config:form.csrf.cookie.name"`
+	CookiePath     string       `inject:"config:form.csrf.cookie.path"`
+	CookieDomain   string       `inject:"config:form.csrf.cookie.domain"`
+	CookieMaxAge   int          `inject:"config:form.csrf.cookie.maxAge"`
+	CookieSameSite string       `inject:"config:form.csrf.cookie.sameSite"`
+	CookieSecure   bool         `inject:"config:form.csrf.cookie.secure"`
+	CookieHTTPOnly bool         `inject:"config:form.csrf.cookie.httpOnly"`
+}) {
+	e.tokenSource = tokenSource
+	e.fieldName = cfg.FieldName
+	e.rotate = cfg.Rotate
+
+	var exemptRoutes []string
+	if err := cfg.ExemptRoutes.MapInto(&exemptRoutes); err != nil {
+		panic(err.Error())
+	}
+	e.exemptRoutes = exemptRoutes
+
+	e.cookie = http.Cookie{
+		Name:     cfg.CookieName,
+		Path:     cfg.CookiePath,
+		Domain:   cfg.CookieDomain,
+		MaxAge:   cfg.CookieMaxAge,
+		SameSite: parseSameSite(cfg.CookieSameSite),
+		Secure:   cfg.CookieSecure,
+		HttpOnly: cfg.CookieHTTPOnly,
+	}
+}
+
+// GetFormData provides CSRF token for the current request. Token from the incoming cookie is reused,
+// unless token rotation is enabled or cookie is missing, in which case fresh token is generated and scheduled as cookie.
+func (e *CSRFTokenExtension) GetFormData(_ context.Context, req *web.Request) (interface{}, error) {
+	token, err := e.currentToken(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return CSRFTokenFormData{
+		FieldName: e.fieldName,
+		Token:     token,
+	}, nil
+}
+
+// Decode extracts submitted CSRF token from form values
+func (e *CSRFTokenExtension) Decode(_ context.Context, _ *web.Request, values url.Values, formData interface{}) (interface{}, error) {
+	data, ok := formData.(CSRFTokenFormData)
+	if !ok {
+		return nil, domain.NewFormErrorf("unexpected csrf form data: %#v", formData)
+	}
+
+	data.submittedToken = values.Get(e.fieldName)
+
+	return data, nil
+}
+
+// Validate checks if submitted CSRF token matches the token from the incoming cookie.
+// Requests to exempt routes are always valid.
+func (e *CSRFTokenExtension) Validate(_ context.Context, req *web.Request, _ domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	validationInfo := &domain.ValidationInfo{}
+
+	if e.isExempt(req) {
+		return validationInfo, nil
+	}
+
+	data, ok := formData.(CSRFTokenFormData)
+	if !ok {
+		return nil, domain.NewFormErrorf("unexpected csrf form data: %#v", formData)
+	}
+
+	expected := e.incomingToken(req)
+	if expected == "" || data.submittedToken == "" || subtle.ConstantTimeCompare([]byte(expected), []byte(data.submittedToken)) != 1 {
+		validationInfo.AddGeneralError("formError.csrfToken.invalid", "Invalid csrf token")
+	}
+
+	return validationInfo, nil
+}
+
+// IsReadOnly reports that csrf token is only compared with token of the session, so it's checked by dry-run submissions as well
+func (e *CSRFTokenExtension) IsReadOnly() bool {
+	return true
+}
+
+// IsUserSpecific reports that csrf token is bound to the session, so form rendering it can't be cached
+func (e *CSRFTokenExtension) IsUserSpecific() bool {
+	return true
+}
+
+// currentToken returns token used for the current request, by generating it only once per request
+func (e *CSRFTokenExtension) currentToken(req *web.Request) (string, error) {
+	if token, ok := req.Values.Load(csrfTokenRequestKey); ok {
+		return token.(string), nil
+	}
+
+	token := e.incomingToken(req)
+	if token == "" || e.rotate {
+		generated, err := e.generateToken()
+		if err != nil {
+			return "", err
+		}
+		token = generated
+
+		cookie := e.cookie
+		cookie.Value = token
+		req.Values.Store(csrfCookieRequestKey, &cookie)
+	}
+
+	req.Values.Store(csrfTokenRequestKey, token)
+
+	return token, nil
+}
+
+// incomingToken returns token stored in the cookie of the incoming request
+func (e *CSRFTokenExtension) incomingToken(req *web.Request) string {
+	cookie, err := req.Request().Cookie(e.cookie.Name)
+	if err != nil {
+		return ""
+	}
+
+	return cookie.Value
+}
+
+// isExempt checks if request path matches any of exempt routes. Route ending with "*" matches as prefix.
+func (e *CSRFTokenExtension) isExempt(req *web.Request) bool {
+	if req.Request().URL == nil {
+		return false
+	}
+
+	path := req.Request().URL.Path
+	for _, route := range e.exemptRoutes {
+		if strings.HasSuffix(route, "*") && strings.HasPrefix(path, strings.TrimSuffix(route, "*")) {
+			return true
+		}
+		if route == path {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CSRFCookieFromRequest returns CSRF cookie which should be attached to the response of the request
+func CSRFCookieFromRequest(req *web.Request) (*http.Cookie, bool) {
+	cookie, ok := req.Values.Load(csrfCookieRequestKey)
+	if !ok {
+		return nil, false
+	}
+
+	return cookie.(*http.Cookie), true
+}
+
+// generateToken generates new random CSRF token from token source
+func (e *CSRFTokenExtension) generateToken() (string, error) {
+	token := make([]byte, csrfTokenLength)
+	if err := domain.ReadToken(e.tokenSource, token); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// parseSameSite transforms configured SameSite value into http.SameSite
+func parseSameSite(value string) http.SameSite {
+	switch strings.ToLower(value) {
+	case "lax":
+		return http.SameSiteLaxMode
+	case "strict":
+		return http.SameSiteStrictMode
+	case "none":
+		return http.SameSiteNoneMode
+	}
+
+	return http.SameSiteDefaultMode
+}
@@ -0,0 +1,204 @@
+package extensions
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/formtest"
+)
+
+type (
+	CSRFTokenExtensionTestSuite struct {
+		suite.Suite
+
+		extension *CSRFTokenExtension
+
+		context context.Context
+	}
+)
+
+func TestCSRFTokenExtensionTestSuite(t *testing.T) {
+	suite.Run(t, &CSRFTokenExtensionTestSuite{})
+}
+
+func (t *CSRFTokenExtensionTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *CSRFTokenExtensionTestSuite) SetupTest() {
+	t.extension = &CSRFTokenExtension{
+		fieldName:    "csrfToken",
+		exemptRoutes: []string{"/api/callback", "/webhook/*"},
+		cookie: http.Cookie{
+			Name:     "form_csrf",
+			Path:     "/",
+			SameSite: http.SameSiteStrictMode,
+			Secure:   true,
+			HttpOnly: true,
+		},
+	}
+}
+
+func (t *CSRFTokenExtensionTestSuite) createRequest(path string, cookieToken string) *web.Request {
+	request := &http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{Path: path},
+		Header: http.Header{},
+	}
+
+	if cookieToken != "" {
+		request.AddCookie(&http.Cookie{Name: "form_csrf", Value: cookieToken})
+	}
+
+	return web.CreateRequest(request, nil)
+}
+
+func (t *CSRFTokenExtensionTestSuite) TestParseSameSite() {
+	t.Equal(http.SameSiteLaxMode, parseSameSite("lax"))
+	t.Equal(http.SameSiteStrictMode, parseSameSite("Strict"))
+	t.Equal(http.SameSiteNoneMode, parseSameSite("none"))
+	t.Equal(http.SameSiteDefaultMode, parseSameSite(""))
+	t.Equal(http.SameSiteDefaultMode, parseSameSite("unknown"))
+}
+
+func (t *CSRFTokenExtensionTestSuite) TestGetFormData_NewToken() {
+	req := t.createRequest("/form", "")
+
+	result, err := t.extension.GetFormData(t.context, req)
+	t.NoError(err)
+
+	data, ok := result.(CSRFTokenFormData)
+	t.True(ok)
+	t.Equal("csrfToken", data.FieldName)
+	t.NotEmpty(data.Token)
+
+	cookie, ok := CSRFCookieFromRequest(req)
+	t.True(ok)
+	t.Equal(data.Token, cookie.Value)
+	t.Equal("form_csrf", cookie.Name)
+	t.Equal(http.SameSiteStrictMode, cookie.SameSite)
+	t.True(cookie.Secure)
+	t.True(cookie.HttpOnly)
+
+	again, err := t.extension.GetFormData(t.context, req)
+	t.NoError(err)
+	t.Equal(data, again)
+}
+
+func (t *CSRFTokenExtensionTestSuite) TestGetFormData_TokenSource() {
+	t.extension.tokenSource = formtest.NewFakeTokenSource(1)
+	req := t.createRequest("/form", "")
+
+	result, err := t.extension.GetFormData(t.context, req)
+	t.NoError(err)
+	t.Equal(CSRFTokenFormData{
+		FieldName: "csrfToken",
+		Token:     "AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE",
+	}, result)
+}
+
+func (t *CSRFTokenExtensionTestSuite) TestGetFormData_ExistingToken() {
+	req := t.createRequest("/form", "existing")
+
+	result, err := t.extension.GetFormData(t.context, req)
+	t.NoError(err)
+	t.Equal(CSRFTokenFormData{
+		FieldName: "csrfToken",
+		Token:     "existing",
+	}, result)
+
+	_, ok := CSRFCookieFromRequest(req)
+	t.False(ok)
+}
+
+func (t *CSRFTokenExtensionTestSuite) TestGetFormData_Rotation() {
+	t.extension.rotate = true
+	req := t.createRequest("/form", "existing")
+
+	result, err := t.extension.GetFormData(t.context, req)
+	t.NoError(err)
+
+	data, ok := result.(CSRFTokenFormData)
+	t.True(ok)
+	t.NotEqual("existing", data.Token)
+
+	cookie, ok := CSRFCookieFromRequest(req)
+	t.True(ok)
+	t.Equal(data.Token, cookie.Value)
+}
+
+func (t *CSRFTokenExtensionTestSuite) TestDecode() {
+	result, err := t.extension.Decode(t.context, nil, url.Values{
+		"csrfToken": []string{"submitted"},
+	}, CSRFTokenFormData{
+		FieldName: "csrfToken",
+		Token:     "current",
+	})
+	t.NoError(err)
+	t.Equal(CSRFTokenFormData{
+		FieldName:      "csrfToken",
+		Token:          "current",
+		submittedToken: "submitted",
+	}, result)
+
+	result, err = t.extension.Decode(t.context, nil, url.Values{}, map[string]string{})
+	t.Error(err)
+	t.Nil(result)
+}
+
+func (t *CSRFTokenExtensionTestSuite) TestValidate() {
+	testCases := []struct {
+		path           string
+		cookieToken    string
+		submittedToken string
+		valid          bool
+	}{
+		{path: "/form", cookieToken: "token", submittedToken: "token", valid: true},
+		{path: "/form", cookieToken: "token", submittedToken: "other", valid: false},
+		{path: "/form", cookieToken: "token", submittedToken: "", valid: false},
+		{path: "/form", cookieToken: "", submittedToken: "token", valid: false},
+		{path: "/form", cookieToken: "", submittedToken: "", valid: false},
+		{path: "/api/callback", cookieToken: "", submittedToken: "", valid: true},
+		{path: "/api/callback/other", cookieToken: "", submittedToken: "", valid: false},
+		{path: "/webhook/payment", cookieToken: "", submittedToken: "", valid: true},
+	}
+
+	for _, testCase := range testCases {
+		req := t.createRequest(testCase.path, testCase.cookieToken)
+
+		validationInfo, err := t.extension.Validate(t.context, req, nil, CSRFTokenFormData{
+			submittedToken: testCase.submittedToken,
+		})
+		t.NoError(err)
+		t.Equal(testCase.valid, validationInfo.IsValid(), testCase)
+
+		if !testCase.valid {
+			t.Equal([]domain.Error{
+				{
+					MessageKey:   "formError.csrfToken.invalid",
+					DefaultLabel: "Invalid csrf token",
+				},
+			}, validationInfo.GetGeneralErrors())
+		}
+	}
+}
+
+func (t *CSRFTokenExtensionTestSuite) TestValidate_WrongFormData() {
+	validationInfo, err := t.extension.Validate(t.context, t.createRequest("/form", "token"), nil, map[string]string{})
+	t.Error(err)
+	t.Nil(validationInfo)
+}
+
+func (t *CSRFTokenExtensionTestSuite) TestIsReadOnly() {
+	t.True(t.extension.IsReadOnly())
+}
+
+func (t *CSRFTokenExtensionTestSuite) TestIsUserSpecific() {
+	t.True(t.extension.IsUserSpecific())
+}
@@ -0,0 +1,94 @@
+package extensions
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+type (
+	// DataSubjectStore defines storage of form data, which can locate and erase all data belonging to single user,
+	// so data subject requests (right of access and right to erasure) can be fulfilled
+	DataSubjectStore interface {
+		// FindSubjectData returns all stored data belonging to the subject
+		FindSubjectData(ctx context.Context, subject string) ([]SubjectData, error)
+		// EraseSubjectData removes all stored data belonging to the subject, and returns number of removed entries
+		EraseSubjectData(ctx context.Context, subject string) (int, error)
+	}
+
+	// SubjectData defines single entry of stored form data belonging to data subject
+	SubjectData struct {
+		// Store name of the storage (like "submissions" or "recordings")
+		Store string `json:"store"`
+		// ID unique identifier of the entry within its storage
+		ID string `json:"id"`
+		// Timestamp of the submission
+		Timestamp time.Time `json:"timestamp"`
+		// Data stored entry encoded as JSON
+		Data json.RawMessage `json:"data"`
+	}
+
+	// DataSubjectRequests defines service for data subject requests, which exports or erases all form data
+	// belonging to given user identifier (like email address), across all stores of the module which implement
+	// DataSubjectStore (submission store and submission record store).
+	DataSubjectRequests struct {
+		stores []DataSubjectStore
+	}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (r *DataSubjectRequests) Inject(submissionStore SubmissionStore, recordStore SubmissionRecordStore) {
+	r.stores = nil
+
+	for _, store := range []interface{}{submissionStore, recordStore} {
+		if subjectStore, ok := store.(DataSubjectStore); ok {
+			r.stores = append(r.stores, subjectStore)
+		}
+	}
+}
+
+// Export returns all stored form data belonging to the subject, for right of access
+func (r *DataSubjectRequests) Export(ctx context.Context, subject string) ([]SubjectData, error) {
+	subject = normalizeSubject(subject)
+	if subject == "" {
+		return nil, nil
+	}
+
+	var result []SubjectData
+	for _, store := range r.stores {
+		data, err := store.FindSubjectData(ctx, subject)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, data...)
+	}
+
+	return result, nil
+}
+
+// Erase removes all stored form data belonging to the subject, for right to erasure, and returns number of
+// removed entries. It stops with the first error, so it can be repeated safely.
+func (r *DataSubjectRequests) Erase(ctx context.Context, subject string) (int, error) {
+	subject = normalizeSubject(subject)
+	if subject == "" {
+		return 0, nil
+	}
+
+	erased := 0
+	for _, store := range r.stores {
+		count, err := store.EraseSubjectData(ctx, subject)
+		erased += count
+		if err != nil {
+			return erased, err
+		}
+	}
+
+	return erased, nil
+}
+
+// normalizeSubject trims and lower cases user identifier, so it's matched case insensitively (like email addresses)
+func normalizeSubject(subject string) string {
+	return strings.ToLower(strings.TrimSpace(subject))
+}
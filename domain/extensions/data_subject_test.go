@@ -0,0 +1,118 @@
+package extensions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	DataSubjectRequestsTestSuite struct {
+		suite.Suite
+
+		requests        *DataSubjectRequests
+		submissionStore *dataSubjectTestSubmissionStore
+		recordStore     *dataSubjectTestRecordStore
+
+		context context.Context
+	}
+
+	dataSubjectTestSubmissionStore struct {
+		submissionStoreTestStore
+		dataSubjectTestStore
+	}
+
+	dataSubjectTestRecordStore struct {
+		submissionRecorderTestStore
+		dataSubjectTestStore
+	}
+
+	dataSubjectTestStore struct {
+		data     []SubjectData
+		subjects []string
+		err      error
+	}
+)
+
+func (s *dataSubjectTestStore) FindSubjectData(_ context.Context, subject string) ([]SubjectData, error) {
+	s.subjects = append(s.subjects, subject)
+	return s.data, s.err
+}
+
+func (s *dataSubjectTestStore) EraseSubjectData(_ context.Context, subject string) (int, error) {
+	s.subjects = append(s.subjects, subject)
+	return len(s.data), s.err
+}
+
+func TestDataSubjectRequestsTestSuite(t *testing.T) {
+	suite.Run(t, &DataSubjectRequestsTestSuite{})
+}
+
+func (t *DataSubjectRequestsTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *DataSubjectRequestsTestSuite) SetupTest() {
+	t.submissionStore = &dataSubjectTestSubmissionStore{}
+	t.submissionStore.data = []SubjectData{{Store: "submissions", ID: "a"}, {Store: "submissions", ID: "b"}}
+	t.recordStore = &dataSubjectTestRecordStore{}
+	t.recordStore.data = []SubjectData{{Store: "recordings", ID: "c"}}
+
+	t.requests = &DataSubjectRequests{}
+	t.requests.Inject(t.submissionStore, t.recordStore)
+}
+
+func (t *DataSubjectRequestsTestSuite) TestExport() {
+	result, err := t.requests.Export(t.context, " User@Example.com ")
+	t.NoError(err)
+	t.Equal([]SubjectData{
+		{Store: "submissions", ID: "a"},
+		{Store: "submissions", ID: "b"},
+		{Store: "recordings", ID: "c"},
+	}, result)
+	t.Equal([]string{"user@example.com"}, t.submissionStore.subjects)
+	t.Equal([]string{"user@example.com"}, t.recordStore.subjects)
+}
+
+func (t *DataSubjectRequestsTestSuite) TestExport_EmptySubject() {
+	result, err := t.requests.Export(t.context, " ")
+	t.NoError(err)
+	t.Empty(result)
+	t.Empty(t.submissionStore.subjects)
+}
+
+func (t *DataSubjectRequestsTestSuite) TestExport_Error() {
+	t.recordStore.dataSubjectTestStore.err = errors.New("error")
+
+	result, err := t.requests.Export(t.context, "user@example.com")
+	t.Equal(errors.New("error"), err)
+	t.Nil(result)
+}
+
+func (t *DataSubjectRequestsTestSuite) TestErase() {
+	erased, err := t.requests.Erase(t.context, "User@Example.com")
+	t.NoError(err)
+	t.Equal(3, erased)
+	t.Equal([]string{"user@example.com"}, t.submissionStore.subjects)
+	t.Equal([]string{"user@example.com"}, t.recordStore.subjects)
+}
+
+func (t *DataSubjectRequestsTestSuite) TestErase_Error() {
+	t.submissionStore.dataSubjectTestStore.err = errors.New("error")
+
+	erased, err := t.requests.Erase(t.context, "user@example.com")
+	t.Equal(errors.New("error"), err)
+	t.Equal(2, erased)
+	t.Empty(t.recordStore.subjects)
+}
+
+func (t *DataSubjectRequestsTestSuite) TestInject_UnsupportedStores() {
+	requests := &DataSubjectRequests{}
+	requests.Inject(&submissionStoreTestStore{}, &submissionRecorderTestStore{})
+
+	result, err := requests.Export(t.context, "user@example.com")
+	t.NoError(err)
+	t.Empty(result)
+}
@@ -0,0 +1,108 @@
+package extensions
+
+import (
+	"context"
+	"net/url"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// HoneypotExtension defines form extension which rejects submissions of spam bots, by adding field which is
+	// hidden from real users. Bots usually fill all fields, so any submitted value of honeypot field rejects submission.
+	//
+	// formHandler := c.formHandlerFactory.CreateFormHandlerWithFormService(c.formService, "formExtension.honeypot")
+	//
+	// ...
+	//
+	// <input type="text" name="{{ form.FormExtensionsData["formExtension.honeypot"].FieldName }}" style="display:none" tabindex="-1" autocomplete="off">
+	//
+	// In flag mode, submission with filled honeypot field is silently accepted, so bots can't learn about the check,
+	// and it's flagged in form data of the extension instead.
+	HoneypotExtension struct {
+		fieldName string
+		flag      bool
+	}
+
+	// HoneypotFormData defines form data provided by HoneypotExtension
+	HoneypotFormData struct {
+		// FieldName name of the hidden form field
+		FieldName string
+		// filled flag if hidden form field was submitted with any value
+		filled bool
+	}
+)
+
+const (
+	// HoneypotModeReject defines mode of honeypot extension, which rejects submissions with filled honeypot field
+	HoneypotModeReject = "reject"
+	// HoneypotModeFlag defines mode of honeypot extension, which accepts submissions with filled honeypot field
+	// and flags them in form data of the extension
+	HoneypotModeFlag = "flag"
+)
+
+var (
+	_ domain.FormDataProvider      = &HoneypotExtension{}
+	_ domain.FormDataDecoder       = &HoneypotExtension{}
+	_ domain.FormDataValidator     = &HoneypotExtension{}
+	_ domain.ReadOnlyFormExtension = &HoneypotExtension{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (e *HoneypotExtension) Inject(cfg *struct {
+	FieldName string `inject:"config:form.honeypot.fieldName"`
+	Mode      string `inject:"config:form.honeypot.mode"`
+}) {
+	e.fieldName = cfg.FieldName
+
+	switch cfg.Mode {
+	case "", HoneypotModeReject:
+	case HoneypotModeFlag:
+		e.flag = true
+	default:
+		panic("unknown honeypot mode " + cfg.Mode)
+	}
+}
+
+// IsFilled returns true if hidden form field was submitted with any value. In flag mode, such submissions
+// are accepted, so controllers can use it to discard them (like skipping of sending mails).
+func (d HoneypotFormData) IsFilled() bool {
+	return d.filled
+}
+
+// GetFormData provides name of the hidden form field
+func (e *HoneypotExtension) GetFormData(context.Context, *web.Request) (interface{}, error) {
+	return HoneypotFormData{
+		FieldName: e.fieldName,
+	}, nil
+}
+
+// Decode checks if hidden form field is submitted with any value
+func (e *HoneypotExtension) Decode(_ context.Context, _ *web.Request, values url.Values, _ interface{}) (interface{}, error) {
+	return HoneypotFormData{
+		FieldName: e.fieldName,
+		filled:    values.Get(e.fieldName) != "",
+	}, nil
+}
+
+// Validate rejects submission in case when hidden form field is filled, unless extension runs in flag mode
+func (e *HoneypotExtension) Validate(_ context.Context, _ *web.Request, _ domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	data, ok := formData.(HoneypotFormData)
+	if !ok {
+		return nil, domain.NewFormErrorf("unexpected honeypot form data: %#v", formData)
+	}
+
+	validationInfo := &domain.ValidationInfo{}
+
+	if data.filled && !e.flag {
+		validationInfo.AddGeneralError("formError.honeypot.filled", "Form submission is rejected")
+	}
+
+	return validationInfo, nil
+}
+
+// IsReadOnly reports that honeypot field is only checked, so it's checked by dry-run submissions as well
+func (e *HoneypotExtension) IsReadOnly() bool {
+	return true
+}
@@ -0,0 +1,116 @@
+package extensions
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	HoneypotExtensionTestSuite struct {
+		suite.Suite
+
+		extension *HoneypotExtension
+
+		context context.Context
+		request *web.Request
+	}
+)
+
+func TestHoneypotExtensionTestSuite(t *testing.T) {
+	suite.Run(t, &HoneypotExtensionTestSuite{})
+}
+
+func (t *HoneypotExtensionTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *HoneypotExtensionTestSuite) SetupTest() {
+	t.extension = &HoneypotExtension{}
+	t.extension.Inject(&struct {
+		FieldName string `inject:"config:form.honeypot.fieldName"`
+		Mode      string `inject:"config:form.honeypot.mode"`
+	}{
+		FieldName: "website",
+	})
+	t.request = web.CreateRequest(&http.Request{}, nil)
+}
+
+func (t *HoneypotExtensionTestSuite) TestGetFormData() {
+	result, err := t.extension.GetFormData(t.context, t.request)
+	t.NoError(err)
+	t.Equal(HoneypotFormData{
+		FieldName: "website",
+	}, result)
+}
+
+func (t *HoneypotExtensionTestSuite) TestValidate() {
+	formData, err := t.extension.Decode(t.context, t.request, url.Values{}, nil)
+	t.NoError(err)
+
+	validationInfo, err := t.extension.Validate(t.context, t.request, nil, formData)
+	t.NoError(err)
+	t.True(validationInfo.IsValid())
+
+	formData, err = t.extension.Decode(t.context, t.request, url.Values{
+		"website": []string{"http://spam.example.com"},
+	}, nil)
+	t.NoError(err)
+
+	validationInfo, err = t.extension.Validate(t.context, t.request, nil, formData)
+	t.NoError(err)
+	t.Equal([]domain.Error{
+		{
+			MessageKey:   "formError.honeypot.filled",
+			DefaultLabel: "Form submission is rejected",
+		},
+	}, validationInfo.GetGeneralErrors())
+}
+
+func (t *HoneypotExtensionTestSuite) TestValidate_FlagMode() {
+	t.extension.Inject(&struct {
+		FieldName string `inject:"config:form.honeypot.fieldName"`
+		Mode      string `inject:"config:form.honeypot.mode"`
+	}{
+		FieldName: "website",
+		Mode:      HoneypotModeFlag,
+	})
+
+	formData, err := t.extension.Decode(t.context, t.request, url.Values{
+		"website": []string{"http://spam.example.com"},
+	}, nil)
+	t.NoError(err)
+	t.True(formData.(HoneypotFormData).IsFilled())
+
+	validationInfo, err := t.extension.Validate(t.context, t.request, nil, formData)
+	t.NoError(err)
+	t.True(validationInfo.IsValid())
+}
+
+func (t *HoneypotExtensionTestSuite) TestInject_UnknownMode() {
+	t.Panics(func() {
+		t.extension.Inject(&struct {
+			FieldName string `inject:"config:form.honeypot.fieldName"`
+			Mode      string `inject:"config:form.honeypot.mode"`
+		}{
+			FieldName: "website",
+			Mode:      "ignore",
+		})
+	})
+}
+
+func (t *HoneypotExtensionTestSuite) TestValidate_WrongFormData() {
+	validationInfo, err := t.extension.Validate(t.context, t.request, nil, map[string]string{})
+	t.Error(err)
+	t.Nil(validationInfo)
+}
+
+func (t *HoneypotExtensionTestSuite) TestIsReadOnly() {
+	t.True(t.extension.IsReadOnly())
+}
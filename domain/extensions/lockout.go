@@ -0,0 +1,182 @@
+package extensions
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// LockoutCounter defines storage for counters of failed attempts used by LockoutExtension
+	LockoutCounter interface {
+		// Count returns number of failed attempts for the key
+		Count(ctx context.Context, key string) (int, error)
+		// Increment increases number of failed attempts for the key and returns the new number.
+		// Counter expires after window duration, measured from the first failed attempt.
+		Increment(ctx context.Context, key string, window time.Duration) (int, error)
+		// Reset removes counter for the key
+		Reset(ctx context.Context, key string) error
+	}
+
+	// LockoutExtension defines form extension which protects forms (like login forms) against brute force attacks.
+	// After configured number of failed validations for the same identity (value of identity field or client IP),
+	// all further submissions for that identity are rejected until cool down period expires. Failed submissions are
+	// counted once their final state is observed, so dry-run submissions are never counted.
+	//
+	// formHandler := c.formHandlerFactory.CreateFormHandlerWithFormService(c.formService, "formExtension.lockout")
+	//
+	LockoutExtension struct {
+		counter        LockoutCounter
+		maxAttempts    int
+		coolDown       time.Duration
+		identityFields []string
+		includeIP      bool
+	}
+
+	// LockoutFormData defines form data provided by LockoutExtension
+	LockoutFormData struct {
+		// identities counter keys of all identities of current submission
+		identities []string
+	}
+)
+
+var (
+	_ domain.FormDataDecoder    = &LockoutExtension{}
+	_ domain.FormDataValidator  = &LockoutExtension{}
+	_ domain.FormResultObserver = &LockoutExtension{}
+	_ domain.DependencyStatus   = &LockoutExtension{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (e *LockoutExtension) Inject(
+	counter LockoutCounter,
+	cfg *struct {
+		MaxAttempts    int          `inject:"config:form.lockout.maxAttempts"`
+		CoolDown       string       `inject:"config:form.lockout.coolDown"`
+		IdentityFields config.Slice `inject:"config:form.lockout.identityFields"`
+		IncludeIP      bool         `inject:"config:form.lockout.includeIP"`
+	},
+) {
+	e.counter = counter
+	e.maxAttempts = cfg.MaxAttempts
+	e.includeIP = cfg.IncludeIP
+
+	coolDown, err := time.ParseDuration(cfg.CoolDown)
+	if err != nil {
+		panic(err.Error())
+	}
+	e.coolDown = coolDown
+
+	var identityFields []string
+	if err := cfg.IdentityFields.MapInto(&identityFields); err != nil {
+		panic(err.Error())
+	}
+	e.identityFields = identityFields
+}
+
+// Decode extracts identities from submitted form values
+func (e *LockoutExtension) Decode(_ context.Context, req *web.Request, values url.Values, _ interface{}) (interface{}, error) {
+	return LockoutFormData{
+		identities: e.identities(req, values),
+	}, nil
+}
+
+// Validate rejects submission in case when any of its identities reached maximum number of failed attempts
+func (e *LockoutExtension) Validate(ctx context.Context, _ *web.Request, _ domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	data, ok := formData.(LockoutFormData)
+	if !ok {
+		return nil, domain.NewFormErrorf("unexpected lockout form data: %#v", formData)
+	}
+
+	validationInfo := &domain.ValidationInfo{}
+	locked := false
+
+	for _, identity := range data.identities {
+		count, err := e.counter.Count(ctx, identity)
+		if err != nil {
+			return nil, err
+		}
+
+		locked = locked || count >= e.maxAttempts
+	}
+
+	if locked {
+		validationInfo.AddGeneralError("formError.lockout.coolDown", "Too many failed attempts, please try again later")
+	}
+
+	return validationInfo, nil
+}
+
+// ObserveFormResult counts failed submission for all its identities, and resets counters of all identities
+// of successful submission, so only failed attempts since last successful submission are counted
+func (e *LockoutExtension) ObserveFormResult(ctx context.Context, req *web.Request, values url.Values, form *domain.Form) error {
+	switch domain.NewFormResult(form, nil).State() {
+	case domain.FormStateInvalid:
+		for _, identity := range e.identities(req, values) {
+			if _, err := e.counter.Increment(ctx, identity, e.coolDown); err != nil {
+				return err
+			}
+		}
+	case domain.FormStateValid:
+		for _, identity := range e.identities(req, values) {
+			if err := e.counter.Reset(ctx, identity); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Status reports availability of storage of failed attempts counters
+func (e *LockoutExtension) Status() (bool, string) {
+	return dependencyStatus(e.counter)
+}
+
+// identities collects counter keys of all identities of submission
+func (e *LockoutExtension) identities(req *web.Request, values url.Values) []string {
+	var identities []string
+
+	for _, field := range e.identityFields {
+		value := strings.ToLower(strings.TrimSpace(values.Get(field)))
+		if value == "" {
+			continue
+		}
+
+		identities = append(identities, field+":"+value)
+	}
+
+	if e.includeIP && req != nil {
+		if ip := clientIP(req); ip != "" {
+			identities = append(identities, "ip:"+ip)
+		}
+	}
+
+	return identities
+}
+
+// clientIP returns IP address of connection which sent the request
+func clientIP(req *web.Request) string {
+	host, _, err := net.SplitHostPort(req.Request().RemoteAddr)
+	if err != nil {
+		return req.Request().RemoteAddr
+	}
+
+	return host
+}
+
+// dependencyStatus returns status of storage used by extension, storages which don't depend on external services
+// are always available
+func dependencyStatus(storage interface{}) (bool, string) {
+	if dependency, ok := storage.(domain.DependencyStatus); ok {
+		return dependency.Status()
+	}
+
+	return true, ""
+}
@@ -0,0 +1,206 @@
+package extensions
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	LockoutExtensionTestSuite struct {
+		suite.Suite
+
+		extension *LockoutExtension
+		counter   *lockoutTestCounter
+
+		context context.Context
+		request *web.Request
+	}
+
+	lockoutTestCounter struct {
+		counts  map[string]int
+		windows map[string]time.Duration
+		err     error
+	}
+
+	lockoutTestStatusCounter struct {
+		lockoutTestCounter
+	}
+)
+
+func (c *lockoutTestCounter) Count(_ context.Context, key string) (int, error) {
+	return c.counts[key], c.err
+}
+
+func (c *lockoutTestCounter) Increment(_ context.Context, key string, window time.Duration) (int, error) {
+	c.counts[key]++
+	c.windows[key] = window
+	return c.counts[key], c.err
+}
+
+func (c *lockoutTestCounter) Reset(_ context.Context, key string) error {
+	delete(c.counts, key)
+	return c.err
+}
+
+func (c *lockoutTestStatusCounter) Status() (bool, string) {
+	return false, "redis: connection refused"
+}
+
+func TestLockoutExtensionTestSuite(t *testing.T) {
+	suite.Run(t, &LockoutExtensionTestSuite{})
+}
+
+func (t *LockoutExtensionTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *LockoutExtensionTestSuite) SetupTest() {
+	t.counter = &lockoutTestCounter{
+		counts:  map[string]int{},
+		windows: map[string]time.Duration{},
+	}
+	t.extension = &LockoutExtension{
+		counter:        t.counter,
+		maxAttempts:    3,
+		coolDown:       15 * time.Minute,
+		identityFields: []string{"email"},
+		includeIP:      true,
+	}
+	t.request = web.CreateRequest(&http.Request{
+		RemoteAddr: "10.0.0.1:52000",
+	}, nil)
+}
+
+func (t *LockoutExtensionTestSuite) TestDecode() {
+	result, err := t.extension.Decode(t.context, t.request, url.Values{
+		"email":    []string{" User@Example.com "},
+		"password": []string{"secret"},
+	}, map[string]string{})
+	t.NoError(err)
+	t.Equal(LockoutFormData{
+		identities: []string{"email:user@example.com", "ip:10.0.0.1"},
+	}, result)
+
+	result, err = t.extension.Decode(t.context, t.request, url.Values{}, map[string]string{})
+	t.NoError(err)
+	t.Equal(LockoutFormData{
+		identities: []string{"ip:10.0.0.1"},
+	}, result)
+}
+
+func (t *LockoutExtensionTestSuite) TestValidate() {
+	formData := LockoutFormData{
+		identities: []string{"email:user@example.com", "ip:10.0.0.1"},
+	}
+
+	t.counter.counts["email:user@example.com"] = 2
+
+	validationInfo, err := t.extension.Validate(t.context, t.request, nil, formData)
+	t.NoError(err)
+	t.True(validationInfo.IsValid())
+	t.Equal(map[string]int{
+		"email:user@example.com": 2,
+	}, t.counter.counts, "validation doesn't count submissions")
+
+	t.counter.counts["ip:10.0.0.1"] = 3
+
+	validationInfo, err = t.extension.Validate(t.context, t.request, nil, formData)
+	t.NoError(err)
+	t.Equal([]domain.Error{
+		{
+			MessageKey:   "formError.lockout.coolDown",
+			DefaultLabel: "Too many failed attempts, please try again later",
+		},
+	}, validationInfo.GetGeneralErrors())
+}
+
+func (t *LockoutExtensionTestSuite) TestValidate_Error() {
+	t.counter.err = errors.New("error")
+
+	validationInfo, err := t.extension.Validate(t.context, t.request, nil, LockoutFormData{
+		identities: []string{"ip:10.0.0.1"},
+	})
+	t.Equal(errors.New("error"), err)
+	t.Nil(validationInfo)
+
+	validationInfo, err = t.extension.Validate(t.context, t.request, nil, map[string]string{})
+	t.Error(err)
+	t.Nil(validationInfo)
+}
+
+func (t *LockoutExtensionTestSuite) TestObserveFormResult_NotSubmitted() {
+	form := domain.NewForm(false, nil)
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{
+		"email": []string{"user@example.com"},
+	}, &form))
+	t.Equal(map[string]int{}, t.counter.counts)
+}
+
+func (t *LockoutExtensionTestSuite) TestObserveFormResult_Invalid() {
+	values := url.Values{
+		"email": []string{"user@example.com"},
+	}
+
+	form := domain.NewForm(true, nil)
+	form.ValidationInfo.AddFieldError("password", "formError.password.invalid", "invalid")
+
+	for i := 0; i < 3; i++ {
+		t.NoError(t.extension.ObserveFormResult(t.context, t.request, values, &form))
+	}
+	t.Equal(map[string]int{
+		"email:user@example.com": 3,
+		"ip:10.0.0.1":            3,
+	}, t.counter.counts)
+	t.Equal(15*time.Minute, t.counter.windows["email:user@example.com"])
+
+	validationInfo, err := t.extension.Validate(t.context, t.request, nil, LockoutFormData{
+		identities: []string{"email:user@example.com"},
+	})
+	t.NoError(err)
+	t.False(validationInfo.IsValid(), "identity is locked after maximum number of failed attempts")
+}
+
+func (t *LockoutExtensionTestSuite) TestObserveFormResult_Valid() {
+	t.counter.counts["email:user@example.com"] = 2
+	t.counter.counts["ip:10.0.0.1"] = 2
+	t.counter.counts["email:other@example.com"] = 2
+
+	form := domain.NewForm(true, nil)
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{
+		"email": []string{"user@example.com"},
+	}, &form))
+	t.Equal(map[string]int{
+		"email:other@example.com": 2,
+	}, t.counter.counts)
+}
+
+func (t *LockoutExtensionTestSuite) TestObserveFormResult_Error() {
+	t.counter.err = errors.New("error")
+
+	form := domain.NewForm(true, nil)
+	t.Equal(errors.New("error"), t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+
+	form.ValidationInfo.AddGeneralError("formError.general", "general")
+	t.Equal(errors.New("error"), t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+}
+
+func (t *LockoutExtensionTestSuite) TestStatus() {
+	alive, details := t.extension.Status()
+	t.True(alive)
+	t.Empty(details)
+
+	t.extension.counter = &lockoutTestStatusCounter{}
+
+	alive, details = t.extension.Status()
+	t.False(alive)
+	t.Equal("redis: connection refused", details)
+}
@@ -0,0 +1,169 @@
+package extensions
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// MinFillTimeExtension defines form extension which rejects submissions sent faster than real users can fill
+	// the form. Time of rendering is delivered as signed token in hidden form field, so it can't be forged.
+	//
+	// formHandler := c.formHandlerFactory.CreateFormHandlerWithFormService(c.formService, "formExtension.minFillTime")
+	//
+	// ...
+	//
+	// <input type="hidden" name="{{ form.FormExtensionsData["formExtension.minFillTime"].FieldName }}" value="{{ form.FormExtensionsData["formExtension.minFillTime"].Token }}">
+	//
+	MinFillTimeExtension struct {
+		fieldName string
+		duration  time.Duration
+		maxAge    time.Duration
+		secret    []byte
+		clock     domain.Clock
+	}
+
+	// MinFillTimeFormData defines form data provided by MinFillTimeExtension
+	MinFillTimeFormData struct {
+		// FieldName name of the hidden form field
+		FieldName string
+		// Token signed time of rendering, which should be rendered as value of the hidden form field
+		Token string
+		// submittedToken token received via form submission
+		submittedToken string
+	}
+)
+
+const minFillTimeSecretLength = 32
+
+var (
+	_ domain.FormDataProvider             = &MinFillTimeExtension{}
+	_ domain.FormDataDecoder              = &MinFillTimeExtension{}
+	_ domain.FormDataValidator            = &MinFillTimeExtension{}
+	_ domain.ReadOnlyFormExtension        = &MinFillTimeExtension{}
+	_ domain.UserSpecificFormDataProvider = &MinFillTimeExtension{}
+)
+
+// Inject is method used to set all dependencies as local variables. If there is no secret configured,
+// random one is generated by token source, so tokens are only valid for the same instance.
+func (e *MinFillTimeExtension) Inject(clock domain.Clock, tokenSource domain.TokenSource, cfg *struct {
+	FieldName string `inject:"config:form.minFillTime.fieldName"`
+	Duration  string `inject:"config:form.minFillTime.duration"`
+	MaxAge    string `inject:"config:form.minFillTime.maxAge"`
+	Secret    string `inject:"config:form.minFillTime.secret"`
+}) {
+	e.clock = clock
+	e.fieldName = cfg.FieldName
+
+	duration, err := time.ParseDuration(cfg.Duration)
+	if err != nil {
+		panic(err.Error())
+	}
+	e.duration = duration
+
+	maxAge, err := time.ParseDuration(cfg.MaxAge)
+	if err != nil {
+		panic(err.Error())
+	}
+	e.maxAge = maxAge
+
+	e.secret = []byte(cfg.Secret)
+	if cfg.Secret == "" {
+		e.secret = make([]byte, minFillTimeSecretLength)
+		if err := domain.ReadToken(tokenSource, e.secret); err != nil {
+			panic(err.Error())
+		}
+	}
+}
+
+// GetFormData provides signed token with current time
+func (e *MinFillTimeExtension) GetFormData(context.Context, *web.Request) (interface{}, error) {
+	return MinFillTimeFormData{
+		FieldName: e.fieldName,
+		Token:     e.sign(domain.CurrentTime(e.clock)),
+	}, nil
+}
+
+// Decode extracts submitted token from form values
+func (e *MinFillTimeExtension) Decode(_ context.Context, _ *web.Request, values url.Values, formData interface{}) (interface{}, error) {
+	data, ok := formData.(MinFillTimeFormData)
+	if !ok {
+		return nil, domain.NewFormErrorf("unexpected min fill time form data: %#v", formData)
+	}
+
+	data.submittedToken = values.Get(e.fieldName)
+
+	return data, nil
+}
+
+// Validate rejects submission in case when submitted token is missing, forged or expired,
+// or when form is submitted before minimum fill time passed
+func (e *MinFillTimeExtension) Validate(_ context.Context, _ *web.Request, _ domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	data, ok := formData.(MinFillTimeFormData)
+	if !ok {
+		return nil, domain.NewFormErrorf("unexpected min fill time form data: %#v", formData)
+	}
+
+	validationInfo := &domain.ValidationInfo{}
+
+	renderedAt, ok := e.verify(data.submittedToken)
+	elapsed := domain.CurrentTime(e.clock).Sub(renderedAt)
+
+	switch {
+	case !ok || elapsed > e.maxAge:
+		validationInfo.AddGeneralError("formError.minFillTime.invalid", "Form is expired, please try again")
+	case elapsed < e.duration:
+		validationInfo.AddGeneralError("formError.minFillTime.tooFast", "Form is submitted too fast, please try again")
+	}
+
+	return validationInfo, nil
+}
+
+// IsReadOnly reports that signed token is only verified, so fill time is checked by dry-run submissions as well
+func (e *MinFillTimeExtension) IsReadOnly() bool {
+	return true
+}
+
+// IsUserSpecific reports that signed token contains time of rendering, which would be outdated in cached form
+func (e *MinFillTimeExtension) IsUserSpecific() bool {
+	return true
+}
+
+// sign creates token from time and its signature
+func (e *MinFillTimeExtension) sign(t time.Time) string {
+	value := strconv.FormatInt(t.Unix(), 10)
+
+	return value + "." + e.signature(value)
+}
+
+// verify checks signature of the token and returns signed time
+func (e *MinFillTimeExtension) verify(token string) (time.Time, bool) {
+	parts := strings.SplitN(token, ".", 2)
+	if len(parts) != 2 || !hmac.Equal([]byte(parts[1]), []byte(e.signature(parts[0]))) {
+		return time.Time{}, false
+	}
+
+	seconds, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(seconds, 0), true
+}
+
+// signature returns base64 encoded HMAC of the value
+func (e *MinFillTimeExtension) signature(value string) string {
+	mac := hmac.New(sha256.New, e.secret)
+	mac.Write([]byte(value))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,174 @@
+package extensions
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/formtest"
+)
+
+type (
+	MinFillTimeExtensionTestSuite struct {
+		suite.Suite
+
+		extension *MinFillTimeExtension
+		clock     *formtest.FakeClock
+
+		context context.Context
+		request *web.Request
+	}
+)
+
+func TestMinFillTimeExtensionTestSuite(t *testing.T) {
+	suite.Run(t, &MinFillTimeExtensionTestSuite{})
+}
+
+func (t *MinFillTimeExtensionTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *MinFillTimeExtensionTestSuite) SetupTest() {
+	t.clock = formtest.NewFakeClock(time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC))
+	t.extension = &MinFillTimeExtension{}
+	t.extension.Inject(t.clock, nil, &struct {
+		FieldName string `inject:"config:form.minFillTime.fieldName"`
+		Duration  string `inject:"config:form.minFillTime.duration"`
+		MaxAge    string `inject:"config:form.minFillTime.maxAge"`
+		Secret    string `inject:"config:form.minFillTime.secret"`
+	}{
+		FieldName: "formRenderedAt",
+		Duration:  "3s",
+		MaxAge:    "1h",
+		Secret:    "secret",
+	})
+	t.request = web.CreateRequest(&http.Request{}, nil)
+}
+
+func (t *MinFillTimeExtensionTestSuite) TestGetFormData() {
+	result, err := t.extension.GetFormData(t.context, t.request)
+	t.NoError(err)
+
+	data := result.(MinFillTimeFormData)
+	t.Equal("formRenderedAt", data.FieldName)
+	t.Regexp(`^1588334400\.[A-Za-z0-9_-]{43}$`, data.Token)
+}
+
+func (t *MinFillTimeExtensionTestSuite) TestInject_RandomSecret() {
+	first := &MinFillTimeExtension{}
+	second := &MinFillTimeExtension{}
+	cfg := &struct {
+		FieldName string `inject:"config:form.minFillTime.fieldName"`
+		Duration  string `inject:"config:form.minFillTime.duration"`
+		MaxAge    string `inject:"config:form.minFillTime.maxAge"`
+		Secret    string `inject:"config:form.minFillTime.secret"`
+	}{
+		Duration: "3s",
+		MaxAge:   "1h",
+	}
+	first.Inject(nil, nil, cfg)
+	second.Inject(nil, nil, cfg)
+
+	t.Len(first.secret, minFillTimeSecretLength)
+	t.NotEqual(first.secret, second.secret)
+
+	third := &MinFillTimeExtension{}
+	third.Inject(nil, formtest.NewFakeTokenSource(1, 2), cfg)
+	t.Equal([]byte{1, 2, 1, 2}, third.secret[:4])
+}
+
+func (t *MinFillTimeExtensionTestSuite) TestValidate() {
+	result, err := t.extension.GetFormData(t.context, t.request)
+	t.NoError(err)
+	token := result.(MinFillTimeFormData).Token
+
+	testCases := []struct {
+		elapsed time.Duration
+		token   string
+		errors  []domain.Error
+	}{
+		{
+			elapsed: 10 * time.Second,
+			token:   token,
+		},
+		{
+			elapsed: time.Second,
+			token:   token,
+			errors: []domain.Error{
+				{
+					MessageKey:   "formError.minFillTime.tooFast",
+					DefaultLabel: "Form is submitted too fast, please try again",
+				},
+			},
+		},
+		{
+			elapsed: 2 * time.Hour,
+			token:   token,
+			errors: []domain.Error{
+				{
+					MessageKey:   "formError.minFillTime.invalid",
+					DefaultLabel: "Form is expired, please try again",
+				},
+			},
+		},
+		{
+			elapsed: 10 * time.Second,
+			token:   "1588330000" + token[10:],
+			errors: []domain.Error{
+				{
+					MessageKey:   "formError.minFillTime.invalid",
+					DefaultLabel: "Form is expired, please try again",
+				},
+			},
+		},
+		{
+			elapsed: 10 * time.Second,
+			errors: []domain.Error{
+				{
+					MessageKey:   "formError.minFillTime.invalid",
+					DefaultLabel: "Form is expired, please try again",
+				},
+			},
+		},
+	}
+
+	renderedAt := t.clock.Now()
+	for _, testCase := range testCases {
+		t.clock.Set(renderedAt.Add(testCase.elapsed))
+
+		formData, err := t.extension.Decode(t.context, t.request, url.Values{
+			"formRenderedAt": []string{testCase.token},
+		}, MinFillTimeFormData{FieldName: "formRenderedAt"})
+		t.NoError(err)
+
+		validationInfo, err := t.extension.Validate(t.context, t.request, nil, formData)
+		t.NoError(err)
+		t.Equal(testCase.errors, validationInfo.GetGeneralErrors())
+	}
+}
+
+func (t *MinFillTimeExtensionTestSuite) TestDecode_WrongFormData() {
+	formData, err := t.extension.Decode(t.context, t.request, url.Values{}, nil)
+	t.Error(err)
+	t.Nil(formData)
+}
+
+func (t *MinFillTimeExtensionTestSuite) TestValidate_WrongFormData() {
+	validationInfo, err := t.extension.Validate(t.context, t.request, nil, map[string]string{})
+	t.Error(err)
+	t.Nil(validationInfo)
+}
+
+func (t *MinFillTimeExtensionTestSuite) TestIsReadOnly() {
+	t.True(t.extension.IsReadOnly())
+}
+
+func (t *MinFillTimeExtensionTestSuite) TestIsUserSpecific() {
+	t.True(t.extension.IsUserSpecific())
+}
@@ -0,0 +1,164 @@
+package extensions
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// NewsletterSubscriber defines adapter of email service provider, which subscribes users to newsletter
+	NewsletterSubscriber interface {
+		// Subscribe subscribes user to newsletter
+		Subscribe(ctx context.Context, subscription NewsletterSubscription) error
+	}
+
+	// NewsletterSubscription defines single newsletter opt-in
+	NewsletterSubscription struct {
+		// Email address of the subscriber, resolved from the main form
+		Email string
+		// Locale in which the form was submitted
+		Locale string
+		// Timestamp of the submission
+		Timestamp time.Time
+	}
+
+	// NewsletterExtension defines form extension which adds newsletter opt-in checkbox to any form. Email address
+	// of the subscriber is resolved from submitted values of the main form, by the first configured email field
+	// which is not empty. After successful form submission, user who checked the checkbox is subscribed via
+	// NewsletterSubscriber.
+	//
+	// formHandler := c.formHandlerFactory.CreateFormHandlerWithFormService(c.formService, "formExtension.newsletter")
+	//
+	// ...
+	//
+	// <input type="checkbox" name="{{ form.FormExtensionsData["formExtension.newsletter"].FieldName }}" value="1">
+	//
+	NewsletterExtension struct {
+		subscriber  NewsletterSubscriber
+		fieldName   string
+		emailFields []string
+		clock       domain.Clock
+	}
+
+	// NewsletterFormData defines form data provided by NewsletterExtension
+	NewsletterFormData struct {
+		// FieldName name of the opt-in checkbox field
+		FieldName string
+		// Subscribed flag if opt-in checkbox was checked with submission
+		Subscribed bool
+		// Email address of the subscriber, resolved from the main form
+		Email string
+	}
+)
+
+var (
+	_ domain.FormDataProvider      = &NewsletterExtension{}
+	_ domain.FormDataDecoder       = &NewsletterExtension{}
+	_ domain.FormDataValidator     = &NewsletterExtension{}
+	_ domain.FormResultObserver    = &NewsletterExtension{}
+	_ domain.ReadOnlyFormExtension = &NewsletterExtension{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (e *NewsletterExtension) Inject(
+	subscriber NewsletterSubscriber,
+	clock domain.Clock,
+	cfg *struct {
+		FieldName   string       `inject:"config:form.newsletter.fieldName"`
+		EmailFields config.Slice `inject:"config:form.newsletter.emailFields"`
+	},
+) {
+	e.subscriber = subscriber
+	e.clock = clock
+	e.fieldName = cfg.FieldName
+
+	var emailFields []string
+	if err := cfg.EmailFields.MapInto(&emailFields); err != nil {
+		panic(err.Error())
+	}
+	e.emailFields = emailFields
+}
+
+// GetFormData provides name of the opt-in checkbox field
+func (e *NewsletterExtension) GetFormData(context.Context, *web.Request) (interface{}, error) {
+	return e.formData(url.Values{}), nil
+}
+
+// Decode captures state of the opt-in checkbox and resolves email address from submitted values
+func (e *NewsletterExtension) Decode(_ context.Context, _ *web.Request, values url.Values, _ interface{}) (interface{}, error) {
+	return e.formData(values), nil
+}
+
+// Validate checks that email address can be resolved if user opted in
+func (e *NewsletterExtension) Validate(_ context.Context, _ *web.Request, _ domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	data, ok := formData.(NewsletterFormData)
+	if !ok {
+		return nil, domain.NewFormErrorf("unexpected newsletter form data: %#v", formData)
+	}
+
+	validationInfo := &domain.ValidationInfo{}
+
+	if data.Subscribed && data.Email == "" {
+		validationInfo.AddFieldError(data.FieldName, "formError.newsletter.email", "Email address is required for newsletter")
+	}
+
+	return validationInfo, nil
+}
+
+// IsReadOnly reports that subscription only happens in form result observer, so submitted subscription is validated
+// by dry-run submissions as well
+func (e *NewsletterExtension) IsReadOnly() bool {
+	return true
+}
+
+// ObserveFormResult subscribes user to newsletter after successful form submission, if user opted in
+func (e *NewsletterExtension) ObserveFormResult(ctx context.Context, req *web.Request, values url.Values, form *domain.Form) error {
+	if !form.IsValidAndSubmitted() {
+		return nil
+	}
+
+	data := e.formData(values)
+	if !data.Subscribed || data.Email == "" {
+		return nil
+	}
+
+	return e.subscriber.Subscribe(ctx, NewsletterSubscription{
+		Email:     data.Email,
+		Locale:    requestLocale(req),
+		Timestamp: domain.CurrentTime(e.clock),
+	})
+}
+
+// formData creates newsletter form data from submitted values
+func (e *NewsletterExtension) formData(values url.Values) NewsletterFormData {
+	data := NewsletterFormData{
+		FieldName:  e.fieldName,
+		Subscribed: isChecked(values.Get(e.fieldName)),
+	}
+
+	for _, field := range e.emailFields {
+		if email := strings.TrimSpace(values.Get(field)); email != "" {
+			data.Email = email
+			break
+		}
+	}
+
+	return data
+}
+
+// isChecked checks if submitted checkbox value means that checkbox is checked.
+// Values "0", "false" and "off" are treated as unchecked, so they can be used for hidden fallback fields.
+func isChecked(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "0", "false", "off":
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,183 @@
+package extensions
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/formtest"
+)
+
+type (
+	NewsletterExtensionTestSuite struct {
+		suite.Suite
+
+		extension  *NewsletterExtension
+		subscriber *newsletterTestSubscriber
+
+		context context.Context
+		request *web.Request
+	}
+
+	newsletterTestSubscriber struct {
+		subscriptions []NewsletterSubscription
+		err           error
+	}
+)
+
+func (s *newsletterTestSubscriber) Subscribe(_ context.Context, subscription NewsletterSubscription) error {
+	s.subscriptions = append(s.subscriptions, subscription)
+	return s.err
+}
+
+func TestNewsletterExtensionTestSuite(t *testing.T) {
+	suite.Run(t, &NewsletterExtensionTestSuite{})
+}
+
+func (t *NewsletterExtensionTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *NewsletterExtensionTestSuite) SetupTest() {
+	t.subscriber = &newsletterTestSubscriber{}
+	t.extension = &NewsletterExtension{}
+	t.extension.Inject(t.subscriber, formtest.NewFakeClock(time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)), &struct {
+		FieldName   string       `inject:"config:form.newsletter.fieldName"`
+		EmailFields config.Slice `inject:"config:form.newsletter.emailFields"`
+	}{
+		FieldName:   "newsletter",
+		EmailFields: config.Slice{"email", "customer.email"},
+	})
+	t.request = web.CreateRequest(&http.Request{
+		Header: http.Header{
+			"Accept-Language": []string{"de-DE,de;q=0.9,en;q=0.8"},
+		},
+	}, nil)
+}
+
+func (t *NewsletterExtensionTestSuite) TestGetFormData() {
+	result, err := t.extension.GetFormData(t.context, t.request)
+	t.NoError(err)
+	t.Equal(NewsletterFormData{
+		FieldName: "newsletter",
+	}, result)
+}
+
+func (t *NewsletterExtensionTestSuite) TestDecode() {
+	testCases := []struct {
+		values url.Values
+		result NewsletterFormData
+	}{
+		{
+			values: url.Values{},
+			result: NewsletterFormData{
+				FieldName: "newsletter",
+			},
+		},
+		{
+			values: url.Values{
+				"newsletter": []string{"false"},
+				"email":      []string{"user@example.com"},
+			},
+			result: NewsletterFormData{
+				FieldName: "newsletter",
+				Email:     "user@example.com",
+			},
+		},
+		{
+			values: url.Values{
+				"newsletter":     []string{"1"},
+				"email":          []string{" "},
+				"customer.email": []string{" customer@example.com "},
+			},
+			result: NewsletterFormData{
+				FieldName:  "newsletter",
+				Subscribed: true,
+				Email:      "customer@example.com",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		result, err := t.extension.Decode(t.context, t.request, testCase.values, nil)
+		t.NoError(err)
+		t.Equal(testCase.result, result)
+	}
+}
+
+func (t *NewsletterExtensionTestSuite) TestValidate() {
+	validationInfo, err := t.extension.Validate(t.context, t.request, nil, NewsletterFormData{
+		FieldName:  "newsletter",
+		Subscribed: true,
+	})
+	t.NoError(err)
+	t.Equal(map[string][]domain.Error{
+		"newsletter": {
+			{
+				MessageKey:   "formError.newsletter.email",
+				DefaultLabel: "Email address is required for newsletter",
+			},
+		},
+	}, validationInfo.GetErrorsForAllFields())
+
+	validationInfo, err = t.extension.Validate(t.context, t.request, nil, NewsletterFormData{
+		FieldName: "newsletter",
+	})
+	t.NoError(err)
+	t.True(validationInfo.IsValid())
+}
+
+func (t *NewsletterExtensionTestSuite) TestValidate_WrongFormData() {
+	validationInfo, err := t.extension.Validate(t.context, t.request, nil, map[string]string{})
+	t.Error(err)
+	t.Nil(validationInfo)
+}
+
+func (t *NewsletterExtensionTestSuite) TestObserveFormResult() {
+	values := url.Values{
+		"newsletter": []string{"1"},
+		"email":      []string{"user@example.com"},
+	}
+
+	form := domain.NewForm(true, nil)
+	form.ValidationInfo.AddGeneralError("error", "error")
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, values, &form))
+	t.Empty(t.subscriber.subscriptions)
+
+	form = domain.NewForm(true, nil)
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{
+		"email": []string{"user@example.com"},
+	}, &form))
+	t.Empty(t.subscriber.subscriptions)
+
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, values, &form))
+	t.Equal([]NewsletterSubscription{
+		{
+			Email:     "user@example.com",
+			Locale:    "de-DE",
+			Timestamp: time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}, t.subscriber.subscriptions)
+}
+
+func (t *NewsletterExtensionTestSuite) TestObserveFormResult_Error() {
+	t.subscriber.err = errors.New("error")
+
+	form := domain.NewForm(true, nil)
+	t.Equal(errors.New("error"), t.extension.ObserveFormResult(t.context, t.request, url.Values{
+		"newsletter": []string{"1"},
+		"email":      []string{"user@example.com"},
+	}, &form))
+}
+
+func (t *NewsletterExtensionTestSuite) TestIsReadOnly() {
+	t.True(t.extension.IsReadOnly())
+}
@@ -0,0 +1,121 @@
+package extensions
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// OriginCheckExtension defines form extension which validates Origin (or Referer, if Origin is missing) header
+	// of submitted form against list of allowed hosts. It's meant as defense in depth companion to CSRF token.
+	// If there are no configured allowed hosts, the host of the request itself is allowed.
+	// In report only mode, failed checks are only logged, without affecting validation result.
+	//
+	// formHandler := c.formHandlerFactory.CreateFormHandlerWithFormService(c.formService, "formExtension.originCheck")
+	//
+	OriginCheckExtension struct {
+		allowedHosts []string
+		allowMissing bool
+		reportOnly   bool
+		logger       flamingo.Logger
+	}
+)
+
+var (
+	_ domain.FormDataValidator     = &OriginCheckExtension{}
+	_ domain.ReadOnlyFormExtension = &OriginCheckExtension{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (e *OriginCheckExtension) Inject(
+	logger flamingo.Logger,
+	cfg *struct {
+		AllowedHosts config.Slice `inject:"config:form.originCheck.allowedHosts"`
+		AllowMissing bool         `inject:"config:form.originCheck.allowMissing"`
+		ReportOnly   bool         `inject:"config:form.originCheck.reportOnly"`
+	},
+) {
+	e.logger = logger
+
+	var allowedHosts []string
+	if err := cfg.AllowedHosts.MapInto(&allowedHosts); err != nil {
+		panic(err.Error())
+	}
+	e.allowedHosts = allowedHosts
+	e.allowMissing = cfg.AllowMissing
+	e.reportOnly = cfg.ReportOnly
+}
+
+// Validate checks if submission originates from one of allowed hosts
+func (e *OriginCheckExtension) Validate(_ context.Context, req *web.Request, _ domain.ValidatorProvider, _ interface{}) (*domain.ValidationInfo, error) {
+	validationInfo := &domain.ValidationInfo{}
+
+	source := req.Request().Header.Get("Origin")
+	if source == "" || source == "null" {
+		source = req.Request().Header.Get("Referer")
+	}
+
+	if source == "" {
+		if !e.allowMissing {
+			e.reject(validationInfo, "missing Origin and Referer header")
+		}
+		return validationInfo, nil
+	}
+
+	sourceURL, err := url.Parse(source)
+	if err != nil || sourceURL.Host == "" {
+		e.reject(validationInfo, "malformed origin "+source)
+		return validationInfo, nil
+	}
+
+	if !e.isAllowed(req, sourceURL) {
+		e.reject(validationInfo, "origin "+sourceURL.Host+" is not allowed")
+	}
+
+	return validationInfo, nil
+}
+
+// IsReadOnly reports that only request headers are checked, so origin is checked by dry-run submissions as well
+func (e *OriginCheckExtension) IsReadOnly() bool {
+	return true
+}
+
+// isAllowed checks if host of source url matches any of allowed hosts.
+// Allowed host starting with "*." matches all subdomains.
+func (e *OriginCheckExtension) isAllowed(req *web.Request, sourceURL *url.URL) bool {
+	allowedHosts := e.allowedHosts
+	if len(allowedHosts) == 0 {
+		allowedHosts = []string{req.Request().Host}
+	}
+
+	host := strings.ToLower(sourceURL.Host)
+	hostname := strings.ToLower(sourceURL.Hostname())
+
+	for _, allowed := range allowedHosts {
+		allowed = strings.ToLower(allowed)
+		if allowed == host || allowed == hostname {
+			return true
+		}
+		if strings.HasPrefix(allowed, "*.") && strings.HasSuffix(hostname, allowed[1:]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reject attaches general error to validation info, or only logs it in report only mode
+func (e *OriginCheckExtension) reject(validationInfo *domain.ValidationInfo, reason string) {
+	if e.reportOnly {
+		e.logger.WithField("FormExtension", "originCheck").Warn("report only: " + reason)
+		return
+	}
+
+	validationInfo.AddGeneralError("formError.origin.invalid", "Invalid origin")
+}
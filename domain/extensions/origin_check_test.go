@@ -0,0 +1,116 @@
+package extensions
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+type (
+	OriginCheckExtensionTestSuite struct {
+		suite.Suite
+
+		extension *OriginCheckExtension
+
+		context context.Context
+	}
+)
+
+func TestOriginCheckExtensionTestSuite(t *testing.T) {
+	suite.Run(t, &OriginCheckExtensionTestSuite{})
+}
+
+func (t *OriginCheckExtensionTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *OriginCheckExtensionTestSuite) SetupTest() {
+	t.extension = &OriginCheckExtension{
+		allowedHosts: []string{"shop.example.com", "localhost:3000", "*.example.org"},
+		logger:       &flamingo.NullLogger{},
+	}
+}
+
+func (t *OriginCheckExtensionTestSuite) createRequest(host string, origin string, referer string) *web.Request {
+	request := &http.Request{
+		Method: http.MethodPost,
+		Host:   host,
+		Header: http.Header{},
+	}
+
+	if origin != "" {
+		request.Header.Set("Origin", origin)
+	}
+	if referer != "" {
+		request.Header.Set("Referer", referer)
+	}
+
+	return web.CreateRequest(request, nil)
+}
+
+func (t *OriginCheckExtensionTestSuite) TestValidate() {
+	testCases := []struct {
+		origin  string
+		referer string
+		valid   bool
+	}{
+		{origin: "https://shop.example.com", valid: true},
+		{origin: "https://SHOP.example.com:443", valid: true},
+		{origin: "http://localhost:3000", valid: true},
+		{origin: "http://localhost:4000", valid: false},
+		{origin: "https://evil.com", valid: false},
+		{origin: "https://shop.example.com.evil.com", valid: false},
+		{origin: "https://www.example.org", valid: true},
+		{origin: "https://example.org", valid: false},
+		{referer: "https://shop.example.com/checkout?step=1", valid: true},
+		{referer: "https://evil.com/shop.example.com", valid: false},
+		{origin: "null", referer: "https://shop.example.com/", valid: true},
+		{origin: "https://evil.com", referer: "https://shop.example.com/", valid: false},
+		{origin: "not a url", valid: false},
+		{valid: false},
+	}
+
+	for _, testCase := range testCases {
+		validationInfo, err := t.extension.Validate(t.context, t.createRequest("internal:8080", testCase.origin, testCase.referer), nil, nil)
+		t.NoError(err)
+		t.Equal(testCase.valid, validationInfo.IsValid(), testCase)
+	}
+}
+
+func (t *OriginCheckExtensionTestSuite) TestValidate_RequestHost() {
+	t.extension.allowedHosts = nil
+
+	validationInfo, err := t.extension.Validate(t.context, t.createRequest("shop.example.com", "https://shop.example.com", ""), nil, nil)
+	t.NoError(err)
+	t.True(validationInfo.IsValid())
+
+	validationInfo, err = t.extension.Validate(t.context, t.createRequest("shop.example.com", "https://other.example.com", ""), nil, nil)
+	t.NoError(err)
+	t.False(validationInfo.IsValid())
+	t.True(validationInfo.HasGeneralErrors())
+}
+
+func (t *OriginCheckExtensionTestSuite) TestValidate_AllowMissing() {
+	t.extension.allowMissing = true
+
+	validationInfo, err := t.extension.Validate(t.context, t.createRequest("shop.example.com", "", ""), nil, nil)
+	t.NoError(err)
+	t.True(validationInfo.IsValid())
+}
+
+func (t *OriginCheckExtensionTestSuite) TestValidate_ReportOnly() {
+	t.extension.reportOnly = true
+
+	validationInfo, err := t.extension.Validate(t.context, t.createRequest("shop.example.com", "https://evil.com", ""), nil, nil)
+	t.NoError(err)
+	t.True(validationInfo.IsValid())
+}
+
+func (t *OriginCheckExtensionTestSuite) TestIsReadOnly() {
+	t.True(t.extension.IsReadOnly())
+}
@@ -0,0 +1,100 @@
+package extensions
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// OutboxStore defines storage of events emitted by OutboxExtension, which are processed by asynchronous consumers
+	OutboxStore interface {
+		// StoreEvent persists single event of valid form submission
+		StoreEvent(ctx context.Context, event OutboxEvent) error
+	}
+
+	// OutboxEvent defines event of valid form submission
+	OutboxEvent struct {
+		// ID unique identifier of the event
+		ID string
+		// Type package qualified name of form data type (like "presets.RegistrationFormData"), so consumers can
+		// distinguish events of different forms
+		Type string
+		// Timestamp of the submission
+		Timestamp time.Time
+		// Path of the form
+		Path string
+		// Data final form data encoded as JSON
+		Data json.RawMessage
+	}
+
+	// OutboxExtension defines form extension which persists final form data of valid submission as event via
+	// OutboxStore, before form handler returns. If event can't be stored, form handler returns error, so controller
+	// doesn't proceed with follow-up work of submission which would never reach asynchronous consumers.
+	//
+	// formHandler := c.formHandlerFactory.CreateFormHandlerWithFormService(c.formService, "formExtension.outbox")
+	//
+	OutboxExtension struct {
+		store       OutboxStore
+		clock       domain.Clock
+		tokenSource domain.TokenSource
+	}
+)
+
+var _ domain.FormResultObserver = &OutboxExtension{}
+
+// Inject is method used to set all dependencies as local variables
+func (e *OutboxExtension) Inject(store OutboxStore, clock domain.Clock, tokenSource domain.TokenSource) {
+	e.store = store
+	e.clock = clock
+	e.tokenSource = tokenSource
+}
+
+// ObserveFormResult stores event of valid submitted form
+func (e *OutboxExtension) ObserveFormResult(ctx context.Context, req *web.Request, _ url.Values, form *domain.Form) error {
+	if !form.IsValidAndSubmitted() {
+		return nil
+	}
+
+	id, err := generateID(e.tokenSource)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(form.Data)
+	if err != nil {
+		return err
+	}
+
+	event := OutboxEvent{
+		ID:        id,
+		Type:      eventType(form.Data),
+		Timestamp: domain.CurrentTime(e.clock),
+		Data:      data,
+	}
+
+	if req != nil && req.Request().URL != nil {
+		event.Path = req.Request().URL.Path
+	}
+
+	return e.store.StoreEvent(ctx, event)
+}
+
+// eventType returns name of form data type, with pointers dereferenced
+func eventType(formData interface{}) string {
+	typeOf := reflect.TypeOf(formData)
+	for typeOf != nil && typeOf.Kind() == reflect.Ptr {
+		typeOf = typeOf.Elem()
+	}
+
+	if typeOf == nil {
+		return ""
+	}
+
+	return typeOf.String()
+}
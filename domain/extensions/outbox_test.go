@@ -0,0 +1,105 @@
+package extensions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/formtest"
+)
+
+type (
+	OutboxExtensionTestSuite struct {
+		suite.Suite
+
+		extension *OutboxExtension
+		store     *outboxTestStore
+
+		context context.Context
+		request *web.Request
+	}
+
+	outboxTestStore struct {
+		events []OutboxEvent
+		err    error
+	}
+
+	outboxTestFormData struct {
+		Email string `json:"email"`
+	}
+)
+
+func (s *outboxTestStore) StoreEvent(_ context.Context, event OutboxEvent) error {
+	s.events = append(s.events, event)
+	return s.err
+}
+
+func TestOutboxExtensionTestSuite(t *testing.T) {
+	suite.Run(t, &OutboxExtensionTestSuite{})
+}
+
+func (t *OutboxExtensionTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *OutboxExtensionTestSuite) SetupTest() {
+	t.store = &outboxTestStore{}
+	t.extension = &OutboxExtension{}
+	t.extension.Inject(t.store, formtest.NewFakeClock(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)), formtest.NewFakeTokenSource(0x0f))
+	t.request = web.CreateRequest(&http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{Path: "/register"},
+	}, nil)
+}
+
+func (t *OutboxExtensionTestSuite) TestObserveFormResult() {
+	form := domain.NewForm(true, nil)
+	form.Data = &outboxTestFormData{Email: "user@example.com"}
+
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+	t.Require().Len(t.store.events, 1)
+
+	event := t.store.events[0]
+	t.Equal("0f0f0f0f0f0f0f0f0f0f0f0f", event.ID)
+	t.Equal(OutboxEvent{
+		ID:        event.ID,
+		Type:      "extensions.outboxTestFormData",
+		Timestamp: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		Path:      "/register",
+		Data:      json.RawMessage(`{"email":"user@example.com"}`),
+	}, event)
+}
+
+func (t *OutboxExtensionTestSuite) TestObserveFormResult_NotValid() {
+	form := domain.NewForm(true, nil)
+	form.ValidationInfo.AddGeneralError("error", "error")
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+
+	form = domain.NewForm(false, nil)
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+
+	t.Empty(t.store.events)
+}
+
+func (t *OutboxExtensionTestSuite) TestObserveFormResult_Error() {
+	t.store.err = errors.New("error")
+
+	form := domain.NewForm(true, nil)
+	form.Data = outboxTestFormData{Email: "user@example.com"}
+	t.Equal(errors.New("error"), t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+}
+
+func (t *OutboxExtensionTestSuite) TestEventType() {
+	t.Equal("", eventType(nil))
+	t.Equal("extensions.outboxTestFormData", eventType(outboxTestFormData{}))
+	t.Equal("extensions.outboxTestFormData", eventType(&outboxTestFormData{}))
+	t.Equal("map[string]string", eventType(map[string]string{}))
+}
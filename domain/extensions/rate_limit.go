@@ -0,0 +1,99 @@
+package extensions
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// RateLimitExtension defines form extension which limits number of submissions per client IP within time window.
+	// Unlike LockoutExtension, all submissions are counted, no matter if they are valid or not. Counters are stored
+	// with the same LockoutCounter storage as used by LockoutExtension.
+	//
+	// formHandler := c.formHandlerFactory.CreateFormHandlerWithFormService(c.formService, "formExtension.rateLimit")
+	//
+	RateLimitExtension struct {
+		counter        LockoutCounter
+		maxSubmissions int
+		window         time.Duration
+	}
+
+	// RateLimitFormData defines form data provided by RateLimitExtension
+	RateLimitFormData struct {
+		// key of the counter for current submission
+		key string
+	}
+)
+
+const rateLimitKeyPrefix = "rateLimit:ip:"
+
+var (
+	_ domain.FormDataDecoder   = &RateLimitExtension{}
+	_ domain.FormDataValidator = &RateLimitExtension{}
+	_ domain.DependencyStatus  = &RateLimitExtension{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (e *RateLimitExtension) Inject(
+	counter LockoutCounter,
+	cfg *struct {
+		MaxSubmissions int    `inject:"config:form.rateLimit.maxSubmissions"`
+		Window         string `inject:"config:form.rateLimit.window"`
+	},
+) {
+	e.counter = counter
+	e.maxSubmissions = cfg.MaxSubmissions
+
+	window, err := time.ParseDuration(cfg.Window)
+	if err != nil {
+		panic(err.Error())
+	}
+	e.window = window
+}
+
+// Decode extracts client IP of the submission
+func (e *RateLimitExtension) Decode(_ context.Context, req *web.Request, _ url.Values, _ interface{}) (interface{}, error) {
+	data := RateLimitFormData{}
+
+	if req != nil {
+		if ip := clientIP(req); ip != "" {
+			data.key = rateLimitKeyPrefix + ip
+		}
+	}
+
+	return data, nil
+}
+
+// Validate counts submission and rejects it in case when client IP exceeded maximum number of submissions
+func (e *RateLimitExtension) Validate(ctx context.Context, _ *web.Request, _ domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	data, ok := formData.(RateLimitFormData)
+	if !ok {
+		return nil, domain.NewFormErrorf("unexpected rate limit form data: %#v", formData)
+	}
+
+	validationInfo := &domain.ValidationInfo{}
+
+	if data.key == "" {
+		return validationInfo, nil
+	}
+
+	count, err := e.counter.Increment(ctx, data.key, e.window)
+	if err != nil {
+		return nil, err
+	}
+
+	if count > e.maxSubmissions {
+		validationInfo.AddGeneralError("formError.rateLimit.exceeded", "Too many submissions, please try again later")
+	}
+
+	return validationInfo, nil
+}
+
+// Status reports availability of storage of submission counters
+func (e *RateLimitExtension) Status() (bool, string) {
+	return dependencyStatus(e.counter)
+}
@@ -0,0 +1,106 @@
+package extensions
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	RateLimitExtensionTestSuite struct {
+		suite.Suite
+
+		extension *RateLimitExtension
+		counter   *lockoutTestCounter
+
+		context context.Context
+		request *web.Request
+	}
+)
+
+func TestRateLimitExtensionTestSuite(t *testing.T) {
+	suite.Run(t, &RateLimitExtensionTestSuite{})
+}
+
+func (t *RateLimitExtensionTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *RateLimitExtensionTestSuite) SetupTest() {
+	t.counter = &lockoutTestCounter{
+		counts:  map[string]int{},
+		windows: map[string]time.Duration{},
+	}
+	t.extension = &RateLimitExtension{}
+	t.extension.Inject(t.counter, &struct {
+		MaxSubmissions int    `inject:"config:form.rateLimit.maxSubmissions"`
+		Window         string `inject:"config:form.rateLimit.window"`
+	}{
+		MaxSubmissions: 2,
+		Window:         "1h",
+	})
+	t.request = web.CreateRequest(&http.Request{
+		RemoteAddr: "10.0.0.1:52000",
+	}, nil)
+}
+
+func (t *RateLimitExtensionTestSuite) TestValidate() {
+	for i := 0; i < 2; i++ {
+		formData, err := t.extension.Decode(t.context, t.request, url.Values{}, nil)
+		t.NoError(err)
+
+		validationInfo, err := t.extension.Validate(t.context, t.request, nil, formData)
+		t.NoError(err)
+		t.True(validationInfo.IsValid())
+	}
+
+	formData, err := t.extension.Decode(t.context, t.request, url.Values{}, nil)
+	t.NoError(err)
+
+	validationInfo, err := t.extension.Validate(t.context, t.request, nil, formData)
+	t.NoError(err)
+	t.Equal([]domain.Error{
+		{
+			MessageKey:   "formError.rateLimit.exceeded",
+			DefaultLabel: "Too many submissions, please try again later",
+		},
+	}, validationInfo.GetGeneralErrors())
+
+	t.Equal(map[string]int{"rateLimit:ip:10.0.0.1": 3}, t.counter.counts)
+	t.Equal(map[string]time.Duration{"rateLimit:ip:10.0.0.1": time.Hour}, t.counter.windows)
+}
+
+func (t *RateLimitExtensionTestSuite) TestValidate_CounterError() {
+	t.counter.err = errors.New("error")
+
+	formData, err := t.extension.Decode(t.context, t.request, url.Values{}, nil)
+	t.NoError(err)
+
+	validationInfo, err := t.extension.Validate(t.context, t.request, nil, formData)
+	t.Equal(errors.New("error"), err)
+	t.Nil(validationInfo)
+}
+
+func (t *RateLimitExtensionTestSuite) TestValidate_WrongFormData() {
+	validationInfo, err := t.extension.Validate(t.context, t.request, nil, map[string]string{})
+	t.Error(err)
+	t.Nil(validationInfo)
+}
+
+func (t *RateLimitExtensionTestSuite) TestStatus() {
+	ok, _ := t.extension.Status()
+	t.True(ok)
+
+	t.extension.counter = &lockoutTestStatusCounter{}
+	ok, message := t.extension.Status()
+	t.False(ok)
+	t.Equal("redis: connection refused", message)
+}
@@ -0,0 +1,137 @@
+package extensions
+
+import (
+	"context"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// SubmissionLocker defines storage for short-lived locks used by SubmissionLockExtension
+	SubmissionLocker interface {
+		// Lock tries to acquire lock for the key. Lock expires after ttl duration, even if it's never unlocked.
+		// Returned token identifies the holder of acquired lock.
+		Lock(ctx context.Context, key string, ttl time.Duration) (token string, acquired bool, err error)
+		// Unlock releases lock for the key, only if it's still held by the holder of the token
+		Unlock(ctx context.Context, key string, token string) error
+	}
+
+	// SubmissionLockExtension defines form extension which prevents concurrent processing of the same form
+	// (like order placement) for the same user. Lock is acquired during form validation and released by
+	// interfaces.SubmissionLockFilter after the request is processed, so it also covers processing in controller.
+	// Concurrent submissions are rejected with "submission in progress" general error.
+	//
+	// formHandler := c.formHandlerFactory.CreateFormHandlerWithFormService(c.formService, "formExtension.submissionLock")
+	//
+	SubmissionLockExtension struct {
+		locker SubmissionLocker
+		ttl    time.Duration
+	}
+
+	// SubmissionLock defines lock acquired by SubmissionLockExtension during current request
+	SubmissionLock struct {
+		// Key of the lock
+		Key string
+		// Token of the lock holder
+		Token string
+	}
+
+	submissionLockRequestKey struct{}
+)
+
+var (
+	_ domain.FormDataValidator = &SubmissionLockExtension{}
+	_ domain.DependencyStatus  = &SubmissionLockExtension{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (e *SubmissionLockExtension) Inject(
+	locker SubmissionLocker,
+	cfg *struct {
+		TTL string `inject:"config:form.submissionLock.ttl"`
+	},
+) {
+	e.locker = locker
+
+	ttl, err := time.ParseDuration(cfg.TTL)
+	if err != nil {
+		panic(err.Error())
+	}
+	e.ttl = ttl
+}
+
+// Validate acquires submission lock for current user and form, and rejects submission if lock is held by concurrent request
+func (e *SubmissionLockExtension) Validate(ctx context.Context, req *web.Request, _ domain.ValidatorProvider, _ interface{}) (*domain.ValidationInfo, error) {
+	validationInfo := &domain.ValidationInfo{}
+
+	key := submissionLockKey(req)
+	if lock, ok := SubmissionLockFromRequest(req); ok && lock.Key == key {
+		return validationInfo, nil
+	}
+
+	token, acquired, err := e.locker.Lock(ctx, key, e.ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	if !acquired {
+		validationInfo.AddGeneralError("formError.submissionLock.inProgress", "Submission is already in progress")
+		return validationInfo, nil
+	}
+
+	req.Values.Store(submissionLockRequestKey{}, SubmissionLock{
+		Key:   key,
+		Token: token,
+	})
+
+	return validationInfo, nil
+}
+
+// Status reports availability of storage of submission locks
+func (e *SubmissionLockExtension) Status() (bool, string) {
+	return dependencyStatus(e.locker)
+}
+
+// SubmissionLockFromRequest returns submission lock acquired during current request, if there is any
+func SubmissionLockFromRequest(req *web.Request) (SubmissionLock, bool) {
+	value, ok := req.Values.Load(submissionLockRequestKey{})
+	if !ok {
+		return SubmissionLock{}, false
+	}
+
+	lock, ok := value.(SubmissionLock)
+
+	return lock, ok
+}
+
+// ReleaseSubmissionLock releases submission lock acquired during current request, if there is any
+func ReleaseSubmissionLock(ctx context.Context, req *web.Request, locker SubmissionLocker) error {
+	lock, ok := SubmissionLockFromRequest(req)
+	if !ok {
+		return nil
+	}
+
+	req.Values.Delete(submissionLockRequestKey{})
+
+	return locker.Unlock(ctx, lock.Key, lock.Token)
+}
+
+// submissionLockKey creates lock key from user session (or client IP, if session is not persisted yet) and form path
+func submissionLockKey(req *web.Request) string {
+	user := ""
+	if session := req.Session(); session != nil {
+		user = session.ID()
+	}
+	if user == "" {
+		user = "ip:" + clientIP(req)
+	}
+
+	path := ""
+	if req.Request().URL != nil {
+		path = req.Request().URL.Path
+	}
+
+	return user + ":" + path
+}
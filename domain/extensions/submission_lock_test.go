@@ -0,0 +1,138 @@
+package extensions
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	SubmissionLockExtensionTestSuite struct {
+		suite.Suite
+
+		extension *SubmissionLockExtension
+		locker    *submissionLockTestLocker
+
+		context context.Context
+	}
+
+	submissionLockTestLocker struct {
+		locks map[string]string
+		ttls  map[string]time.Duration
+		err   error
+	}
+)
+
+func (l *submissionLockTestLocker) Lock(_ context.Context, key string, ttl time.Duration) (string, bool, error) {
+	if l.err != nil {
+		return "", false, l.err
+	}
+	if _, ok := l.locks[key]; ok {
+		return "", false, nil
+	}
+
+	l.locks[key] = "token-" + key
+	l.ttls[key] = ttl
+
+	return l.locks[key], true, nil
+}
+
+func (l *submissionLockTestLocker) Unlock(_ context.Context, key string, token string) error {
+	if l.locks[key] == token {
+		delete(l.locks, key)
+	}
+	return l.err
+}
+
+func TestSubmissionLockExtensionTestSuite(t *testing.T) {
+	suite.Run(t, &SubmissionLockExtensionTestSuite{})
+}
+
+func (t *SubmissionLockExtensionTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *SubmissionLockExtensionTestSuite) SetupTest() {
+	t.locker = &submissionLockTestLocker{
+		locks: map[string]string{},
+		ttls:  map[string]time.Duration{},
+	}
+	t.extension = &SubmissionLockExtension{
+		locker: t.locker,
+		ttl:    30 * time.Second,
+	}
+}
+
+func (t *SubmissionLockExtensionTestSuite) createRequest(path string) *web.Request {
+	return web.CreateRequest(&http.Request{
+		Method:     http.MethodPost,
+		URL:        &url.URL{Path: path},
+		RemoteAddr: "10.0.0.1:52000",
+	}, nil)
+}
+
+func (t *SubmissionLockExtensionTestSuite) TestValidate() {
+	first := t.createRequest("/checkout")
+
+	validationInfo, err := t.extension.Validate(t.context, first, nil, nil)
+	t.NoError(err)
+	t.True(validationInfo.IsValid())
+	t.Equal(map[string]string{"ip:10.0.0.1:/checkout": "token-ip:10.0.0.1:/checkout"}, t.locker.locks)
+	t.Equal(30*time.Second, t.locker.ttls["ip:10.0.0.1:/checkout"])
+
+	lock, ok := SubmissionLockFromRequest(first)
+	t.True(ok)
+	t.Equal(SubmissionLock{Key: "ip:10.0.0.1:/checkout", Token: "token-ip:10.0.0.1:/checkout"}, lock)
+
+	validationInfo, err = t.extension.Validate(t.context, first, nil, nil)
+	t.NoError(err)
+	t.True(validationInfo.IsValid())
+
+	validationInfo, err = t.extension.Validate(t.context, t.createRequest("/checkout"), nil, nil)
+	t.NoError(err)
+	t.Equal([]domain.Error{
+		{
+			MessageKey:   "formError.submissionLock.inProgress",
+			DefaultLabel: "Submission is already in progress",
+		},
+	}, validationInfo.GetGeneralErrors())
+
+	validationInfo, err = t.extension.Validate(t.context, t.createRequest("/newsletter"), nil, nil)
+	t.NoError(err)
+	t.True(validationInfo.IsValid())
+}
+
+func (t *SubmissionLockExtensionTestSuite) TestValidate_Error() {
+	t.locker.err = errors.New("error")
+
+	validationInfo, err := t.extension.Validate(t.context, t.createRequest("/checkout"), nil, nil)
+	t.Equal(errors.New("error"), err)
+	t.Nil(validationInfo)
+}
+
+func (t *SubmissionLockExtensionTestSuite) TestReleaseSubmissionLock() {
+	first := t.createRequest("/checkout")
+
+	t.NoError(ReleaseSubmissionLock(t.context, first, t.locker))
+
+	_, err := t.extension.Validate(t.context, first, nil, nil)
+	t.NoError(err)
+
+	t.NoError(ReleaseSubmissionLock(t.context, first, t.locker))
+	t.Empty(t.locker.locks)
+
+	_, ok := SubmissionLockFromRequest(first)
+	t.False(ok)
+
+	validationInfo, err := t.extension.Validate(t.context, t.createRequest("/checkout"), nil, nil)
+	t.NoError(err)
+	t.True(validationInfo.IsValid())
+}
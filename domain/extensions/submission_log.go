@@ -0,0 +1,180 @@
+package extensions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// SubmissionLogWriter defines append-only destination of records exported by SubmissionLogExtension
+	// (like local file, log shipper or message queue)
+	SubmissionLogWriter interface {
+		// AppendRecord appends single record, encoded as JSON object terminated by new line
+		AppendRecord(ctx context.Context, record []byte) error
+	}
+
+	// SubmissionLogExtension defines form extension which exports record of every handled submission as line of JSON
+	// (JSONL) via SubmissionLogWriter, so data teams can build funnels of forms without instrumenting controllers.
+	// Exported fields are configurable, values of sensitive fields of the form (like payment card data or encrypted
+	// fields) and of fields which names contain any of configured redacted names (like "password") are replaced,
+	// card numbers of all other values are masked, and export is opt-in, so extension does nothing until it's enabled via
+	// configuration. Failed export is logged, without failing the submission.
+	//
+	// formHandler := c.formHandlerFactory.CreateFormHandlerWithFormService(c.formService, "formExtension.submissionLog")
+	//
+	SubmissionLogExtension struct {
+		writer        SubmissionLogWriter
+		logger        flamingo.Logger
+		enabled       bool
+		fields        []string
+		redactedNames []string
+		clock         domain.Clock
+	}
+)
+
+// Fields of submission log records
+const (
+	// SubmissionLogFieldID correlation ID of the submission
+	SubmissionLogFieldID = "id"
+	// SubmissionLogFieldTimestamp timestamp of the submission, formatted as RFC 3339
+	SubmissionLogFieldTimestamp = "timestamp"
+	// SubmissionLogFieldType package qualified name of form data type (like "presets.RegistrationFormData")
+	SubmissionLogFieldType = "type"
+	// SubmissionLogFieldMethod http method used for the submission
+	SubmissionLogFieldMethod = "method"
+	// SubmissionLogFieldPath path of the form
+	SubmissionLogFieldPath = "path"
+	// SubmissionLogFieldValid flag if submitted form is valid
+	SubmissionLogFieldValid = "valid"
+	// SubmissionLogFieldDegraded flag if any of optional subsystems was skipped during form handling
+	SubmissionLogFieldDegraded = "degraded"
+	// SubmissionLogFieldGeneralErrors message keys of general errors
+	SubmissionLogFieldGeneralErrors = "generalErrors"
+	// SubmissionLogFieldFieldErrors message keys of field errors, by field name
+	SubmissionLogFieldFieldErrors = "fieldErrors"
+	// SubmissionLogFieldValues submitted values, with secrets redacted
+	SubmissionLogFieldValues = "values"
+)
+
+var (
+	_ domain.FormResultObserver = &SubmissionLogExtension{}
+
+	// submissionLogFields all fields which can be exported
+	submissionLogFields = map[string]bool{
+		SubmissionLogFieldID:            true,
+		SubmissionLogFieldTimestamp:     true,
+		SubmissionLogFieldType:          true,
+		SubmissionLogFieldMethod:        true,
+		SubmissionLogFieldPath:          true,
+		SubmissionLogFieldValid:         true,
+		SubmissionLogFieldDegraded:      true,
+		SubmissionLogFieldGeneralErrors: true,
+		SubmissionLogFieldFieldErrors:   true,
+		SubmissionLogFieldValues:        true,
+	}
+)
+
+// Inject is method used to set all dependencies as local variables. It panics if any of configured fields is unknown.
+func (e *SubmissionLogExtension) Inject(
+	writer SubmissionLogWriter,
+	logger flamingo.Logger,
+	clock domain.Clock,
+	cfg *struct {
+		Enabled       bool         `inject:"config:form.submissionLog.enabled"`
+		Fields        config.Slice `inject:"config:form.submissionLog.fields"`
+		RedactedNames config.Slice `inject:"config:form.submissionLog.redactedNames"`
+	},
+) {
+	e.writer = writer
+	e.logger = logger
+	e.clock = clock
+	e.enabled = cfg.Enabled
+	e.redactedNames = configuredRedactedNames(cfg.RedactedNames)
+
+	if err := cfg.Fields.MapInto(&e.fields); err != nil {
+		panic(err.Error())
+	}
+
+	for _, field := range e.fields {
+		if !submissionLogFields[field] {
+			panic(fmt.Sprintf("unknown field %q of submission log", field))
+		}
+	}
+}
+
+// ObserveFormResult exports record of submitted form
+func (e *SubmissionLogExtension) ObserveFormResult(ctx context.Context, req *web.Request, values url.Values, form *domain.Form) error {
+	if !e.enabled || !form.IsSubmitted() {
+		return nil
+	}
+
+	record, err := json.Marshal(e.record(req, values, form))
+	if err != nil {
+		e.logger.WithContext(ctx).WithField("FormExtension", "submissionLog").Error(err)
+		return nil
+	}
+
+	if err := e.writer.AppendRecord(ctx, append(record, '\n')); err != nil {
+		e.logger.WithContext(ctx).WithField("FormExtension", "submissionLog").Error(err)
+	}
+
+	return nil
+}
+
+// record returns configured fields of record of submitted form
+func (e *SubmissionLogExtension) record(req *web.Request, values url.Values, form *domain.Form) map[string]interface{} {
+	record := make(map[string]interface{}, len(e.fields))
+
+	for _, field := range e.fields {
+		switch field {
+		case SubmissionLogFieldID:
+			record[field] = form.CorrelationID
+		case SubmissionLogFieldTimestamp:
+			record[field] = domain.CurrentTime(e.clock).UTC().Format(time.RFC3339Nano)
+		case SubmissionLogFieldType:
+			record[field] = eventType(form.Data)
+		case SubmissionLogFieldMethod:
+			if req != nil {
+				record[field] = req.Request().Method
+			}
+		case SubmissionLogFieldPath:
+			if req != nil && req.Request().URL != nil {
+				record[field] = req.Request().URL.Path
+			}
+		case SubmissionLogFieldValid:
+			record[field] = form.IsValid()
+		case SubmissionLogFieldDegraded:
+			record[field] = form.IsDegraded()
+		case SubmissionLogFieldGeneralErrors:
+			record[field] = messageKeys(form.ValidationInfo.GetGeneralErrors())
+		case SubmissionLogFieldFieldErrors:
+			fieldErrors := map[string][]string{}
+			for name, errs := range form.ValidationInfo.GetErrorsForAllFields() {
+				fieldErrors[name] = messageKeys(errs)
+			}
+			record[field] = fieldErrors
+		case SubmissionLogFieldValues:
+			record[field] = domain.RedactValues(values, form, e.redactedNames)
+		}
+	}
+
+	return record
+}
+
+// messageKeys returns message keys of errors, so records don't depend on translations of their labels
+func messageKeys(errs []domain.Error) []string {
+	keys := make([]string, 0, len(errs))
+	for _, err := range errs {
+		keys = append(keys, err.MessageKey)
+	}
+
+	return keys
+}
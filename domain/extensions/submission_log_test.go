@@ -0,0 +1,152 @@
+package extensions
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/formtest"
+)
+
+type (
+	SubmissionLogExtensionTestSuite struct {
+		suite.Suite
+
+		extension *SubmissionLogExtension
+		writer    *submissionLogTestWriter
+
+		context context.Context
+		request *web.Request
+	}
+
+	submissionLogTestWriter struct {
+		records []string
+		err     error
+	}
+
+	submissionLogTestData struct {
+		Email string
+	}
+)
+
+func (w *submissionLogTestWriter) AppendRecord(_ context.Context, record []byte) error {
+	w.records = append(w.records, string(record))
+	return w.err
+}
+
+func TestSubmissionLogExtensionTestSuite(t *testing.T) {
+	suite.Run(t, &SubmissionLogExtensionTestSuite{})
+}
+
+func (t *SubmissionLogExtensionTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *SubmissionLogExtensionTestSuite) SetupTest() {
+	t.writer = &submissionLogTestWriter{}
+	t.extension = &SubmissionLogExtension{
+		writer:        t.writer,
+		logger:        &flamingo.NullLogger{},
+		enabled:       true,
+		fields:        []string{"id", "timestamp", "type", "method", "path", "valid", "degraded", "generalErrors", "fieldErrors", "values"},
+		redactedNames: []string{"password"},
+		clock:         formtest.NewFakeClock(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)),
+	}
+	t.request = web.CreateRequest(&http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{Path: "/register"},
+	}, nil)
+}
+
+func (t *SubmissionLogExtensionTestSuite) TestInject() {
+	extension := &SubmissionLogExtension{}
+	extension.Inject(t.writer, &flamingo.NullLogger{}, nil, &struct {
+		Enabled       bool         `inject:"config:form.submissionLog.enabled"`
+		Fields        config.Slice `inject:"config:form.submissionLog.fields"`
+		RedactedNames config.Slice `inject:"config:form.submissionLog.redactedNames"`
+	}{
+		Enabled:       true,
+		Fields:        config.Slice{"id", "valid"},
+		RedactedNames: config.Slice{"Password"},
+	})
+	t.Equal([]string{"id", "valid"}, extension.fields)
+	t.Equal([]string{"password"}, extension.redactedNames)
+
+	t.Panics(func() {
+		extension.Inject(t.writer, &flamingo.NullLogger{}, nil, &struct {
+			Enabled       bool         `inject:"config:form.submissionLog.enabled"`
+			Fields        config.Slice `inject:"config:form.submissionLog.fields"`
+			RedactedNames config.Slice `inject:"config:form.submissionLog.redactedNames"`
+		}{
+			Fields: config.Slice{"ip"},
+		})
+	})
+}
+
+func (t *SubmissionLogExtensionTestSuite) TestObserveFormResult() {
+	form := domain.NewForm(true, nil)
+	form.Data = &submissionLogTestData{}
+	form.CorrelationID = "correlation"
+	form.ValidationInfo.AddGeneralError("formError.general", "general")
+	form.ValidationInfo.AddFieldError("email", "formError.email.email", "email")
+
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{
+		"email":    []string{"user@example.com"},
+		"password": []string{"secret"},
+	}, &form))
+	t.Equal([]string{
+		`{"degraded":false,"fieldErrors":{"email":["formError.email.email"]},"generalErrors":["formError.general"],` +
+			`"id":"correlation","method":"POST","path":"/register","timestamp":"2020-01-01T12:00:00Z",` +
+			`"type":"extensions.submissionLogTestData","valid":false,` +
+			`"values":{"email":["user@example.com"],"password":["[REDACTED]"]}}` + "\n",
+	}, t.writer.records)
+}
+
+func (t *SubmissionLogExtensionTestSuite) TestObserveFormResult_SensitiveFields() {
+	t.extension.fields = []string{"values"}
+
+	form := domain.NewForm(true, nil)
+	form.SensitiveFields = map[string]bool{"iban": true}
+
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{
+		"iban": []string{"DE89370400440532013000"},
+		"note": []string{"card 4111111111111111"},
+	}, &form))
+	t.Equal([]string{`{"values":{"iban":["[REDACTED]"],"note":["card ************1111"]}}` + "\n"}, t.writer.records)
+}
+
+func (t *SubmissionLogExtensionTestSuite) TestObserveFormResult_ConfiguredFields() {
+	t.extension.fields = []string{"path", "valid"}
+
+	form := domain.NewForm(true, nil)
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{"password": []string{"secret"}}, &form))
+	t.Equal([]string{`{"path":"/register","valid":true}` + "\n"}, t.writer.records)
+}
+
+func (t *SubmissionLogExtensionTestSuite) TestObserveFormResult_NotExported() {
+	form := domain.NewForm(false, nil)
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+
+	t.extension.enabled = false
+	form = domain.NewForm(true, nil)
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+
+	t.Empty(t.writer.records)
+}
+
+func (t *SubmissionLogExtensionTestSuite) TestObserveFormResult_Error() {
+	t.writer.err = errors.New("error")
+
+	form := domain.NewForm(true, nil)
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+	t.Len(t.writer.records, 1)
+}
@@ -0,0 +1,183 @@
+package extensions
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// SubmissionMailer defines delivery of mails rendered by SubmissionMailExtension (like SMTP server)
+	SubmissionMailer interface {
+		// Send sends single mail to configured recipients
+		Send(ctx context.Context, mail SubmissionMail) error
+	}
+
+	// SubmissionMail defines mail rendered from valid form submission
+	SubmissionMail struct {
+		// Subject rendered subject of the mail
+		Subject string
+		// Body rendered plain text body of the mail
+		Body string
+		// Timestamp of the submission
+		Timestamp time.Time
+	}
+
+	// SubmissionMailData defines data available in subject and body templates of SubmissionMailExtension
+	SubmissionMailData struct {
+		// Type package qualified name of form data type (like "presets.ContactFormData")
+		Type string
+		// Path of the form
+		Path string
+		// Locale in which the form was submitted
+		Locale string
+		// Timestamp of the submission
+		Timestamp time.Time
+		// Data final form data
+		Data interface{}
+		// Values final form data encoded as form values, without excluded fields
+		Values url.Values
+	}
+
+	// SubmissionMailExtension defines form extension which renders final form data of valid submission through
+	// configured templates, and sends it as mail via SubmissionMailer, covering "email me this form" requirement.
+	// Fields which names contain any of configured excluded names (like "password") are not part of Values.
+	//
+	// formHandler := c.formHandlerFactory.CreateFormHandlerWithFormService(c.formService, "formExtension.submissionMail")
+	//
+	SubmissionMailExtension struct {
+		mailer        SubmissionMailer
+		encoder       domain.DefaultFormDataEncoder
+		subject       *template.Template
+		body          *template.Template
+		excludedNames []string
+		clock         domain.Clock
+	}
+)
+
+var (
+	_ domain.FormResultObserver = &SubmissionMailExtension{}
+	_ domain.DependencyStatus   = &SubmissionMailExtension{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (e *SubmissionMailExtension) Inject(
+	mailer SubmissionMailer,
+	encoder domain.DefaultFormDataEncoder,
+	clock domain.Clock,
+	cfg *struct {
+		Subject       string       `inject:"config:form.submissionMail.subject"`
+		Template      string       `inject:"config:form.submissionMail.template"`
+		ExcludedNames config.Slice `inject:"config:form.submissionMail.excludedNames"`
+	},
+) {
+	e.mailer = mailer
+	e.encoder = encoder
+	e.clock = clock
+	e.subject = submissionMailTemplate("subject", cfg.Subject)
+	e.body = submissionMailTemplate("body", cfg.Template)
+
+	var excludedNames []string
+	if err := cfg.ExcludedNames.MapInto(&excludedNames); err != nil {
+		panic(err.Error())
+	}
+
+	for _, name := range excludedNames {
+		e.excludedNames = append(e.excludedNames, strings.ToLower(name))
+	}
+}
+
+// ObserveFormResult renders and sends mail of valid submitted form
+func (e *SubmissionMailExtension) ObserveFormResult(ctx context.Context, req *web.Request, _ url.Values, form *domain.Form) error {
+	if !form.IsValidAndSubmitted() {
+		return nil
+	}
+
+	values, err := e.encoder.Encode(ctx, form.Data)
+	if err != nil {
+		return err
+	}
+
+	data := SubmissionMailData{
+		Type:      eventType(form.Data),
+		Locale:    requestLocale(req),
+		Timestamp: domain.CurrentTime(e.clock),
+		Data:      form.Data,
+		Values:    e.exclude(values),
+	}
+
+	if req != nil && req.Request().URL != nil {
+		data.Path = req.Request().URL.Path
+	}
+
+	subject, err := renderSubmissionMail(e.subject, data)
+	if err != nil {
+		return err
+	}
+
+	body, err := renderSubmissionMail(e.body, data)
+	if err != nil {
+		return err
+	}
+
+	return e.mailer.Send(ctx, SubmissionMail{
+		Subject:   subject,
+		Body:      body,
+		Timestamp: data.Timestamp,
+	})
+}
+
+// Status reports availability of mail delivery
+func (e *SubmissionMailExtension) Status() (bool, string) {
+	return dependencyStatus(e.mailer)
+}
+
+// exclude creates copy of values, without fields which names contain any of excluded names
+func (e *SubmissionMailExtension) exclude(values url.Values) url.Values {
+	result := make(url.Values, len(values))
+
+	for name, fieldValues := range values {
+		if !e.isExcluded(name) {
+			result[name] = fieldValues
+		}
+	}
+
+	return result
+}
+
+// isExcluded checks if field name contains any of excluded names
+func (e *SubmissionMailExtension) isExcluded(name string) bool {
+	name = strings.ToLower(name)
+
+	for _, excludedName := range e.excludedNames {
+		if strings.Contains(name, excludedName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// submissionMailTemplate parses template of submission mail, with function "join" for joining multiple values
+func submissionMailTemplate(name string, text string) *template.Template {
+	return template.Must(template.New(name).Funcs(template.FuncMap{
+		"join": strings.Join,
+	}).Parse(text))
+}
+
+// renderSubmissionMail executes template of submission mail with data
+func renderSubmissionMail(tmpl *template.Template, data interface{}) (string, error) {
+	buffer := &bytes.Buffer{}
+	if err := tmpl.Execute(buffer, data); err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
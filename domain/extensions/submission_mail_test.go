@@ -0,0 +1,138 @@
+package extensions
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/formtest"
+)
+
+type (
+	SubmissionMailExtensionTestSuite struct {
+		suite.Suite
+
+		extension *SubmissionMailExtension
+		mailer    *submissionMailTestMailer
+		encoder   *submissionMailTestEncoder
+
+		context context.Context
+		request *web.Request
+	}
+
+	submissionMailTestMailer struct {
+		mails []SubmissionMail
+		err   error
+	}
+
+	submissionMailTestEncoder struct {
+		values url.Values
+		err    error
+	}
+
+	submissionMailTestFormData struct {
+		Email string
+	}
+)
+
+func (m *submissionMailTestMailer) Send(_ context.Context, mail SubmissionMail) error {
+	m.mails = append(m.mails, mail)
+	return m.err
+}
+
+func (e *submissionMailTestEncoder) Encode(context.Context, interface{}) (url.Values, error) {
+	return e.values, e.err
+}
+
+func TestSubmissionMailExtensionTestSuite(t *testing.T) {
+	suite.Run(t, &SubmissionMailExtensionTestSuite{})
+}
+
+func (t *SubmissionMailExtensionTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *SubmissionMailExtensionTestSuite) SetupTest() {
+	t.mailer = &submissionMailTestMailer{}
+	t.encoder = &submissionMailTestEncoder{
+		values: url.Values{
+			"email":    []string{"user@example.com"},
+			"password": []string{"secret"},
+			"topics":   []string{"shoes", "shirts"},
+		},
+	}
+	t.extension = &SubmissionMailExtension{}
+	t.extension.Inject(t.mailer, t.encoder, formtest.NewFakeClock(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)), &struct {
+		Subject       string       `inject:"config:form.submissionMail.subject"`
+		Template      string       `inject:"config:form.submissionMail.template"`
+		ExcludedNames config.Slice `inject:"config:form.submissionMail.excludedNames"`
+	}{
+		Subject:       "New submission of {{ .Path }}",
+		Template:      "{{ range $name, $values := .Values }}{{ $name }}: {{ join $values \", \" }}\n{{ end }}{{ .Locale }} {{ .Data.Email }}",
+		ExcludedNames: config.Slice{"Password"},
+	})
+	t.request = web.CreateRequest(&http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{Path: "/contact"},
+		Header: http.Header{
+			"Accept-Language": []string{"de-DE,de;q=0.9"},
+		},
+	}, nil)
+}
+
+func (t *SubmissionMailExtensionTestSuite) TestObserveFormResult() {
+	form := domain.NewForm(true, nil)
+	form.Data = submissionMailTestFormData{Email: "user@example.com"}
+
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+	t.Equal([]SubmissionMail{
+		{
+			Subject:   "New submission of /contact",
+			Body:      "email: user@example.com\ntopics: shoes, shirts\nde-DE user@example.com",
+			Timestamp: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}, t.mailer.mails)
+}
+
+func (t *SubmissionMailExtensionTestSuite) TestObserveFormResult_NotValid() {
+	form := domain.NewForm(true, nil)
+	form.ValidationInfo.AddGeneralError("error", "error")
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+
+	form = domain.NewForm(false, nil)
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+
+	t.Empty(t.mailer.mails)
+}
+
+func (t *SubmissionMailExtensionTestSuite) TestObserveFormResult_EncoderError() {
+	t.encoder.err = errors.New("error")
+
+	form := domain.NewForm(true, nil)
+	t.Equal(errors.New("error"), t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+	t.Empty(t.mailer.mails)
+}
+
+func (t *SubmissionMailExtensionTestSuite) TestObserveFormResult_TemplateError() {
+	form := domain.NewForm(true, nil)
+	form.Data = struct{ Name string }{}
+
+	t.Error(t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+	t.Empty(t.mailer.mails)
+}
+
+func (t *SubmissionMailExtensionTestSuite) TestObserveFormResult_MailerError() {
+	t.mailer.err = errors.New("error")
+
+	form := domain.NewForm(true, nil)
+	form.Data = submissionMailTestFormData{}
+	t.Equal(errors.New("error"), t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+}
@@ -0,0 +1,133 @@
+package extensions
+
+import (
+	"context"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// SubmissionRecordStore defines storage for submissions recorded by SubmissionRecorderExtension
+	SubmissionRecordStore interface {
+		// StoreSubmission stores single recorded submission
+		StoreSubmission(ctx context.Context, recording SubmissionRecording) error
+		// LoadSubmission loads recorded submission by its ID
+		LoadSubmission(ctx context.Context, id string) (*SubmissionRecording, error)
+	}
+
+	// SubmissionRecording defines anonymized failed form submission, which can be replayed later
+	SubmissionRecording struct {
+		// ID unique identifier of the recording
+		ID string
+		// Timestamp of the submission
+		Timestamp time.Time
+		// Method http method used for the submission
+		Method string
+		// Path of the form
+		Path string
+		// Values submitted form values, with secrets redacted
+		Values url.Values
+		// GeneralErrors resulting general errors of the submission
+		GeneralErrors []domain.Error
+		// FieldErrors resulting field errors of the submission
+		FieldErrors map[string][]domain.Error
+	}
+
+	// SubmissionRecorderExtension defines form extension which records failed submissions for debugging.
+	// Values of sensitive fields of the form (like payment card data or encrypted fields) and of fields which names
+	// contain any of configured redacted names (like "password") are replaced, card numbers of all other values
+	// are masked, and none of client's data (like IP address or session) is recorded. Recording is opt-in, so extension
+	// does nothing until it's enabled via configuration.
+	//
+	// formHandler := c.formHandlerFactory.CreateFormHandlerWithFormService(c.formService, "formExtension.submissionRecorder")
+	//
+	SubmissionRecorderExtension struct {
+		store         SubmissionRecordStore
+		enabled       bool
+		redactedNames []string
+		clock         domain.Clock
+		tokenSource   domain.TokenSource
+	}
+)
+
+// RedactedValue is value recorded instead of redacted form values
+const RedactedValue = domain.RedactedValue
+
+var _ domain.FormResultObserver = &SubmissionRecorderExtension{}
+
+// Inject is method used to set all dependencies as local variables
+func (e *SubmissionRecorderExtension) Inject(
+	store SubmissionRecordStore,
+	clock domain.Clock,
+	tokenSource domain.TokenSource,
+	cfg *struct {
+		Enabled       bool         `inject:"config:form.submissionRecorder.enabled"`
+		RedactedNames config.Slice `inject:"config:form.submissionRecorder.redactedNames"`
+	},
+) {
+	e.store = store
+	e.clock = clock
+	e.tokenSource = tokenSource
+	e.enabled = cfg.Enabled
+
+	e.redactedNames = configuredRedactedNames(cfg.RedactedNames)
+}
+
+// ObserveFormResult records submitted form which is not valid
+func (e *SubmissionRecorderExtension) ObserveFormResult(ctx context.Context, req *web.Request, values url.Values, form *domain.Form) error {
+	if !e.enabled || !form.IsSubmitted() || form.IsValid() {
+		return nil
+	}
+
+	id, err := generateID(e.tokenSource)
+	if err != nil {
+		return err
+	}
+
+	recording := SubmissionRecording{
+		ID:            id,
+		Timestamp:     domain.CurrentTime(e.clock),
+		Values:        domain.RedactValues(values, form, e.redactedNames),
+		GeneralErrors: form.ValidationInfo.GetGeneralErrors(),
+		FieldErrors:   form.ValidationInfo.GetErrorsForAllFields(),
+	}
+
+	if req != nil {
+		recording.Method = req.Request().Method
+		if req.Request().URL != nil {
+			recording.Path = req.Request().URL.Path
+		}
+	}
+
+	return e.store.StoreSubmission(ctx, recording)
+}
+
+// generateID generates random ID of recording or event by token source, which is safe to be used as file name
+func generateID(tokenSource domain.TokenSource) (string, error) {
+	id := make([]byte, 12)
+	if err := domain.ReadToken(tokenSource, id); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(id), nil
+}
+
+// configuredRedactedNames returns configured redacted names in lower case. It panics if they are not list of strings.
+func configuredRedactedNames(names config.Slice) []string {
+	var redactedNames []string
+	if err := names.MapInto(&redactedNames); err != nil {
+		panic(err.Error())
+	}
+
+	for i := range redactedNames {
+		redactedNames[i] = strings.ToLower(redactedNames[i])
+	}
+
+	return redactedNames
+}
@@ -0,0 +1,158 @@
+package extensions
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/formtest"
+)
+
+type (
+	SubmissionRecorderExtensionTestSuite struct {
+		suite.Suite
+
+		extension *SubmissionRecorderExtension
+		store     *submissionRecorderTestStore
+
+		context context.Context
+		request *web.Request
+	}
+
+	submissionRecorderTestStore struct {
+		recordings []SubmissionRecording
+		err        error
+	}
+)
+
+func (s *submissionRecorderTestStore) StoreSubmission(_ context.Context, recording SubmissionRecording) error {
+	s.recordings = append(s.recordings, recording)
+	return s.err
+}
+
+func (s *submissionRecorderTestStore) LoadSubmission(context.Context, string) (*SubmissionRecording, error) {
+	return nil, s.err
+}
+
+func TestSubmissionRecorderExtensionTestSuite(t *testing.T) {
+	suite.Run(t, &SubmissionRecorderExtensionTestSuite{})
+}
+
+func (t *SubmissionRecorderExtensionTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *SubmissionRecorderExtensionTestSuite) SetupTest() {
+	t.store = &submissionRecorderTestStore{}
+	t.extension = &SubmissionRecorderExtension{
+		store:         t.store,
+		enabled:       true,
+		redactedNames: []string{"password", "iban"},
+		clock:         formtest.NewFakeClock(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)),
+		tokenSource:   formtest.NewFakeTokenSource(0x0f),
+	}
+	t.request = web.CreateRequest(&http.Request{
+		Method:     http.MethodPost,
+		URL:        &url.URL{Path: "/register"},
+		RemoteAddr: "10.0.0.1:52000",
+	}, nil)
+}
+
+func (t *SubmissionRecorderExtensionTestSuite) TestObserveFormResult() {
+	values := url.Values{
+		"email":                []string{"user@example.com"},
+		"password":             []string{"secret"},
+		"passwordConfirmation": []string{"secret"},
+		"account.IBAN":         []string{"DE00", "DE01"},
+		"payment.number":       []string{"4111 1111 1111 1111"},
+	}
+
+	form := domain.NewForm(true, nil)
+	form.ValidationInfo.AddGeneralError("formError.general", "general")
+	form.ValidationInfo.AddFieldError("email", "formError.email.email", "email")
+
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, values, &form))
+	t.Len(t.store.recordings, 1)
+
+	recording := t.store.recordings[0]
+	t.Equal("0f0f0f0f0f0f0f0f0f0f0f0f", recording.ID)
+	t.Equal(SubmissionRecording{
+		ID:        recording.ID,
+		Timestamp: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		Method:    http.MethodPost,
+		Path:      "/register",
+		Values: url.Values{
+			"email":                []string{"user@example.com"},
+			"password":             []string{RedactedValue},
+			"passwordConfirmation": []string{RedactedValue},
+			"account.IBAN":         []string{RedactedValue, RedactedValue},
+			"payment.number":       []string{"************1111"},
+		},
+		GeneralErrors: []domain.Error{
+			{
+				MessageKey:   "formError.general",
+				DefaultLabel: "general",
+			},
+		},
+		FieldErrors: map[string][]domain.Error{
+			"email": {
+				{
+					MessageKey:   "formError.email.email",
+					DefaultLabel: "email",
+				},
+			},
+		},
+	}, recording)
+	t.Equal([]string{"secret"}, values["password"])
+}
+
+func (t *SubmissionRecorderExtensionTestSuite) TestObserveFormResult_SensitiveFields() {
+	form := domain.NewForm(true, nil)
+	form.SensitiveFields = map[string]bool{"secret": true, "contacts.phone": true, "card.cvc": true}
+	form.ValidationInfo.AddGeneralError("formError.general", "general")
+
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{
+		"secret":            []string{"encrypted in clear text"},
+		"contacts[0].phone": []string{"+49 30 1234567"},
+		"card.cvc":          []string{"123"},
+		"note":              []string{"card 4111111111111111"},
+	}, &form))
+	t.Len(t.store.recordings, 1)
+
+	t.Equal(url.Values{
+		"secret":            []string{RedactedValue},
+		"contacts[0].phone": []string{RedactedValue},
+		"card.cvc":          []string{RedactedValue},
+		"note":              []string{"card ************1111"},
+	}, t.store.recordings[0].Values)
+}
+
+func (t *SubmissionRecorderExtensionTestSuite) TestObserveFormResult_NotRecorded() {
+	form := domain.NewForm(false, nil)
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+
+	form = domain.NewForm(true, nil)
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+
+	t.extension.enabled = false
+	form.ValidationInfo.AddGeneralError("formError.general", "general")
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+
+	t.Empty(t.store.recordings)
+}
+
+func (t *SubmissionRecorderExtensionTestSuite) TestObserveFormResult_Error() {
+	t.store.err = errors.New("error")
+
+	form := domain.NewForm(true, nil)
+	form.ValidationInfo.AddGeneralError("formError.general", "general")
+
+	t.Equal(errors.New("error"), t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+}
@@ -0,0 +1,215 @@
+package extensions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// RetainableSubmissionStore defines SubmissionStore which supports retention policies of SubmissionRetention
+	RetainableSubmissionStore interface {
+		SubmissionStore
+		// FindSubmissions returns all stored submissions of form data type, which are stored before given time
+		FindSubmissions(ctx context.Context, submissionType string, before time.Time) ([]StoredSubmission, error)
+		// UpdateSubmission replaces data of stored submission
+		UpdateSubmission(ctx context.Context, submission StoredSubmission) error
+		// DeleteSubmission removes stored submission by its ID
+		DeleteSubmission(ctx context.Context, id string) error
+	}
+
+	// SubmissionRetention defines scheduled job, which deletes or anonymizes stored submissions after configured
+	// number of days, so stored form data stays GDPR-compliant. Policies are defined per form data type, and job is
+	// meant to be run periodically (like via "form-retention" command from cron job).
+	SubmissionRetention struct {
+		store    SubmissionStore
+		policies []SubmissionRetentionPolicy
+		clock    domain.Clock
+	}
+
+	// SubmissionRetentionPolicy defines retention of stored submissions of single form data type
+	SubmissionRetentionPolicy struct {
+		// Type package qualified name of form data type (like "presets.ContactFormData")
+		Type string `json:"type"`
+		// Action either "delete" or "anonymize"
+		Action string `json:"action"`
+		// Days after which submissions are deleted or anonymized
+		Days int `json:"days"`
+		// Fields dot separated paths of JSON encoded form data, which are anonymized (like "customer.email")
+		Fields []string `json:"fields"`
+	}
+
+	// SubmissionRetentionResult defines outcome of single SubmissionRetention run
+	SubmissionRetentionResult struct {
+		// Deleted number of deleted submissions
+		Deleted int
+		// Anonymized number of submissions which data changed by anonymization
+		Anonymized int
+	}
+)
+
+const (
+	// RetentionActionDelete deletes stored submissions
+	RetentionActionDelete = "delete"
+	// RetentionActionAnonymize replaces configured fields of stored submissions with RedactedValue
+	RetentionActionAnonymize = "anonymize"
+)
+
+// Inject is method used to set all dependencies as local variables
+func (r *SubmissionRetention) Inject(
+	store SubmissionStore,
+	clock domain.Clock,
+	cfg *struct {
+		Policies config.Slice `inject:"config:form.submissionStore.retention.policies"`
+	},
+) {
+	r.store = store
+	r.clock = clock
+
+	var policies []SubmissionRetentionPolicy
+	if err := cfg.Policies.MapInto(&policies); err != nil {
+		panic(err.Error())
+	}
+
+	for _, policy := range policies {
+		if err := policy.check(); err != nil {
+			panic(err.Error())
+		}
+	}
+	r.policies = policies
+}
+
+// Run applies all retention policies to stored submissions. Policies are applied in configured order, and run stops
+// with the first error, so it can be repeated safely.
+func (r *SubmissionRetention) Run(ctx context.Context) (SubmissionRetentionResult, error) {
+	result := SubmissionRetentionResult{}
+	if len(r.policies) == 0 {
+		return result, nil
+	}
+
+	store, ok := r.store.(RetainableSubmissionStore)
+	if !ok {
+		return result, fmt.Errorf("submission store %T doesn't support retention policies", r.store)
+	}
+
+	now := domain.CurrentTime(r.clock)
+	for _, policy := range r.policies {
+		submissions, err := store.FindSubmissions(ctx, policy.Type, now.AddDate(0, 0, -policy.Days))
+		if err != nil {
+			return result, err
+		}
+
+		for _, submission := range submissions {
+			if policy.Action == RetentionActionDelete {
+				if err := store.DeleteSubmission(ctx, submission.ID); err != nil {
+					return result, err
+				}
+				result.Deleted++
+				continue
+			}
+
+			data, changed, err := anonymizeSubmissionData(submission.Data, policy.Fields)
+			if err != nil {
+				return result, fmt.Errorf("anonymization of submission %s failed: %v", submission.ID, err)
+			}
+			if !changed {
+				continue
+			}
+
+			submission.Data = data
+			if err := store.UpdateSubmission(ctx, submission); err != nil {
+				return result, err
+			}
+			result.Anonymized++
+		}
+	}
+
+	return result, nil
+}
+
+// check validates configured retention policy
+func (p SubmissionRetentionPolicy) check() error {
+	if p.Type == "" {
+		return fmt.Errorf("retention policy without form data type")
+	}
+
+	if p.Days < 0 {
+		return fmt.Errorf("retention policy of %s with negative days", p.Type)
+	}
+
+	switch p.Action {
+	case RetentionActionDelete:
+		return nil
+	case RetentionActionAnonymize:
+		if len(p.Fields) == 0 {
+			return fmt.Errorf("anonymization policy of %s without fields", p.Type)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unknown retention action %q of %s", p.Action, p.Type)
+}
+
+// anonymizeSubmissionData replaces values of fields in JSON encoded form data with RedactedValue,
+// and reports if any value has changed
+func anonymizeSubmissionData(data json.RawMessage, fields []string) (json.RawMessage, bool, error) {
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, false, err
+	}
+
+	changed := false
+	for _, field := range fields {
+		if anonymizeField(decoded, strings.Split(field, ".")) {
+			changed = true
+		}
+	}
+
+	if !changed {
+		return data, false, nil
+	}
+
+	encoded, err := json.Marshal(decoded)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return encoded, true, nil
+}
+
+// anonymizeField replaces value found by path with RedactedValue. Lists are traversed, so path "items.name"
+// anonymizes names of all items.
+func anonymizeField(value interface{}, path []string) bool {
+	switch typed := value.(type) {
+	case []interface{}:
+		changed := false
+		for _, item := range typed {
+			if anonymizeField(item, path) {
+				changed = true
+			}
+		}
+		return changed
+	case map[string]interface{}:
+		fieldValue, ok := typed[path[0]]
+		if !ok {
+			return false
+		}
+
+		if len(path) > 1 {
+			return anonymizeField(fieldValue, path[1:])
+		}
+
+		if fieldValue == nil || fieldValue == RedactedValue {
+			return false
+		}
+		typed[path[0]] = RedactedValue
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,163 @@
+package extensions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+
+	"flamingo.me/form/formtest"
+)
+
+type (
+	SubmissionRetentionTestSuite struct {
+		suite.Suite
+
+		retention *SubmissionRetention
+		store     *submissionRetentionTestStore
+
+		context context.Context
+	}
+
+	submissionRetentionTestStore struct {
+		submissionStoreTestStore
+		found   map[string][]StoredSubmission
+		before  []time.Time
+		updated []StoredSubmission
+		deleted []string
+		err     error
+	}
+)
+
+func (s *submissionRetentionTestStore) FindSubmissions(_ context.Context, submissionType string, before time.Time) ([]StoredSubmission, error) {
+	s.before = append(s.before, before)
+	return s.found[submissionType], s.err
+}
+
+func (s *submissionRetentionTestStore) UpdateSubmission(_ context.Context, submission StoredSubmission) error {
+	s.updated = append(s.updated, submission)
+	return nil
+}
+
+func (s *submissionRetentionTestStore) DeleteSubmission(_ context.Context, id string) error {
+	s.deleted = append(s.deleted, id)
+	return nil
+}
+
+func TestSubmissionRetentionTestSuite(t *testing.T) {
+	suite.Run(t, &SubmissionRetentionTestSuite{})
+}
+
+func (t *SubmissionRetentionTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *SubmissionRetentionTestSuite) SetupTest() {
+	t.store = &submissionRetentionTestStore{
+		found: map[string][]StoredSubmission{
+			"presets.ContactFormData": {
+				{ID: "a", Data: json.RawMessage(`{"email":"user@example.com"}`)},
+				{ID: "b", Data: json.RawMessage(`{"email":"[REDACTED]"}`)},
+			},
+			"presets.RegistrationFormData": {
+				{ID: "c", Data: json.RawMessage(`{"customer":{"email":"user@example.com","newsletter":true},"addresses":[{"street":"Main"},{"street":"Side"}]}`)},
+			},
+			"presets.LoginFormData": {
+				{ID: "d"},
+				{ID: "e"},
+			},
+		},
+	}
+	t.retention = t.createRetention(config.Slice{
+		config.Map{"type": "presets.ContactFormData", "action": "anonymize", "days": float64(30), "fields": config.Slice{"email", "name"}},
+		config.Map{"type": "presets.RegistrationFormData", "action": "anonymize", "days": float64(90), "fields": config.Slice{"customer.email", "addresses.street"}},
+		config.Map{"type": "presets.LoginFormData", "action": "delete", "days": float64(7)},
+	})
+}
+
+func (t *SubmissionRetentionTestSuite) createRetention(policies config.Slice) *SubmissionRetention {
+	retention := &SubmissionRetention{}
+	retention.Inject(t.store, formtest.NewFakeClock(time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)), &struct {
+		Policies config.Slice `inject:"config:form.submissionStore.retention.policies"`
+	}{
+		Policies: policies,
+	})
+
+	return retention
+}
+
+func (t *SubmissionRetentionTestSuite) TestRun() {
+	result, err := t.retention.Run(t.context)
+	t.NoError(err)
+	t.Equal(SubmissionRetentionResult{Deleted: 2, Anonymized: 2}, result)
+
+	t.Equal([]time.Time{
+		time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2020, 2, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2020, 4, 24, 12, 0, 0, 0, time.UTC),
+	}, t.store.before)
+	t.Equal([]string{"d", "e"}, t.store.deleted)
+	t.Require().Len(t.store.updated, 2)
+	t.Equal("a", t.store.updated[0].ID)
+	t.JSONEq(`{"email":"[REDACTED]"}`, string(t.store.updated[0].Data))
+	t.Equal("c", t.store.updated[1].ID)
+	t.JSONEq(`{"customer":{"email":"[REDACTED]","newsletter":true},"addresses":[{"street":"[REDACTED]"},{"street":"[REDACTED]"}]}`, string(t.store.updated[1].Data))
+}
+
+func (t *SubmissionRetentionTestSuite) TestRun_NoPolicies() {
+	t.retention = t.createRetention(config.Slice{})
+
+	result, err := t.retention.Run(t.context)
+	t.NoError(err)
+	t.Equal(SubmissionRetentionResult{}, result)
+	t.Empty(t.store.before)
+}
+
+func (t *SubmissionRetentionTestSuite) TestRun_UnsupportedStore() {
+	retention := &SubmissionRetention{}
+	retention.Inject(&submissionStoreTestStore{}, nil, &struct {
+		Policies config.Slice `inject:"config:form.submissionStore.retention.policies"`
+	}{
+		Policies: config.Slice{
+			config.Map{"type": "presets.LoginFormData", "action": "delete", "days": float64(7)},
+		},
+	})
+
+	_, err := retention.Run(t.context)
+	t.Error(err)
+}
+
+func (t *SubmissionRetentionTestSuite) TestRun_Error() {
+	t.store.err = errors.New("error")
+
+	_, err := t.retention.Run(t.context)
+	t.Equal(errors.New("error"), err)
+}
+
+func (t *SubmissionRetentionTestSuite) TestRun_InvalidData() {
+	t.store.found["presets.ContactFormData"] = []StoredSubmission{{ID: "a", Data: json.RawMessage(`{`)}}
+
+	_, err := t.retention.Run(t.context)
+	t.Error(err)
+	t.Empty(t.store.updated)
+}
+
+func (t *SubmissionRetentionTestSuite) TestInject_InvalidPolicy() {
+	testCases := []config.Map{
+		{"action": "delete", "days": float64(7)},
+		{"type": "presets.LoginFormData", "action": "archive", "days": float64(7)},
+		{"type": "presets.LoginFormData", "action": "anonymize", "days": float64(7)},
+		{"type": "presets.LoginFormData", "action": "delete", "days": float64(-1)},
+	}
+
+	for _, testCase := range testCases {
+		t.Panics(func() {
+			t.createRetention(config.Slice{testCase})
+		})
+	}
+}
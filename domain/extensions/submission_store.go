@@ -0,0 +1,169 @@
+package extensions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// SubmissionStore defines persistence of valid submissions stored by SubmissionStoreExtension (like database table)
+	SubmissionStore interface {
+		// StoreSubmission persists single valid submission
+		StoreSubmission(ctx context.Context, submission StoredSubmission) error
+		// LoadSubmission loads stored submission by its ID, or returns ErrSubmissionNotFound
+		LoadSubmission(ctx context.Context, id string) (*StoredSubmission, error)
+	}
+
+	// StoredSubmission defines valid form submission persisted via SubmissionStore
+	StoredSubmission struct {
+		// ID unique identifier of the submission
+		ID string
+		// Type package qualified name of form data type (like "presets.ContactFormData")
+		Type string
+		// Timestamp of the submission
+		Timestamp time.Time
+		// Path of the form
+		Path string
+		// Subject identifier of the user who submitted the form (like email address), in lower case,
+		// empty if it can't be resolved
+		Subject string
+		// Data final form data encoded as JSON
+		Data json.RawMessage
+	}
+
+	// SubmissionStoreExtension defines form extension which persists final form data of valid submission via
+	// SubmissionStore, so basic forms can be stored without hand-written persistence code. ID of stored submission
+	// is available in form extension data, so controller can refer to it (like in confirmation page). Subject of the
+	// submission is resolved from submitted values, by the first configured subject field which is not empty.
+	// If submission can't be stored, form handler returns error.
+	//
+	// formHandler := c.formHandlerFactory.CreateFormHandlerWithFormService(c.formService, "formExtension.submissionStore")
+	//
+	// ...
+	//
+	// id := form.FormExtensionsData["formExtension.submissionStore"].(*extensions.SubmissionStoreFormData).ID
+	//
+	SubmissionStoreExtension struct {
+		store         SubmissionStore
+		subjectFields []string
+		clock         domain.Clock
+		tokenSource   domain.TokenSource
+	}
+
+	// SubmissionStoreFormData defines form data provided by SubmissionStoreExtension
+	SubmissionStoreFormData struct {
+		// ID unique identifier of stored submission, empty until valid submission is stored
+		ID string
+	}
+)
+
+// ErrSubmissionNotFound is returned by SubmissionStore if there is no submission with requested ID
+var ErrSubmissionNotFound = errors.New("submission not found")
+
+var (
+	_ domain.FormDataProvider   = &SubmissionStoreExtension{}
+	_ domain.FormDataDecoder    = &SubmissionStoreExtension{}
+	_ domain.FormDataValidator  = &SubmissionStoreExtension{}
+	_ domain.FormResultObserver = &SubmissionStoreExtension{}
+	_ domain.DependencyStatus   = &SubmissionStoreExtension{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (e *SubmissionStoreExtension) Inject(
+	store SubmissionStore,
+	clock domain.Clock,
+	tokenSource domain.TokenSource,
+	cfg *struct {
+		SubjectFields config.Slice `inject:"config:form.submissionStore.subjectFields"`
+	},
+) {
+	e.store = store
+	e.clock = clock
+	e.tokenSource = tokenSource
+
+	var subjectFields []string
+	if err := cfg.SubjectFields.MapInto(&subjectFields); err != nil {
+		panic(err.Error())
+	}
+	e.subjectFields = subjectFields
+}
+
+// GetFormData provides empty form data, which receives ID of stored submission
+func (e *SubmissionStoreExtension) GetFormData(context.Context, *web.Request) (interface{}, error) {
+	return &SubmissionStoreFormData{}, nil
+}
+
+// Decode provides empty form data, which receives ID of stored submission
+func (e *SubmissionStoreExtension) Decode(context.Context, *web.Request, url.Values, interface{}) (interface{}, error) {
+	return &SubmissionStoreFormData{}, nil
+}
+
+// Validate accepts any submission, since extension doesn't define any fields
+func (e *SubmissionStoreExtension) Validate(context.Context, *web.Request, domain.ValidatorProvider, interface{}) (*domain.ValidationInfo, error) {
+	return &domain.ValidationInfo{}, nil
+}
+
+// ObserveFormResult stores valid submitted form and exposes ID of stored submission via form extension data
+func (e *SubmissionStoreExtension) ObserveFormResult(ctx context.Context, req *web.Request, values url.Values, form *domain.Form) error {
+	if !form.IsValidAndSubmitted() {
+		return nil
+	}
+
+	id, err := generateID(e.tokenSource)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(form.Data)
+	if err != nil {
+		return err
+	}
+
+	submission := StoredSubmission{
+		ID:        id,
+		Type:      eventType(form.Data),
+		Timestamp: domain.CurrentTime(e.clock),
+		Subject:   e.subject(values),
+		Data:      data,
+	}
+
+	if req != nil && req.Request().URL != nil {
+		submission.Path = req.Request().URL.Path
+	}
+
+	if err := e.store.StoreSubmission(ctx, submission); err != nil {
+		return err
+	}
+
+	// form extension data is looked up by its type, since extension can be bound under any name
+	for _, formData := range form.FormExtensionsData {
+		if storeFormData, ok := formData.(*SubmissionStoreFormData); ok {
+			storeFormData.ID = id
+		}
+	}
+
+	return nil
+}
+
+// Status reports availability of submission storage
+func (e *SubmissionStoreExtension) Status() (bool, string) {
+	return dependencyStatus(e.store)
+}
+
+// subject resolves identifier of the user from submitted values
+func (e *SubmissionStoreExtension) subject(values url.Values) string {
+	for _, field := range e.subjectFields {
+		if subject := normalizeSubject(values.Get(field)); subject != "" {
+			return subject
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,140 @@
+package extensions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/formtest"
+)
+
+type (
+	SubmissionStoreExtensionTestSuite struct {
+		suite.Suite
+
+		extension *SubmissionStoreExtension
+		store     *submissionStoreTestStore
+
+		context context.Context
+		request *web.Request
+	}
+
+	submissionStoreTestStore struct {
+		submissions []StoredSubmission
+		err         error
+	}
+
+	submissionStoreTestFormData struct {
+		Email string `json:"email"`
+	}
+)
+
+func (s *submissionStoreTestStore) StoreSubmission(_ context.Context, submission StoredSubmission) error {
+	s.submissions = append(s.submissions, submission)
+	return s.err
+}
+
+func (s *submissionStoreTestStore) LoadSubmission(context.Context, string) (*StoredSubmission, error) {
+	return nil, ErrSubmissionNotFound
+}
+
+func TestSubmissionStoreExtensionTestSuite(t *testing.T) {
+	suite.Run(t, &SubmissionStoreExtensionTestSuite{})
+}
+
+func (t *SubmissionStoreExtensionTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *SubmissionStoreExtensionTestSuite) SetupTest() {
+	t.store = &submissionStoreTestStore{}
+	t.extension = &SubmissionStoreExtension{}
+	t.extension.Inject(t.store, formtest.NewFakeClock(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)), formtest.NewFakeTokenSource(0x0f), &struct {
+		SubjectFields config.Slice `inject:"config:form.submissionStore.subjectFields"`
+	}{
+		SubjectFields: config.Slice{"email", "customer.email"},
+	})
+	t.request = web.CreateRequest(&http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{Path: "/contact"},
+	}, nil)
+}
+
+func (t *SubmissionStoreExtensionTestSuite) TestGetFormData() {
+	result, err := t.extension.GetFormData(t.context, t.request)
+	t.NoError(err)
+	t.Equal(&SubmissionStoreFormData{}, result)
+}
+
+func (t *SubmissionStoreExtensionTestSuite) TestDecode() {
+	result, err := t.extension.Decode(t.context, t.request, url.Values{"ID": []string{"injected"}}, nil)
+	t.NoError(err)
+	t.Equal(&SubmissionStoreFormData{}, result)
+}
+
+func (t *SubmissionStoreExtensionTestSuite) TestValidate() {
+	validationInfo, err := t.extension.Validate(t.context, t.request, nil, &SubmissionStoreFormData{})
+	t.NoError(err)
+	t.True(validationInfo.IsValid())
+}
+
+func (t *SubmissionStoreExtensionTestSuite) TestObserveFormResult() {
+	formData := &SubmissionStoreFormData{}
+	form := domain.NewForm(true, nil)
+	form.Data = submissionStoreTestFormData{Email: "user@example.com"}
+	form.FormExtensionsData = map[string]interface{}{
+		"formExtension.submissionStore": formData,
+		"formExtension.csrfToken":       "token",
+	}
+
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{
+		"email":          []string{" "},
+		"customer.email": []string{" User@Example.com "},
+	}, &form))
+	t.Require().Len(t.store.submissions, 1)
+
+	submission := t.store.submissions[0]
+	t.Equal("0f0f0f0f0f0f0f0f0f0f0f0f", submission.ID)
+	t.Equal(StoredSubmission{
+		ID:        submission.ID,
+		Type:      "extensions.submissionStoreTestFormData",
+		Timestamp: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		Path:      "/contact",
+		Subject:   "user@example.com",
+		Data:      json.RawMessage(`{"email":"user@example.com"}`),
+	}, submission)
+	t.Equal(submission.ID, formData.ID)
+}
+
+func (t *SubmissionStoreExtensionTestSuite) TestObserveFormResult_NotValid() {
+	form := domain.NewForm(true, nil)
+	form.ValidationInfo.AddGeneralError("error", "error")
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+
+	form = domain.NewForm(false, nil)
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+
+	t.Empty(t.store.submissions)
+}
+
+func (t *SubmissionStoreExtensionTestSuite) TestObserveFormResult_Error() {
+	t.store.err = errors.New("error")
+
+	formData := &SubmissionStoreFormData{}
+	form := domain.NewForm(true, nil)
+	form.FormExtensionsData = map[string]interface{}{
+		"formExtension.submissionStore": formData,
+	}
+
+	t.Equal(errors.New("error"), t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+	t.Empty(formData.ID)
+}
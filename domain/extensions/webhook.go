@@ -0,0 +1,89 @@
+package extensions
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// WebhookNotifier defines delivery of webhook notifications emitted by WebhookExtension
+	WebhookNotifier interface {
+		// Notify delivers single notification to all configured webhooks
+		Notify(ctx context.Context, notification WebhookNotification) error
+	}
+
+	// WebhookNotification defines notification about valid form submission
+	WebhookNotification struct {
+		// ID unique identifier of the notification, so webhooks can detect redelivery
+		ID string `json:"id"`
+		// CorrelationID identifier of the handled submission, same as in logs of the form handler
+		CorrelationID string `json:"correlationId,omitempty"`
+		// Type package qualified name of form data type (like "presets.ContactFormData")
+		Type string `json:"type"`
+		// Timestamp of the submission
+		Timestamp time.Time `json:"timestamp"`
+		// Path of the form
+		Path string `json:"path"`
+		// Data final form data
+		Data interface{} `json:"data"`
+	}
+
+	// WebhookExtension defines form extension which notifies configured webhooks about valid form submission
+	// via WebhookNotifier, so simple integrations don't need custom controller code. If notification can't be
+	// delivered, form handler returns error.
+	//
+	// formHandler := c.formHandlerFactory.CreateFormHandlerWithFormService(c.formService, "formExtension.webhook")
+	//
+	WebhookExtension struct {
+		notifier    WebhookNotifier
+		clock       domain.Clock
+		tokenSource domain.TokenSource
+	}
+)
+
+var (
+	_ domain.FormResultObserver = &WebhookExtension{}
+	_ domain.DependencyStatus   = &WebhookExtension{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (e *WebhookExtension) Inject(notifier WebhookNotifier, clock domain.Clock, tokenSource domain.TokenSource) {
+	e.notifier = notifier
+	e.clock = clock
+	e.tokenSource = tokenSource
+}
+
+// ObserveFormResult notifies webhooks about valid submitted form
+func (e *WebhookExtension) ObserveFormResult(ctx context.Context, req *web.Request, _ url.Values, form *domain.Form) error {
+	if !form.IsValidAndSubmitted() {
+		return nil
+	}
+
+	id, err := generateID(e.tokenSource)
+	if err != nil {
+		return err
+	}
+
+	notification := WebhookNotification{
+		ID:            id,
+		CorrelationID: form.CorrelationID,
+		Type:          eventType(form.Data),
+		Timestamp:     domain.CurrentTime(e.clock),
+		Data:          form.Data,
+	}
+
+	if req != nil && req.Request().URL != nil {
+		notification.Path = req.Request().URL.Path
+	}
+
+	return e.notifier.Notify(ctx, notification)
+}
+
+// Status reports availability of webhooks
+func (e *WebhookExtension) Status() (bool, string) {
+	return dependencyStatus(e.notifier)
+}
@@ -0,0 +1,118 @@
+package extensions
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/formtest"
+)
+
+type (
+	WebhookExtensionTestSuite struct {
+		suite.Suite
+
+		extension *WebhookExtension
+		notifier  *webhookTestNotifier
+
+		context context.Context
+		request *web.Request
+	}
+
+	webhookTestNotifier struct {
+		notifications []WebhookNotification
+		err           error
+		alive         bool
+	}
+
+	webhookTestFormData struct {
+		Email string
+	}
+)
+
+func (n *webhookTestNotifier) Notify(_ context.Context, notification WebhookNotification) error {
+	n.notifications = append(n.notifications, notification)
+	return n.err
+}
+
+func (n *webhookTestNotifier) Status() (bool, string) {
+	if n.alive {
+		return true, ""
+	}
+
+	return false, "webhook unavailable"
+}
+
+func TestWebhookExtensionTestSuite(t *testing.T) {
+	suite.Run(t, &WebhookExtensionTestSuite{})
+}
+
+func (t *WebhookExtensionTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *WebhookExtensionTestSuite) SetupTest() {
+	t.notifier = &webhookTestNotifier{alive: true}
+	t.extension = &WebhookExtension{}
+	t.extension.Inject(t.notifier, formtest.NewFakeClock(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)), formtest.NewFakeTokenSource(0x0f))
+	t.request = web.CreateRequest(&http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{Path: "/contact"},
+	}, nil)
+}
+
+func (t *WebhookExtensionTestSuite) TestObserveFormResult() {
+	form := domain.NewForm(true, nil)
+	form.Data = webhookTestFormData{Email: "user@example.com"}
+	form.CorrelationID = "correlation"
+
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+	t.Require().Len(t.notifier.notifications, 1)
+
+	notification := t.notifier.notifications[0]
+	t.Equal("0f0f0f0f0f0f0f0f0f0f0f0f", notification.ID)
+	t.Equal(WebhookNotification{
+		ID:            notification.ID,
+		CorrelationID: "correlation",
+		Type:          "extensions.webhookTestFormData",
+		Timestamp:     time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		Path:          "/contact",
+		Data:          webhookTestFormData{Email: "user@example.com"},
+	}, notification)
+}
+
+func (t *WebhookExtensionTestSuite) TestObserveFormResult_NotValid() {
+	form := domain.NewForm(true, nil)
+	form.ValidationInfo.AddGeneralError("error", "error")
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+
+	form = domain.NewForm(false, nil)
+	t.NoError(t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+
+	t.Empty(t.notifier.notifications)
+}
+
+func (t *WebhookExtensionTestSuite) TestObserveFormResult_Error() {
+	t.notifier.err = errors.New("error")
+
+	form := domain.NewForm(true, nil)
+	t.Equal(errors.New("error"), t.extension.ObserveFormResult(t.context, t.request, url.Values{}, &form))
+}
+
+func (t *WebhookExtensionTestSuite) TestStatus() {
+	alive, details := t.extension.Status()
+	t.True(alive)
+	t.Empty(details)
+
+	t.notifier.alive = false
+	alive, details = t.extension.Status()
+	t.False(alive)
+	t.Equal("webhook unavailable", details)
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+type (
+	// FeatureFlagProvider is interface for defining feature flags, which are consulted by form handler
+	// to enable or disable parts of the form per request (like for specific user segment)
+	FeatureFlagProvider interface {
+		// IsEnabled as method for checking if feature is enabled for the request
+		IsEnabled(ctx context.Context, req *web.Request, feature string) bool
+	}
+
+	// FeatureToggle defines parts of form, which are enabled only if feature flag is enabled for the request.
+	// If feature is disabled, submitted values of fields are ignored, validation rules and errors of fields and rules
+	// are removed, and form extensions are not processed.
+	FeatureToggle struct {
+		// Feature name of the feature flag
+		Feature string
+		// Fields names of form fields, including all their sub fields
+		Fields []string
+		// Rules names of validation rules
+		Rules []string
+		// Extensions names of form extensions
+		Extensions []string
+	}
+)
@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+// File defines file uploaded by multipart form or submitted as data URI, which is bound by default decoder to form data
+// fields of type *File and []*File, by name of the file input (like `form:"avatar"`). Its attributes are never decoded
+// from submitted values.
+type File struct {
+	// Filename name of the file, as it's sent by the client
+	Filename string `form:"-"`
+	// Size size of the file in bytes
+	Size int64 `form:"-"`
+	// ContentType content type of the file, as it's sent by the client
+	ContentType string `form:"-"`
+	// open function for opening content of the file
+	open func() (io.ReadCloser, error)
+	// stream part of multipart body of streamed file, nil for files which are not streamed
+	stream *fileStream
+}
+
+type (
+	// fileStream as part of multipart body, which is read directly as content of streamed file
+	fileStream struct {
+		part   *multipart.Part
+		mutex  sync.Mutex
+		opened bool
+		closed bool
+	}
+
+	// streamedFilesKey as key of request value, under which streamed files of multipart body are stored
+	streamedFilesKey struct{}
+)
+
+// NewFile returns new instance of File for uploaded file of multipart form
+func NewFile(header *multipart.FileHeader) *File {
+	return &File{
+		Filename:    header.Filename,
+		Size:        header.Size,
+		ContentType: header.Header.Get("Content-Type"),
+		open: func() (io.ReadCloser, error) {
+			return header.Open()
+		},
+	}
+}
+
+// NewFileWithContent returns new instance of File with content in memory (like decoded data URI)
+func NewFileWithContent(filename string, contentType string, content []byte) *File {
+	return &File{
+		Filename:    filename,
+		Size:        int64(len(content)),
+		ContentType: contentType,
+		open: func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(content)), nil
+		},
+	}
+}
+
+// DecodeFile returns new instance of File with content of data URI (like "data:image/png;base64,...") or of base64
+// encoded string, as they are submitted by JavaScript canvas or image croppers. Content type of data URI is its media
+// type and filename is its "name" parameter, if there is any. Content type of base64 encoded string is detected from
+// its content. It returns error if value is neither valid data URI nor valid base64 encoded string.
+func DecodeFile(value string) (*File, error) {
+	value = strings.TrimSpace(value)
+	if !strings.HasPrefix(value, "data:") {
+		content, err := decodeBase64(value)
+		if err != nil {
+			return nil, NewFormErrorWithParent(err)
+		}
+
+		return NewFileWithContent("", http.DetectContentType(content), content), nil
+	}
+
+	comma := strings.Index(value, ",")
+	if comma < 0 {
+		return nil, NewFormError("data URI has no content")
+	}
+
+	params := strings.Split(value[len("data:"):comma], ";")
+	contentType := strings.ToLower(strings.TrimSpace(params[0]))
+	if contentType == "" {
+		contentType = "text/plain"
+	}
+
+	filename := ""
+	for _, param := range params[1:] {
+		if strings.HasPrefix(param, "name=") {
+			filename, _ = url.PathUnescape(strings.TrimPrefix(param, "name="))
+		}
+	}
+
+	if params[len(params)-1] == "base64" {
+		content, err := decodeBase64(value[comma+1:])
+		if err != nil {
+			return nil, NewFormErrorWithParent(err)
+		}
+
+		return NewFileWithContent(filename, contentType, content), nil
+	}
+
+	content, err := url.PathUnescape(value[comma+1:])
+	if err != nil {
+		return nil, NewFormErrorWithParent(err)
+	}
+
+	return NewFileWithContent(filename, contentType, []byte(content)), nil
+}
+
+// decodeBase64 decodes base64 encoded content, with or without padding
+func decodeBase64(value string) ([]byte, error) {
+	if strings.HasSuffix(value, "=") {
+		return base64.StdEncoding.DecodeString(value)
+	}
+
+	return base64.RawStdEncoding.DecodeString(value)
+}
+
+// NewStreamedFile returns new instance of File, which reads its content directly from the part of multipart body,
+// without buffering it in memory or temporary file. Size of streamed file is unknown (-1), and its content can be
+// opened only once, while form is handled.
+func NewStreamedFile(part *multipart.Part) *File {
+	return &File{
+		Filename:    part.FileName(),
+		Size:        -1,
+		ContentType: part.Header.Get("Content-Type"),
+		stream:      &fileStream{part: part},
+	}
+}
+
+// Open returns reader of file content, which needs to be closed by the caller.
+// Content of streamed file can be opened only once, and closing of its reader doesn't close the file.
+func (f *File) Open() (io.ReadCloser, error) {
+	if f.stream != nil {
+		f.stream.mutex.Lock()
+		defer f.stream.mutex.Unlock()
+
+		if f.stream.opened || f.stream.closed {
+			return nil, NewFormError("streamed file can be opened only once")
+		}
+		f.stream.opened = true
+
+		return ioutil.NopCloser(f.stream.part), nil
+	}
+
+	if f.open == nil {
+		return nil, NewFormError("file has no content")
+	}
+
+	return f.open()
+}
+
+// IsStreamed returns true if content of the file is read directly from multipart body
+func (f *File) IsStreamed() bool {
+	return f.stream != nil
+}
+
+// Close closes part of multipart body of streamed file, so rest of its content is discarded.
+// Files which are not streamed are closed by net/http server, after the request is handled.
+func (f *File) Close() error {
+	if f.stream == nil {
+		return nil
+	}
+
+	f.stream.mutex.Lock()
+	defer f.stream.mutex.Unlock()
+
+	if f.stream.closed {
+		return nil
+	}
+	f.stream.closed = true
+
+	return f.stream.part.Close()
+}
+
+// StoreStreamedFiles stores streamed files of multipart body into the request, by names of their inputs,
+// so they are bound to form data by default decoder
+func StoreStreamedFiles(req *web.Request, files map[string][]*File) {
+	if req == nil {
+		return
+	}
+
+	req.Values.Store(streamedFilesKey{}, files)
+}
+
+// StreamedFilesFromRequest returns streamed files of multipart body stored in the request, or nil if there are none
+func StreamedFilesFromRequest(req *web.Request) map[string][]*File {
+	if req == nil {
+		return nil
+	}
+
+	files, ok := req.Values.Load(streamedFilesKey{})
+	if !ok {
+		return nil
+	}
+
+	return files.(map[string][]*File)
+}
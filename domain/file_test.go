@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"bytes"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+type (
+	FileTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestFileTestSuite(t *testing.T) {
+	suite.Run(t, &FileTestSuite{})
+}
+
+func (t *FileTestSuite) TestNewFile() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Disposition": {`form-data; name="avatar"; filename="avatar.png"`},
+		"Content-Type":        {"image/png"},
+	})
+	t.NoError(err)
+	_, err = part.Write([]byte("content"))
+	t.NoError(err)
+	t.NoError(writer.Close())
+
+	form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(1024)
+	t.NoError(err)
+
+	file := NewFile(form.File["avatar"][0])
+	t.Equal("avatar.png", file.Filename)
+	t.Equal(int64(7), file.Size)
+	t.Equal("image/png", file.ContentType)
+
+	reader, err := file.Open()
+	t.NoError(err)
+	content, err := ioutil.ReadAll(reader)
+	t.NoError(err)
+	t.Equal([]byte("content"), content)
+	t.NoError(reader.Close())
+}
+
+func (t *FileTestSuite) TestNewFileWithContent() {
+	file := NewFileWithContent("signature.svg", "image/svg+xml", []byte("<svg/>"))
+	t.Equal("signature.svg", file.Filename)
+	t.Equal(int64(6), file.Size)
+	t.Equal("image/svg+xml", file.ContentType)
+
+	reader, err := file.Open()
+	t.NoError(err)
+	content, err := ioutil.ReadAll(reader)
+	t.NoError(err)
+	t.Equal([]byte("<svg/>"), content)
+}
+
+func (t *FileTestSuite) TestDecodeFile() {
+	content := func(file *File) []byte {
+		reader, err := file.Open()
+		t.NoError(err)
+		content, err := ioutil.ReadAll(reader)
+		t.NoError(err)
+
+		return content
+	}
+
+	file, err := DecodeFile("data:image/png;name=signature%20v2.png;base64,iVBORw0KGgo=")
+	t.NoError(err)
+	t.Equal("signature v2.png", file.Filename)
+	t.Equal("image/png", file.ContentType)
+	t.Equal(int64(8), file.Size)
+	t.Equal([]byte("\x89PNG\r\n\x1a\n"), content(file))
+
+	file, err = DecodeFile("data:,hello%20world")
+	t.NoError(err)
+	t.Equal("", file.Filename)
+	t.Equal("text/plain", file.ContentType)
+	t.Equal([]byte("hello world"), content(file))
+
+	file, err = DecodeFile("iVBORw0KGgo")
+	t.NoError(err)
+	t.Equal("image/png", file.ContentType)
+	t.Equal([]byte("\x89PNG\r\n\x1a\n"), content(file))
+
+	for _, value := range []string{"data:image/png;base64", "data:image/png;base64,!!!", "data:,%zz", "not base64!"} {
+		file, err = DecodeFile(value)
+		t.Error(err, value)
+		t.Nil(file, value)
+	}
+}
+
+func (t *FileTestSuite) TestOpen_NoContent() {
+	reader, err := (&File{}).Open()
+	t.Error(err)
+	t.Nil(reader)
+}
+
+func (t *FileTestSuite) TestNewStreamedFile() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Disposition": {`form-data; name="video"; filename="video.mp4"`},
+		"Content-Type":        {"video/mp4"},
+	})
+	t.NoError(err)
+	_, err = part.Write([]byte("content"))
+	t.NoError(err)
+	t.NoError(writer.Close())
+
+	streamed, err := multipart.NewReader(body, writer.Boundary()).NextPart()
+	t.NoError(err)
+
+	file := NewStreamedFile(streamed)
+	t.True(file.IsStreamed())
+	t.Equal("video.mp4", file.Filename)
+	t.Equal(int64(-1), file.Size)
+	t.Equal("video/mp4", file.ContentType)
+
+	reader, err := file.Open()
+	t.NoError(err)
+	content, err := ioutil.ReadAll(reader)
+	t.NoError(err)
+	t.Equal([]byte("content"), content)
+	t.NoError(reader.Close())
+
+	_, err = file.Open()
+	t.Error(err, "streamed file can be opened only once")
+
+	t.NoError(file.Close())
+	t.NoError(file.Close())
+}
+
+func (t *FileTestSuite) TestStreamedFilesFromRequest() {
+	request := web.CreateRequest(&http.Request{}, nil)
+	t.Nil(StreamedFilesFromRequest(request))
+	t.Nil(StreamedFilesFromRequest(nil))
+
+	files := map[string][]*File{"video": {NewFileWithContent("video.mp4", "video/mp4", nil)}}
+	StoreStreamedFiles(request, files)
+	t.Equal(files, StreamedFilesFromRequest(request))
+
+	t.False(files["video"][0].IsStreamed())
+	t.NoError(files["video"][0].Close())
+}
@@ -1,6 +1,10 @@
 package domain
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"html/template"
+)
 
 // Form as struct for storing form processing results
 type Form struct {
@@ -10,6 +14,23 @@ type Form struct {
 	FormExtensionsData map[string]interface{}
 	// ValidationInfo for the form
 	ValidationInfo ValidationInfo
+	// DebugInfo inputs and outputs of each form processing stage, nil if debug mode is disabled
+	DebugInfo *DebugInfo
+	// MarkdownPreviews rendered HTML of all non-empty markdown fields, by field name
+	MarkdownPreviews map[string]template.HTML
+	// Degradations optional subsystems which were skipped during form handling, nil for clean result
+	Degradations []Degradation
+	// CorrelationID identifier of the handled submission, which is included in logs, traces and notifications,
+	// so failed submission reported by the user can be traced across systems
+	CorrelationID string
+	// DryRun flag if submitted form was decoded and validated without side effects, so success pipeline,
+	// form result observers and form extensions which aren't read-only didn't run
+	DryRun bool
+	// CacheHint cache metadata of unsubmitted form, nil for submitted form, which is never cacheable
+	CacheHint *CacheHint
+	// SensitiveFields form names of sensitive fields of form data, like payment card data, passwords and encrypted
+	// fields, which values must not be retained or logged. It's shared between forms and must not be modified.
+	SensitiveFields map[string]bool
 	// submitted  flag if form was submitted and this is the result page
 	submitted bool
 	// validationRules contains map with validation rules for all validatable fields
@@ -22,6 +43,15 @@ type FormError struct {
 	parent  error
 }
 
+var (
+	// ErrRequestBodyTooLarge is returned by form handlers if request body exceeds configured limit of its size,
+	// it's checked by IsRequestBodyTooLarge, so controllers can respond with status 413
+	ErrRequestBodyTooLarge = NewFormErrorWithParent(errRequestBodyTooLarge)
+
+	// errRequestBodyTooLarge parent error of ErrRequestBodyTooLarge, which identifies it even if it's wrapped
+	errRequestBodyTooLarge = errors.New("request body is too large")
+)
+
 // NewForm returns new instance of Form struct
 func NewForm(submitted bool, validationRules map[string][]ValidationRule) Form {
 	return Form{
@@ -45,6 +75,16 @@ func (f Form) IsSubmitted() bool {
 	return f.submitted
 }
 
+// IsDegraded defines if any optional subsystem was skipped during form handling
+func (f Form) IsDegraded() bool {
+	return len(f.Degradations) > 0
+}
+
+// IsCacheable defines if unsubmitted form contains no user specific data, so response rendering it can be cached
+func (f Form) IsCacheable() bool {
+	return f.CacheHint != nil && f.CacheHint.Cacheable
+}
+
 // HasErrorForField method which defines if there is any field validations error for specific field
 func (f Form) HasErrorForField(name string) bool {
 	return f.ValidationInfo.HasErrorsForField(name)
@@ -80,6 +120,23 @@ func (f Form) GetValidationRules() map[string][]ValidationRule {
 	return f.validationRules
 }
 
+// GetHTMLAttributesForField adds option to extract HTML5 input attributes of validation rules of desired field
+// in templates (like "&attributes(form.GetHTMLAttributesForField('email'))" in pug templates)
+func (f Form) GetHTMLAttributesForField(name string) map[string]string {
+	return HTMLAttributes(f.validationRules[name])
+}
+
+// RenderHTMLAttributesForField adds option to render HTML5 input attributes of validation rules of desired field
+// in templates (like "<input name="email" {{ form.RenderHTMLAttributesForField "email" }}>")
+func (f Form) RenderHTMLAttributesForField(name string) template.HTMLAttr {
+	return RenderHTMLAttributes(f.GetHTMLAttributesForField(name))
+}
+
+// GetMarkdownPreview adds option to render preview of desired markdown field in templates
+func (f Form) GetMarkdownPreview(name string) template.HTML {
+	return f.MarkdownPreviews[name]
+}
+
 // NewFormError returns new instance of error interface by defining string content of error
 func NewFormError(details string) FormError {
 	return FormError{
@@ -109,3 +166,20 @@ func (e FormError) Error() string {
 func (e FormError) Parent() error {
 	return e.parent
 }
+
+// IsRequestBodyTooLarge checks if error is ErrRequestBodyTooLarge, or FormError with it as parent error
+func IsRequestBodyTooLarge(err error) bool {
+	for err != nil {
+		if err == errRequestBodyTooLarge {
+			return true
+		}
+
+		formError, ok := err.(FormError)
+		if !ok {
+			return false
+		}
+		err = formError.parent
+	}
+
+	return false
+}
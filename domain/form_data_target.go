@@ -0,0 +1,24 @@
+package domain
+
+import "context"
+
+type (
+	// formDataKey as key of context value, under which form data of the handled form is stored
+	formDataKey struct{}
+)
+
+// ContextWithFormData returns context with form data of the handled form, into which submitted values are decoded.
+// Form handler passes it to request body decoders, so structured bodies (like JSON) can be flattened into values
+// by the structure of the form data.
+func ContextWithFormData(ctx context.Context, formData interface{}) context.Context {
+	return context.WithValue(ctx, formDataKey{}, formData)
+}
+
+// FormDataFromContext returns form data of the handled form, or nil if there is no form data in the context
+func FormDataFromContext(ctx context.Context) interface{} {
+	if ctx == nil {
+		return nil
+	}
+
+	return ctx.Value(formDataKey{})
+}
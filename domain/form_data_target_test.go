@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	FormDataTargetTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestFormDataTargetTestSuite(t *testing.T) {
+	suite.Run(t, &FormDataTargetTestSuite{})
+}
+
+func (t *FormDataTargetTestSuite) TestFormDataFromContext() {
+	ctx := ContextWithFormData(context.Background(), map[string]string{})
+	t.Equal(map[string]string{}, FormDataFromContext(ctx))
+}
+
+func (t *FormDataTargetTestSuite) TestFormDataFromContext_WithoutFormData() {
+	t.Nil(FormDataFromContext(context.Background()))
+	t.Nil(FormDataFromContext(nil))
+}
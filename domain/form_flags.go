@@ -0,0 +1,37 @@
+package domain
+
+import "context"
+
+type (
+	// formFlagsKey as key of context value, under which request-scoped form flags are stored
+	formFlagsKey struct{}
+)
+
+// ContextWithFormFlags returns context with request-scoped form flags supplied by controller (like "guestCheckout"
+// depending on session of the user). Flags are evaluated by fields tagged with `required_when:"ctx:guestCheckout"`.
+// Flags already stored in context are kept, unless they are overridden.
+func ContextWithFormFlags(ctx context.Context, flags map[string]bool) context.Context {
+	merged := make(map[string]bool, len(flags))
+	if existing, ok := ctx.Value(formFlagsKey{}).(map[string]bool); ok {
+		for name, value := range existing {
+			merged[name] = value
+		}
+	}
+
+	for name, value := range flags {
+		merged[name] = value
+	}
+
+	return context.WithValue(ctx, formFlagsKey{}, merged)
+}
+
+// FormFlagFromContext returns value of request-scoped form flag, or false if flag is not supplied
+func FormFlagFromContext(ctx context.Context, name string) bool {
+	if ctx == nil {
+		return false
+	}
+
+	flags, _ := ctx.Value(formFlagsKey{}).(map[string]bool)
+
+	return flags[name]
+}
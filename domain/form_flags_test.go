@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	FormFlagsTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestFormFlagsTestSuite(t *testing.T) {
+	suite.Run(t, &FormFlagsTestSuite{})
+}
+
+func (t *FormFlagsTestSuite) TestFormFlagFromContext() {
+	ctx := ContextWithFormFlags(context.Background(), map[string]bool{
+		"guestCheckout": true,
+		"business":      true,
+	})
+	ctx = ContextWithFormFlags(ctx, map[string]bool{
+		"business": false,
+		"b2b":      true,
+	})
+
+	t.True(FormFlagFromContext(ctx, "guestCheckout"))
+	t.False(FormFlagFromContext(ctx, "business"))
+	t.True(FormFlagFromContext(ctx, "b2b"))
+	t.False(FormFlagFromContext(ctx, "unknown"))
+}
+
+func (t *FormFlagsTestSuite) TestFormFlagFromContext_WithoutFlags() {
+	t.False(FormFlagFromContext(context.Background(), "guestCheckout"))
+	t.False(FormFlagFromContext(nil, "guestCheckout"))
+}
@@ -12,20 +12,138 @@ type (
 	FormHandler interface {
 		// HandleUnsubmittedForm as method for returning Form instance which is not submitted
 		HandleUnsubmittedForm(ctx context.Context, req *web.Request) (*Form, error)
-		// HandleSubmittedForm as method for returning Form instance which is submitted via POST request
+		// HandleSubmittedForm as method for returning Form instance which is submitted via POST request, or via
+		// request with another submission method of the form handler (like PUT, PATCH or DELETE)
 		HandleSubmittedForm(ctx context.Context, req *web.Request) (*Form, error)
+		// HandleSubmittedFormDryRun as method for returning Form instance which is submitted via POST request, decoded
+		// and validated without side effects (like for "review your input" pages before final submission)
+		HandleSubmittedFormDryRun(ctx context.Context, req *web.Request) (*Form, error)
 		// HandleSubmittedGETForm as method for returning Form instance which is submitted via GET request
 		HandleSubmittedGETForm(ctx context.Context, req *web.Request) (*Form, error)
-		// HandleForm as method for returning Form instance with state depending on fact if there was form submission or not, via POST request
+		// HandleForm as method for returning Form instance with state depending on fact if there was form submission or not,
+		// via POST request or request with another submission method of the form handler
 		HandleForm(ctx context.Context, req *web.Request) (*Form, error)
+		// HandleFormResult as method for returning FormResult with explicit state, otherwise it behaves like HandleForm
+		HandleFormResult(ctx context.Context, req *web.Request) FormResult
+	}
+
+	// FormHandlerDecorator is interface for defining decorators of form handlers, bound via dingo injector as multi
+	// binding, which wrap all form handlers created by Build method of form handler builder with custom behavior
+	// (like tenant checks or logging), without replacing actual implementation of form handler
+	FormHandlerDecorator interface {
+		// Decorate as method for returning form handler which wraps passed form handler
+		Decorate(formHandler FormHandler) FormHandler
+	}
+
+	// SearchFormHandler is interface for defining form processor of search/filter forms (like listing pages),
+	// which are always submitted via GET request
+	SearchFormHandler interface {
+		FormHandler
+		// HandleSearchForm as method for returning SearchForm instance with query parameters of the request bound as form
+		// data, defaults applied to missing parameters, and canonical query without defaults and invalid parameters.
+		// Search is handled as dry run, without success pipeline, form result observers and form extensions which
+		// aren't read-only.
+		HandleSearchForm(ctx context.Context, req *web.Request) (*SearchForm, error)
+	}
+
+	// DeferredFormHandler is interface for defining form processor of high-traffic forms with expensive processing,
+	// which pushes valid submissions to SubmissionQueue, so they are processed later by its consumers
+	DeferredFormHandler interface {
+		FormHandler
+		// HandleDeferredForm as method for returning DeferredForm instance, which behaves like HandleForm,
+		// and contains acknowledgment token if valid submission is pushed to SubmissionQueue
+		HandleDeferredForm(ctx context.Context, req *web.Request) (*DeferredForm, error)
+	}
+
+	// ConfirmFormHandler is interface for defining form processor of two-phase submissions with confirmation step
+	// (like orders or bookings), where valid submission is reviewed by the user before it's executed
+	ConfirmFormHandler interface {
+		FormHandler
+		// HandleConfirmForm as method for returning ConfirmForm instance. Submission without snapshot token is handled
+		// as dry run, and contains encrypted snapshot of submitted values if it's valid. Submission with snapshot token is
+		// validated again and executed with values of the snapshot, so they can't be changed after review.
+		HandleConfirmForm(ctx context.Context, req *web.Request) (*ConfirmForm, error)
+	}
+
+	// SubmissionQueue is interface for defining message queue of valid submissions handled by DeferredFormHandler
+	SubmissionQueue interface {
+		// Enqueue as method for pushing valid submission to the queue
+		Enqueue(ctx context.Context, submission QueuedSubmission) error
 	}
 
 	// FormExtension is helper interface for form extensions used for binding with dingo injector
 	FormExtension interface{}
 
+	// FormResultObserver is optional interface for form extensions which need to be notified about
+	// final state of submitted form, after form data and all form extensions are validated
+	FormResultObserver interface {
+		// ObserveFormResult as method which receives submitted form values and final instance of Form
+		ObserveFormResult(ctx context.Context, req *web.Request, values url.Values, form *Form) error
+	}
+
+	// FormValidityGate is optional interface for form extensions which can veto validity of submitted form
+	// (like fraud detection), after form data and all form extensions are validated without errors
+	FormValidityGate interface {
+		// GateFormValidity as method which returns general error if form must be treated as invalid, or nil otherwise
+		GateFormValidity(ctx context.Context, req *web.Request, values url.Values, form *Form) (*Error, error)
+	}
+
+	// ReadOnlyFormExtension is optional interface for form extensions which cause no side effects by decoding,
+	// validation and validity gates (unlike consuming single-use captcha tokens or counting submissions), so they
+	// also run for dry-run submissions. Other form extensions only provide their form data for dry-run submissions.
+	ReadOnlyFormExtension interface {
+		// IsReadOnly as method which returns true if form extension causes no side effects
+		IsReadOnly() bool
+	}
+
+	// PrioritizedFormExtension is optional interface for form extensions which depend on order of processing (like
+	// captcha, which must be verified before rate limiter counts the submission). Form extensions are decoded,
+	// validated, asked to veto validity and notified about final state of the form by descending priority, and
+	// by their names for the same priority. Other form extensions have priority 0.
+	PrioritizedFormExtension interface {
+		// Priority as method which returns priority of form extension
+		Priority() int
+	}
+
+	// UserSpecificFormDataProvider is optional interface for form services, form data contributors and form extensions
+	// which provide form data specific for the user or the request (like CSRF tokens or session drafts), so response
+	// rendering the unsubmitted form can't be shared between users by response caching
+	UserSpecificFormDataProvider interface {
+		// IsUserSpecific as method which returns true if provided form data is specific for the user or the request
+		IsUserSpecific() bool
+	}
+
+	// SuccessStep is interface for defining single step of success pipeline, which runs after valid form submission
+	// (like creating an account, subscribing to newsletter or sending mail). Steps run in order they are added,
+	// and if one of them fails, already executed steps are compensated in reverse order.
+	SuccessStep interface {
+		// Execute as method for performing effect of valid submitted form
+		Execute(ctx context.Context, req *web.Request, form *Form) error
+		// Compensate as method for reverting effect of executed step, in case that one of later steps fails
+		Compensate(ctx context.Context, req *web.Request, form *Form) error
+	}
+
 	// FormService is helper interface for form services used for binding with dingo injector
 	FormService interface{}
 
+	// WarmableFormService is optional interface for form services, sub forms and form extensions which prepare
+	// expensive resources (like loading of lists or connecting to external services), so they are prepared
+	// by warm-up of form handlers at boot instead of by the first request
+	WarmableFormService interface {
+		// Warmup as method for preparing resources before the first request
+		Warmup(ctx context.Context) error
+	}
+
+	// SubForm is interface for defining reusable sub form component (like "address" or "contact person"), which is
+	// embedded into form data of multiple parent forms, as field named by its prefix. Same as form service, it can
+	// implement FormDataProvider, FormDataDecoder and FormDataValidator, which operate on form data of the sub form
+	// only. Values passed to sub form and its form extensions are stripped of the prefix, and their field errors
+	// are prefixed.
+	SubForm interface {
+		// SubFormExtensions returns names of form extensions, which are added to parent form together with sub form
+		SubFormExtensions() []string
+	}
+
 	// FormDataProvider is interface for defining all form services which creates form data
 	FormDataProvider interface {
 		// GetFormData as method for defining form data
@@ -38,12 +156,41 @@ type (
 		FormDataProvider
 	}
 
+	// FormDataContributor is interface for defining single step of chained form data provider, which augments form data
+	// provided by previous steps (like defaults, entity values, query parameters or session draft)
+	FormDataContributor interface {
+		// ContributeFormData as method for returning form data with contributed values
+		ContributeFormData(ctx context.Context, req *web.Request, formData interface{}) (interface{}, error)
+	}
+
 	// FormDataDecoder is interface for defining all form services which process http request and transform it into form data
 	FormDataDecoder interface {
 		// Decode as method for transforming http request body into form data
 		Decode(ctx context.Context, req *web.Request, values url.Values, formData interface{}) (interface{}, error)
 	}
 
+	// RequestBodyDecoder is interface for defining decoders of request bodies of single content type (like JSON or XML),
+	// which transform body of submitted form into values, before they are decoded into form data. Decoders are bound
+	// via dingo injector as map binding by media type (like "application/xml"), and they override default ones.
+	RequestBodyDecoder interface {
+		// DecodeBody as method for transforming request body into submitted values, merged with query parameters
+		DecodeBody(ctx context.Context, req *web.Request) (url.Values, error)
+	}
+
+	// FormValuesTransformer is interface for defining single step of chained form data decoder, which transforms
+	// submitted values before they are decoded into form data (like charset transcoding or sanitizing)
+	FormValuesTransformer interface {
+		// TransformValues as method for returning transformed submitted values
+		TransformValues(ctx context.Context, req *web.Request, values url.Values) (url.Values, error)
+	}
+
+	// FormDataTransformer is interface for defining single step of chained form data decoder, which transforms
+	// form data after values are decoded into it (like normalizing of decoded fields)
+	FormDataTransformer interface {
+		// TransformFormData as method for returning transformed form data
+		TransformFormData(ctx context.Context, req *web.Request, formData interface{}) (interface{}, error)
+	}
+
 	// DefaultFormDataDecoder is interface for defining default form data decoder
 	// used in case when there is no custom form data decoder defined
 	DefaultFormDataDecoder interface {
@@ -62,6 +209,13 @@ type (
 		FormDataValidator
 	}
 
+	// FormDataEnricher is interface for defining form services which augment form data after successful validation
+	// (like geo-coding of address or resolving of customer group), before form data is exposed via Form
+	FormDataEnricher interface {
+		// Enrich as method for augmenting valid form data, errors of returned validation info are attached to form
+		Enrich(ctx context.Context, req *web.Request, formData interface{}) (interface{}, *ValidationInfo, error)
+	}
+
 	// CompleteFormService is interface for defining all form services which can acts as provider, decoder and validator
 	CompleteFormService interface {
 		FormDataProvider
@@ -75,6 +229,23 @@ type (
 		Encode(ctx context.Context, formData interface{}) (url.Values, error)
 	}
 
+	// FieldEncryptor is interface for defining encryption of form data fields tagged with `encrypt:"true"`.
+	// Tagged fields are encrypted after validation, before form data is exposed via Form.
+	FieldEncryptor interface {
+		// Encrypt as method for transforming plaintext value into ciphertext
+		Encrypt(ctx context.Context, plaintext string) (string, error)
+		// Decrypt as method for transforming ciphertext back into plaintext value
+		Decrypt(ctx context.Context, ciphertext string) (string, error)
+	}
+
+	// DependencyStatus is interface for defining form extensions, validators and their storages,
+	// which depend on external services (like captcha, VAT number or address verification services).
+	// It matches Flamingo's healthcheck.Status, so availability of form dependencies is reported by healthcheck.
+	DependencyStatus interface {
+		// Status as method for checking availability of external service, details describe the reason of unavailability
+		Status() (alive bool, details string)
+	}
+
 	// DefaultFormDataEncoder is interface for defining default form data encoder
 	// used in case when there is no custom form data encoder defined
 	DefaultFormDataEncoder interface {
@@ -0,0 +1,129 @@
+package domain
+
+type (
+	// FormState defines explicit state of handled form
+	FormState int
+
+	// FormResult as struct for storing result of form handling with explicit state, so controllers don't need
+	// to infer state from Form.IsSubmitted and error checks
+	FormResult struct {
+		// form the handled Form, nil in case of failure
+		form *Form
+		// err the error which occurred during form handling
+		err error
+	}
+)
+
+const (
+	// FormStateNotSubmitted state of form which is not submitted
+	FormStateNotSubmitted FormState = iota
+	// FormStateInvalid state of submitted form with validation errors
+	FormStateInvalid
+	// FormStateValid state of submitted form without validation errors
+	FormStateValid
+	// FormStateFailed state of form which handling failed with error
+	FormStateFailed
+)
+
+// String returns name of form state
+func (s FormState) String() string {
+	switch s {
+	case FormStateNotSubmitted:
+		return "NotSubmitted"
+	case FormStateInvalid:
+		return "Invalid"
+	case FormStateValid:
+		return "Valid"
+	case FormStateFailed:
+		return "Failed"
+	}
+
+	return "Unknown"
+}
+
+// NewFormResult returns new instance of FormResult struct from result of form handling
+func NewFormResult(form *Form, err error) FormResult {
+	if err == nil && form == nil {
+		err = NewFormError("there is no form handled")
+	}
+
+	return FormResult{
+		form: form,
+		err:  err,
+	}
+}
+
+// State returns explicit state of handled form
+func (r FormResult) State() FormState {
+	switch {
+	case r.err != nil:
+		return FormStateFailed
+	case !r.form.IsSubmitted():
+		return FormStateNotSubmitted
+	case !r.form.IsValid():
+		return FormStateInvalid
+	}
+
+	return FormStateValid
+}
+
+// IsNotSubmitted defines if form is not submitted
+func (r FormResult) IsNotSubmitted() bool {
+	return r.State() == FormStateNotSubmitted
+}
+
+// IsInvalid defines if form is submitted with validation errors
+func (r FormResult) IsInvalid() bool {
+	return r.State() == FormStateInvalid
+}
+
+// IsValid defines if form is submitted without validation errors
+func (r FormResult) IsValid() bool {
+	return r.State() == FormStateValid
+}
+
+// IsFailed defines if form handling failed with error
+func (r FormResult) IsFailed() bool {
+	return r.State() == FormStateFailed
+}
+
+// Form returns handled Form, nil in case of failure
+func (r FormResult) Form() *Form {
+	if r.err != nil {
+		return nil
+	}
+
+	return r.form
+}
+
+// Err returns error which occurred during form handling
+func (r FormResult) Err() error {
+	return r.err
+}
+
+// Data returns form data, nil in case of failure
+func (r FormResult) Data() interface{} {
+	if r.Form() == nil {
+		return nil
+	}
+
+	return r.form.Data
+}
+
+// ExtensionData returns form data of named form extension, nil in case of failure or missing extension
+func (r FormResult) ExtensionData(name string) interface{} {
+	if r.Form() == nil {
+		return nil
+	}
+
+	return r.form.FormExtensionsData[name]
+}
+
+// ValidationInfo returns validation info of handled form, empty in case of failure
+func (r FormResult) ValidationInfo() ValidationInfo {
+	if r.Form() == nil {
+		return ValidationInfo{}
+	}
+
+	return r.form.ValidationInfo
+}
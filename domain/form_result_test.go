@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	FormResultTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestFormResultTestSuite(t *testing.T) {
+	suite.Run(t, &FormResultTestSuite{})
+}
+
+func (t *FormResultTestSuite) TestFormState_String() {
+	t.Equal("NotSubmitted", FormStateNotSubmitted.String())
+	t.Equal("Invalid", FormStateInvalid.String())
+	t.Equal("Valid", FormStateValid.String())
+	t.Equal("Failed", FormStateFailed.String())
+	t.Equal("Unknown", FormState(10).String())
+}
+
+func (t *FormResultTestSuite) TestNewFormResult_NotSubmitted() {
+	form := NewForm(false, nil)
+	form.Data = "data"
+
+	result := NewFormResult(&form, nil)
+	t.Equal(FormStateNotSubmitted, result.State())
+	t.True(result.IsNotSubmitted())
+	t.False(result.IsInvalid())
+	t.False(result.IsValid())
+	t.False(result.IsFailed())
+	t.Equal(&form, result.Form())
+	t.Equal("data", result.Data())
+	t.NoError(result.Err())
+}
+
+func (t *FormResultTestSuite) TestNewFormResult_Invalid() {
+	form := NewForm(true, nil)
+	form.ValidationInfo.AddGeneralError("formError.general", "General error")
+
+	result := NewFormResult(&form, nil)
+	t.Equal(FormStateInvalid, result.State())
+	t.True(result.IsInvalid())
+	t.Equal(form.ValidationInfo, result.ValidationInfo())
+}
+
+func (t *FormResultTestSuite) TestNewFormResult_Valid() {
+	form := NewForm(true, nil)
+	form.FormExtensionsData = map[string]interface{}{
+		"extension": "extensionData",
+	}
+
+	result := NewFormResult(&form, nil)
+	t.Equal(FormStateValid, result.State())
+	t.True(result.IsValid())
+	t.Equal("extensionData", result.ExtensionData("extension"))
+	t.Nil(result.ExtensionData("unknown"))
+}
+
+func (t *FormResultTestSuite) TestNewFormResult_Failed() {
+	form := NewForm(true, nil)
+
+	result := NewFormResult(&form, errors.New("error"))
+	t.Equal(FormStateFailed, result.State())
+	t.True(result.IsFailed())
+	t.Equal(errors.New("error"), result.Err())
+	t.Nil(result.Form())
+	t.Nil(result.Data())
+	t.Nil(result.ExtensionData("extension"))
+	t.Equal(ValidationInfo{}, result.ValidationInfo())
+
+	result = NewFormResult(nil, nil)
+	t.True(result.IsFailed())
+	t.Error(result.Err())
+}
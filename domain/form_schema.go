@@ -0,0 +1,11 @@
+package domain
+
+type (
+	// FormSchemaExporter as interface for exporters of form contracts, which convert validation rules and field types
+	// of the form into JSON Schema document, so clients (like SPAs) can mirror server-side validation without
+	// duplicating rules
+	FormSchemaExporter interface {
+		// ExportSchema returns JSON Schema document of the form, ready to be encoded as JSON
+		ExportSchema(form *Form) (map[string]interface{}, error)
+	}
+)
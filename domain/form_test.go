@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"errors"
+	"html/template"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -58,6 +60,17 @@ func (t *FormTestSuite) TestNewForm() {
 	}, form.GetValidationRulesForField("fieldName1"))
 }
 
+func (t *FormTestSuite) TestGetMarkdownPreview() {
+	form := NewForm(true, nil)
+	t.Equal(template.HTML(""), form.GetMarkdownPreview("comment"))
+
+	form.MarkdownPreviews = map[string]template.HTML{
+		"comment": "<p><strong>great</strong></p>",
+	}
+	t.Equal(template.HTML("<p><strong>great</strong></p>"), form.GetMarkdownPreview("comment"))
+	t.Equal(template.HTML(""), form.GetMarkdownPreview("review"))
+}
+
 func (t *FormTestSuite) TestIsValidAndSubmitted() {
 	form := NewForm(false, map[string][]ValidationRule{})
 	t.True(form.IsValid())
@@ -77,6 +90,30 @@ func (t *FormTestSuite) TestIsValidAndSubmitted() {
 	t.False(form.IsValidAndSubmitted())
 }
 
+func (t *FormTestSuite) TestIsDegraded() {
+	form := NewForm(true, nil)
+	t.False(form.IsDegraded())
+
+	form.Degradations = []Degradation{
+		{
+			Subsystem: "formExtension.captcha",
+			Reason:    "captcha service unavailable",
+		},
+	}
+	t.True(form.IsDegraded())
+}
+
+func (t *FormTestSuite) TestIsCacheable() {
+	form := NewForm(false, nil)
+	t.False(form.IsCacheable())
+
+	form.CacheHint = &CacheHint{Reasons: []string{"formExtension.csrfToken"}}
+	t.False(form.IsCacheable())
+
+	form.CacheHint = &CacheHint{Cacheable: true}
+	t.True(form.IsCacheable())
+}
+
 func (t *FormTestSuite) TestErrors() {
 	form := NewForm(false, map[string][]ValidationRule{})
 	t.False(form.HasAnyFieldErrors())
@@ -103,3 +140,12 @@ func (t *FormTestSuite) TestErrors() {
 		},
 	}, form.GetErrorsForField("fieldName1"))
 }
+
+func (t *FormTestSuite) TestIsRequestBodyTooLarge() {
+	t.True(IsRequestBodyTooLarge(ErrRequestBodyTooLarge))
+	t.True(IsRequestBodyTooLarge(NewFormErrorWithParent(ErrRequestBodyTooLarge)))
+	t.True(IsRequestBodyTooLarge(NewFormErrorWithParent(NewFormErrorWithParent(ErrRequestBodyTooLarge))))
+	t.False(IsRequestBodyTooLarge(NewFormError("request body is too large, but not from limit")))
+	t.False(IsRequestBodyTooLarge(NewFormErrorWithParent(errors.New("error"))))
+	t.False(IsRequestBodyTooLarge(nil))
+}
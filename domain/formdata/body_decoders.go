@@ -0,0 +1,131 @@
+package formdata
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// URLEncodedBodyDecoder represents decoder of "application/x-www-form-urlencoded" request bodies
+	URLEncodedBodyDecoder struct{}
+
+	// MultipartBodyDecoder represents decoder of "multipart/form-data" request bodies, parsed together with their files
+	MultipartBodyDecoder struct {
+		// MaxMemory maximal number of bytes of body kept in memory, MultipartMaxMemory is used if it's not set
+		MaxMemory int64
+	}
+
+	// JSONBodyDecoder represents decoder of JSON request bodies, like "application/json"
+	JSONBodyDecoder struct{}
+
+	// XMLBodyDecoder represents decoder of XML request bodies, like "application/xml"
+	XMLBodyDecoder struct{}
+)
+
+const (
+	// MultipartMaxMemory maximal number of bytes of multipart body kept in memory, rest of uploaded files is stored
+	// in temporary files, same as by default of net/http
+	MultipartMaxMemory = 32 << 20
+
+	// maxURLEncodedBodySize maximal number of bytes of url encoded body, same as limit of net/http
+	maxURLEncodedBodySize = 10 << 20
+)
+
+var (
+	_ domain.RequestBodyDecoder = &URLEncodedBodyDecoder{}
+	_ domain.RequestBodyDecoder = &MultipartBodyDecoder{}
+	_ domain.RequestBodyDecoder = &JSONBodyDecoder{}
+	_ domain.RequestBodyDecoder = &XMLBodyDecoder{}
+)
+
+// DecodeBody parses url encoded body of the request together with query parameters. Bodies of POST, PUT and PATCH
+// requests are parsed by net/http, while bodies of other methods (like DELETE) are parsed here, as net/http
+// ignores them.
+func (d *URLEncodedBodyDecoder) DecodeBody(_ context.Context, req *web.Request) (url.Values, error) {
+	request := req.Request()
+	switch request.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, "":
+	default:
+		mediaType, _, _ := mime.ParseMediaType(request.Header.Get("Content-Type"))
+		if request.PostForm == nil && request.Body != nil && mediaType == "application/x-www-form-urlencoded" {
+			body, err := ioutil.ReadAll(io.LimitReader(request.Body, maxURLEncodedBodySize+1))
+			if err != nil {
+				return nil, err
+			}
+			if len(body) > maxURLEncodedBodySize {
+				return nil, domain.NewFormError("url encoded body is too large")
+			}
+
+			request.PostForm, err = url.ParseQuery(string(body))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := request.ParseForm(); err != nil {
+		return nil, err
+	}
+
+	return request.Form, nil
+}
+
+// DecodeBody parses multipart body of the request together with query parameters. Files are bound to form data
+// by default decoder.
+func (d *MultipartBodyDecoder) DecodeBody(_ context.Context, req *web.Request) (url.Values, error) {
+	maxMemory := d.MaxMemory
+	if maxMemory <= 0 {
+		maxMemory = MultipartMaxMemory
+	}
+
+	if err := req.Request().ParseMultipartForm(maxMemory); err != nil {
+		return nil, err
+	}
+
+	return req.Request().Form, nil
+}
+
+// DecodeBody transforms JSON object of request body into values, which take precedence over query parameters.
+// Body is flattened by structure of form data from the context, if there is any.
+func (d *JSONBodyDecoder) DecodeBody(ctx context.Context, req *web.Request) (url.Values, error) {
+	formData := domain.FormDataFromContext(ctx)
+
+	return decodeBodyWithQuery(req, func(body io.Reader) (url.Values, error) {
+		return JSONValuesFor(body, formData)
+	})
+}
+
+// DecodeBody transforms XML document of request body into values, which take precedence over query parameters
+func (d *XMLBodyDecoder) DecodeBody(_ context.Context, req *web.Request) (url.Values, error) {
+	return decodeBodyWithQuery(req, XMLValues)
+}
+
+// decodeBodyWithQuery returns query parameters of the request, overridden by values of its body
+func decodeBodyWithQuery(req *web.Request, decode func(body io.Reader) (url.Values, error)) (url.Values, error) {
+	values := url.Values{}
+	if req.Request().URL != nil {
+		values = req.Request().URL.Query()
+	}
+
+	if req.Request().Body == nil {
+		return values, nil
+	}
+
+	bodyValues, err := decode(req.Request().Body)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, list := range bodyValues {
+		values[key] = list
+	}
+
+	return values, nil
+}
@@ -0,0 +1,109 @@
+package formdata
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	BodyDecodersTestSuite struct {
+		suite.Suite
+
+		context context.Context
+	}
+)
+
+func TestBodyDecodersTestSuite(t *testing.T) {
+	suite.Run(t, &BodyDecodersTestSuite{})
+}
+
+func (t *BodyDecodersTestSuite) SetupTest() {
+	t.context = context.Background()
+}
+
+func (t *BodyDecodersTestSuite) request(method string, contentType string, body string) *web.Request {
+	return web.CreateRequest(&http.Request{
+		Method: method,
+		URL:    &url.URL{RawQuery: "id=1&name=query"},
+		Header: http.Header{"Content-Type": []string{contentType}},
+		Body:   ioutil.NopCloser(strings.NewReader(body)),
+	}, nil)
+}
+
+func (t *BodyDecodersTestSuite) TestURLEncodedBodyDecoder_DecodeBody() {
+	decoder := &URLEncodedBodyDecoder{}
+
+	values, err := decoder.DecodeBody(t.context, t.request(http.MethodPost, "application/x-www-form-urlencoded", "name=body"))
+	t.NoError(err)
+	t.Equal(url.Values{"id": []string{"1"}, "name": []string{"body", "query"}}, values)
+
+	values, err = decoder.DecodeBody(t.context, t.request(http.MethodDelete, "application/x-www-form-urlencoded", "reason=duplicate"))
+	t.NoError(err)
+	t.Equal(url.Values{"id": []string{"1"}, "name": []string{"query"}, "reason": []string{"duplicate"}}, values)
+}
+
+func (t *BodyDecodersTestSuite) TestURLEncodedBodyDecoder_DecodeBodyTooLarge() {
+	decoder := &URLEncodedBodyDecoder{}
+
+	_, err := decoder.DecodeBody(t.context, t.request(http.MethodDelete, "application/x-www-form-urlencoded", "reason="+strings.Repeat("x", maxURLEncodedBodySize)))
+	t.Error(err)
+}
+
+func (t *BodyDecodersTestSuite) TestMultipartBodyDecoder_DecodeBody() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	t.NoError(writer.WriteField("name", "body"))
+	part, err := writer.CreateFormFile("avatar", "avatar.png")
+	t.NoError(err)
+	_, err = part.Write([]byte("content"))
+	t.NoError(err)
+	t.NoError(writer.Close())
+
+	request := t.request(http.MethodPost, writer.FormDataContentType(), body.String())
+	decoder := &MultipartBodyDecoder{MaxMemory: 1}
+
+	values, err := decoder.DecodeBody(t.context, request)
+	t.NoError(err)
+	t.Equal(url.Values{"id": []string{"1"}, "name": []string{"body", "query"}}, values)
+	t.Len(request.Request().MultipartForm.File["avatar"], 1)
+	t.NoError(request.Request().MultipartForm.RemoveAll())
+}
+
+func (t *BodyDecodersTestSuite) TestJSONBodyDecoder_DecodeBody() {
+	decoder := &JSONBodyDecoder{}
+
+	values, err := decoder.DecodeBody(t.context, t.request(http.MethodPost, "application/json", `{"name": "body"}`))
+	t.NoError(err)
+	t.Equal(url.Values{"id": []string{"1"}, "name": []string{"body"}}, values)
+
+	values, err = decoder.DecodeBody(domain.ContextWithFormData(t.context, struct {
+		Labels map[string]string `form:"labels"`
+	}{}), t.request(http.MethodPost, "application/json", `{"labels": {"first": "a"}}`))
+	t.NoError(err)
+	t.Equal(url.Values{"id": []string{"1"}, "name": []string{"query"}, "labels[first]": []string{"a"}}, values)
+
+	_, err = decoder.DecodeBody(t.context, t.request(http.MethodPost, "application/json", `[1]`))
+	t.Error(err)
+}
+
+func (t *BodyDecodersTestSuite) TestXMLBodyDecoder_DecodeBody() {
+	decoder := &XMLBodyDecoder{}
+
+	values, err := decoder.DecodeBody(t.context, t.request(http.MethodPost, "application/xml", `<user><name>body</name></user>`))
+	t.NoError(err)
+	t.Equal(url.Values{"id": []string{"1"}, "name": []string{"body"}}, values)
+
+	_, err = decoder.DecodeBody(t.context, t.request(http.MethodPost, "application/xml", `<user>`))
+	t.Error(err)
+}
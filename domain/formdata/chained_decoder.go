@@ -0,0 +1,165 @@
+package formdata
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// ChainedFormDataDecoder represents domain.FormDataDecoder composed of layered input processing: values transformers
+	// which process submitted values, decoder which binds values into form data and form data transformers which
+	// process decoded form data. Transformers are called in the order they are defined, and each of them receives
+	// result of the previous one.
+	//
+	// decoder := formdata.NewChainedFormDataDecoder(nil).
+	//   WithValuesTransformers(formdata.Latin1Transcoder(), formdata.SanitizeValues()).
+	//   WithFormDataTransformers(formdata.FormDataTransformerFunc(c.normalizeAddress))
+	ChainedFormDataDecoder struct {
+		valuesTransformers   []domain.FormValuesTransformer
+		decoder              domain.FormDataDecoder
+		formDataTransformers []domain.FormDataTransformer
+	}
+
+	// DecoderFunc represents function which acts as domain.FormDataDecoder
+	DecoderFunc func(ctx context.Context, req *web.Request, values url.Values, formData interface{}) (interface{}, error)
+
+	// ValuesTransformerFunc represents function which acts as domain.FormValuesTransformer
+	ValuesTransformerFunc func(ctx context.Context, req *web.Request, values url.Values) (url.Values, error)
+
+	// FormDataTransformerFunc represents function which acts as domain.FormDataTransformer
+	FormDataTransformerFunc func(ctx context.Context, req *web.Request, formData interface{}) (interface{}, error)
+)
+
+var (
+	_ domain.FormDataDecoder       = &ChainedFormDataDecoder{}
+	_ domain.FormDataDecoder       = DecoderFunc(nil)
+	_ domain.FormValuesTransformer = ValuesTransformerFunc(nil)
+	_ domain.FormDataTransformer   = FormDataTransformerFunc(nil)
+)
+
+// NewChainedFormDataDecoder creates chained form data decoder. If decoder is nil, default form data decoder is used.
+func NewChainedFormDataDecoder(decoder domain.FormDataDecoder) *ChainedFormDataDecoder {
+	if decoder == nil {
+		decoder = &DefaultFormDataDecoderImpl{}
+	}
+
+	return &ChainedFormDataDecoder{
+		decoder: decoder,
+	}
+}
+
+// WithValuesTransformers adds transformers of submitted values, which run before decoding
+func (d *ChainedFormDataDecoder) WithValuesTransformers(transformers ...domain.FormValuesTransformer) *ChainedFormDataDecoder {
+	d.valuesTransformers = append(d.valuesTransformers, transformers...)
+
+	return d
+}
+
+// WithFormDataTransformers adds transformers of form data, which run after decoding
+func (d *ChainedFormDataDecoder) WithFormDataTransformers(transformers ...domain.FormDataTransformer) *ChainedFormDataDecoder {
+	d.formDataTransformers = append(d.formDataTransformers, transformers...)
+
+	return d
+}
+
+// Decode passes submitted values through all values transformers, decodes them into form data
+// and passes form data through all form data transformers
+func (d *ChainedFormDataDecoder) Decode(ctx context.Context, req *web.Request, values url.Values, formData interface{}) (interface{}, error) {
+	var err error
+	for _, transformer := range d.valuesTransformers {
+		values, err = transformer.TransformValues(ctx, req, values)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	formData, err = d.decoder.Decode(ctx, req, values, formData)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, transformer := range d.formDataTransformers {
+		formData, err = transformer.TransformFormData(ctx, req, formData)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return formData, nil
+}
+
+// Decode calls decoder function
+func (f DecoderFunc) Decode(ctx context.Context, req *web.Request, values url.Values, formData interface{}) (interface{}, error) {
+	return f(ctx, req, values, formData)
+}
+
+// TransformValues calls values transformer function
+func (f ValuesTransformerFunc) TransformValues(ctx context.Context, req *web.Request, values url.Values) (url.Values, error) {
+	return f(ctx, req, values)
+}
+
+// TransformFormData calls form data transformer function
+func (f FormDataTransformerFunc) TransformFormData(ctx context.Context, req *web.Request, formData interface{}) (interface{}, error) {
+	return f(ctx, req, formData)
+}
+
+// Latin1Transcoder creates values transformer which transcodes values from ISO-8859-1 into UTF-8, for clients which
+// submit forms in legacy charset. Values which are already valid UTF-8 are kept.
+func Latin1Transcoder() domain.FormValuesTransformer {
+	return mapValues(func(value string) string {
+		if utf8.ValidString(value) {
+			return value
+		}
+
+		var builder strings.Builder
+		builder.Grow(len(value) * 2)
+		for i := 0; i < len(value); i++ {
+			builder.WriteRune(rune(value[i]))
+		}
+
+		return builder.String()
+	})
+}
+
+// SanitizeValues creates values transformer which trims white spaces, removes invalid UTF-8 sequences
+// and removes control characters, except new lines and tabs
+func SanitizeValues() domain.FormValuesTransformer {
+	return mapValues(func(value string) string {
+		value = strings.ToValidUTF8(value, "")
+
+		return strings.TrimSpace(strings.Map(func(r rune) rune {
+			if unicode.IsControl(r) && r != '\n' && r != '\r' && r != '\t' {
+				return -1
+			}
+
+			return r
+		}, value))
+	})
+}
+
+// mapValues creates values transformer which applies mapping to each submitted value. Submitted values are copied,
+// so values of the request stay unchanged.
+func mapValues(mapping func(value string) string) domain.FormValuesTransformer {
+	return ValuesTransformerFunc(func(_ context.Context, _ *web.Request, values url.Values) (url.Values, error) {
+		if values == nil {
+			return nil, nil
+		}
+
+		mapped := make(url.Values, len(values))
+		for key, list := range values {
+			mappedList := make([]string, len(list))
+			for i, value := range list {
+				mappedList[i] = mapping(value)
+			}
+			mapped[key] = mappedList
+		}
+
+		return mapped, nil
+	})
+}
@@ -0,0 +1,167 @@
+package formdata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	ChainedFormDataDecoderTestSuite struct {
+		suite.Suite
+
+		context context.Context
+		request *web.Request
+	}
+)
+
+func TestChainedFormDataDecoderTestSuite(t *testing.T) {
+	suite.Run(t, &ChainedFormDataDecoderTestSuite{})
+}
+
+func (t *ChainedFormDataDecoderTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *ChainedFormDataDecoderTestSuite) SetupTest() {
+	t.request = web.CreateRequest(&http.Request{}, nil)
+}
+
+func (t *ChainedFormDataDecoderTestSuite) TestDecode() {
+	var calls []string
+
+	decoder := NewChainedFormDataDecoder(nil).
+		WithValuesTransformers(
+			Latin1Transcoder(),
+			SanitizeValues(),
+			ValuesTransformerFunc(func(_ context.Context, _ *web.Request, values url.Values) (url.Values, error) {
+				calls = append(calls, "values")
+				values.Set("country", "DE")
+				return values, nil
+			}),
+		).
+		WithFormDataTransformers(
+			FormDataTransformerFunc(func(_ context.Context, _ *web.Request, formData interface{}) (interface{}, error) {
+				calls = append(calls, "first")
+				data := formData.(map[string]string)
+				data["city"] = data["city"] + "!"
+				return data, nil
+			}),
+			FormDataTransformerFunc(func(_ context.Context, _ *web.Request, formData interface{}) (interface{}, error) {
+				calls = append(calls, "second")
+				data := formData.(map[string]string)
+				data["city"] = data["city"] + "?"
+				return data, nil
+			}),
+		)
+
+	values := url.Values{
+		"city":   []string{"M\xfcnchen"},
+		"street": []string{"  Main\x00 Street \n"},
+	}
+
+	result, err := decoder.Decode(t.context, t.request, values, map[string]string{})
+	t.NoError(err)
+	t.Equal(map[string]string{
+		"city":    "München!?",
+		"street":  "Main Street",
+		"country": "DE",
+	}, result)
+	t.Equal([]string{"values", "first", "second"}, calls)
+	t.Equal(url.Values{
+		"city":   []string{"M\xfcnchen"},
+		"street": []string{"  Main\x00 Street \n"},
+	}, values)
+}
+
+func (t *ChainedFormDataDecoderTestSuite) TestDecode_Decoder() {
+	decoder := &mocks.FormDataDecoder{}
+	decoder.On("Decode", t.context, t.request, url.Values{"name": []string{"value"}}, "formData").Return("decoded", nil).Once()
+
+	result, err := NewChainedFormDataDecoder(decoder).
+		WithValuesTransformers(SanitizeValues()).
+		Decode(t.context, t.request, url.Values{"name": []string{" value "}}, "formData")
+	t.NoError(err)
+	t.Equal("decoded", result)
+
+	decoder.AssertExpectations(t.T())
+}
+
+func (t *ChainedFormDataDecoderTestSuite) TestDecode_ValuesTransformerError() {
+	decoder := &mocks.FormDataDecoder{}
+	transformer := &mocks.FormValuesTransformer{}
+	transformer.On("TransformValues", t.context, t.request, url.Values{}).Return(nil, errors.New("error")).Once()
+
+	result, err := NewChainedFormDataDecoder(decoder).
+		WithValuesTransformers(transformer).
+		Decode(t.context, t.request, url.Values{}, "formData")
+	t.EqualError(err, "error")
+	t.Nil(result)
+
+	decoder.AssertExpectations(t.T())
+	transformer.AssertExpectations(t.T())
+}
+
+func (t *ChainedFormDataDecoderTestSuite) TestDecode_DecoderError() {
+	decoder := &mocks.FormDataDecoder{}
+	transformer := &mocks.FormDataTransformer{}
+	decoder.On("Decode", t.context, t.request, url.Values{}, "formData").Return(nil, errors.New("error")).Once()
+
+	result, err := NewChainedFormDataDecoder(decoder).
+		WithFormDataTransformers(transformer).
+		Decode(t.context, t.request, url.Values{}, "formData")
+	t.EqualError(err, "error")
+	t.Nil(result)
+
+	decoder.AssertExpectations(t.T())
+	transformer.AssertExpectations(t.T())
+}
+
+func (t *ChainedFormDataDecoderTestSuite) TestDecode_FormDataTransformerError() {
+	decoder := &mocks.FormDataDecoder{}
+	transformer := &mocks.FormDataTransformer{}
+	decoder.On("Decode", t.context, t.request, url.Values{}, "formData").Return("decoded", nil).Once()
+	transformer.On("TransformFormData", t.context, t.request, "decoded").Return(nil, errors.New("error")).Once()
+
+	result, err := NewChainedFormDataDecoder(decoder).
+		WithFormDataTransformers(transformer).
+		Decode(t.context, t.request, url.Values{}, "formData")
+	t.EqualError(err, "error")
+	t.Nil(result)
+
+	decoder.AssertExpectations(t.T())
+	transformer.AssertExpectations(t.T())
+}
+
+func (t *ChainedFormDataDecoderTestSuite) TestLatin1Transcoder() {
+	result, err := Latin1Transcoder().TransformValues(t.context, t.request, url.Values{
+		"latin1": []string{"Gr\xfc\xdfe"},
+		"utf8":   []string{"Grüße"},
+	})
+	t.NoError(err)
+	t.Equal(url.Values{
+		"latin1": []string{"Grüße"},
+		"utf8":   []string{"Grüße"},
+	}, result)
+}
+
+func (t *ChainedFormDataDecoderTestSuite) TestSanitizeValues() {
+	result, err := SanitizeValues().TransformValues(t.context, t.request, url.Values{
+		"comment": []string{" first\tline\r\nsecond\x1b[0m line\xff "},
+	})
+	t.NoError(err)
+	t.Equal(url.Values{
+		"comment": []string{"first\tline\r\nsecond[0m line"},
+	}, result)
+
+	result, err = SanitizeValues().TransformValues(t.context, t.request, nil)
+	t.NoError(err)
+	t.Nil(result)
+}
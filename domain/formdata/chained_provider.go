@@ -0,0 +1,307 @@
+package formdata
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// ChainedFormDataProvider represents domain.FormDataProvider composed of provider of initial form data and chain
+	// of contributors. Contributors are called in the order they are defined, and each of them receives form data
+	// returned by the previous one, so later contributors take precedence. Common order is: defaults from struct tags,
+	// entity values, query parameter overrides and session draft.
+	//
+	// provider := formdata.NewChainedFormDataProvider(
+	//   formdata.ProviderFunc(func(context.Context, *web.Request) (interface{}, error) { return AddressFormData{}, nil }),
+	//   formdata.TagDefaultsContributor(),
+	//   formdata.ContributorFunc(c.addressValues),
+	//   formdata.QueryContributor("country"),
+	//   formdata.SessionDraftContributor("address.draft"),
+	// )
+	ChainedFormDataProvider struct {
+		provider     domain.FormDataProvider
+		contributors []domain.FormDataContributor
+	}
+
+	// ProviderFunc represents function which acts as domain.FormDataProvider
+	ProviderFunc func(ctx context.Context, req *web.Request) (interface{}, error)
+
+	// ContributorFunc represents function which acts as domain.FormDataContributor
+	ContributorFunc func(ctx context.Context, req *web.Request, formData interface{}) (interface{}, error)
+
+	// valuesContributor represents contributor which applies form values to form data
+	valuesContributor func(ctx context.Context, req *web.Request, formData interface{}) url.Values
+
+	// sessionValuesContributor represents contributor which applies form values stored in session to form data
+	sessionValuesContributor valuesContributor
+)
+
+var (
+	_ domain.FormDataProvider             = &ChainedFormDataProvider{}
+	_ domain.UserSpecificFormDataProvider = &ChainedFormDataProvider{}
+	_ domain.FormDataContributor          = ContributorFunc(nil)
+	_ domain.UserSpecificFormDataProvider = sessionValuesContributor(nil)
+
+	// tagDefaults contains form values of `default:"value"` tags per form data type
+	tagDefaults = domain.NewCache("defaultValues")
+)
+
+// NewChainedFormDataProvider creates chained form data provider. If provider is nil, default form data provider is used.
+func NewChainedFormDataProvider(provider domain.FormDataProvider, contributors ...domain.FormDataContributor) *ChainedFormDataProvider {
+	if provider == nil {
+		provider = &DefaultFormDataProviderImpl{}
+	}
+
+	return &ChainedFormDataProvider{
+		provider:     provider,
+		contributors: contributors,
+	}
+}
+
+// GetFormData provides initial form data and passes it through all contributors
+func (p *ChainedFormDataProvider) GetFormData(ctx context.Context, req *web.Request) (interface{}, error) {
+	formData, err := p.provider.GetFormData(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, contributor := range p.contributors {
+		formData, err = contributor.ContributeFormData(ctx, req, formData)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return formData, nil
+}
+
+// IsUserSpecific returns true if provider of initial form data or any of contributors provides user specific form data
+func (p *ChainedFormDataProvider) IsUserSpecific() bool {
+	if userSpecific, ok := p.provider.(domain.UserSpecificFormDataProvider); ok && userSpecific.IsUserSpecific() {
+		return true
+	}
+
+	for _, contributor := range p.contributors {
+		if userSpecific, ok := contributor.(domain.UserSpecificFormDataProvider); ok && userSpecific.IsUserSpecific() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetFormData calls provider function
+func (f ProviderFunc) GetFormData(ctx context.Context, req *web.Request) (interface{}, error) {
+	return f(ctx, req)
+}
+
+// ContributeFormData calls contributor function
+func (f ContributorFunc) ContributeFormData(ctx context.Context, req *web.Request, formData interface{}) (interface{}, error) {
+	return f(ctx, req, formData)
+}
+
+// TagDefaultsContributor creates contributor which sets default values defined by `default:"value"` struct tags
+func TagDefaultsContributor() domain.FormDataContributor {
+	return valuesContributor(func(_ context.Context, _ *web.Request, formData interface{}) url.Values {
+		return defaultValues(reflect.TypeOf(formData))
+	})
+}
+
+// QueryContributor creates contributor which overrides form data with query parameters of the request. Only listed
+// fields are taken over, so links can't prefill arbitrary fields.
+func QueryContributor(fields ...string) domain.FormDataContributor {
+	return valuesContributor(func(_ context.Context, req *web.Request, _ interface{}) url.Values {
+		if req == nil || req.Request().URL == nil {
+			return nil
+		}
+
+		return selectValues(req.Request().URL.Query(), fields)
+	})
+}
+
+// SessionDraftContributor creates contributor which overrides form data with draft stored in session under the key.
+// Draft is stored as url.Values, like values submitted via the form. Chained form data provider with this contributor
+// provides user specific form data.
+func SessionDraftContributor(key string) domain.FormDataContributor {
+	return sessionValuesContributor(func(_ context.Context, req *web.Request, _ interface{}) url.Values {
+		if req == nil || req.Session() == nil {
+			return nil
+		}
+
+		draft, ok := req.Session().Load(key)
+		if !ok {
+			return nil
+		}
+
+		values, _ := draft.(url.Values)
+
+		return values
+	})
+}
+
+// ContributeFormData applies contributed form values to form data
+func (f valuesContributor) ContributeFormData(ctx context.Context, req *web.Request, formData interface{}) (interface{}, error) {
+	values := f(ctx, req, formData)
+	if len(values) == 0 {
+		return formData, nil
+	}
+
+	return applyValues(formData, values)
+}
+
+// ContributeFormData applies contributed form values stored in session to form data
+func (f sessionValuesContributor) ContributeFormData(ctx context.Context, req *web.Request, formData interface{}) (interface{}, error) {
+	return valuesContributor(f).ContributeFormData(ctx, req, formData)
+}
+
+// IsUserSpecific reports that form values stored in session are specific for the user
+func (f sessionValuesContributor) IsUserSpecific() bool {
+	return true
+}
+
+// selectValues returns listed fields of values, including indexed values of slices and maps (like "tags[0]")
+func selectValues(values url.Values, fields []string) url.Values {
+	selected := url.Values{}
+
+	for key, value := range values {
+		name := key
+		if index := strings.Index(key, "["); index > 0 {
+			name = key[:index]
+		}
+
+		for _, field := range fields {
+			if field == name {
+				selected[key] = value
+				break
+			}
+		}
+	}
+
+	return selected
+}
+
+// applyValues returns copy of form data, with values decoded into it. Fields without values keep their data.
+func applyValues(formData interface{}, values url.Values) (result interface{}, err error) {
+	if data, ok := formData.(map[string]string); ok {
+		applied := make(map[string]string, len(data)+len(values))
+		for k, v := range data {
+			applied[k] = v
+		}
+		for k, v := range values {
+			applied[k] = strings.Join(v, " ")
+		}
+
+		return applied, nil
+	}
+
+	if formData == nil {
+		return nil, domain.NewFormError("there is no form data to apply values to")
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			result = nil
+			err = domain.NewFormErrorf("applying of form values failed: %v", r)
+		}
+	}()
+
+	value := reflect.ValueOf(formData)
+	pointer := value.Kind() == reflect.Ptr
+	if pointer {
+		if value.IsNil() {
+			return nil, domain.NewFormError("there is no form data to apply values to")
+		}
+		value = value.Elem()
+	}
+
+	target := reflect.New(value.Type())
+	target.Elem().Set(value)
+
+	if err := formDecoder.Decode(target.Interface(), values); err != nil {
+		return nil, err
+	}
+
+	if pointer {
+		return target.Interface(), nil
+	}
+
+	return target.Elem().Interface(), nil
+}
+
+// defaultValues returns form values of `default:"value"` tags of struct type, including sub structs
+func defaultValues(typeOf reflect.Type) url.Values {
+	for typeOf != nil && typeOf.Kind() == reflect.Ptr {
+		typeOf = typeOf.Elem()
+	}
+
+	if typeOf == nil || typeOf.Kind() != reflect.Struct {
+		return nil
+	}
+
+	if values, ok := tagDefaults.Load(typeOf); ok {
+		return values.(url.Values)
+	}
+
+	values := url.Values{}
+	collectDefaultValues(typeOf, "", values, map[reflect.Type]bool{})
+	tagDefaults.Store(typeOf, values)
+
+	return values
+}
+
+// collectDefaultValues adds default values of all exported fields of struct type. Sub structs which are already part
+// of the current path are skipped, so recursive types don't cause endless collection.
+func collectDefaultValues(typeOf reflect.Type, prefix string, values url.Values, path map[reflect.Type]bool) {
+	path[typeOf] = true
+	defer delete(path, typeOf)
+
+	for i := 0; i < typeOf.NumField(); i++ {
+		fieldType := typeOf.Field(i)
+		if fieldType.PkgPath != "" {
+			continue
+		}
+
+		name := fieldType.Tag.Get("form")
+		if name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name = fieldType.Name
+		}
+
+		fieldTypeOf := fieldType.Type
+		if fieldTypeOf.Kind() == reflect.Ptr {
+			fieldTypeOf = fieldTypeOf.Elem()
+		}
+
+		if fieldTypeOf.Kind() == reflect.Struct && hasExportedFields(fieldTypeOf) {
+			if !path[fieldTypeOf] {
+				collectDefaultValues(fieldTypeOf, fmt.Sprintf("%s%s.", prefix, name), values, path)
+			}
+			continue
+		}
+
+		if defaultValue := fieldType.Tag.Get("default"); defaultValue != "" {
+			values.Set(prefix+name, defaultValue)
+		}
+	}
+}
+
+// hasExportedFields checks if struct type has any exported field, structs without them (like time.Time)
+// are decoded from single value
+func hasExportedFields(typeOf reflect.Type) bool {
+	for i := 0; i < typeOf.NumField(); i++ {
+		if typeOf.Field(i).PkgPath == "" {
+			return true
+		}
+	}
+
+	return false
+}
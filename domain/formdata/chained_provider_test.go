@@ -0,0 +1,165 @@
+package formdata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+type (
+	ChainedFormDataProviderTestSuite struct {
+		suite.Suite
+
+		context context.Context
+		request *web.Request
+	}
+
+	chainedProviderTestFormData struct {
+		Country string                      `form:"country" default:"DE"`
+		Zip     string                      `form:"zip"`
+		Street  string                      `form:"street" default:"Main"`
+		Contact *chainedProviderTestContact `form:"contact"`
+		Secret  string                      `form:"-" default:"secret"`
+	}
+
+	chainedProviderTestContact struct {
+		Email string `form:"email" default:"user@example.com"`
+		Phone string `form:"phone"`
+	}
+)
+
+func TestChainedFormDataProviderTestSuite(t *testing.T) {
+	suite.Run(t, &ChainedFormDataProviderTestSuite{})
+}
+
+func (t *ChainedFormDataProviderTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *ChainedFormDataProviderTestSuite) SetupTest() {
+	session := web.EmptySession()
+	session.Store("address.draft", url.Values{
+		"street": []string{"Draft"},
+	})
+
+	t.request = web.CreateRequest(&http.Request{
+		URL: &url.URL{RawQuery: "country=AT&street=Query&zip=1010"},
+	}, session)
+}
+
+func (t *ChainedFormDataProviderTestSuite) TestGetFormData() {
+	provider := NewChainedFormDataProvider(
+		ProviderFunc(func(context.Context, *web.Request) (interface{}, error) {
+			return chainedProviderTestFormData{}, nil
+		}),
+		TagDefaultsContributor(),
+		ContributorFunc(func(_ context.Context, _ *web.Request, formData interface{}) (interface{}, error) {
+			data := formData.(chainedProviderTestFormData)
+			data.Zip = "80331"
+			data.Street = "Entity"
+			return data, nil
+		}),
+		QueryContributor("country", "zip"),
+		SessionDraftContributor("address.draft"),
+	)
+
+	result, err := provider.GetFormData(t.context, t.request)
+	t.NoError(err)
+	t.Equal(chainedProviderTestFormData{
+		Country: "AT",
+		Zip:     "1010",
+		Street:  "Draft",
+		Contact: &chainedProviderTestContact{
+			Email: "user@example.com",
+		},
+	}, result)
+}
+
+func (t *ChainedFormDataProviderTestSuite) TestGetFormData_DefaultProvider() {
+	provider := NewChainedFormDataProvider(nil, QueryContributor("country"))
+
+	result, err := provider.GetFormData(t.context, t.request)
+	t.NoError(err)
+	t.Equal(map[string]string{"country": "AT"}, result)
+}
+
+func (t *ChainedFormDataProviderTestSuite) TestGetFormData_Pointer() {
+	initial := &chainedProviderTestFormData{Zip: "80331"}
+	provider := NewChainedFormDataProvider(
+		ProviderFunc(func(context.Context, *web.Request) (interface{}, error) {
+			return initial, nil
+		}),
+		TagDefaultsContributor(),
+	)
+
+	result, err := provider.GetFormData(t.context, t.request)
+	t.NoError(err)
+	t.Equal(&chainedProviderTestFormData{
+		Country: "DE",
+		Zip:     "80331",
+		Street:  "Main",
+		Contact: &chainedProviderTestContact{
+			Email: "user@example.com",
+		},
+	}, result)
+	t.Equal(&chainedProviderTestFormData{Zip: "80331"}, initial)
+}
+
+func (t *ChainedFormDataProviderTestSuite) TestGetFormData_Error() {
+	provider := NewChainedFormDataProvider(
+		ProviderFunc(func(context.Context, *web.Request) (interface{}, error) {
+			return nil, errors.New("provider error")
+		}),
+	)
+	_, err := provider.GetFormData(t.context, t.request)
+	t.Equal(errors.New("provider error"), err)
+
+	provider = NewChainedFormDataProvider(
+		nil,
+		ContributorFunc(func(context.Context, *web.Request, interface{}) (interface{}, error) {
+			return nil, errors.New("contributor error")
+		}),
+	)
+	_, err = provider.GetFormData(t.context, t.request)
+	t.Equal(errors.New("contributor error"), err)
+}
+
+func (t *ChainedFormDataProviderTestSuite) TestIsUserSpecific() {
+	t.False(NewChainedFormDataProvider(nil, TagDefaultsContributor(), QueryContributor("country")).IsUserSpecific())
+	t.True(NewChainedFormDataProvider(nil, QueryContributor("country"), SessionDraftContributor("address.draft")).IsUserSpecific())
+	t.True(NewChainedFormDataProvider(NewChainedFormDataProvider(nil, SessionDraftContributor("address.draft"))).IsUserSpecific())
+}
+
+func (t *ChainedFormDataProviderTestSuite) TestSessionDraftContributor_MissingDraft() {
+	result, err := SessionDraftContributor("missing").ContributeFormData(t.context, t.request, map[string]string{"zip": "1010"})
+	t.NoError(err)
+	t.Equal(map[string]string{"zip": "1010"}, result)
+}
+
+func (t *ChainedFormDataProviderTestSuite) TestSelectValues() {
+	t.Equal(url.Values{
+		"tags[0]": []string{"a"},
+		"zip":     []string{"1010"},
+	}, selectValues(url.Values{
+		"tags[0]": []string{"a"},
+		"zip":     []string{"1010"},
+		"street":  []string{"Main"},
+	}, []string{"tags", "zip"}))
+}
+
+func (t *ChainedFormDataProviderTestSuite) TestDefaultValues() {
+	t.Equal(url.Values{
+		"country":       []string{"DE"},
+		"street":        []string{"Main"},
+		"contact.email": []string{"user@example.com"},
+	}, defaultValues(reflect.TypeOf(&chainedProviderTestFormData{})))
+	t.Nil(defaultValues(reflect.TypeOf(map[string]string{})))
+	t.Nil(defaultValues(nil))
+}
@@ -0,0 +1,317 @@
+package formdata
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"unsafe"
+
+	"github.com/go-playground/form"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/markdown"
+	"flamingo.me/form/domain/tags"
+)
+
+type (
+	// decodePlan as precompiled decoding of struct form data type, which sets submitted values directly into fields
+	// at their offsets, by converters selected for types of the fields. Types with fields which can't be set
+	// by converters (like slices, maps or pointers) have no plan, and are decoded by go-playground form package.
+	// Decode plans are shared between decodings and must not be modified.
+	decodePlan struct {
+		// setters of all decoded fields, including fields of embedded and nested structs
+		setters []fieldSetter
+	}
+
+	// fieldSetter as precompiled setter of single field
+	fieldSetter struct {
+		// name form name of the field, prefixed by names of parent fields
+		name string
+		// offset of the field from start of form data struct
+		offset uintptr
+		// typeOf type of the field, used for decoding errors
+		typeOf reflect.Type
+		// convert converts submitted values of the field and sets them into the field
+		convert fieldConverter
+		// label describes expected value of the field in decoding errors, like "Integer"
+		label string
+	}
+
+	// fieldConverter converts non empty list of submitted values and sets result into field at pointer.
+	// It returns false if value can't be converted into type of the field.
+	fieldConverter func(field unsafe.Pointer, values []string) bool
+)
+
+var (
+	// decodePlans contains decode plans per form data type, or nil for types which have no plan
+	decodePlans = domain.NewCache("decodePlans")
+
+	// markdownType type of markdown fields, which are sanitized while decoding
+	markdownType = reflect.TypeOf(markdown.Text(""))
+
+	// tagsType type of tag lists, which are normalized while decoding
+	tagsType = reflect.TypeOf(tags.List{})
+)
+
+// CompileDecodePlan compiles decode plan of form data type for default form data decoder, so fields of the type are
+// set by precompiled setters while requests are handled. Types which are not structs are ignored.
+func CompileDecodePlan(typeOf reflect.Type) {
+	if typeOf == nil {
+		return
+	}
+
+	if typeOf.Kind() == reflect.Ptr {
+		typeOf = typeOf.Elem()
+	}
+
+	if typeOf.Kind() == reflect.Struct {
+		loadDecodePlan(typeOf)
+	}
+}
+
+// loadDecodePlan returns decode plan of struct type, by compiling it if it's not compiled yet.
+// It returns nil if type has fields which can't be set by precompiled setters.
+func loadDecodePlan(typeOf reflect.Type) *decodePlan {
+	if plan, ok := decodePlans.Load(typeOf); ok {
+		return plan.(*decodePlan)
+	}
+
+	plan, _ := decodePlans.LoadOrStore(typeOf, compileDecodePlan(typeOf))
+
+	return plan.(*decodePlan)
+}
+
+// compileDecodePlan compiles decode plan of struct type, or returns nil if plan can't be compiled
+func compileDecodePlan(typeOf reflect.Type) *decodePlan {
+	setters, ok := compileFieldSetters(typeOf, 0, "")
+	if !ok {
+		return nil
+	}
+
+	return &decodePlan{
+		setters: setters,
+	}
+}
+
+// compileFieldSetters compiles setters of all fields of struct type, by names of their form tags, same as names used
+// by go-playground form package. Nested structs are compiled with names prefixed by name of parent field, and fields
+// of embedded structs without form tag are compiled without name of embedded struct, same as files and times.
+// Files and times with layouts are skipped, as they are bound after decoding. It returns false if any field can't be
+// set by setter.
+func compileFieldSetters(typeOf reflect.Type, offset uintptr, prefix string) ([]fieldSetter, bool) {
+	var setters []fieldSetter
+
+	for i := 0; i < typeOf.NumField(); i++ {
+		field := typeOf.Field(i)
+		if !field.Anonymous && field.PkgPath != "" {
+			continue
+		}
+
+		name := fieldName(field)
+		if name == "-" {
+			continue
+		}
+
+		if field.Anonymous && field.Type.Kind() == reflect.Struct && field.Tag.Get("form") == "" {
+			embedded, ok := compileFieldSetters(field.Type, offset+field.Offset, prefix)
+			if !ok {
+				return nil, false
+			}
+			setters = append(setters, embedded...)
+			continue
+		}
+		name = prefix + name
+
+		switch {
+		case field.Type == reflect.PtrTo(fileType) || field.Type == reflect.SliceOf(reflect.PtrTo(fileType)):
+			continue
+		case field.Type == timeType || field.Type == reflect.PtrTo(timeType):
+			if timeLayout(field) == "" {
+				return nil, false
+			}
+			continue
+		case field.Type.Kind() == reflect.Struct:
+			nested, ok := compileFieldSetters(field.Type, offset+field.Offset, name+".")
+			if !ok {
+				return nil, false
+			}
+			setters = append(setters, nested...)
+			continue
+		}
+
+		convert, label := fieldConverterOf(field.Type)
+		if convert == nil {
+			return nil, false
+		}
+
+		setters = append(setters, fieldSetter{
+			name:    name,
+			offset:  offset + field.Offset,
+			typeOf:  field.Type,
+			convert: convert,
+			label:   label,
+		})
+	}
+
+	return setters, true
+}
+
+// fieldConverterOf returns converter for type of the field with label of its values, or nil if there is no converter
+// for the type
+func fieldConverterOf(typeOf reflect.Type) (fieldConverter, string) {
+	switch typeOf {
+	case markdownType:
+		return convertMarkdown, ""
+	case tagsType:
+		return convertTags, ""
+	}
+
+	switch typeOf.Kind() {
+	case reflect.String:
+		return convertString, ""
+	case reflect.Bool:
+		return convertBool, "Boolean"
+	case reflect.Int:
+		return convertInt(func(field unsafe.Pointer, value int64) { *(*int)(field) = int(value) }, 64), "Integer"
+	case reflect.Int8:
+		return convertInt(func(field unsafe.Pointer, value int64) { *(*int8)(field) = int8(value) }, 8), "Integer"
+	case reflect.Int16:
+		return convertInt(func(field unsafe.Pointer, value int64) { *(*int16)(field) = int16(value) }, 16), "Integer"
+	case reflect.Int32:
+		return convertInt(func(field unsafe.Pointer, value int64) { *(*int32)(field) = int32(value) }, 32), "Integer"
+	case reflect.Int64:
+		return convertInt(func(field unsafe.Pointer, value int64) { *(*int64)(field) = value }, 64), "Integer"
+	case reflect.Uint:
+		return convertUint(func(field unsafe.Pointer, value uint64) { *(*uint)(field) = uint(value) }, 64), "Unsigned Integer"
+	case reflect.Uint8:
+		return convertUint(func(field unsafe.Pointer, value uint64) { *(*uint8)(field) = uint8(value) }, 8), "Unsigned Integer"
+	case reflect.Uint16:
+		return convertUint(func(field unsafe.Pointer, value uint64) { *(*uint16)(field) = uint16(value) }, 16), "Unsigned Integer"
+	case reflect.Uint32:
+		return convertUint(func(field unsafe.Pointer, value uint64) { *(*uint32)(field) = uint32(value) }, 32), "Unsigned Integer"
+	case reflect.Uint64:
+		return convertUint(func(field unsafe.Pointer, value uint64) { *(*uint64)(field) = value }, 64), "Unsigned Integer"
+	case reflect.Float32:
+		return convertFloat(func(field unsafe.Pointer, value float64) { *(*float32)(field) = float32(value) }, 32), "Float"
+	case reflect.Float64:
+		return convertFloat(func(field unsafe.Pointer, value float64) { *(*float64)(field) = value }, 64), "Float"
+	}
+
+	return nil, ""
+}
+
+// decode sets submitted values into fields of struct at target pointer. Fields without values keep their values.
+// Same as go-playground form package, all values are decoded even if some of them are invalid, and errors of invalid
+// values are returned as form.DecodeErrors by names of their fields.
+func (p *decodePlan) decode(target unsafe.Pointer, values url.Values) error {
+	var errs form.DecodeErrors
+
+	for i := range p.setters {
+		setter := &p.setters[i]
+
+		list := values[setter.name]
+		if len(list) == 0 {
+			continue
+		}
+
+		if !setter.convert(unsafe.Pointer(uintptr(target)+setter.offset), list) {
+			if errs == nil {
+				errs = form.DecodeErrors{}
+			}
+			errs[setter.name] = fmt.Errorf("Invalid %s Value '%s' Type '%v' Namespace '%s'", setter.label, list[0], setter.typeOf, setter.name)
+		}
+	}
+
+	if errs != nil {
+		return errs
+	}
+
+	return nil
+}
+
+// convertString sets first value into string field
+func convertString(field unsafe.Pointer, values []string) bool {
+	*(*string)(field) = values[0]
+
+	return true
+}
+
+// convertMarkdown sets sanitized first value into markdown field, so raw HTML never reaches form data
+func convertMarkdown(field unsafe.Pointer, values []string) bool {
+	*(*markdown.Text)(field) = markdown.Text(markdown.Sanitize(values[0]))
+
+	return true
+}
+
+// convertTags sets normalized tags of all values into tag list field
+func convertTags(field unsafe.Pointer, values []string) bool {
+	*(*tags.List)(field) = tags.Parse(values...)
+
+	return true
+}
+
+// convertBool sets first value into bool field, by accepting the same values as go-playground form package
+func convertBool(field unsafe.Pointer, values []string) bool {
+	switch values[0] {
+	case "1", "t", "T", "true", "TRUE", "True", "on", "yes", "ok":
+		*(*bool)(field) = true
+	case "", "0", "f", "F", "false", "FALSE", "False", "off", "no":
+		*(*bool)(field) = false
+	default:
+		return false
+	}
+
+	return true
+}
+
+// convertInt returns converter of signed integer fields with bit size, empty values are skipped
+func convertInt(set func(field unsafe.Pointer, value int64), bitSize int) fieldConverter {
+	return func(field unsafe.Pointer, values []string) bool {
+		if values[0] == "" {
+			return true
+		}
+
+		value, err := strconv.ParseInt(values[0], 10, bitSize)
+		if err != nil {
+			return false
+		}
+		set(field, value)
+
+		return true
+	}
+}
+
+// convertUint returns converter of unsigned integer fields with bit size, empty values are skipped
+func convertUint(set func(field unsafe.Pointer, value uint64), bitSize int) fieldConverter {
+	return func(field unsafe.Pointer, values []string) bool {
+		if values[0] == "" {
+			return true
+		}
+
+		value, err := strconv.ParseUint(values[0], 10, bitSize)
+		if err != nil {
+			return false
+		}
+		set(field, value)
+
+		return true
+	}
+}
+
+// convertFloat returns converter of float fields with bit size, empty values are skipped
+func convertFloat(set func(field unsafe.Pointer, value float64), bitSize int) fieldConverter {
+	return func(field unsafe.Pointer, values []string) bool {
+		if values[0] == "" {
+			return true
+		}
+
+		value, err := strconv.ParseFloat(values[0], bitSize)
+		if err != nil {
+			return false
+		}
+		set(field, value)
+
+		return true
+	}
+}
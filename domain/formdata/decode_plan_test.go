@@ -0,0 +1,184 @@
+package formdata
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/go-playground/form"
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/markdown"
+	"flamingo.me/form/domain/tags"
+)
+
+type (
+	DecodePlanTestSuite struct {
+		suite.Suite
+	}
+
+	decodePlanTestContact struct {
+		Email string `form:"email"`
+		Phone string `form:"phone,omitempty"`
+	}
+
+	decodePlanTestCategory string
+
+	decodePlanTestData struct {
+		decodePlanTestContact
+		Name     string                 `form:"name"`
+		Category decodePlanTestCategory `form:"category"`
+		Active   bool                   `form:"active"`
+		Age      int8                   `form:"age"`
+		Count    int                    `form:"count"`
+		Total    uint32                 `form:"total"`
+		Price    float64                `form:"price"`
+		Rate     float32                `form:"rate"`
+		Comment  markdown.Text          `form:"comment"`
+		Keywords tags.List              `form:"keywords"`
+		Arrival  time.Time              `form:"arrival" formLayout:"2006-01-02"`
+		Avatar   *domain.File           `form:"avatar"`
+		Address  struct {
+			Street string `form:"street"`
+			Zip    uint   `form:"zip"`
+		} `form:"address"`
+		Untagged string
+		Ignored  string `form:"-"`
+		internal string
+	}
+)
+
+func TestDecodePlanTestSuite(t *testing.T) {
+	suite.Run(t, &DecodePlanTestSuite{})
+}
+
+func (t *DecodePlanTestSuite) TestCompileDecodePlan() {
+	plan := compileDecodePlan(reflect.TypeOf(decodePlanTestData{}))
+	t.NotNil(plan)
+
+	var names []string
+	for _, setter := range plan.setters {
+		names = append(names, setter.name)
+	}
+	t.Equal([]string{
+		"email", "phone", "name", "category", "active", "age", "count", "total", "price", "rate", "comment", "keywords",
+		"address.street", "address.zip", "Untagged",
+	}, names)
+
+	field, _ := reflect.TypeOf(decodePlanTestData{}).FieldByName("Address")
+	t.Equal(field.Offset+field.Type.Field(1).Offset, plan.setters[13].offset)
+}
+
+func (t *DecodePlanTestSuite) TestCompileDecodePlan_Unsupported() {
+	t.Nil(compileDecodePlan(reflect.TypeOf(struct {
+		Values []string `form:"values"`
+	}{})))
+	t.Nil(compileDecodePlan(reflect.TypeOf(struct {
+		Value *string `form:"value"`
+	}{})))
+	t.Nil(compileDecodePlan(reflect.TypeOf(struct {
+		Created time.Time `form:"created"`
+	}{})))
+	t.Nil(compileDecodePlan(reflect.TypeOf(struct {
+		Nested struct {
+			Values map[string]string `form:"values"`
+		} `form:"nested"`
+	}{})))
+}
+
+func (t *DecodePlanTestSuite) TestLoadDecodePlan() {
+	typeOf := reflect.TypeOf(decodePlanTestContact{})
+	CompileDecodePlan(reflect.PtrTo(typeOf))
+
+	plan, ok := decodePlans.Load(typeOf)
+	t.True(ok)
+	t.Same(plan, loadDecodePlan(typeOf))
+}
+
+func (t *DecodePlanTestSuite) TestDecode() {
+	result, err := (&DefaultFormDataDecoderImpl{}).decodeUnknownInterface(url.Values{
+		"email":          {"mail@example.com"},
+		"name":           {" John ", "ignored"},
+		"category":       {"news"},
+		"active":         {"on"},
+		"age":            {"-42"},
+		"count":          {""},
+		"total":          {"4000000000"},
+		"price":          {"9.99"},
+		"rate":           {"0.5"},
+		"comment":        {"**great**<script>alert(1)</script>"},
+		"keywords":       {"News, Go", "go"},
+		"arrival":        {"2020-01-02"},
+		"avatar":         {"data:text/plain;name=avatar.txt,avatar"},
+		"address.street": {"Main Street"},
+		"address.zip":    {"12345"},
+		"Untagged":       {"untagged"},
+		"-":              {"ignored"},
+		"Ignored":        {"ignored"},
+		"internal":       {"internal"},
+	}, nil, decodePlanTestData{})
+	t.NoError(err)
+
+	data := result.(decodePlanTestData)
+	t.Equal("mail@example.com", data.Email)
+	t.Equal(" John ", data.Name)
+	t.Equal(decodePlanTestCategory("news"), data.Category)
+	t.True(data.Active)
+	t.Equal(int8(-42), data.Age)
+	t.Equal(0, data.Count)
+	t.Equal(uint32(4000000000), data.Total)
+	t.Equal(9.99, data.Price)
+	t.Equal(float32(0.5), data.Rate)
+	t.Equal(markdown.Text("**great**alert(1)"), data.Comment)
+	t.Equal(tags.List{"news", "go"}, data.Keywords)
+	t.Equal(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), data.Arrival)
+	t.Equal("avatar.txt", data.Avatar.Filename)
+	t.Equal("Main Street", data.Address.Street)
+	t.Equal(uint(12345), data.Address.Zip)
+	t.Equal("untagged", data.Untagged)
+	t.Empty(data.Ignored)
+	t.Empty(data.internal)
+}
+
+func (t *DecodePlanTestSuite) TestDecode_Bool() {
+	plan := compileDecodePlan(reflect.TypeOf(decodePlanTestData{}))
+
+	for _, value := range []string{"1", "t", "T", "true", "TRUE", "True", "on", "yes", "ok"} {
+		data := decodePlanTestData{}
+		t.NoError(plan.decode(unsafe.Pointer(&data), url.Values{"active": {value}}), value)
+		t.True(data.Active, value)
+	}
+
+	for _, value := range []string{"", "0", "f", "F", "false", "FALSE", "False", "off", "no"} {
+		data := decodePlanTestData{Active: true}
+		t.NoError(plan.decode(unsafe.Pointer(&data), url.Values{"active": {value}}), value)
+		t.False(data.Active, value)
+	}
+}
+
+func (t *DecodePlanTestSuite) TestDecode_Errors() {
+	plan := compileDecodePlan(reflect.TypeOf(decodePlanTestData{}))
+
+	data := decodePlanTestData{}
+	err := plan.decode(unsafe.Pointer(&data), url.Values{
+		"name":        {"John"},
+		"active":      {"maybe"},
+		"age":         {"128"},
+		"total":       {"-1"},
+		"price":       {"cheap"},
+		"address.zip": {"zip"},
+	})
+
+	t.Equal(form.DecodeErrors{
+		"active":      errors.New("Invalid Boolean Value 'maybe' Type 'bool' Namespace 'active'"),
+		"age":         errors.New("Invalid Integer Value '128' Type 'int8' Namespace 'age'"),
+		"total":       errors.New("Invalid Unsigned Integer Value '-1' Type 'uint32' Namespace 'total'"),
+		"price":       errors.New("Invalid Float Value 'cheap' Type 'float64' Namespace 'price'"),
+		"address.zip": errors.New("Invalid Unsigned Integer Value 'zip' Type 'uint' Namespace 'address.zip'"),
+	}, err)
+	t.Equal("John", data.Name, "valid values are decoded")
+}
@@ -5,6 +5,8 @@ import (
 	"net/url"
 	"reflect"
 	"strings"
+	"sync"
+	"unsafe"
 
 	"github.com/leebenson/conform"
 
@@ -12,22 +14,93 @@ import (
 
 	"flamingo.me/flamingo/v3/framework/web"
 	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/markdown"
+	"flamingo.me/form/domain/tags"
 )
 
 type (
 	// DefaultFormDataDecoderImpl represents implementation of default domain.FormDataDecoder.
+	// Decoding targets and buffers of filtered values are reused via sync.Pool per form data type,
+	// to reduce allocations for high-throughput forms.
 	DefaultFormDataDecoderImpl struct{}
+
+	// decodeState represents per-request scratch structure of decoding, which is reused between requests
+	decodeState struct {
+		// target pointer to zero value of form data type, which values are decoded into
+		target reflect.Value
+		// values buffer of submitted values without values of time fields with layouts
+		values url.Values
+	}
+)
+
+const (
+	// maxPooledValues defines maximal number of buffered values kept by pooled decoding state, so single
+	// oversized request doesn't keep its buffer in memory
+	maxPooledValues = 256
 )
 
-var _ domain.DefaultFormDataDecoder = &DefaultFormDataDecoderImpl{}
+var (
+	_ domain.DefaultFormDataDecoder = &DefaultFormDataDecoderImpl{}
+
+	// formDecoder is shared between all decodings, so information about form data types is cached by decoder.
+	// It's safe for concurrent use.
+	formDecoder = newFormDecoder()
+
+	// decodeStatePools contains pools of decoding states per form data type
+	decodeStatePools = domain.NewCache("decodeStates")
+
+	// emptyValues are used for decoding in case when there are no values, decoder doesn't modify them
+	emptyValues = url.Values{}
+)
 
 // Decode performs default form data decoding, depending if passed form data is instance of map[string]string or any other interface.
-func (p *DefaultFormDataDecoderImpl) Decode(_ context.Context, _ *web.Request, values url.Values, formData interface{}) (interface{}, error) {
+// Files of multipart request, and files encoded as data URIs or base64 strings, are bound into fields of type
+// *domain.File and []*domain.File.
+func (p *DefaultFormDataDecoderImpl) Decode(_ context.Context, req *web.Request, values url.Values, formData interface{}) (interface{}, error) {
 	if _, ok := formData.(map[string]string); ok {
 		return p.decodeStringMap(values), nil
 	}
 
-	return p.decodeUnknownInterface(values, formData)
+	if formData == nil {
+		return nil, domain.NewFormError("there is no form data to decode values into")
+	}
+
+	return p.decodeUnknownInterface(values, uploadedFiles(req), formData)
+}
+
+// uploadedFiles returns files of request's parsed multipart form and streamed files of multipart body,
+// or nil if there are none
+func uploadedFiles(req *web.Request) map[string][]*domain.File {
+	files := domain.StreamedFilesFromRequest(req)
+	if req == nil || req.Request() == nil || req.Request().MultipartForm == nil {
+		return files
+	}
+
+	uploaded := make(map[string][]*domain.File, len(req.Request().MultipartForm.File)+len(files))
+	for name, headers := range req.Request().MultipartForm.File {
+		for _, header := range headers {
+			uploaded[name] = append(uploaded[name], domain.NewFile(header))
+		}
+	}
+	for name, list := range files {
+		uploaded[name] = append(uploaded[name], list...)
+	}
+
+	return uploaded
+}
+
+// newFormDecoder creates decoder from go-playground form package, which sanitizes markdown fields while decoding,
+// so raw HTML never reaches form data, and which splits separated inputs of tag lists into normalized tags
+func newFormDecoder() *form.Decoder {
+	decoder := form.NewDecoder()
+	decoder.RegisterCustomTypeFunc(func(values []string) (interface{}, error) {
+		return markdown.Text(markdown.Sanitize(values[0])), nil
+	}, markdown.Text(""))
+	decoder.RegisterCustomTypeFunc(func(values []string) (interface{}, error) {
+		return tags.Parse(values...), nil
+	}, tags.List{})
+
+	return decoder
 }
 
 // decodeStringMap performs form data decoding by storing all POST values into simple instance of map[string]string.
@@ -45,35 +118,99 @@ func (p *DefaultFormDataDecoderImpl) decodeStringMap(values url.Values) map[stri
 	return stringMap
 }
 
-// decodeUnknownInterface performs form data decoding by using decoder from go-playground form package.
+// decodeUnknownInterface performs form data decoding by using precompiled decode plan of form data type, or by using
+// decoder from go-playground form package for types which have no plan.
 // It also performs string values' optimization byt using conform package.
-func (p *DefaultFormDataDecoderImpl) decodeUnknownInterface(values url.Values, formData interface{}) (interface{}, error) {
+// Time fields with layout tags (like `formLayout:"2006-01-02"`) are parsed by their layouts instead of RFC 3339.
+// Uploaded files are bound after values are decoded, so they can't be overwritten by submitted values.
+// Any panic caused by malformed or adversarial values is recovered and returned as error.
+func (p *DefaultFormDataDecoderImpl) decodeUnknownInterface(values url.Values, files map[string][]*domain.File, formData interface{}) (result interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = nil
+			err = domain.NewFormErrorf("decoding of form data failed: %v", r)
+		}
+	}()
+
 	typeOf := reflect.TypeOf(formData)
 	if typeOf.Kind() == reflect.Ptr {
 		typeOf = typeOf.Elem()
 	}
 
-	zeroFormData := reflect.New(typeOf).Interface()
-
 	if values == nil {
-		values = url.Values{}
+		values = emptyValues
 	}
 
-	decoder := form.NewDecoder()
-	err := decoder.Decode(&zeroFormData, values)
-	if err != nil {
-		return nil, err
+	var layouts []timeBinding
+	var plan *decodePlan
+	if typeOf.Kind() == reflect.Struct {
+		layouts = loadTimeBindings(typeOf)
+		plan = loadDecodePlan(typeOf)
+	}
+
+	pool := decodeStatePool(typeOf)
+	state := pool.Get().(*decodeState)
+
+	if plan != nil {
+		err = plan.decode(unsafe.Pointer(state.target.Pointer()), values)
+	} else if len(layouts) > 0 {
+		state.values = withoutTimeValues(state.values, values, layouts)
+		err = formDecoder.Decode(state.target.Interface(), state.values)
+	} else {
+		err = formDecoder.Decode(state.target.Interface(), values)
+	}
+	if err == nil && len(layouts) > 0 {
+		err = bindTimes(state.target.Elem(), values, layouts)
+	}
+	if err == nil && typeOf.Kind() == reflect.Struct && hasFileFields(typeOf) {
+		bindFiles(state.target.Elem(), files, values, "")
+	}
+	if err == nil {
+		err = conform.Strings(state.target.Interface())
+	}
+	if err == nil {
+		result = state.target.Elem().Interface()
 	}
 
-	err = conform.Strings(zeroFormData)
+	// decoded values are already copied into result, so target and buffers can be reset and reused
+	state.reset()
+	pool.Put(state)
+
 	if err != nil {
 		return nil, err
 	}
 
-	finalFormData := reflect.ValueOf(zeroFormData)
-	if finalFormData.Kind() == reflect.Ptr {
-		return finalFormData.Elem().Interface(), nil
+	return result, nil
+}
+
+// decodeStatePool returns pool of decoding states for form data type
+func decodeStatePool(typeOf reflect.Type) *sync.Pool {
+	if pool, ok := decodeStatePools.Load(typeOf); ok {
+		return pool.(*sync.Pool)
+	}
+
+	pool, _ := decodeStatePools.LoadOrStore(typeOf, &sync.Pool{
+		New: func() interface{} {
+			return &decodeState{
+				target: reflect.New(typeOf),
+			}
+		},
+	})
+
+	return pool.(*sync.Pool)
+}
+
+// reset sets decoding target back to zero value and empties buffer of values. Oversized buffer is dropped.
+func (s *decodeState) reset() {
+	target := s.target.Elem()
+	target.Set(reflect.Zero(target.Type()))
+
+	if len(s.values) > maxPooledValues {
+		s.values = nil
+		return
 	}
 
-	return zeroFormData, nil
+	for key := range s.values {
+		delete(s.values, key)
+	}
 }
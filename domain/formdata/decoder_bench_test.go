@@ -0,0 +1,160 @@
+package formdata
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+	"unsafe"
+)
+
+type (
+	benchmarkSmallFormData struct {
+		Email    string `form:"email" conform:"trim,lower"`
+		Password string `form:"password"`
+		Remember bool   `form:"remember"`
+	}
+
+	benchmarkMediumFormData struct {
+		FirstName  string `form:"firstName" conform:"trim"`
+		LastName   string `form:"lastName" conform:"trim"`
+		Email      string `form:"email" conform:"trim,lower"`
+		Phone      string `form:"phone" conform:"num"`
+		Birthday   string `form:"birthday"`
+		Newsletter bool   `form:"newsletter"`
+		Address    struct {
+			Street  string `form:"street" conform:"trim"`
+			Number  string `form:"number" conform:"trim"`
+			ZipCode string `form:"zipCode" conform:"trim"`
+			City    string `form:"city" conform:"trim"`
+			Country string `form:"country" conform:"upper"`
+		} `form:"address"`
+		Comment string `form:"comment" conform:"trim"`
+	}
+
+	benchmarkLargeFormData struct {
+		OrderID string `form:"orderId"`
+		Items   []struct {
+			SKU      string  `form:"sku" conform:"trim"`
+			Quantity int     `form:"quantity"`
+			Price    float64 `form:"price"`
+			Comment  string  `form:"comment" conform:"trim"`
+		} `form:"items"`
+	}
+
+	benchmarkTimesFormData struct {
+		Email     string     `form:"email" conform:"trim,lower"`
+		Arrival   time.Time  `form:"arrival" formLayout:"2006-01-02"`
+		Departure *time.Time `form:"departure" formLayout:"2006-01-02"`
+		Comment   string     `form:"comment" conform:"trim"`
+	}
+)
+
+func benchmarkSmallValues() url.Values {
+	return url.Values{
+		"email":    []string{" User@Example.com "},
+		"password": []string{"secret"},
+		"remember": []string{"true"},
+	}
+}
+
+func benchmarkMediumValues() url.Values {
+	return url.Values{
+		"firstName":       []string{" John "},
+		"lastName":        []string{" Doe "},
+		"email":           []string{" John.Doe@Example.com "},
+		"phone":           []string{"+49 123 456"},
+		"birthday":        []string{"1990-01-01"},
+		"newsletter":      []string{"true"},
+		"address.street":  []string{" Main Street "},
+		"address.number":  []string{" 1a "},
+		"address.zipCode": []string{" 12345 "},
+		"address.city":    []string{" Berlin "},
+		"address.country": []string{"de"},
+		"comment":         []string{" please ring twice "},
+	}
+}
+
+func benchmarkTimesValues() url.Values {
+	return url.Values{
+		"email":     []string{" User@Example.com "},
+		"arrival":   []string{"2020-05-01"},
+		"departure": []string{"2020-05-08"},
+		"comment":   []string{" late check-in "},
+	}
+}
+
+func benchmarkLargeValues() url.Values {
+	values := url.Values{
+		"orderId": []string{"order"},
+	}
+
+	for i := 0; i < 50; i++ {
+		prefix := "items[" + strconv.Itoa(i) + "]."
+		values[prefix+"sku"] = []string{" sku-" + strconv.Itoa(i) + " "}
+		values[prefix+"quantity"] = []string{strconv.Itoa(i + 1)}
+		values[prefix+"price"] = []string{"9.99"}
+		values[prefix+"comment"] = []string{" comment "}
+	}
+
+	return values
+}
+
+func BenchmarkDefaultFormDataDecoderImpl_Decode(b *testing.B) {
+	benchmarks := []struct {
+		name     string
+		values   url.Values
+		formData interface{}
+	}{
+		{name: "StringMap", values: benchmarkMediumValues(), formData: map[string]string{}},
+		{name: "Small", values: benchmarkSmallValues(), formData: benchmarkSmallFormData{}},
+		{name: "Medium", values: benchmarkMediumValues(), formData: benchmarkMediumFormData{}},
+		{name: "Large", values: benchmarkLargeValues(), formData: benchmarkLargeFormData{}},
+		{name: "Times", values: benchmarkTimesValues(), formData: benchmarkTimesFormData{}},
+	}
+
+	decoder := &DefaultFormDataDecoderImpl{}
+
+	for _, benchmark := range benchmarks {
+		benchmark := benchmark
+		b.Run(benchmark.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := decoder.Decode(context.Background(), nil, benchmark.values, benchmark.formData); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDecodePlan_Decode(b *testing.B) {
+	values := benchmarkMediumValues()
+	typeOf := reflect.TypeOf(benchmarkMediumFormData{})
+	plan := loadDecodePlan(typeOf)
+	if plan == nil {
+		b.Fatal("medium form data has no decode plan")
+	}
+
+	b.Run("Plan", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			target := &benchmarkMediumFormData{}
+			if err := plan.decode(unsafe.Pointer(target), values); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("Reflection", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			target := &benchmarkMediumFormData{}
+			if err := formDecoder.Decode(target, values); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
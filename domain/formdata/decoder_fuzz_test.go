@@ -0,0 +1,39 @@
+//go:build go1.18
+// +build go1.18
+
+package formdata
+
+import (
+	"testing"
+)
+
+func FuzzDecodeURLEncoded(f *testing.F) {
+	f.Add("text=+text+&number=1&float=1.5&flag=true")
+	f.Add("slice[0]=1&slice[2]=3&map[key]=1&strings[key]=+value+")
+	f.Add("nested.name=name&nested.tags[0]=tag&pointer.value=255&items[0].id=1&items[1].email=USER@EXAMPLE.COM")
+	f.Add("slice[99999]=1&items[-1].id=1&map[]=&nested..name=name")
+
+	f.Fuzz(func(t *testing.T, body string) {
+		DecodeFuzz(append([]byte{fuzzModeURLEncoded}, body...))
+	})
+}
+
+func FuzzDecodeMultipart(f *testing.F) {
+	f.Add("--fuzzboundary\r\nContent-Disposition: form-data; name=\"text\"\r\n\r\ntext\r\n--fuzzboundary--\r\n")
+	f.Add("--fuzzboundary\r\nContent-Disposition: form-data; name=\"items[0].id\"\r\n\r\n1\r\n" +
+		"--fuzzboundary\r\nContent-Disposition: form-data; name=\"file\"; filename=\"file.txt\"\r\n\r\ncontent\r\n--fuzzboundary--\r\n")
+
+	f.Fuzz(func(t *testing.T, body string) {
+		DecodeFuzz(append([]byte{fuzzModeMultipart}, body...))
+	})
+}
+
+func FuzzDecodeJSON(f *testing.F) {
+	f.Add(`{"text":" text ","number":1,"float":1.5,"flag":true}`)
+	f.Add(`{"map":{"key":1,"other":"2"},"strings":{"key":" value "},"pointer":{"value":256},"slice":[1,null]}`)
+	f.Add(`{"items":[{"email":"user@example.com"},{"id":"x"}],"nested":{"tags":["tag"],"name":{}}}`)
+
+	f.Fuzz(func(t *testing.T, body string) {
+		DecodeFuzz(append([]byte{fuzzModeJSON}, body...))
+	})
+}
@@ -3,7 +3,12 @@ package formdata
 import (
 	"github.com/stretchr/testify/suite"
 	"net/url"
+	"reflect"
+	"strconv"
 	"testing"
+
+	"flamingo.me/form/domain/markdown"
+	"flamingo.me/form/domain/tags"
 )
 
 type (
@@ -59,7 +64,7 @@ func (t *DefaultFormDataDecoderImplTestSuite) TestDecodeUnknownInterface_Nil() {
 		Slice:  []float64{1.0, 2.0},
 	}
 
-	result, err := t.decoder.decodeUnknownInterface(nil, formData)
+	result, err := t.decoder.decodeUnknownInterface(nil, nil, formData)
 
 	t.NoError(err)
 	t.Equal(formDataDecoderTestData{}, result)
@@ -72,7 +77,7 @@ func (t *DefaultFormDataDecoderImplTestSuite) TestDecodeUnknownInterface_Empty()
 		Slice:  []float64{1.0, 2.0},
 	}
 
-	result, err := t.decoder.decodeUnknownInterface(url.Values{}, formData)
+	result, err := t.decoder.decodeUnknownInterface(url.Values{}, nil, formData)
 
 	t.NoError(err)
 	t.Equal(formDataDecoderTestData{}, result)
@@ -88,7 +93,7 @@ func (t *DefaultFormDataDecoderImplTestSuite) TestDecodeUnknownInterface_Full()
 	result, err := t.decoder.decodeUnknownInterface(url.Values{
 		"text":   []string{" new text "},
 		"number": []string{"10"},
-	}, formData)
+	}, nil, formData)
 
 	t.NoError(err)
 	t.Equal(formDataDecoderTestData{
@@ -97,6 +102,80 @@ func (t *DefaultFormDataDecoderImplTestSuite) TestDecodeUnknownInterface_Full()
 	}, result)
 }
 
+func (t *DefaultFormDataDecoderImplTestSuite) TestDecodeUnknownInterface_ReusedTarget() {
+	result, err := t.decoder.decodeUnknownInterface(url.Values{
+		"text":  []string{"first"},
+		"slice": []string{"1.5", "2.5"},
+	}, nil, formDataDecoderTestData{})
+
+	t.NoError(err)
+	t.Equal(formDataDecoderTestData{
+		Text:  "first",
+		Slice: []float64{1.5, 2.5},
+	}, result)
+
+	second, err := t.decoder.decodeUnknownInterface(url.Values{
+		"number": []string{"3"},
+	}, nil, formDataDecoderTestData{})
+
+	t.NoError(err)
+	t.Equal(formDataDecoderTestData{
+		Number: 3,
+	}, second)
+	t.Equal(formDataDecoderTestData{
+		Text:  "first",
+		Slice: []float64{1.5, 2.5},
+	}, result)
+}
+
+func (t *DefaultFormDataDecoderImplTestSuite) TestDecodeState_Reset() {
+	state := &decodeState{target: reflect.New(reflect.TypeOf(formDataDecoderTestData{}))}
+	state.target.Elem().FieldByName("Text").SetString("first")
+	state.values = withoutTimeValues(state.values, url.Values{"text": {"first"}}, nil)
+
+	state.reset()
+	t.Equal(formDataDecoderTestData{}, state.target.Elem().Interface())
+	t.NotNil(state.values)
+	t.Empty(state.values)
+
+	for i := 0; i <= maxPooledValues; i++ {
+		state.values.Set(strconv.Itoa(i), "value")
+	}
+
+	state.reset()
+	t.Nil(state.values, "oversized buffer is dropped")
+}
+
+func (t *DefaultFormDataDecoderImplTestSuite) TestDecodeUnknownInterface_Markdown() {
+	type markdownData struct {
+		Comment markdown.Text `form:"comment"`
+	}
+
+	result, err := t.decoder.decodeUnknownInterface(url.Values{
+		"comment": []string{"**great**<script>alert(1)</script>\r\n"},
+	}, nil, markdownData{})
+
+	t.NoError(err)
+	t.Equal(markdownData{
+		Comment: "**great**alert(1)",
+	}, result)
+}
+
+func (t *DefaultFormDataDecoderImplTestSuite) TestDecodeUnknownInterface_Tags() {
+	type tagsData struct {
+		Keywords tags.List `form:"keywords"`
+	}
+
+	result, err := t.decoder.decodeUnknownInterface(url.Values{
+		"keywords": []string{"News, Go  #release", "go"},
+	}, nil, tagsData{})
+
+	t.NoError(err)
+	t.Equal(tagsData{
+		Keywords: tags.List{"news", "go", "release"},
+	}, result)
+}
+
 func (t *DefaultFormDataDecoderImplTestSuite) TestDecodeUnknownInterface_FullWithPointer() {
 	formData := formDataDecoderTestData{
 		Text:   "some text",
@@ -107,7 +186,7 @@ func (t *DefaultFormDataDecoderImplTestSuite) TestDecodeUnknownInterface_FullWit
 	result, err := t.decoder.decodeUnknownInterface(url.Values{
 		"text":   []string{" new text "},
 		"number": []string{"10"},
-	}, &formData)
+	}, nil, &formData)
 
 	t.NoError(err)
 	t.Equal(formDataDecoderTestData{
@@ -147,3 +226,21 @@ func (t *DefaultFormDataDecoderImplTestSuite) TestDecode_UnknownInterface() {
 		Number: 10,
 	}, result)
 }
+
+func (t *DefaultFormDataDecoderImplTestSuite) TestDecode_Nil() {
+	result, err := t.decoder.Decode(nil, nil, url.Values{
+		"text": []string{"text"},
+	}, nil)
+
+	t.Error(err)
+	t.Nil(result)
+}
+
+func (t *DefaultFormDataDecoderImplTestSuite) TestDecode_Error() {
+	result, err := t.decoder.Decode(nil, nil, url.Values{
+		"number": []string{"text"},
+	}, formDataDecoderTestData{})
+
+	t.Error(err)
+	t.Nil(result)
+}
@@ -3,10 +3,12 @@ package formdata
 import (
 	"context"
 	"net/url"
+	"reflect"
 
 	"github.com/go-playground/form"
 
 	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/tags"
 )
 
 type (
@@ -14,7 +16,24 @@ type (
 	DefaultFormDataEncoderImpl struct{}
 )
 
-var _ domain.DefaultFormDataEncoder = &DefaultFormDataEncoderImpl{}
+var (
+	_ domain.DefaultFormDataEncoder = &DefaultFormDataEncoderImpl{}
+
+	// formEncoder is shared between all encodings, so information about form data types is cached by encoder.
+	// It's safe for concurrent use.
+	formEncoder = newFormEncoder()
+)
+
+// newFormEncoder creates encoder from go-playground form package, which encodes tag lists as single comma separated
+// input, same as they are submitted
+func newFormEncoder() *form.Encoder {
+	encoder := form.NewEncoder()
+	encoder.RegisterCustomTypeFunc(func(value interface{}) ([]string, error) {
+		return []string{value.(tags.List).String()}, nil
+	}, tags.List{})
+
+	return encoder
+}
 
 // Encode performs default form data encoding, depending if passed form data is instance of map[string]string or any other interface.
 func (p *DefaultFormDataEncoderImpl) Encode(_ context.Context, formData interface{}) (url.Values, error) {
@@ -29,7 +48,21 @@ func (p *DefaultFormDataEncoderImpl) Encode(_ context.Context, formData interfac
 	return p.encodeUnknownInterface(formData)
 }
 
+// encodeUnknownInterface performs form data encoding by using encoder from go-playground form package.
+// Time fields with layout tags are formatted by their layouts, same as they are decoded.
 func (p *DefaultFormDataEncoderImpl) encodeUnknownInterface(formData interface{}) (url.Values, error) {
-	encoder := form.NewEncoder()
-	return encoder.Encode(formData)
+	values, err := formEncoder.Encode(formData)
+	if err != nil {
+		return nil, err
+	}
+
+	valueOf := reflect.Indirect(reflect.ValueOf(formData))
+	if valueOf.Kind() == reflect.Struct {
+		if values == nil {
+			values = url.Values{}
+		}
+		encodeTimes(valueOf, values, loadTimeBindings(valueOf.Type()))
+	}
+
+	return values, nil
 }
@@ -4,6 +4,8 @@ import (
 	"github.com/stretchr/testify/suite"
 	"net/url"
 	"testing"
+
+	"flamingo.me/form/domain/tags"
 )
 
 type (
@@ -57,6 +59,19 @@ func (t *DefaultFormDataEncoderImplTestSuite) TestEncodeUnknownInterface_Struct(
 	}, urlValues)
 }
 
+func (t *DefaultFormDataEncoderImplTestSuite) TestEncodeUnknownInterface_Tags() {
+	urlValues, err := t.encoder.encodeUnknownInterface(struct {
+		Keywords tags.List `form:"keywords"`
+	}{
+		Keywords: tags.List{"news", "go"},
+	})
+
+	t.NoError(err)
+	t.Equal(url.Values{
+		"keywords": []string{"news, go"},
+	}, urlValues)
+}
+
 func (t *DefaultFormDataEncoderImplTestSuite) TestEncode_Error() {
 	urlValues, err := t.encoder.Encode(nil, nil)
 
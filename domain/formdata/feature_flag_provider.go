@@ -0,0 +1,37 @@
+package formdata
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// DefaultFeatureFlagProviderImpl represents implementation of default domain.FeatureFlagProvider.
+	// It enables features by configuration, equally for all requests. Features which are not configured are disabled.
+	DefaultFeatureFlagProviderImpl struct {
+		features map[string]bool
+	}
+)
+
+var _ domain.FeatureFlagProvider = &DefaultFeatureFlagProviderImpl{}
+
+// Inject is method used to set all dependencies as local variables
+func (p *DefaultFeatureFlagProviderImpl) Inject(cfg *struct {
+	Features config.Map `inject:"config:form.featureFlags"`
+}) {
+	if cfg == nil {
+		return
+	}
+
+	if err := cfg.Features.MapInto(&p.features); err != nil {
+		panic(err.Error())
+	}
+}
+
+// IsEnabled checks if feature is enabled by configuration
+func (p *DefaultFeatureFlagProviderImpl) IsEnabled(_ context.Context, _ *web.Request, feature string) bool {
+	return p.features[feature]
+}
@@ -0,0 +1,46 @@
+package formdata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+)
+
+type (
+	DefaultFeatureFlagProviderImplTestSuite struct {
+		suite.Suite
+
+		provider *DefaultFeatureFlagProviderImpl
+	}
+)
+
+func TestDefaultFeatureFlagProviderImplTestSuite(t *testing.T) {
+	suite.Run(t, &DefaultFeatureFlagProviderImplTestSuite{})
+}
+
+func (t *DefaultFeatureFlagProviderImplTestSuite) SetupTest() {
+	t.provider = &DefaultFeatureFlagProviderImpl{}
+	t.provider.Inject(&struct {
+		Features config.Map `inject:"config:form.featureFlags"`
+	}{
+		Features: config.Map{
+			"newsletter": true,
+			"birthday":   false,
+		},
+	})
+}
+
+func (t *DefaultFeatureFlagProviderImplTestSuite) TestIsEnabled() {
+	t.True(t.provider.IsEnabled(nil, nil, "newsletter"))
+	t.False(t.provider.IsEnabled(nil, nil, "birthday"))
+	t.False(t.provider.IsEnabled(nil, nil, "unknown"))
+}
+
+func (t *DefaultFeatureFlagProviderImplTestSuite) TestIsEnabled_WithoutConfiguration() {
+	provider := &DefaultFeatureFlagProviderImpl{}
+	provider.Inject(nil)
+
+	t.False(provider.IsEnabled(nil, nil, "newsletter"))
+}
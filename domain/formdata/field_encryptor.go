@@ -0,0 +1,89 @@
+package formdata
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// DefaultFieldEncryptorImpl represents implementation of default domain.FieldEncryptor.
+	// It uses AES-GCM with configured base64 encoded key of 16, 24 or 32 bytes.
+	DefaultFieldEncryptorImpl struct {
+		aead        cipher.AEAD
+		tokenSource domain.TokenSource
+	}
+)
+
+var _ domain.FieldEncryptor = &DefaultFieldEncryptorImpl{}
+
+// Inject is method used to set all dependencies as local variables
+func (e *DefaultFieldEncryptorImpl) Inject(tokenSource domain.TokenSource, cfg *struct {
+	Key string `inject:"config:form.encryption.key"`
+}) {
+	e.tokenSource = tokenSource
+
+	if cfg.Key == "" {
+		return
+	}
+
+	key, err := base64.StdEncoding.DecodeString(cfg.Key)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	e.aead = aead
+}
+
+// Encrypt encrypts plaintext value with random nonce, and returns base64 encoded nonce and ciphertext
+func (e *DefaultFieldEncryptorImpl) Encrypt(_ context.Context, plaintext string) (string, error) {
+	if e.aead == nil {
+		return "", domain.NewFormError("there is no encryption key configured")
+	}
+
+	nonce := make([]byte, e.aead.NonceSize())
+	if err := domain.ReadToken(e.tokenSource, nonce); err != nil {
+		return "", err
+	}
+
+	sealed := e.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+
+	return base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts value previously encrypted by Encrypt method
+func (e *DefaultFieldEncryptorImpl) Decrypt(_ context.Context, ciphertext string) (string, error) {
+	if e.aead == nil {
+		return "", domain.NewFormError("there is no encryption key configured")
+	}
+
+	sealed, err := base64.RawURLEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", domain.NewFormErrorWithParent(err)
+	}
+
+	nonceSize := e.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", domain.NewFormError("encrypted value is too short")
+	}
+
+	plaintext, err := e.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if err != nil {
+		return "", domain.NewFormErrorWithParent(err)
+	}
+
+	return string(plaintext), nil
+}
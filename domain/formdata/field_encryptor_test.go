@@ -0,0 +1,115 @@
+package formdata
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	DefaultFieldEncryptorImplTestSuite struct {
+		suite.Suite
+
+		encryptor *DefaultFieldEncryptorImpl
+
+		context context.Context
+	}
+)
+
+func TestDefaultFieldEncryptorImplTestSuite(t *testing.T) {
+	suite.Run(t, &DefaultFieldEncryptorImplTestSuite{})
+}
+
+func (t *DefaultFieldEncryptorImplTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *DefaultFieldEncryptorImplTestSuite) SetupTest() {
+	t.encryptor = &DefaultFieldEncryptorImpl{}
+	t.encryptor.Inject(nil, &struct {
+		Key string `inject:"config:form.encryption.key"`
+	}{
+		Key: "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=",
+	})
+}
+
+func (t *DefaultFieldEncryptorImplTestSuite) TestInject_WrongKey() {
+	t.Panics(func() {
+		(&DefaultFieldEncryptorImpl{}).Inject(nil, &struct {
+			Key string `inject:"config:form.encryption.key"`
+		}{
+			Key: "not base64",
+		})
+	})
+
+	t.Panics(func() {
+		(&DefaultFieldEncryptorImpl{}).Inject(nil, &struct {
+			Key string `inject:"config:form.encryption.key"`
+		}{
+			Key: "c2hvcnQ=",
+		})
+	})
+}
+
+func (t *DefaultFieldEncryptorImplTestSuite) TestEncryptDecrypt() {
+	encrypted, err := t.encryptor.Encrypt(t.context, "AB123456C")
+	t.NoError(err)
+	t.NotEqual("AB123456C", encrypted)
+	t.NotContains(encrypted, "AB123456C")
+
+	other, err := t.encryptor.Encrypt(t.context, "AB123456C")
+	t.NoError(err)
+	t.NotEqual(encrypted, other)
+
+	decrypted, err := t.encryptor.Decrypt(t.context, encrypted)
+	t.NoError(err)
+	t.Equal("AB123456C", decrypted)
+}
+
+func (t *DefaultFieldEncryptorImplTestSuite) TestDecrypt_Error() {
+	_, err := t.encryptor.Decrypt(t.context, "%%%")
+	t.Error(err)
+
+	_, err = t.encryptor.Decrypt(t.context, "c2hvcnQ")
+	t.Error(err)
+
+	encrypted, err := t.encryptor.Encrypt(t.context, "value")
+	t.NoError(err)
+
+	_, err = t.encryptor.Decrypt(t.context, encrypted+"AA")
+	t.Error(err)
+}
+
+func (t *DefaultFieldEncryptorImplTestSuite) TestWithoutKey() {
+	encryptor := &DefaultFieldEncryptorImpl{}
+	encryptor.Inject(nil, &struct {
+		Key string `inject:"config:form.encryption.key"`
+	}{})
+
+	_, err := encryptor.Encrypt(t.context, "value")
+	t.Error(err)
+
+	_, err = encryptor.Decrypt(t.context, "value")
+	t.Error(err)
+}
+
+func (t *DefaultFieldEncryptorImplTestSuite) TestEncrypt_TokenSource() {
+	encryptor := &DefaultFieldEncryptorImpl{}
+	encryptor.Inject(strings.NewReader(strings.Repeat("\x01", 24)), &struct {
+		Key string `inject:"config:form.encryption.key"`
+	}{
+		Key: "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=",
+	})
+
+	encrypted, err := encryptor.Encrypt(t.context, "AB123456C")
+	t.NoError(err)
+
+	other, err := encryptor.Encrypt(t.context, "AB123456C")
+	t.NoError(err)
+	t.Equal(encrypted, other, "same nonce of token source")
+
+	_, err = encryptor.Encrypt(t.context, "AB123456C")
+	t.Error(err, "exhausted token source")
+}
@@ -0,0 +1,133 @@
+package formdata
+
+import (
+	"mime"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"flamingo.me/form/domain"
+)
+
+var (
+	// fileType type of uploaded files
+	fileType = reflect.TypeOf(domain.File{})
+
+	// fileStructs contains flags per form data type, if it contains any file fields
+	fileStructs = domain.NewCache("fileStructs")
+)
+
+// IsMultipartContentType checks if content type of request body is "multipart/form-data"
+func IsMultipartContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "multipart/form-data"
+}
+
+// bindFiles sets uploaded files into fields of type *domain.File and []*domain.File of struct, by names of their
+// form tags, same as names of decoded values. Nested structs are bound with names prefixed by name of parent field.
+// Fields without uploaded files are bound to files encoded as data URIs or base64 strings in submitted values
+// of the same name, while values which can't be decoded are ignored.
+func bindFiles(valueOf reflect.Value, files map[string][]*domain.File, values url.Values, prefix string) {
+	typeOf := valueOf.Type()
+
+	for i := 0; i < typeOf.NumField(); i++ {
+		field := typeOf.Field(i)
+		if !field.Anonymous && field.PkgPath != "" {
+			continue
+		}
+
+		name := fieldName(field)
+		if name == "-" {
+			continue
+		}
+
+		// fields of embedded structs are decoded without name of embedded struct, unless it's named by form tag
+		if field.Anonymous && field.Type.Kind() == reflect.Struct && field.Tag.Get("form") == "" {
+			bindFiles(valueOf.Field(i), files, values, prefix)
+			continue
+		}
+		name = prefix + name
+
+		switch {
+		case field.Type == reflect.PtrTo(fileType):
+			if list := boundFiles(files, values, name); len(list) > 0 {
+				valueOf.Field(i).Set(reflect.ValueOf(list[0]))
+			}
+		case field.Type == reflect.SliceOf(reflect.PtrTo(fileType)):
+			if list := boundFiles(files, values, name); len(list) > 0 {
+				valueOf.Field(i).Set(reflect.ValueOf(append([]*domain.File(nil), list...)))
+			}
+		case field.Type.Kind() == reflect.Struct && field.Type != fileType:
+			bindFiles(valueOf.Field(i), files, values, name+".")
+		}
+	}
+}
+
+// boundFiles returns uploaded files of the input, or files encoded in submitted values of the input if there are
+// no uploaded files
+func boundFiles(files map[string][]*domain.File, values url.Values, name string) []*domain.File {
+	if list := files[name]; len(list) > 0 {
+		return list
+	}
+
+	var decoded []*domain.File
+	for _, value := range values[name] {
+		if value == "" {
+			continue
+		}
+
+		if file, err := domain.DecodeFile(value); err == nil {
+			decoded = append(decoded, file)
+		}
+	}
+
+	return decoded
+}
+
+// hasFileFields checks if struct type contains any fields of type *domain.File or []*domain.File, including fields
+// of embedded and nested structs
+func hasFileFields(typeOf reflect.Type) bool {
+	if found, ok := fileStructs.Load(typeOf); ok {
+		return found.(bool)
+	}
+
+	found, _ := fileStructs.LoadOrStore(typeOf, compileFileFields(typeOf, map[reflect.Type]bool{typeOf: true}))
+
+	return found.(bool)
+}
+
+// compileFileFields as function for searching file fields of struct type. Structs which are already part of the
+// current path are skipped.
+func compileFileFields(typeOf reflect.Type, path map[reflect.Type]bool) bool {
+	for i := 0; i < typeOf.NumField(); i++ {
+		field := typeOf.Field(i)
+
+		switch {
+		case field.Type == reflect.PtrTo(fileType) || field.Type == reflect.SliceOf(reflect.PtrTo(fileType)):
+			return true
+		case field.Type.Kind() == reflect.Struct && field.Type != fileType && !path[field.Type]:
+			path[field.Type] = true
+			found := compileFileFields(field.Type, path)
+			delete(path, field.Type)
+			if found {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// fieldName returns name of struct field, as it's used by decoder
+func fieldName(field reflect.StructField) string {
+	name := strings.SplitN(field.Tag.Get("form"), ",", 2)[0]
+	if name == "" {
+		return field.Name
+	}
+
+	return name
+}
@@ -0,0 +1,164 @@
+package formdata
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	FilesTestSuite struct {
+		suite.Suite
+	}
+
+	filesTestAttachments struct {
+		Documents []*domain.File `form:"documents"`
+	}
+
+	filesTestData struct {
+		filesTestAttachments
+		Name     string               `form:"name"`
+		Avatar   *domain.File         `form:"avatar"`
+		Ignored  *domain.File         `form:"-"`
+		Nested   filesTestAttachments `form:"nested"`
+		Untagged *domain.File
+	}
+)
+
+func TestFilesTestSuite(t *testing.T) {
+	suite.Run(t, &FilesTestSuite{})
+}
+
+func (t *FilesTestSuite) multipartForm(files map[string][]string) *multipart.Form {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for name, filenames := range files {
+		for _, filename := range filenames {
+			part, err := writer.CreateFormFile(name, filename)
+			t.NoError(err)
+			_, err = part.Write([]byte(filename))
+			t.NoError(err)
+		}
+	}
+	t.NoError(writer.WriteField("name", "text"))
+	t.NoError(writer.Close())
+
+	form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(1024)
+	t.NoError(err)
+
+	return form
+}
+
+func (t *FilesTestSuite) TestIsMultipartContentType() {
+	t.True(IsMultipartContentType("multipart/form-data; boundary=something"))
+	t.True(IsMultipartContentType("Multipart/Form-Data"))
+	t.False(IsMultipartContentType("multipart/mixed"))
+	t.False(IsMultipartContentType("application/x-www-form-urlencoded"))
+	t.False(IsMultipartContentType(""))
+}
+
+func (t *FilesTestSuite) TestDecode_Files() {
+	form := t.multipartForm(map[string][]string{
+		"avatar":           {"avatar.png", "second.png"},
+		"documents":        {"first.pdf", "second.pdf"},
+		"nested.documents": {"nested.pdf"},
+		"Untagged":         {"untagged.txt"},
+		"-":                {"ignored.txt"},
+		"name":             {"name.txt"},
+	})
+
+	request := web.CreateRequest(&http.Request{MultipartForm: form}, nil)
+
+	result, err := (&DefaultFormDataDecoderImpl{}).Decode(nil, request, url.Values{
+		"name":            {"text"},
+		"avatar.Filename": {"spoofed.exe"},
+	}, filesTestData{})
+	t.NoError(err)
+
+	data := result.(filesTestData)
+	t.Equal("text", data.Name)
+	t.Equal("avatar.png", data.Avatar.Filename)
+	t.Equal(int64(len("avatar.png")), data.Avatar.Size)
+	t.Equal("application/octet-stream", data.Avatar.ContentType)
+	t.Nil(data.Ignored)
+	t.Len(data.Documents, 2)
+	t.Equal("second.pdf", data.Documents[1].Filename)
+	t.Len(data.Nested.Documents, 1)
+	t.Equal("nested.pdf", data.Nested.Documents[0].Filename)
+	t.Equal("untagged.txt", data.Untagged.Filename)
+}
+
+func (t *FilesTestSuite) TestDecode_WithoutFiles() {
+	result, err := (&DefaultFormDataDecoderImpl{}).Decode(nil, web.CreateRequest(&http.Request{}, nil), url.Values{
+		"name": {"text"},
+	}, filesTestData{})
+	t.NoError(err)
+	t.Equal(filesTestData{Name: "text"}, result)
+}
+
+func (t *FilesTestSuite) TestDecode_EncodedFiles() {
+	form := t.multipartForm(map[string][]string{
+		"documents": {"uploaded.pdf"},
+	})
+
+	request := web.CreateRequest(&http.Request{MultipartForm: form}, nil)
+
+	result, err := (&DefaultFormDataDecoderImpl{}).Decode(nil, request, url.Values{
+		"avatar":           {"data:image/png;name=avatar.png;base64,iVBORw0KGgo="},
+		"documents":        {"data:text/plain;base64,aWdub3JlZA=="},
+		"nested.documents": {"data:text/plain;name=first.txt,first", "!!!", "", "c2Vjb25k"},
+		"Untagged":         {"data:image/png;base64,!!!"},
+	}, filesTestData{})
+	t.NoError(err)
+
+	data := result.(filesTestData)
+	t.Equal("avatar.png", data.Avatar.Filename)
+	t.Equal("image/png", data.Avatar.ContentType)
+	t.Equal(int64(8), data.Avatar.Size)
+	t.Len(data.Documents, 1)
+	t.Equal("uploaded.pdf", data.Documents[0].Filename)
+	t.Len(data.Nested.Documents, 2)
+	t.Equal("first.txt", data.Nested.Documents[0].Filename)
+	t.Equal("text/plain; charset=utf-8", data.Nested.Documents[1].ContentType)
+	t.Nil(data.Untagged)
+}
+
+func (t *FilesTestSuite) TestHasFileFields() {
+	t.True(hasFileFields(reflect.TypeOf(filesTestData{})))
+	t.True(hasFileFields(reflect.TypeOf(filesTestAttachments{})))
+	t.False(hasFileFields(reflect.TypeOf(struct{ Name string }{})))
+}
+
+func (t *FilesTestSuite) TestDecode_StreamedFiles() {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("avatar", "avatar.png")
+	t.NoError(err)
+	_, err = part.Write([]byte("content"))
+	t.NoError(err)
+	t.NoError(writer.Close())
+
+	streamed, err := multipart.NewReader(body, writer.Boundary()).NextPart()
+	t.NoError(err)
+
+	request := web.CreateRequest(&http.Request{}, nil)
+	domain.StoreStreamedFiles(request, map[string][]*domain.File{
+		"avatar": {domain.NewStreamedFile(streamed)},
+	})
+
+	result, err := (&DefaultFormDataDecoderImpl{}).Decode(nil, request, url.Values{}, filesTestData{})
+	t.NoError(err)
+
+	data := result.(filesTestData)
+	t.Equal("avatar.png", data.Avatar.Filename)
+	t.True(data.Avatar.IsStreamed())
+	t.Equal(int64(-1), data.Avatar.Size)
+}
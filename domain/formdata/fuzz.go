@@ -0,0 +1,100 @@
+package formdata
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/url"
+)
+
+type (
+	// fuzzFormData represents form data with all kinds of fields supported by default form data decoder
+	fuzzFormData struct {
+		Text    string            `form:"text" conform:"trim"`
+		Email   string            `form:"email" conform:"email"`
+		Number  int               `form:"number"`
+		Float   float64           `form:"float"`
+		Flag    bool              `form:"flag"`
+		Slice   []float64         `form:"slice"`
+		Map     map[string]int    `form:"map"`
+		Strings map[string]string `form:"strings" conform:"trim"`
+		Nested  struct {
+			Name string   `form:"name" conform:"name"`
+			Tags []string `form:"tags"`
+		} `form:"nested"`
+		Pointer *struct {
+			Value uint8 `form:"value"`
+		} `form:"pointer"`
+		Items []struct {
+			ID    int    `form:"id"`
+			Email string `form:"email" conform:"email"`
+		} `form:"items"`
+	}
+)
+
+const (
+	// fuzzModeURLEncoded interprets fuzz input as url encoded request body
+	fuzzModeURLEncoded byte = iota
+	// fuzzModeMultipart interprets fuzz input as multipart request body, with fuzzMultipartBoundary as boundary
+	fuzzModeMultipart
+	// fuzzModeJSON interprets fuzz input as JSON request body, flattened by structure of fuzzFormData
+	fuzzModeJSON
+	fuzzModes
+
+	fuzzMultipartBoundary  = "fuzzboundary"
+	fuzzMultipartMaxMemory = 1 << 20
+)
+
+// DecodeFuzz is fuzzing entry point (compatible with go-fuzz) for default form data decoder.
+// First byte of input selects how the rest is transformed into values: url encoded body, multipart body
+// (with "fuzzboundary" as boundary) or JSON body. Values are decoded into string map
+// and into struct covering all supported field kinds. Decoding errors are expected, while panics are not.
+//
+// It returns 1 if values are successfully decoded, 0 if decoding failed and -1 if input is not valid.
+func DecodeFuzz(data []byte) int {
+	if len(data) == 0 {
+		return -1
+	}
+
+	values, ok := fuzzValues(data[0]%fuzzModes, data[1:])
+	if !ok {
+		return -1
+	}
+
+	decoder := &DefaultFormDataDecoderImpl{}
+
+	if _, err := decoder.Decode(context.Background(), nil, values, map[string]string{}); err != nil {
+		return 0
+	}
+
+	if _, err := decoder.Decode(context.Background(), nil, values, fuzzFormData{}); err != nil {
+		return 0
+	}
+
+	if _, err := decoder.Decode(context.Background(), nil, values, &fuzzFormData{}); err != nil {
+		return 0
+	}
+
+	return 1
+}
+
+// fuzzValues transforms fuzz input into values, depending on selected mode
+func fuzzValues(mode byte, data []byte) (url.Values, bool) {
+	switch mode {
+	case fuzzModeURLEncoded:
+		values, err := url.ParseQuery(string(data))
+		return values, err == nil
+	case fuzzModeMultipart:
+		form, err := multipart.NewReader(bytes.NewReader(data), fuzzMultipartBoundary).ReadForm(fuzzMultipartMaxMemory)
+		if err != nil {
+			return nil, false
+		}
+		defer form.RemoveAll()
+		return form.Value, true
+	case fuzzModeJSON:
+		values, err := JSONValuesFor(bytes.NewReader(data), fuzzFormData{})
+		return values, err == nil
+	}
+
+	return nil, false
+}
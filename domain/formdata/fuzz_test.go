@@ -0,0 +1,45 @@
+package formdata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	DecodeFuzzTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestDecodeFuzzTestSuite(t *testing.T) {
+	suite.Run(t, &DecodeFuzzTestSuite{})
+}
+
+func (t *DecodeFuzzTestSuite) TestDecodeFuzz_Invalid() {
+	t.Equal(-1, DecodeFuzz(nil))
+	t.Equal(-1, DecodeFuzz([]byte{fuzzModeURLEncoded, '%', 'z'}))
+	t.Equal(-1, DecodeFuzz(append([]byte{fuzzModeMultipart}, "no multipart"...)))
+	t.Equal(-1, DecodeFuzz(append([]byte{fuzzModeJSON}, "{"...)))
+}
+
+func (t *DecodeFuzzTestSuite) TestDecodeFuzz_URLEncoded() {
+	t.Equal(1, DecodeFuzz(append([]byte{fuzzModeURLEncoded}, "text=+text+&number=1&slice[0]=1.5&nested.tags[1]=tag&items[0].id=2"...)))
+	t.Equal(0, DecodeFuzz(append([]byte{fuzzModeURLEncoded}, "number=text"...)))
+}
+
+func (t *DecodeFuzzTestSuite) TestDecodeFuzz_Multipart() {
+	body := "--fuzzboundary\r\n" +
+		"Content-Disposition: form-data; name=\"text\"\r\n" +
+		"\r\n" +
+		"text\r\n" +
+		"--fuzzboundary--\r\n"
+
+	t.Equal(1, DecodeFuzz(append([]byte{fuzzModeMultipart}, body...)))
+}
+
+func (t *DecodeFuzzTestSuite) TestDecodeFuzz_JSON() {
+	t.Equal(1, DecodeFuzz(append([]byte{fuzzModeJSON}, `{"map":{"key":1},"pointer":{"value":255},"items":[{"id":1}]}`...)))
+	t.Equal(0, DecodeFuzz(append([]byte{fuzzModeJSON}, `{"pointer":{"value":256}}`...)))
+	t.Equal(-1, DecodeFuzz(append([]byte{fuzzModeJSON}, `["text"]`...)))
+}
@@ -0,0 +1,257 @@
+package formdata
+
+import (
+	"encoding/json"
+	"io"
+	"mime"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"flamingo.me/form/domain"
+)
+
+// IsJSONContentType checks if content type of request body is JSON, like "application/json" or "application/ld+json"
+func IsJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/json" || (strings.HasPrefix(mediaType, "application/") && strings.HasSuffix(mediaType, "+json"))
+}
+
+type (
+	// jsonField represents field of struct, which is followed by flattening of JSON object
+	jsonField struct {
+		// name form name of the field, under which values of the field are submitted
+		name string
+		// typeOf type of the field
+		typeOf reflect.Type
+	}
+
+	// jsonFieldSet contains fields of struct type by their JSON and form names
+	jsonFieldSet struct {
+		// exact fields by their names
+		exact map[string]jsonField
+		// folded fields by their names in lower case. If names of several fields differ only in case,
+		// field of the first name in sorted order is used.
+		folded map[string]jsonField
+	}
+)
+
+var (
+	// jsonFields contains field sets of struct types
+	jsonFields = domain.NewCache("jsonFields")
+)
+
+// JSONValues transforms JSON object of request body into values, same as they would be submitted by HTML form,
+// so JSON payloads pass through the same decoding, validation and form extensions as url encoded ones.
+// Names of nested objects are joined by "." (like "address.street"), arrays of objects and arrays are indexed
+// (like "items[0].name"), and arrays of other values are submitted as multiple values. Null values are skipped.
+// Use JSONValuesFor, if structure of form data is known.
+func JSONValues(body io.Reader) (url.Values, error) {
+	return JSONValuesFor(body, nil)
+}
+
+// JSONValuesFor transforms JSON object of request body into values, same as JSONValues, by following structure
+// of form data. Keys of objects decoded into maps are submitted as map keys (like "labels[key]"), and keys of objects
+// decoded into structs are matched with json or form tags of their fields (or with names of fields, ignoring case),
+// and submitted under form names of the fields. Keys without matching field are submitted as they are, so they
+// are still available for form extensions.
+func JSONValuesFor(body io.Reader, formData interface{}) (url.Values, error) {
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		if err == io.EOF {
+			return url.Values{}, nil
+		}
+		return nil, domain.NewFormErrorf("JSON body can't be decoded: %s", err)
+	}
+
+	object, ok := data.(map[string]interface{})
+	if !ok {
+		return nil, domain.NewFormError("JSON body must be an object")
+	}
+
+	values := url.Values{}
+	addJSONObject(values, "", object, reflect.TypeOf(formData))
+
+	return values, nil
+}
+
+// addJSONObject adds values of JSON object into values, with keys prefixed by the key of the object. Keys of objects
+// decoded into maps are added as map keys, except for top level object, which is decoded same as url values.
+func addJSONObject(values url.Values, key string, object map[string]interface{}, typeOf reflect.Type) {
+	typeOf = indirectType(typeOf)
+	if typeOf != nil && typeOf.Kind() == reflect.Struct {
+		addJSONStruct(values, key, object, typeOf)
+		return
+	}
+
+	for name, item := range object {
+		switch {
+		case typeOf != nil && typeOf.Kind() == reflect.Map && key != "":
+			addJSONValue(values, key+"["+name+"]", item, typeOf.Elem())
+		case typeOf != nil && typeOf.Kind() == reflect.Map:
+			addJSONValue(values, name, item, typeOf.Elem())
+		default:
+			addJSONValue(values, joinJSONKey(key, name), item, nil)
+		}
+	}
+}
+
+// addJSONStruct adds values of JSON object decoded into struct type, with keys prefixed by the key of the object.
+// Keys matching any name of field exactly take precedence over keys matching it ignoring case, and of several keys
+// matching the same field equally, the first one in sorted order is used, while the others are skipped, so values
+// don't depend on random order of keys.
+func addJSONStruct(values url.Values, key string, object map[string]interface{}, typeOf reflect.Type) {
+	fields := loadJSONFields(typeOf)
+
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	bound := make(map[string]bool, len(names))
+	for _, name := range names {
+		if field, ok := fields.exact[name]; ok && !bound[field.name] {
+			bound[field.name] = true
+			addJSONValue(values, joinJSONKey(key, field.name), object[name], field.typeOf)
+		}
+	}
+
+	for _, name := range names {
+		if _, ok := fields.exact[name]; ok {
+			continue
+		}
+
+		field, ok := fields.folded[strings.ToLower(name)]
+		if !ok {
+			addJSONValue(values, joinJSONKey(key, name), object[name], nil)
+			continue
+		}
+
+		if !bound[field.name] {
+			bound[field.name] = true
+			addJSONValue(values, joinJSONKey(key, field.name), object[name], field.typeOf)
+		}
+	}
+}
+
+// addJSONValue adds JSON value under the key into values, by flattening objects and arrays
+func addJSONValue(values url.Values, key string, value interface{}, typeOf reflect.Type) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		addJSONObject(values, key, typed, typeOf)
+	case []interface{}:
+		elemTypeOf := indirectType(typeOf)
+		if elemTypeOf != nil && (elemTypeOf.Kind() == reflect.Slice || elemTypeOf.Kind() == reflect.Array) {
+			elemTypeOf = elemTypeOf.Elem()
+		} else {
+			elemTypeOf = nil
+		}
+
+		for i, item := range typed {
+			switch item.(type) {
+			case map[string]interface{}, []interface{}:
+				addJSONValue(values, key+"["+strconv.Itoa(i)+"]", item, elemTypeOf)
+			default:
+				addJSONValue(values, key, item, elemTypeOf)
+			}
+		}
+	case string:
+		values.Add(key, typed)
+	case json.Number:
+		values.Add(key, typed.String())
+	case bool:
+		values.Add(key, strconv.FormatBool(typed))
+	}
+}
+
+// loadJSONFields returns field set of struct type, using cache
+func loadJSONFields(typeOf reflect.Type) *jsonFieldSet {
+	if fields, ok := jsonFields.Load(typeOf); ok {
+		return fields.(*jsonFieldSet)
+	}
+
+	fields := &jsonFieldSet{
+		exact:  map[string]jsonField{},
+		folded: map[string]jsonField{},
+	}
+	compileJSONFields(typeOf, fields.exact)
+
+	names := make([]string, 0, len(fields.exact))
+	for name := range fields.exact {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, ok := fields.folded[strings.ToLower(name)]; !ok {
+			fields.folded[strings.ToLower(name)] = fields.exact[name]
+		}
+	}
+
+	loaded, _ := jsonFields.LoadOrStore(typeOf, fields)
+
+	return loaded.(*jsonFieldSet)
+}
+
+// compileJSONFields adds fields of struct type by names of their json tags, form tags and by their names.
+// Fields of embedded structs are added without name of embedded struct, unless it's named by form tag,
+// same as they are decoded, while fields of the struct itself take precedence over them.
+func compileJSONFields(typeOf reflect.Type, fields map[string]jsonField) {
+	var embedded []reflect.Type
+
+	for i := 0; i < typeOf.NumField(); i++ {
+		field := typeOf.Field(i)
+		if !field.Anonymous && field.PkgPath != "" {
+			continue
+		}
+
+		name := fieldName(field)
+		if name == "-" {
+			continue
+		}
+
+		if field.Anonymous && indirectType(field.Type).Kind() == reflect.Struct && field.Tag.Get("form") == "" {
+			embedded = append(embedded, indirectType(field.Type))
+			continue
+		}
+
+		bound := jsonField{name: name, typeOf: field.Type}
+		for _, alias := range []string{strings.SplitN(field.Tag.Get("json"), ",", 2)[0], name, field.Name} {
+			if _, ok := fields[alias]; !ok && alias != "" && alias != "-" {
+				fields[alias] = bound
+			}
+		}
+	}
+
+	for _, embeddedTypeOf := range embedded {
+		compileJSONFields(embeddedTypeOf, fields)
+	}
+}
+
+// joinJSONKey returns key of nested value, joined by "." with key of its parent
+func joinJSONKey(key string, name string) string {
+	if key == "" {
+		return name
+	}
+
+	return key + "." + name
+}
+
+// indirectType returns type referenced by pointers, or nil for nil type
+func indirectType(typeOf reflect.Type) reflect.Type {
+	for typeOf != nil && typeOf.Kind() == reflect.Ptr {
+		typeOf = typeOf.Elem()
+	}
+
+	return typeOf
+}
@@ -0,0 +1,169 @@
+package formdata
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	JSONValuesTestSuite struct {
+		suite.Suite
+	}
+
+	jsonValuesTestBase struct {
+		Locale string `json:"locale"`
+		Name   string `form:"baseName"`
+	}
+
+	jsonValuesTestItem struct {
+		Quantity int               `json:"qty" form:"quantity"`
+		Options  map[string]string `form:"options"`
+	}
+
+	jsonValuesTestData struct {
+		jsonValuesTestBase
+		Name       string                         `json:"fullName" form:"name"`
+		Email      string                         `form:"email"`
+		Labels     map[string]string              `json:"labels" form:"tags"`
+		Prices     map[string][]float64           `form:"prices"`
+		Items      []jsonValuesTestItem           `json:"items" form:"positions"`
+		Addresses  map[string]*jsonValuesTestItem `form:"addresses"`
+		Ignored    string                         `form:"-"`
+		Untagged   string
+		unexported string
+	}
+)
+
+func TestJSONValuesTestSuite(t *testing.T) {
+	suite.Run(t, &JSONValuesTestSuite{})
+}
+
+func (t *JSONValuesTestSuite) TestIsJSONContentType() {
+	t.True(IsJSONContentType("application/json"))
+	t.True(IsJSONContentType("Application/JSON; charset=utf-8"))
+	t.True(IsJSONContentType("application/merge-patch+json"))
+	t.False(IsJSONContentType("application/x-www-form-urlencoded"))
+	t.False(IsJSONContentType("text/json+plain"))
+	t.False(IsJSONContentType(""))
+}
+
+func (t *JSONValuesTestSuite) TestJSONValues() {
+	values, err := JSONValues(strings.NewReader(`{
+		"text": " some text ",
+		"number": 10,
+		"float": 1.50,
+		"flag": true,
+		"empty": null,
+		"slice": [1, 2.5],
+		"nested": {"name": "name", "tags": ["first", "second"]},
+		"items": [{"id": 1}, {"id": 2, "email": "mail@example.com"}],
+		"matrix": [[1, 2], [3]]
+	}`))
+
+	t.NoError(err)
+	t.Equal(url.Values{
+		"text":           []string{" some text "},
+		"number":         []string{"10"},
+		"float":          []string{"1.50"},
+		"flag":           []string{"true"},
+		"slice":          []string{"1", "2.5"},
+		"nested.name":    []string{"name"},
+		"nested.tags":    []string{"first", "second"},
+		"items[0].id":    []string{"1"},
+		"items[1].id":    []string{"2"},
+		"items[1].email": []string{"mail@example.com"},
+		"matrix[0]":      []string{"1", "2"},
+		"matrix[1]":      []string{"3"},
+	}, values)
+}
+
+func (t *JSONValuesTestSuite) TestJSONValues_Empty() {
+	values, err := JSONValues(strings.NewReader(""))
+	t.NoError(err)
+	t.Equal(url.Values{}, values)
+
+	values, err = JSONValues(strings.NewReader("{}"))
+	t.NoError(err)
+	t.Equal(url.Values{}, values)
+}
+
+func (t *JSONValuesTestSuite) TestJSONValues_Error() {
+	values, err := JSONValues(strings.NewReader(`{"text":`))
+	t.Error(err)
+	t.Nil(values)
+
+	values, err = JSONValues(strings.NewReader(`["text"]`))
+	t.Error(err)
+	t.Nil(values)
+}
+
+func (t *JSONValuesTestSuite) TestJSONValuesFor() {
+	values, err := JSONValuesFor(strings.NewReader(`{
+		"fullName": "John",
+		"EMAIL": "john@example.com",
+		"locale": "de",
+		"baseName": "base",
+		"labels": {"first": "a", "second": "b"},
+		"prices": {"eur": [1.5, 2]},
+		"items": [{"qty": 2, "options": {"color": "red"}}, {"quantity": 1}],
+		"addresses": {"home": {"qty": 3}},
+		"Ignored": "ignored",
+		"untagged": "untagged",
+		"unexported": "unexported",
+		"captcha": {"token": "abc"}
+	}`), &jsonValuesTestData{})
+
+	t.NoError(err)
+	t.Equal(url.Values{
+		"name":                        []string{"John"},
+		"email":                       []string{"john@example.com"},
+		"Locale":                      []string{"de"},
+		"baseName":                    []string{"base"},
+		"tags[first]":                 []string{"a"},
+		"tags[second]":                []string{"b"},
+		"prices[eur]":                 []string{"1.5", "2"},
+		"positions[0].quantity":       []string{"2"},
+		"positions[0].options[color]": []string{"red"},
+		"positions[1].quantity":       []string{"1"},
+		"addresses[home].quantity":    []string{"3"},
+		"Ignored":                     []string{"ignored"},
+		"Untagged":                    []string{"untagged"},
+		"unexported":                  []string{"unexported"},
+		"captcha.token":               []string{"abc"},
+	}, values)
+}
+
+func (t *JSONValuesTestSuite) TestJSONValuesFor_KeysDifferingInCase() {
+	for i := 0; i < 20; i++ {
+		values, err := JSONValuesFor(strings.NewReader(`{
+			"EMAIL": "upper@example.com",
+			"email": "exact@example.com",
+			"Name": "field",
+			"name": "form",
+			"FULLNAME": "Upper",
+			"FullName": "Title",
+			"untagged": "first",
+			"UNTAGGED": "second"
+		}`), &jsonValuesTestData{})
+
+		t.NoError(err)
+		t.Equal(url.Values{
+			"email":    []string{"exact@example.com"},
+			"name":     []string{"field"},
+			"Untagged": []string{"second"},
+		}, values)
+	}
+}
+
+func (t *JSONValuesTestSuite) TestJSONValuesFor_Map() {
+	values, err := JSONValuesFor(strings.NewReader(`{"name": "John", "address": {"city": "Berlin"}}`), map[string]map[string]string{})
+
+	t.NoError(err)
+	t.Equal(url.Values{
+		"name":          []string{"John"},
+		"address[city]": []string{"Berlin"},
+	}, values)
+}
@@ -0,0 +1,52 @@
+package formdata
+
+import (
+	"context"
+	"net"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// DefaultTenantResolverImpl represents implementation of default domain.TenantResolver.
+	// It resolves tenant selected in context by domain.ContextWithTenant, otherwise tenant configured for host
+	// of the request. Requests of hosts which are not configured belong to default tenant.
+	DefaultTenantResolverImpl struct {
+		hosts map[string]string
+	}
+)
+
+var _ domain.TenantResolver = &DefaultTenantResolverImpl{}
+
+// Inject is method used to set all dependencies as local variables
+func (r *DefaultTenantResolverImpl) Inject(cfg *struct {
+	Hosts config.Map `inject:"config:form.tenantHosts"`
+}) {
+	if cfg == nil {
+		return
+	}
+
+	if err := cfg.Hosts.MapInto(&r.hosts); err != nil {
+		panic(err.Error())
+	}
+}
+
+// ResolveTenant returns tenant selected in context, or tenant configured for host of the request
+func (r *DefaultTenantResolverImpl) ResolveTenant(ctx context.Context, req *web.Request) string {
+	if tenant := domain.TenantFromContext(ctx); tenant != "" {
+		return tenant
+	}
+
+	if req == nil || req.Request() == nil {
+		return ""
+	}
+
+	host := req.Request().Host
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+
+	return r.hosts[host]
+}
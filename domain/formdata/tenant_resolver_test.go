@@ -0,0 +1,64 @@
+package formdata
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	DefaultTenantResolverImplTestSuite struct {
+		suite.Suite
+
+		resolver *DefaultTenantResolverImpl
+	}
+)
+
+func TestDefaultTenantResolverImplTestSuite(t *testing.T) {
+	suite.Run(t, &DefaultTenantResolverImplTestSuite{})
+}
+
+func (t *DefaultTenantResolverImplTestSuite) SetupTest() {
+	t.resolver = &DefaultTenantResolverImpl{}
+	t.resolver.Inject(&struct {
+		Hosts config.Map `inject:"config:form.tenantHosts"`
+	}{
+		Hosts: config.Map{
+			"shop-a.example.com": "brandA",
+			"shop-b.example.com": "brandB",
+		},
+	})
+}
+
+func (t *DefaultTenantResolverImplTestSuite) TestResolveTenant_FromContext() {
+	ctx := domain.ContextWithTenant(context.Background(), "brandC")
+	req := web.CreateRequest(&http.Request{Host: "shop-a.example.com"}, nil)
+
+	t.Equal("brandC", t.resolver.ResolveTenant(ctx, req))
+}
+
+func (t *DefaultTenantResolverImplTestSuite) TestResolveTenant_FromHost() {
+	req := web.CreateRequest(&http.Request{Host: "shop-a.example.com"}, nil)
+	t.Equal("brandA", t.resolver.ResolveTenant(context.Background(), req))
+
+	req = web.CreateRequest(&http.Request{Host: "shop-b.example.com:8080"}, nil)
+	t.Equal("brandB", t.resolver.ResolveTenant(context.Background(), req))
+
+	req = web.CreateRequest(&http.Request{Host: "shop-c.example.com"}, nil)
+	t.Equal("", t.resolver.ResolveTenant(context.Background(), req))
+}
+
+func (t *DefaultTenantResolverImplTestSuite) TestResolveTenant_WithoutConfiguration() {
+	resolver := &DefaultTenantResolverImpl{}
+	resolver.Inject(nil)
+
+	req := web.CreateRequest(&http.Request{Host: "shop-a.example.com"}, nil)
+	t.Equal("", resolver.ResolveTenant(context.Background(), req))
+	t.Equal("", resolver.ResolveTenant(nil, nil))
+}
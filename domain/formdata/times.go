@@ -0,0 +1,161 @@
+package formdata
+
+import (
+	"net/url"
+	"reflect"
+	"time"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// timeBinding as binding of time field, which is decoded and encoded by layout of its tag
+	timeBinding struct {
+		// name form name of the field, prefixed by names of parent fields
+		name string
+		// index path of the field, which may cross embedded and nested structs
+		index []int
+		// layout time layout of the field, like "2006-01-02"
+		layout string
+		// pointer flag if field is *time.Time instead of time.Time
+		pointer bool
+	}
+)
+
+var (
+	// timeType type of time fields
+	timeType = reflect.TypeOf(time.Time{})
+
+	// timeBindings contains bindings of time fields with layouts per form data type
+	timeBindings = domain.NewCache("timeLayouts")
+)
+
+// timeLayout returns layout of time field defined by `formLayout:"2006-01-02"` tag, or by `time_format` tag
+// if there is no formLayout tag
+func timeLayout(field reflect.StructField) string {
+	if layout := field.Tag.Get("formLayout"); layout != "" {
+		return layout
+	}
+
+	return field.Tag.Get("time_format")
+}
+
+// loadTimeBindings returns bindings of time fields with layouts of struct type, by compiling them if they are not
+// compiled yet
+func loadTimeBindings(typeOf reflect.Type) []timeBinding {
+	if bindings, ok := timeBindings.Load(typeOf); ok {
+		return bindings.([]timeBinding)
+	}
+
+	bindings, _ := timeBindings.LoadOrStore(typeOf, compileTimeBindings(typeOf, nil, ""))
+
+	return bindings.([]timeBinding)
+}
+
+// compileTimeBindings compiles bindings of fields of type time.Time and *time.Time with layouts, by names of
+// their form tags. Nested structs are compiled with names prefixed by name of parent field, same as for files.
+func compileTimeBindings(typeOf reflect.Type, index []int, prefix string) []timeBinding {
+	var bindings []timeBinding
+
+	for i := 0; i < typeOf.NumField(); i++ {
+		field := typeOf.Field(i)
+		if !field.Anonymous && field.PkgPath != "" {
+			continue
+		}
+
+		name := fieldName(field)
+		if name == "-" {
+			continue
+		}
+
+		fieldIndex := append(append([]int(nil), index...), i)
+
+		// fields of embedded structs are decoded without name of embedded struct, unless it's named by form tag
+		if field.Anonymous && field.Type.Kind() == reflect.Struct && field.Tag.Get("form") == "" {
+			bindings = append(bindings, compileTimeBindings(field.Type, fieldIndex, prefix)...)
+			continue
+		}
+		name = prefix + name
+
+		switch {
+		case field.Type == timeType || field.Type == reflect.PtrTo(timeType):
+			if layout := timeLayout(field); layout != "" {
+				bindings = append(bindings, timeBinding{
+					name:    name,
+					index:   fieldIndex,
+					layout:  layout,
+					pointer: field.Type.Kind() == reflect.Ptr,
+				})
+			}
+		case field.Type.Kind() == reflect.Struct && field.Type != fileType:
+			bindings = append(bindings, compileTimeBindings(field.Type, fieldIndex, name+".")...)
+		}
+	}
+
+	return bindings
+}
+
+// withoutTimeValues copies values into filtered values without values of time fields with layouts, so they are
+// not decoded by layout of go-playground form package. Filtered values are expected to be empty, and are returned.
+func withoutTimeValues(filtered url.Values, values url.Values, bindings []timeBinding) url.Values {
+	if filtered == nil {
+		filtered = make(url.Values, len(values))
+	}
+
+	for key, list := range values {
+		filtered[key] = list
+	}
+
+	for _, binding := range bindings {
+		delete(filtered, binding.name)
+	}
+
+	return filtered
+}
+
+// bindTimes sets values of time fields parsed by their layouts. Empty values are not set, so fields keep zero time
+// or nil pointer. It returns error if value doesn't match layout of its field.
+func bindTimes(valueOf reflect.Value, values url.Values, bindings []timeBinding) error {
+	for _, binding := range bindings {
+		value := values.Get(binding.name)
+		if value == "" {
+			continue
+		}
+
+		parsed, err := time.Parse(binding.layout, value)
+		if err != nil {
+			return domain.NewFormErrorf("value of field %q doesn't match time layout %q", binding.name, binding.layout)
+		}
+
+		field := valueOf.FieldByIndex(binding.index)
+		if binding.pointer {
+			field.Set(reflect.ValueOf(&parsed))
+		} else {
+			field.Set(reflect.ValueOf(parsed))
+		}
+	}
+
+	return nil
+}
+
+// encodeTimes sets values of time fields formatted by their layouts, so encoded values are decoded again into
+// the same time. Nil pointers and zero times are encoded as empty values.
+func encodeTimes(valueOf reflect.Value, values url.Values, bindings []timeBinding) {
+	for _, binding := range bindings {
+		field := valueOf.FieldByIndex(binding.index)
+		if binding.pointer {
+			if field.IsNil() {
+				delete(values, binding.name)
+				continue
+			}
+			field = field.Elem()
+		}
+
+		formatted := ""
+		if value := field.Interface().(time.Time); !value.IsZero() {
+			formatted = value.Format(binding.layout)
+		}
+
+		values[binding.name] = []string{formatted}
+	}
+}
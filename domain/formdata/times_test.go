@@ -0,0 +1,110 @@
+package formdata
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	TimesTestSuite struct {
+		suite.Suite
+	}
+
+	timesTestPeriod struct {
+		From time.Time  `form:"from" formLayout:"2006-01-02"`
+		To   *time.Time `form:"to" formLayout:"2006-01-02"`
+	}
+
+	timesTestData struct {
+		timesTestPeriod
+		Name      string          `form:"name"`
+		Appointed time.Time       `form:"appointed" time_format:"2006-01-02T15:04"`
+		Created   time.Time       `form:"created"`
+		Nested    timesTestPeriod `form:"nested"`
+		Ignored   time.Time       `form:"-" formLayout:"2006-01-02"`
+	}
+)
+
+func TestTimesTestSuite(t *testing.T) {
+	suite.Run(t, &TimesTestSuite{})
+}
+
+func (t *TimesTestSuite) TestCompileTimeBindings() {
+	t.Equal([]timeBinding{
+		{name: "from", index: []int{0, 0}, layout: "2006-01-02"},
+		{name: "to", index: []int{0, 1}, layout: "2006-01-02", pointer: true},
+		{name: "appointed", index: []int{2}, layout: "2006-01-02T15:04"},
+		{name: "nested.from", index: []int{4, 0}, layout: "2006-01-02"},
+		{name: "nested.to", index: []int{4, 1}, layout: "2006-01-02", pointer: true},
+	}, compileTimeBindings(reflect.TypeOf(timesTestData{}), nil, ""))
+}
+
+func (t *TimesTestSuite) TestDecode_Layouts() {
+	result, err := (&DefaultFormDataDecoderImpl{}).decodeUnknownInterface(url.Values{
+		"name":        {"John"},
+		"from":        {"2020-01-02"},
+		"to":          {"2020-01-05"},
+		"appointed":   {"2020-01-03T10:30"},
+		"created":     {"2020-01-01T12:00:00Z"},
+		"nested.from": {""},
+	}, nil, timesTestData{})
+	t.NoError(err)
+
+	to := time.Date(2020, 1, 5, 0, 0, 0, 0, time.UTC)
+	t.Equal(timesTestData{
+		timesTestPeriod: timesTestPeriod{
+			From: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+			To:   &to,
+		},
+		Name:      "John",
+		Appointed: time.Date(2020, 1, 3, 10, 30, 0, 0, time.UTC),
+		Created:   time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+	}, result)
+}
+
+func (t *TimesTestSuite) TestDecode_InvalidLayout() {
+	result, err := (&DefaultFormDataDecoderImpl{}).decodeUnknownInterface(url.Values{
+		"from": {"02.01.2020"},
+	}, nil, timesTestData{})
+	t.Error(err)
+	t.Nil(result)
+}
+
+func (t *TimesTestSuite) TestWithoutTimeValues() {
+	bindings := loadTimeBindings(reflect.TypeOf(timesTestData{}))
+	filtered := url.Values{}
+
+	t.Equal(url.Values{"name": {"John"}}, withoutTimeValues(filtered, url.Values{
+		"name": {"John"},
+		"from": {"2020-01-02"},
+	}, bindings))
+	t.Equal(url.Values{"name": {"John"}}, filtered, "filtered values are buffered")
+
+	t.Equal(url.Values{"name": {"Jane"}}, withoutTimeValues(nil, url.Values{
+		"name": {"Jane"},
+		"to":   {"2020-01-05"},
+	}, bindings))
+}
+
+func (t *TimesTestSuite) TestEncode_Layouts() {
+	to := time.Date(2020, 1, 5, 0, 0, 0, 0, time.UTC)
+	values, err := (&DefaultFormDataEncoderImpl{}).Encode(context.Background(), &timesTestData{
+		timesTestPeriod: timesTestPeriod{
+			From: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+			To:   &to,
+		},
+		Appointed: time.Date(2020, 1, 3, 10, 30, 0, 0, time.UTC),
+	})
+	t.NoError(err)
+	t.Equal("2020-01-02", values.Get("from"))
+	t.Equal("2020-01-05", values.Get("to"))
+	t.Equal("2020-01-03T10:30", values.Get("appointed"))
+	t.Equal("", values.Get("nested.from"))
+	_, ok := values["nested.to"]
+	t.False(ok)
+}
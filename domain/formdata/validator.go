@@ -10,9 +10,17 @@ import (
 type (
 	// DefaultFormDataValidatorImpl represents implementation of default domain.FormDataValidator.
 	DefaultFormDataValidatorImpl struct{}
+
+	// stopOnInvalidValidator represents domain.FormDataValidator which stops validation, if wrapped validator reports errors
+	stopOnInvalidValidator struct {
+		validator domain.FormDataValidator
+	}
 )
 
-var _ domain.DefaultFormDataValidator = &DefaultFormDataValidatorImpl{}
+var (
+	_ domain.DefaultFormDataValidator = &DefaultFormDataValidatorImpl{}
+	_ domain.FormDataValidator        = &stopOnInvalidValidator{}
+)
 
 // Validate performs default form data validation, by using go-playground validator package and storing results into domain.ValidationInfo instance.
 func (p *DefaultFormDataValidatorImpl) Validate(ctx context.Context, req *web.Request, validatorProvider domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
@@ -22,3 +30,27 @@ func (p *DefaultFormDataValidatorImpl) Validate(ctx context.Context, req *web.Re
 	validationInfo := validatorProvider.Validate(ctx, req, formData)
 	return &validationInfo, nil
 }
+
+// StopOnInvalid wraps form data validator, so remaining form data validators of the handler are skipped if it reports
+// any validation error. It allows cheap syntactic checks to gate expensive ones (like remote address verification).
+func StopOnInvalid(validator domain.FormDataValidator) domain.FormDataValidator {
+	return &stopOnInvalidValidator{
+		validator: validator,
+	}
+}
+
+// Validate performs validation by wrapped validator, and stops further validation if form data is invalid.
+func (v *stopOnInvalidValidator) Validate(ctx context.Context, req *web.Request, validatorProvider domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	validationInfo, err := v.validator.Validate(ctx, req, validatorProvider, formData)
+	if err != nil {
+		return nil, err
+	} else if validationInfo == nil {
+		validationInfo = &domain.ValidationInfo{}
+	}
+
+	if !validationInfo.IsValid() {
+		validationInfo.StopValidation()
+	}
+
+	return validationInfo, nil
+}
@@ -1,6 +1,7 @@
 package formdata
 
 import (
+	"errors"
 	"testing"
 
 	"flamingo.me/flamingo/v3/framework/web"
@@ -54,3 +55,37 @@ func (t *DefaultFormDataValidatorImplTestSuite) TestGetFormData_Map() {
 	t.NoError(err)
 	t.Equal(&domain.ValidationInfo{}, result)
 }
+
+func (t *DefaultFormDataValidatorImplTestSuite) TestStopOnInvalid_Valid() {
+	object := struct{}{}
+	t.validatorProvider.On("Validate", nil, (*web.Request)(nil), object).Return(domain.ValidationInfo{}).Once()
+
+	result, err := StopOnInvalid(t.validator).Validate(nil, nil, t.validatorProvider, object)
+
+	t.NoError(err)
+	t.False(result.IsValidationStopped())
+}
+
+func (t *DefaultFormDataValidatorImplTestSuite) TestStopOnInvalid_Invalid() {
+	object := struct{}{}
+	validationInfo := domain.ValidationInfo{}
+	validationInfo.AddFieldError("email", "email", "invalid email")
+	t.validatorProvider.On("Validate", nil, (*web.Request)(nil), object).Return(validationInfo).Once()
+
+	result, err := StopOnInvalid(t.validator).Validate(nil, nil, t.validatorProvider, object)
+
+	t.NoError(err)
+	t.True(result.IsValidationStopped())
+	t.True(result.HasErrorsForField("email"))
+}
+
+func (t *DefaultFormDataValidatorImplTestSuite) TestStopOnInvalid_Error() {
+	validator := &mocks.FormDataValidator{}
+	validator.On("Validate", nil, (*web.Request)(nil), t.validatorProvider, nil).Return(nil, errors.New("error")).Once()
+
+	result, err := StopOnInvalid(validator).Validate(nil, nil, t.validatorProvider, nil)
+
+	t.EqualError(err, "error")
+	t.Nil(result)
+	validator.AssertExpectations(t.T())
+}
@@ -0,0 +1,28 @@
+package formdata
+
+import (
+	"reflect"
+)
+
+// WarmupFormDataType primes caches of default form data decoder and tag defaults contributor for form data type,
+// so the first request which decodes form data of the type doesn't analyze its struct. Types which are not structs
+// are ignored.
+func WarmupFormDataType(typeOf reflect.Type) error {
+	if typeOf == nil {
+		return nil
+	}
+
+	if typeOf.Kind() == reflect.Ptr {
+		typeOf = typeOf.Elem()
+	}
+
+	if typeOf.Kind() != reflect.Struct {
+		return nil
+	}
+
+	defaultValues(typeOf)
+
+	_, err := (&DefaultFormDataDecoderImpl{}).decodeUnknownInterface(nil, nil, reflect.New(typeOf).Elem().Interface())
+
+	return err
+}
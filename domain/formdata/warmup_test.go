@@ -0,0 +1,42 @@
+package formdata
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	WarmupTestSuite struct {
+		suite.Suite
+	}
+
+	warmupTestFormData struct {
+		Name    string `form:"name" default:"John"`
+		Country string `form:"country"`
+	}
+)
+
+func TestWarmupTestSuite(t *testing.T) {
+	suite.Run(t, &WarmupTestSuite{})
+}
+
+func (t *WarmupTestSuite) TestWarmupFormDataType() {
+	typeOf := reflect.TypeOf(warmupTestFormData{})
+	t.NoError(WarmupFormDataType(reflect.PtrTo(typeOf)))
+
+	_, ok := decodeStatePools.Load(typeOf)
+	t.True(ok)
+
+	_, ok = tagDefaults.Load(typeOf)
+	t.True(ok)
+}
+
+func (t *WarmupTestSuite) TestWarmupFormDataType_NotStruct() {
+	t.NoError(WarmupFormDataType(nil))
+	t.NoError(WarmupFormDataType(reflect.TypeOf(map[string]string{})))
+
+	_, ok := decodeStatePools.Load(reflect.TypeOf(map[string]string{}))
+	t.False(ok)
+}
@@ -0,0 +1,111 @@
+package formdata
+
+import (
+	"encoding/xml"
+	"io"
+	"mime"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// xmlElement as element of XML document, with its child elements and text content
+	xmlElement struct {
+		name     string
+		children []*xmlElement
+		text     strings.Builder
+	}
+)
+
+// maxXMLDepth maximal nesting of elements of XML body, so deeply nested documents can't exhaust the stack
+const maxXMLDepth = 64
+
+// IsXMLContentType checks if content type of request body is XML, like "application/xml", "text/xml"
+// or "application/atom+xml"
+func IsXMLContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/xml" || mediaType == "text/xml" ||
+		(strings.HasPrefix(mediaType, "application/") && strings.HasSuffix(mediaType, "+xml"))
+}
+
+// XMLValues transforms XML document of request body into values, same as they would be submitted by HTML form.
+// Root element is ignored, and names of nested elements are joined by "." (like "address.street"). Repeated elements
+// with child elements are indexed (like "items[0].name"), and other repeated elements are submitted as multiple
+// values. Text of elements is trimmed, and attributes are ignored.
+func XMLValues(body io.Reader) (url.Values, error) {
+	decoder := xml.NewDecoder(body)
+
+	var root *xmlElement
+	var stack []*xmlElement
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, domain.NewFormErrorf("XML body can't be decoded: %s", err)
+		}
+
+		switch typed := token.(type) {
+		case xml.StartElement:
+			if len(stack) >= maxXMLDepth {
+				return nil, domain.NewFormError("XML body is nested too deeply")
+			}
+
+			element := &xmlElement{name: typed.Name.Local}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, element)
+			} else if root == nil {
+				root = element
+			}
+			stack = append(stack, element)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(typed)
+			}
+		}
+	}
+
+	values := url.Values{}
+	if root != nil {
+		addXMLElements(values, "", root.children)
+	}
+
+	return values, nil
+}
+
+// addXMLElements adds child elements under the prefix into values, by flattening nested and repeated elements
+func addXMLElements(values url.Values, prefix string, elements []*xmlElement) {
+	counts := make(map[string]int, len(elements))
+	for _, element := range elements {
+		if len(element.children) > 0 {
+			counts[element.name]++
+		}
+	}
+
+	indexes := make(map[string]int, len(counts))
+	for _, element := range elements {
+		key := prefix + element.name
+		if len(element.children) == 0 {
+			values.Add(key, strings.TrimSpace(element.text.String()))
+			continue
+		}
+
+		if counts[element.name] > 1 {
+			key += "[" + strconv.Itoa(indexes[element.name]) + "]"
+			indexes[element.name]++
+		}
+
+		addXMLElements(values, key+".", element.children)
+	}
+}
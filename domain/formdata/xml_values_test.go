@@ -0,0 +1,72 @@
+package formdata
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	XMLValuesTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestXMLValuesTestSuite(t *testing.T) {
+	suite.Run(t, &XMLValuesTestSuite{})
+}
+
+func (t *XMLValuesTestSuite) TestIsXMLContentType() {
+	t.True(IsXMLContentType("application/xml"))
+	t.True(IsXMLContentType("Text/XML; charset=utf-8"))
+	t.True(IsXMLContentType("application/atom+xml"))
+	t.False(IsXMLContentType("application/json"))
+	t.False(IsXMLContentType("text/xml+plain"))
+	t.False(IsXMLContentType(""))
+}
+
+func (t *XMLValuesTestSuite) TestXMLValues() {
+	values, err := XMLValues(strings.NewReader(`<?xml version="1.0"?>
+		<order id="ignored">
+			<text> some text </text>
+			<empty/>
+			<tag>first</tag>
+			<tag>second</tag>
+			<address>
+				<street>main street</street>
+				<city>Berlin</city>
+			</address>
+			<item><id>1</id></item>
+			<item><id>2</id><email>mail@example.com</email></item>
+		</order>`))
+
+	t.NoError(err)
+	t.Equal(url.Values{
+		"text":           []string{"some text"},
+		"empty":          []string{""},
+		"tag":            []string{"first", "second"},
+		"address.street": []string{"main street"},
+		"address.city":   []string{"Berlin"},
+		"item[0].id":     []string{"1"},
+		"item[1].id":     []string{"2"},
+		"item[1].email":  []string{"mail@example.com"},
+	}, values)
+}
+
+func (t *XMLValuesTestSuite) TestXMLValues_Empty() {
+	values, err := XMLValues(strings.NewReader(""))
+	t.NoError(err)
+	t.Equal(url.Values{}, values)
+}
+
+func (t *XMLValuesTestSuite) TestXMLValues_Invalid() {
+	_, err := XMLValues(strings.NewReader("<order><name>John</order>"))
+	t.Error(err)
+}
+
+func (t *XMLValuesTestSuite) TestXMLValues_TooDeep() {
+	_, err := XMLValues(strings.NewReader(strings.Repeat("<a>", maxXMLDepth+1)))
+	t.Error(err)
+}
@@ -0,0 +1,197 @@
+package geo
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+
+	validator "gopkg.in/go-playground/validator.v9"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/formdata"
+)
+
+type (
+	// Coordinates defines reusable sub form of geo coordinates, which can be embedded into any form data (like search
+	// of store locator). Besides ranges defined by tags, coordinates are validated by Validator with configured
+	// precision and bounding box.
+	//
+	// Data struct {
+	//	 Location geo.Coordinates `form:"location"`
+	// }
+	Coordinates struct {
+		Latitude  string `form:"lat" validate:"omitempty,latitude" conform:"trim"`
+		Longitude string `form:"lng" validate:"omitempty,longitude" conform:"trim"`
+	}
+
+	// BoundingBox defines area which contains all valid coordinates. If minimal longitude is greater than maximal
+	// longitude, bounding box crosses 180th meridian.
+	BoundingBox struct {
+		MinLatitude  float64 `json:"minLat"`
+		MaxLatitude  float64 `json:"maxLat"`
+		MinLongitude float64 `json:"minLng"`
+		MaxLongitude float64 `json:"maxLng"`
+	}
+
+	// Validator defines struct validator of Coordinates, which validates that both coordinates are submitted,
+	// that they don't exceed configured number of decimal places and that they are inside configured bounding box
+	Validator struct {
+		precision   int
+		boundingBox *BoundingBox
+	}
+)
+
+var _ domain.StructValidator = &Validator{}
+
+// Inject is method used to set all dependencies as local variables
+func (v *Validator) Inject(cfg *struct {
+	Precision   int        `inject:"config:form.geo.precision"`
+	BoundingBox config.Map `inject:"config:form.geo.boundingBox"`
+}) {
+	if cfg == nil {
+		return
+	}
+
+	v.precision = cfg.Precision
+
+	if len(cfg.BoundingBox) > 0 {
+		boundingBox := &BoundingBox{}
+		if err := cfg.BoundingBox.MapInto(boundingBox); err != nil {
+			panic(err.Error())
+		}
+
+		if boundingBox.MinLatitude > boundingBox.MaxLatitude {
+			panic("minimal latitude of bounding box is greater than maximal latitude")
+		}
+
+		v.boundingBox = boundingBox
+	}
+}
+
+// IsEmpty returns if none of coordinates is submitted
+func (c Coordinates) IsEmpty() bool {
+	return c.Latitude == "" && c.Longitude == ""
+}
+
+// LatLng returns coordinates as numbers. It returns false if coordinates are not submitted or not numbers.
+func (c Coordinates) LatLng() (float64, float64, bool) {
+	lat, err := strconv.ParseFloat(c.Latitude, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	lng, err := strconv.ParseFloat(c.Longitude, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return lat, lng, true
+}
+
+// Contains checks if coordinates are inside bounding box, including its borders
+func (b BoundingBox) Contains(lat float64, lng float64) bool {
+	if lat < b.MinLatitude || lat > b.MaxLatitude {
+		return false
+	}
+
+	if b.MinLongitude > b.MaxLongitude {
+		return lng >= b.MinLongitude || lng <= b.MaxLongitude
+	}
+
+	return lng >= b.MinLongitude && lng <= b.MaxLongitude
+}
+
+// StructType defines Coordinates as type validated by this validator
+func (v *Validator) StructType() interface{} {
+	return Coordinates{}
+}
+
+// ValidateStruct validates coordinates by precision and bounding box. Empty coordinates are valid, so sub form
+// needs to be required by `validate:"required"` tag of the embedding field, if it's mandatory.
+func (v *Validator) ValidateStruct(_ context.Context, sl validator.StructLevel) {
+	coordinates, ok := sl.Current().Interface().(Coordinates)
+	if !ok || coordinates.IsEmpty() {
+		return
+	}
+
+	if coordinates.Latitude == "" {
+		sl.ReportError(coordinates.Latitude, "Latitude", "Latitude", "required", "")
+		return
+	}
+
+	if coordinates.Longitude == "" {
+		sl.ReportError(coordinates.Longitude, "Longitude", "Longitude", "required", "")
+		return
+	}
+
+	lat, lng, ok := coordinates.LatLng()
+	if !ok {
+		return
+	}
+
+	valid := true
+	if v.precision > 0 {
+		if decimalPlaces(coordinates.Latitude) > v.precision {
+			sl.ReportError(coordinates.Latitude, "Latitude", "Latitude", "precision", strconv.Itoa(v.precision))
+			valid = false
+		}
+
+		if decimalPlaces(coordinates.Longitude) > v.precision {
+			sl.ReportError(coordinates.Longitude, "Longitude", "Longitude", "precision", strconv.Itoa(v.precision))
+			valid = false
+		}
+	}
+
+	if valid && v.boundingBox != nil && !v.boundingBox.Contains(lat, lng) {
+		sl.ReportError(coordinates.Latitude, "Latitude", "Latitude", "boundingbox", "")
+	}
+}
+
+// CombinedInput creates values transformer for forms which submit coordinates as single "lat,lng" input (like value
+// of map picker). Combined value of the field is split into values of its "lat" and "lng" fields, which are kept
+// if they are submitted as well.
+//
+// decoder := formdata.NewChainedFormDataDecoder(nil).WithValuesTransformers(geo.CombinedInput("location"))
+func CombinedInput(fieldName string) domain.FormValuesTransformer {
+	return formdata.ValuesTransformerFunc(func(_ context.Context, _ *web.Request, values url.Values) (url.Values, error) {
+		combined, ok := values[fieldName]
+		if !ok {
+			return values, nil
+		}
+
+		transformed := make(url.Values, len(values)+1)
+		for key, list := range values {
+			if key != fieldName {
+				transformed[key] = list
+			}
+		}
+
+		if len(combined) == 0 {
+			return transformed, nil
+		}
+
+		parts := strings.SplitN(combined[0], ",", 2)
+		if _, ok := transformed[fieldName+".lat"]; !ok {
+			transformed.Set(fieldName+".lat", strings.TrimSpace(parts[0]))
+		}
+
+		if _, ok := transformed[fieldName+".lng"]; !ok && len(parts) == 2 {
+			transformed.Set(fieldName+".lng", strings.TrimSpace(parts[1]))
+		}
+
+		return transformed, nil
+	})
+}
+
+// decimalPlaces returns number of decimal places of coordinate
+func decimalPlaces(coordinate string) int {
+	index := strings.Index(coordinate, ".")
+	if index < 0 {
+		return 0
+	}
+
+	return len(coordinate) - index - 1
+}
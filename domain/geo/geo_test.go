@@ -0,0 +1,171 @@
+package geo
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	ValidatorTestSuite struct {
+		suite.Suite
+
+		validator   *Validator
+		structLevel *mocks.StructLevel
+
+		context context.Context
+	}
+
+	CombinedInputTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &ValidatorTestSuite{})
+}
+
+func TestCombinedInputTestSuite(t *testing.T) {
+	suite.Run(t, &CombinedInputTestSuite{})
+}
+
+func (t *ValidatorTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *ValidatorTestSuite) SetupTest() {
+	t.validator = &Validator{}
+	t.validator.Inject(&struct {
+		Precision   int        `inject:"config:form.geo.precision"`
+		BoundingBox config.Map `inject:"config:form.geo.boundingBox"`
+	}{
+		Precision: 4,
+		BoundingBox: config.Map{
+			"minLat": 47.27,
+			"maxLat": 55.06,
+			"minLng": 5.87,
+			"maxLng": 15.04,
+		},
+	})
+
+	t.structLevel = &mocks.StructLevel{}
+}
+
+func (t *ValidatorTestSuite) TearDownTest() {
+	t.structLevel.AssertExpectations(t.T())
+}
+
+func (t *ValidatorTestSuite) TestInject_InvalidBoundingBox() {
+	t.Panics(func() {
+		(&Validator{}).Inject(&struct {
+			Precision   int        `inject:"config:form.geo.precision"`
+			BoundingBox config.Map `inject:"config:form.geo.boundingBox"`
+		}{
+			BoundingBox: config.Map{
+				"minLat": 55.06,
+				"maxLat": 47.27,
+			},
+		})
+	})
+}
+
+func (t *ValidatorTestSuite) TestStructType() {
+	t.Equal(Coordinates{}, t.validator.StructType())
+}
+
+func (t *ValidatorTestSuite) TestValidateStruct_Valid() {
+	for _, coordinates := range []Coordinates{
+		{},
+		{Latitude: "52.5200", Longitude: "13.405"},
+		{Latitude: "wrong", Longitude: "13.405"},
+	} {
+		structLevel := &mocks.StructLevel{}
+		structLevel.On("Current").Return(reflect.ValueOf(coordinates)).Once()
+
+		t.validator.ValidateStruct(t.context, structLevel)
+		structLevel.AssertExpectations(t.T())
+	}
+}
+
+func (t *ValidatorTestSuite) TestValidateStruct_Incomplete() {
+	t.structLevel.On("Current").Return(reflect.ValueOf(Coordinates{Longitude: "13.405"})).Once()
+	t.structLevel.On("ReportError", "", "Latitude", "Latitude", "required", "").Once()
+
+	t.validator.ValidateStruct(t.context, t.structLevel)
+}
+
+func (t *ValidatorTestSuite) TestValidateStruct_Precision() {
+	t.structLevel.On("Current").Return(reflect.ValueOf(Coordinates{Latitude: "52.52001", Longitude: "13.40495"})).Once()
+	t.structLevel.On("ReportError", "52.52001", "Latitude", "Latitude", "precision", "4").Once()
+	t.structLevel.On("ReportError", "13.40495", "Longitude", "Longitude", "precision", "4").Once()
+
+	t.validator.ValidateStruct(t.context, t.structLevel)
+}
+
+func (t *ValidatorTestSuite) TestValidateStruct_BoundingBox() {
+	t.structLevel.On("Current").Return(reflect.ValueOf(Coordinates{Latitude: "48.8566", Longitude: "2.3522"})).Once()
+	t.structLevel.On("ReportError", "48.8566", "Latitude", "Latitude", "boundingbox", "").Once()
+
+	t.validator.ValidateStruct(t.context, t.structLevel)
+}
+
+func (t *ValidatorTestSuite) TestLatLng() {
+	lat, lng, ok := Coordinates{Latitude: "52.52", Longitude: "-13.405"}.LatLng()
+	t.True(ok)
+	t.Equal(52.52, lat)
+	t.Equal(-13.405, lng)
+
+	_, _, ok = Coordinates{Latitude: "52.52"}.LatLng()
+	t.False(ok)
+}
+
+func (t *ValidatorTestSuite) TestBoundingBoxContains() {
+	box := BoundingBox{MinLatitude: -20, MaxLatitude: 0, MinLongitude: 170, MaxLongitude: -170}
+
+	t.True(box.Contains(-10, 175))
+	t.True(box.Contains(-10, -175))
+	t.True(box.Contains(0, 170))
+	t.False(box.Contains(-10, 0))
+	t.False(box.Contains(10, 175))
+}
+
+func (t *CombinedInputTestSuite) TestTransformValues() {
+	values := url.Values{
+		"location": {"52.52, 13.405"},
+		"radius":   {"10"},
+	}
+
+	transformed, err := CombinedInput("location").TransformValues(context.Background(), nil, values)
+	t.NoError(err)
+	t.Equal(url.Values{
+		"location.lat": {"52.52"},
+		"location.lng": {"13.405"},
+		"radius":       {"10"},
+	}, transformed)
+	t.Equal([]string{"52.52, 13.405"}, values["location"])
+}
+
+func (t *CombinedInputTestSuite) TestTransformValues_SeparateInputs() {
+	transformed, err := CombinedInput("location").TransformValues(context.Background(), nil, url.Values{
+		"location":     {"52.52"},
+		"location.lng": {"13.405"},
+	})
+	t.NoError(err)
+	t.Equal(url.Values{
+		"location.lat": {"52.52"},
+		"location.lng": {"13.405"},
+	}, transformed)
+
+	values := url.Values{
+		"radius": {"10"},
+	}
+	transformed, err = CombinedInput("location").TransformValues(context.Background(), nil, values)
+	t.NoError(err)
+	t.Equal(values, transformed)
+}
@@ -0,0 +1,86 @@
+package geo
+
+import (
+	"context"
+	"strconv"
+
+	validator "gopkg.in/go-playground/validator.v9"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// Location defines reusable sub form of consented geolocation (like "use my location" of store locator), which
+	// groups consent flag with coordinates and accuracy (in meters) reported by the browser. Coordinates and accuracy
+	// depend on consent, so LocationValidator accepts them only if consent is given.
+	//
+	// Data struct {
+	//	 Location geo.Location `form:"location"`
+	// }
+	Location struct {
+		Consent     bool        `form:"consent"`
+		Coordinates Coordinates `form:"coords"`
+		Accuracy    string      `form:"accuracy" validate:"omitempty,numeric" conform:"trim"`
+	}
+
+	// LocationValidator defines struct validator of Location, which validates that coordinates and accuracy are
+	// submitted only together with consent, and that accuracy is submitted only together with coordinates
+	LocationValidator struct{}
+)
+
+var _ domain.StructValidator = &LocationValidator{}
+
+// IsEmpty returns if neither consent, nor coordinates or accuracy are submitted
+func (l Location) IsEmpty() bool {
+	return !l.Consent && l.Coordinates.IsEmpty() && l.Accuracy == ""
+}
+
+// LatLng returns coordinates as numbers. It returns false if consent is not given, or if coordinates are
+// not submitted or not numbers.
+func (l Location) LatLng() (float64, float64, bool) {
+	if !l.Consent {
+		return 0, 0, false
+	}
+
+	return l.Coordinates.LatLng()
+}
+
+// StructType defines Location as type validated by this validator
+func (v *LocationValidator) StructType() interface{} {
+	return Location{}
+}
+
+// ValidateStruct validates dependencies of coordinates and accuracy on consent. Coordinates themselves are validated
+// by Validator. Location without consent is valid, if it contains no coordinates, so consent needs to be required
+// by `validate:"required"` tag of the Consent field in own sub form, if it's mandatory.
+func (v *LocationValidator) ValidateStruct(_ context.Context, sl validator.StructLevel) {
+	location, ok := sl.Current().Interface().(Location)
+	if !ok {
+		return
+	}
+
+	if !location.Consent {
+		if !location.Coordinates.IsEmpty() {
+			sl.ReportError(location.Coordinates, "Coordinates", "Coordinates", "geoconsent", "")
+		}
+
+		if location.Accuracy != "" {
+			sl.ReportError(location.Accuracy, "Accuracy", "Accuracy", "geoconsent", "")
+		}
+
+		return
+	}
+
+	if location.Accuracy == "" {
+		return
+	}
+
+	if location.Coordinates.IsEmpty() {
+		sl.ReportError(location.Accuracy, "Accuracy", "Accuracy", "required_with", "Coordinates")
+		return
+	}
+
+	if accuracy, err := strconv.ParseFloat(location.Accuracy, 64); err == nil && accuracy < 0 {
+		sl.ReportError(location.Accuracy, "Accuracy", "Accuracy", "min", "0")
+	}
+}
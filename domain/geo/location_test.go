@@ -0,0 +1,101 @@
+package geo
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	LocationValidatorTestSuite struct {
+		suite.Suite
+
+		validator   *LocationValidator
+		structLevel *mocks.StructLevel
+
+		context context.Context
+	}
+)
+
+func TestLocationValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &LocationValidatorTestSuite{})
+}
+
+func (t *LocationValidatorTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *LocationValidatorTestSuite) SetupTest() {
+	t.validator = &LocationValidator{}
+	t.structLevel = &mocks.StructLevel{}
+}
+
+func (t *LocationValidatorTestSuite) TearDownTest() {
+	t.structLevel.AssertExpectations(t.T())
+}
+
+func (t *LocationValidatorTestSuite) TestStructType() {
+	t.Equal(Location{}, t.validator.StructType())
+}
+
+func (t *LocationValidatorTestSuite) TestValidateStruct_Valid() {
+	for _, location := range []Location{
+		{},
+		{Consent: true},
+		{Consent: true, Coordinates: Coordinates{Latitude: "52.52", Longitude: "13.405"}},
+		{Consent: true, Coordinates: Coordinates{Latitude: "52.52", Longitude: "13.405"}, Accuracy: "25.5"},
+	} {
+		structLevel := &mocks.StructLevel{}
+		structLevel.On("Current").Return(reflect.ValueOf(location)).Once()
+
+		t.validator.ValidateStruct(t.context, structLevel)
+		structLevel.AssertExpectations(t.T())
+	}
+}
+
+func (t *LocationValidatorTestSuite) TestValidateStruct_WithoutConsent() {
+	coordinates := Coordinates{Latitude: "52.52", Longitude: "13.405"}
+	t.structLevel.On("Current").Return(reflect.ValueOf(Location{Coordinates: coordinates, Accuracy: "10"})).Once()
+	t.structLevel.On("ReportError", coordinates, "Coordinates", "Coordinates", "geoconsent", "").Once()
+	t.structLevel.On("ReportError", "10", "Accuracy", "Accuracy", "geoconsent", "").Once()
+
+	t.validator.ValidateStruct(t.context, t.structLevel)
+}
+
+func (t *LocationValidatorTestSuite) TestValidateStruct_AccuracyWithoutCoordinates() {
+	t.structLevel.On("Current").Return(reflect.ValueOf(Location{Consent: true, Accuracy: "10"})).Once()
+	t.structLevel.On("ReportError", "10", "Accuracy", "Accuracy", "required_with", "Coordinates").Once()
+
+	t.validator.ValidateStruct(t.context, t.structLevel)
+}
+
+func (t *LocationValidatorTestSuite) TestValidateStruct_NegativeAccuracy() {
+	location := Location{Consent: true, Coordinates: Coordinates{Latitude: "52.52", Longitude: "13.405"}, Accuracy: "-1"}
+	t.structLevel.On("Current").Return(reflect.ValueOf(location)).Once()
+	t.structLevel.On("ReportError", "-1", "Accuracy", "Accuracy", "min", "0").Once()
+
+	t.validator.ValidateStruct(t.context, t.structLevel)
+}
+
+func (t *LocationValidatorTestSuite) TestIsEmpty() {
+	t.True(Location{}.IsEmpty())
+	t.False(Location{Consent: true}.IsEmpty())
+	t.False(Location{Accuracy: "10"}.IsEmpty())
+	t.False(Location{Coordinates: Coordinates{Latitude: "52.52"}}.IsEmpty())
+}
+
+func (t *LocationValidatorTestSuite) TestLatLng() {
+	coordinates := Coordinates{Latitude: "52.52", Longitude: "13.405"}
+
+	lat, lng, ok := Location{Consent: true, Coordinates: coordinates}.LatLng()
+	t.True(ok)
+	t.Equal(52.52, lat)
+	t.Equal(13.405, lng)
+
+	_, _, ok = Location{Coordinates: coordinates}.LatLng()
+	t.False(ok)
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"html"
+	"html/template"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// HTMLAttributes maps validation rules of single field to HTML5 input attributes ("required", "min", "max",
+// "minlength", "maxlength", "pattern" and "type" of email and url fields), so server-defined rules are rendered
+// as client-side constraints. Boolean attributes have empty value. Rules without HTML5 counterpart (like exclusive
+// comparisons or cross-field rules) are skipped, as they are validated by server anyway. Rules after "dive" are
+// skipped as well, as they apply to elements of the field.
+func HTMLAttributes(rules []ValidationRule) map[string]string {
+	attributes := map[string]string{}
+
+	for _, rule := range rules {
+		switch rule.Name {
+		case "dive":
+			return attributes
+		case "required":
+			attributes["required"] = ""
+		case "email":
+			attributes["type"] = "email"
+		case "url":
+			attributes["type"] = "url"
+		case "pattern":
+			// HTML pattern has to match whole value, while pattern rule matches any part of it
+			if pattern := rule.Meta["jsPattern"]; pattern != "" {
+				attributes["pattern"] = `[\s\S]*(?:` + pattern + `)[\s\S]*`
+			}
+		case "min", "max", "len", "gt", "gte", "lt", "lte":
+			addComparisonAttributes(attributes, rule)
+		}
+	}
+
+	return attributes
+}
+
+// RenderHTMLAttributes renders attributes sorted by name, with escaped values, ready to be placed into input tag
+func RenderHTMLAttributes(attributes map[string]string) template.HTMLAttr {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rendered := make([]string, 0, len(names))
+	for _, name := range names {
+		if attributes[name] == "" {
+			rendered = append(rendered, html.EscapeString(name))
+			continue
+		}
+		rendered = append(rendered, html.EscapeString(name)+`="`+html.EscapeString(attributes[name])+`"`)
+	}
+
+	return template.HTMLAttr(strings.Join(rendered, " "))
+}
+
+// addComparisonAttributes maps comparison rule to attributes of numeric values or lengths. Exclusive bounds of lengths
+// are converted into inclusive ones, while exclusive bounds of numbers and comparisons of times are skipped.
+func addComparisonAttributes(attributes map[string]string, rule ValidationRule) {
+	switch rule.ValueType {
+	case RuleValueTypeNumber:
+		switch rule.Name {
+		case "min", "gte":
+			attributes["min"] = rule.Value
+		case "max", "lte":
+			attributes["max"] = rule.Value
+		case "len":
+			attributes["min"] = rule.Value
+			attributes["max"] = rule.Value
+		}
+	case RuleValueTypeLength:
+		length, err := strconv.ParseUint(rule.Value, 10, 64)
+		if err != nil {
+			return
+		}
+
+		switch rule.Name {
+		case "min", "gte":
+			attributes["minlength"] = rule.Value
+		case "max", "lte":
+			attributes["maxlength"] = rule.Value
+		case "len":
+			attributes["minlength"] = rule.Value
+			attributes["maxlength"] = rule.Value
+		case "gt":
+			attributes["minlength"] = strconv.FormatUint(length+1, 10)
+		case "lt":
+			if length > 0 {
+				attributes["maxlength"] = strconv.FormatUint(length-1, 10)
+			}
+		}
+	}
+}
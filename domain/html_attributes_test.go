@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"html/template"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	HTMLAttributesTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestHTMLAttributesTestSuite(t *testing.T) {
+	suite.Run(t, &HTMLAttributesTestSuite{})
+}
+
+func (t *HTMLAttributesTestSuite) TestHTMLAttributes() {
+	testCases := []struct {
+		rules      []ValidationRule
+		attributes map[string]string
+	}{
+		{
+			rules:      nil,
+			attributes: map[string]string{},
+		},
+		{
+			rules: []ValidationRule{
+				{Name: "required"},
+				{Name: "email"},
+				{Name: "max", Value: "64", ValueType: RuleValueTypeLength},
+			},
+			attributes: map[string]string{"required": "", "type": "email", "maxlength": "64"},
+		},
+		{
+			rules: []ValidationRule{
+				{Name: "url"},
+				{Name: "len", Value: "10", ValueType: RuleValueTypeLength},
+			},
+			attributes: map[string]string{"type": "url", "minlength": "10", "maxlength": "10"},
+		},
+		{
+			rules: []ValidationRule{
+				{Name: "gt", Value: "2", ValueType: RuleValueTypeLength},
+				{Name: "lt", Value: "8", ValueType: RuleValueTypeLength},
+			},
+			attributes: map[string]string{"minlength": "3", "maxlength": "7"},
+		},
+		{
+			rules: []ValidationRule{
+				{Name: "gte", Value: "18", ValueType: RuleValueTypeNumber},
+				{Name: "lte", Value: "99.5", ValueType: RuleValueTypeNumber},
+			},
+			attributes: map[string]string{"min": "18", "max": "99.5"},
+		},
+		{
+			rules: []ValidationRule{
+				{Name: "gt", Value: "0", ValueType: RuleValueTypeNumber},
+				{Name: "lt", Value: "0", ValueType: RuleValueTypeLength},
+				{Name: "gt", ValueType: RuleValueTypeTime},
+				{Name: "eqfield", Value: "password"},
+			},
+			attributes: map[string]string{},
+		},
+		{
+			rules: []ValidationRule{
+				{Name: "pattern", Value: `^\d+$`, Meta: map[string]string{"jsPattern": `^\d+$`, "jsFlags": "u"}},
+			},
+			attributes: map[string]string{"pattern": `[\s\S]*(?:^\d+$)[\s\S]*`},
+		},
+		{
+			rules: []ValidationRule{
+				{Name: "required"},
+				{Name: "dive"},
+				{Name: "max", Value: "5", ValueType: RuleValueTypeLength},
+			},
+			attributes: map[string]string{"required": ""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Equal(testCase.attributes, HTMLAttributes(testCase.rules))
+	}
+}
+
+func (t *HTMLAttributesTestSuite) TestRenderHTMLAttributes() {
+	t.Equal(template.HTMLAttr(""), RenderHTMLAttributes(nil))
+	t.Equal(
+		template.HTMLAttr(`maxlength="64" pattern="a&#34;b" required`),
+		RenderHTMLAttributes(map[string]string{"required": "", "maxlength": "64", "pattern": `a"b`}),
+	)
+}
+
+func (t *HTMLAttributesTestSuite) TestForm() {
+	form := NewForm(false, map[string][]ValidationRule{
+		"email": {
+			{Name: "required"},
+			{Name: "email"},
+		},
+	})
+
+	t.Equal(map[string]string{"required": "", "type": "email"}, form.GetHTMLAttributesForField("email"))
+	t.Equal(template.HTMLAttr(`required type="email"`), form.RenderHTMLAttributesForField("email"))
+	t.Equal(map[string]string{}, form.GetHTMLAttributesForField("unknown"))
+	t.Equal(template.HTMLAttr(""), form.RenderHTMLAttributesForField("unknown"))
+}
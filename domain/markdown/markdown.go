@@ -0,0 +1,127 @@
+package markdown
+
+import (
+	"context"
+	"html"
+	"html/template"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	validator "gopkg.in/go-playground/validator.v9"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// Text defines form field of user-generated markdown content (like comment or product review). Text is sanitized
+	// while it's decoded, so it never contains raw HTML. Form handler attaches rendered HTML preview of all markdown
+	// fields to the form, which is available in templates via domain.Form.GetMarkdownPreview.
+	//
+	// Data struct {
+	//	 Comment markdown.Text `form:"comment" validate:"required,markdownmax=2000"`
+	// }
+	Text string
+
+	// Renderer defines rendering of markdown into HTML. Rendered HTML must be safe to be embedded into pages,
+	// so renderer is responsible for escaping of all text.
+	Renderer interface {
+		// Render returns HTML of markdown text
+		Render(ctx context.Context, text Text) (template.HTML, error)
+	}
+
+	// MaxLengthValidator defines field validator of markdown fields, which limits length of rendered text,
+	// so markup doesn't count into length. If markdown can't be rendered, length of the markdown itself is used.
+	//
+	// Data struct {
+	//	 Comment markdown.Text `validate:"markdownmax=2000"`
+	// }
+	//
+	MaxLengthValidator struct {
+		renderer Renderer
+	}
+)
+
+var (
+	_ domain.FieldValidator = &MaxLengthValidator{}
+	_ domain.RuleDescriber  = &MaxLengthValidator{}
+
+	// htmlCommentRegex matches HTML comments
+	htmlCommentRegex = regexp.MustCompile(`<!--[\s\S]*?(-->|$)`)
+	// htmlTagRegex matches opening and closing HTML tags, but not autolinks like <https://example.com>
+	htmlTagRegex = regexp.MustCompile(`</?[a-zA-Z][a-zA-Z0-9-]*(\s[^>]*)?/?>`)
+	// newlineReplacer normalizes line endings
+	newlineReplacer = strings.NewReplacer("\r\n", "\n", "\r", "\n")
+)
+
+// Sanitize returns markdown without raw HTML and control characters, with normalized line endings
+func Sanitize(text string) string {
+	text = newlineReplacer.Replace(text)
+	text = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) && r != '\n' && r != '\t' {
+			return -1
+		}
+		return r
+	}, text)
+	text = htmlCommentRegex.ReplaceAllString(text, "")
+	text = htmlTagRegex.ReplaceAllString(text, "")
+
+	return strings.TrimSpace(text)
+}
+
+// TextLength returns number of characters of text of rendered HTML, without tags and with collapsed white spaces
+func TextLength(rendered template.HTML) int {
+	text := html.UnescapeString(htmlTagRegex.ReplaceAllString(string(rendered), ""))
+
+	return utf8.RuneCountInString(strings.Join(strings.Fields(text), " "))
+}
+
+// Inject is method used to set all dependencies as local variables
+func (v *MaxLengthValidator) Inject(renderer Renderer) {
+	v.renderer = renderer
+}
+
+// ValidatorName defines tag name of markdown length validator
+func (v *MaxLengthValidator) ValidatorName() string {
+	return "markdownmax"
+}
+
+// DescribeRule returns description of markdown length rule
+func (v *MaxLengthValidator) DescribeRule() domain.RuleDescription {
+	return domain.RuleDescription{
+		Name:        v.ValidatorName(),
+		Description: "markdown which rendered text is not longer than desired number of characters",
+		Params: map[string]interface{}{
+			"type":    "integer",
+			"minimum": 0,
+		},
+	}
+}
+
+// ValidateField validates that rendered text of markdown is not longer than parameter. Valid if string is empty.
+func (v *MaxLengthValidator) ValidateField(ctx context.Context, fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	text := field.String()
+	if text == "" {
+		return true
+	}
+
+	maxLength, err := strconv.Atoi(fl.Param())
+	if err != nil {
+		panic(err.Error())
+	}
+
+	rendered, err := v.renderer.Render(ctx, Text(text))
+	if err != nil {
+		return utf8.RuneCountInString(text) <= maxLength
+	}
+
+	return TextLength(rendered) <= maxLength
+}
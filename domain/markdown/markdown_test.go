@@ -0,0 +1,142 @@
+package markdown
+
+import (
+	"context"
+	"errors"
+	"html/template"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	MarkdownTestSuite struct {
+		suite.Suite
+	}
+
+	MaxLengthValidatorTestSuite struct {
+		suite.Suite
+
+		validator *MaxLengthValidator
+		renderer  *markdownTestRenderer
+	}
+
+	markdownTestRenderer struct {
+		rendered template.HTML
+		err      error
+	}
+)
+
+func (r *markdownTestRenderer) Render(context.Context, Text) (template.HTML, error) {
+	return r.rendered, r.err
+}
+
+func TestMarkdownTestSuite(t *testing.T) {
+	suite.Run(t, &MarkdownTestSuite{})
+}
+
+func TestMaxLengthValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &MaxLengthValidatorTestSuite{})
+}
+
+func (t *MarkdownTestSuite) TestSanitize() {
+	t.Equal("", Sanitize("  "))
+	t.Equal("**bold** text", Sanitize("**bold** <b>text</b>"))
+	t.Equal("first\nsecond\nthird", Sanitize("first\r\nsecond\rthird\n"))
+	t.Equal("alert(1) and more", Sanitize("<script type=\"text/javascript\">alert(1)</script> and<!-- hidden --> more"))
+	t.Equal("link <https://example.com> and 1 < 2", Sanitize("link <https://example.com> and 1 < 2"))
+	t.Equal("tab\tand bell", Sanitize("tab\tand \abell"))
+}
+
+func (t *MarkdownTestSuite) TestTextLength() {
+	t.Equal(0, TextLength(""))
+	t.Equal(9, TextLength("<p><strong>bold</strong> text</p>\n"))
+	t.Equal(10, TextLength("<p>a &amp; b</p>\n<ul>\n<li>item</li>\n</ul>\n"))
+	t.Equal(5, TextLength(`<p><a href="https://example.com" rel="nofollow">link</a>!</p>`))
+}
+
+func (t *MaxLengthValidatorTestSuite) SetupTest() {
+	t.renderer = &markdownTestRenderer{}
+	t.validator = &MaxLengthValidator{}
+	t.validator.Inject(t.renderer)
+}
+
+func (t *MaxLengthValidatorTestSuite) TestValidatorName() {
+	t.Equal("markdownmax", t.validator.ValidatorName())
+}
+
+func (t *MaxLengthValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "markdownmax",
+		Description: "markdown which rendered text is not longer than desired number of characters",
+		Params: map[string]interface{}{
+			"type":    "integer",
+			"minimum": 0,
+		},
+	}, t.validator.DescribeRule())
+}
+
+func (t *MaxLengthValidatorTestSuite) TestValidateField() {
+	testCases := []struct {
+		Text     interface{}
+		Rendered template.HTML
+		Err      error
+		Result   bool
+	}{
+		{
+			Text:   "",
+			Result: true,
+		},
+		{
+			Text:     "**bold** text",
+			Rendered: "<p><strong>bold</strong> text</p>",
+			Result:   true,
+		},
+		{
+			Text:     "**bold** longer text",
+			Rendered: "<p><strong>bold</strong> longer text</p>",
+			Result:   false,
+		},
+		{
+			Text:   "**bold**",
+			Err:    errors.New("error"),
+			Result: true,
+		},
+		{
+			Text:   "**bold** text",
+			Err:    errors.New("error"),
+			Result: false,
+		},
+		{
+			Text:   10,
+			Result: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.renderer.rendered = testCase.Rendered
+		t.renderer.err = testCase.Err
+
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Field").Return(reflect.ValueOf(testCase.Text)).Once()
+		if text, ok := testCase.Text.(string); ok && text != "" {
+			fieldLevel.On("Param").Return("10").Once()
+		}
+		t.Equal(testCase.Result, t.validator.ValidateField(context.Background(), fieldLevel), testCase.Text)
+		fieldLevel.AssertExpectations(t.T())
+	}
+}
+
+func (t *MaxLengthValidatorTestSuite) TestValidateField_InvalidParam() {
+	fieldLevel := &mocks.FieldLevel{}
+	fieldLevel.On("Field").Return(reflect.ValueOf("text")).Once()
+	fieldLevel.On("Param").Return("wrong").Once()
+
+	t.Panics(func() {
+		t.validator.ValidateField(context.Background(), fieldLevel)
+	})
+}
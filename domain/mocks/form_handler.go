@@ -39,6 +39,20 @@ func (_m *FormHandler) HandleForm(ctx context.Context, req *web.Request) (*domai
 	return r0, r1
 }
 
+// HandleFormResult provides a mock function with given fields: ctx, req
+func (_m *FormHandler) HandleFormResult(ctx context.Context, req *web.Request) domain.FormResult {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.FormResult
+	if rf, ok := ret.Get(0).(func(context.Context, *web.Request) domain.FormResult); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.FormResult)
+	}
+
+	return r0
+}
+
 // HandleSubmittedForm provides a mock function with given fields: ctx, req
 func (_m *FormHandler) HandleSubmittedForm(ctx context.Context, req *web.Request) (*domain.Form, error) {
 	ret := _m.Called(ctx, req)
@@ -62,6 +76,29 @@ func (_m *FormHandler) HandleSubmittedForm(ctx context.Context, req *web.Request
 	return r0, r1
 }
 
+// HandleSubmittedFormDryRun provides a mock function with given fields: ctx, req
+func (_m *FormHandler) HandleSubmittedFormDryRun(ctx context.Context, req *web.Request) (*domain.Form, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *domain.Form
+	if rf, ok := ret.Get(0).(func(context.Context, *web.Request) *domain.Form); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Form)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *web.Request) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // HandleSubmittedGETForm provides a mock function with given fields: ctx, req
 func (_m *FormHandler) HandleSubmittedGETForm(ctx context.Context, req *web.Request) (*domain.Form, error) {
 	ret := _m.Called(ctx, req)
@@ -0,0 +1,159 @@
+package pagination
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	validator "gopkg.in/go-playground/validator.v9"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// Pagination defines reusable pagination sub form, which can be embedded into any GET form data.
+	// Page size is limited by validation rule, which is exported with other validation rules of the form,
+	// so frontends know the limits.
+	//
+	// Data struct {
+	//	 Pagination pagination.Pagination `form:"pagination"`
+	// }
+	Pagination struct {
+		Page     int `form:"page" default:"1" validate:"omitempty,min=1"`
+		PageSize int `form:"pageSize" default:"20" validate:"omitempty,min=1,max=100"`
+	}
+
+	// Sort defines reusable sorting sub form, which can be embedded into any GET form data.
+	// Sortable fields are whitelisted by `sort:"field1,field2"` tag of the embedding field. Whitelist is exported
+	// as "oneof" validation rule of the sort field, so frontends know the options.
+	//
+	// Data struct {
+	//	 Sort pagination.Sort `form:"sort" sort:"name,price,date"`
+	// }
+	Sort struct {
+		Field     string `form:"field" conform:"trim"`
+		Direction string `form:"direction" default:"asc" validate:"omitempty,oneof=asc desc" conform:"trim,lower"`
+	}
+
+	// SortValidator defines struct validator of Sort, which checks sort field against whitelist of the embedding
+	// field. If there is no whitelist, no sort field is allowed.
+	SortValidator struct{}
+)
+
+const (
+	// DefaultPageSize defines page size used when page size is not submitted
+	DefaultPageSize = 20
+	// MaxPageSize defines maximal allowed page size
+	MaxPageSize = 100
+	// SortTag defines name of the struct tag which contains whitelist of sortable fields
+	SortTag = "sort"
+)
+
+var (
+	_ domain.StructValidator = &SortValidator{}
+
+	sortType = reflect.TypeOf(Sort{})
+)
+
+// CurrentPage returns submitted page, or first page if it's not submitted
+func (p Pagination) CurrentPage() int {
+	if p.Page < 1 {
+		return 1
+	}
+
+	return p.Page
+}
+
+// Limit returns submitted page size, or default page size if it's not submitted
+func (p Pagination) Limit() int {
+	if p.PageSize < 1 {
+		return DefaultPageSize
+	}
+
+	if p.PageSize > MaxPageSize {
+		return MaxPageSize
+	}
+
+	return p.PageSize
+}
+
+// Offset returns number of items before current page
+func (p Pagination) Offset() int {
+	return (p.CurrentPage() - 1) * p.Limit()
+}
+
+// PageCount returns number of pages needed for total number of items
+func (p Pagination) PageCount(total int) int {
+	if total < 1 {
+		return 0
+	}
+
+	return (total + p.Limit() - 1) / p.Limit()
+}
+
+// IsDescending defines if sorting is in descending direction
+func (s Sort) IsDescending() bool {
+	return s.Direction == "desc"
+}
+
+// SortFields returns whitelist of sortable fields defined by `sort:"field1,field2"` tag of struct field
+func SortFields(field reflect.StructField) []string {
+	var fields []string
+
+	for _, name := range strings.Split(field.Tag.Get(SortTag), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			fields = append(fields, name)
+		}
+	}
+
+	return fields
+}
+
+// StructType defines Sort as type validated by this validator
+func (v *SortValidator) StructType() interface{} {
+	return Sort{}
+}
+
+// ValidateStruct validates that sort field is whitelisted by embedding field
+func (v *SortValidator) ValidateStruct(_ context.Context, sl validator.StructLevel) {
+	sort, ok := sl.Current().Interface().(Sort)
+	if !ok || sort.Field == "" {
+		return
+	}
+
+	fields := parentSortFields(sl.Parent())
+	for _, field := range fields {
+		if field == sort.Field {
+			return
+		}
+	}
+
+	sl.ReportError(sort.Field, "Field", "Field", "oneof", strings.Join(fields, " "))
+}
+
+// parentSortFields returns whitelist of sortable fields of the first field of type Sort in parent struct
+func parentSortFields(parent reflect.Value) []string {
+	for parent.Kind() == reflect.Ptr && !parent.IsNil() {
+		parent = parent.Elem()
+	}
+
+	if parent.Kind() != reflect.Struct {
+		return nil
+	}
+
+	typeOf := parent.Type()
+	for i := 0; i < typeOf.NumField(); i++ {
+		fieldType := typeOf.Field(i)
+
+		fieldTypeOf := fieldType.Type
+		if fieldTypeOf.Kind() == reflect.Ptr {
+			fieldTypeOf = fieldTypeOf.Elem()
+		}
+
+		if fieldTypeOf == sortType {
+			return SortFields(fieldType)
+		}
+	}
+
+	return nil
+}
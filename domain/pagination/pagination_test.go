@@ -0,0 +1,128 @@
+package pagination
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	PaginationTestSuite struct {
+		suite.Suite
+	}
+
+	SortValidatorTestSuite struct {
+		suite.Suite
+
+		validator   *SortValidator
+		structLevel *mocks.StructLevel
+
+		context context.Context
+	}
+
+	sortTestData struct {
+		Query string
+		Sort  *Sort `form:"sort" sort:"name, price,"`
+	}
+)
+
+func TestPaginationTestSuite(t *testing.T) {
+	suite.Run(t, &PaginationTestSuite{})
+}
+
+func (t *PaginationTestSuite) TestCurrentPage() {
+	t.Equal(1, Pagination{}.CurrentPage())
+	t.Equal(1, Pagination{Page: -1}.CurrentPage())
+	t.Equal(3, Pagination{Page: 3}.CurrentPage())
+}
+
+func (t *PaginationTestSuite) TestLimit() {
+	t.Equal(DefaultPageSize, Pagination{}.Limit())
+	t.Equal(50, Pagination{PageSize: 50}.Limit())
+	t.Equal(MaxPageSize, Pagination{PageSize: 1000}.Limit())
+}
+
+func (t *PaginationTestSuite) TestOffset() {
+	t.Equal(0, Pagination{}.Offset())
+	t.Equal(100, Pagination{Page: 3, PageSize: 50}.Offset())
+}
+
+func (t *PaginationTestSuite) TestPageCount() {
+	t.Equal(0, Pagination{}.PageCount(0))
+	t.Equal(1, Pagination{PageSize: 10}.PageCount(10))
+	t.Equal(2, Pagination{PageSize: 10}.PageCount(11))
+}
+
+func (t *PaginationTestSuite) TestIsDescending() {
+	t.False(Sort{}.IsDescending())
+	t.False(Sort{Direction: "asc"}.IsDescending())
+	t.True(Sort{Direction: "desc"}.IsDescending())
+}
+
+func (t *PaginationTestSuite) TestSortFields() {
+	field, _ := reflect.TypeOf(sortTestData{}).FieldByName("Sort")
+	t.Equal([]string{"name", "price"}, SortFields(field))
+
+	field, _ = reflect.TypeOf(sortTestData{}).FieldByName("Query")
+	t.Nil(SortFields(field))
+}
+
+func TestSortValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &SortValidatorTestSuite{})
+}
+
+func (t *SortValidatorTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *SortValidatorTestSuite) SetupTest() {
+	t.validator = &SortValidator{}
+	t.structLevel = &mocks.StructLevel{}
+}
+
+func (t *SortValidatorTestSuite) TearDownTest() {
+	t.structLevel.AssertExpectations(t.T())
+}
+
+func (t *SortValidatorTestSuite) TestStructType() {
+	t.Equal(Sort{}, t.validator.StructType())
+}
+
+func (t *SortValidatorTestSuite) TestValidateStruct_Empty() {
+	t.structLevel.On("Current").Return(reflect.ValueOf(Sort{Direction: "asc"})).Once()
+
+	t.validator.ValidateStruct(t.context, t.structLevel)
+}
+
+func (t *SortValidatorTestSuite) TestValidateStruct_Whitelisted() {
+	sort := Sort{Field: "price"}
+
+	t.structLevel.On("Current").Return(reflect.ValueOf(sort)).Once()
+	t.structLevel.On("Parent").Return(reflect.ValueOf(&sortTestData{Sort: &sort})).Once()
+
+	t.validator.ValidateStruct(t.context, t.structLevel)
+}
+
+func (t *SortValidatorTestSuite) TestValidateStruct_NotWhitelisted() {
+	sort := Sort{Field: "password"}
+
+	t.structLevel.On("Current").Return(reflect.ValueOf(sort)).Once()
+	t.structLevel.On("Parent").Return(reflect.ValueOf(sortTestData{Sort: &sort})).Once()
+	t.structLevel.On("ReportError", "password", "Field", "Field", "oneof", "name price").Once()
+
+	t.validator.ValidateStruct(t.context, t.structLevel)
+}
+
+func (t *SortValidatorTestSuite) TestValidateStruct_NoWhitelist() {
+	sort := Sort{Field: "name"}
+
+	t.structLevel.On("Current").Return(reflect.ValueOf(sort)).Once()
+	t.structLevel.On("Parent").Return(reflect.ValueOf(sort)).Once()
+	t.structLevel.On("ReportError", "name", "Field", "Field", "oneof", "").Once()
+
+	t.validator.ValidateStruct(t.context, t.structLevel)
+}
@@ -0,0 +1,174 @@
+package password
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+
+	validator "gopkg.in/go-playground/validator.v9"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// HistoryStore defines project-provided store of previous passwords of the user. Store is responsible for
+	// identifying the user (like by session of the request in the context) and for comparing hashed passwords,
+	// so password hashes never leave the store.
+	HistoryStore interface {
+		// MatchesPrevious returns if password is equal to any of last count passwords of the user
+		MatchesPrevious(ctx context.Context, password string, count int) (bool, error)
+	}
+
+	// BreachCorpus defines corpus of passwords which are known from data breaches (like Have I Been Pwned)
+	BreachCorpus interface {
+		// IsBreached returns if password is found in breach corpus
+		IsBreached(ctx context.Context, password string) (bool, error)
+	}
+
+	// HistoryValidator defines field validator of new passwords, which must not be equal to previous passwords
+	// of the user. Number of previous passwords is defined by parameter, or by configuration.
+	// Errors of HistoryStore don't invalidate password, so forms stay usable while store is down.
+	//
+	// Data struct {
+	//	 Password string `validate:"required,min=8,passwordhistory=5"`
+	// }
+	//
+	HistoryValidator struct {
+		store  HistoryStore
+		count  int
+		logger flamingo.Logger
+	}
+
+	// BreachValidator defines field validator of new passwords, which must not be found in breach corpus.
+	// Errors of BreachCorpus don't invalidate password, so forms stay usable while breach service is down.
+	//
+	// Data struct {
+	//	 Password string `validate:"required,min=8,notbreached"`
+	// }
+	//
+	BreachValidator struct {
+		corpus BreachCorpus
+		logger flamingo.Logger
+	}
+)
+
+var (
+	_ domain.FieldValidator = &HistoryValidator{}
+	_ domain.RuleDescriber  = &HistoryValidator{}
+	_ domain.FieldValidator = &BreachValidator{}
+	_ domain.RuleDescriber  = &BreachValidator{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (v *HistoryValidator) Inject(
+	store HistoryStore,
+	logger flamingo.Logger,
+	cfg *struct {
+		Count int `inject:"config:form.password.history.count"`
+	},
+) {
+	v.store = store
+	v.logger = logger
+	if cfg != nil {
+		v.count = cfg.Count
+	}
+}
+
+// ValidatorName defines tag name of password history validator
+func (v *HistoryValidator) ValidatorName() string {
+	return "passwordhistory"
+}
+
+// DescribeRule returns description of password history rule
+func (v *HistoryValidator) DescribeRule() domain.RuleDescription {
+	return domain.RuleDescription{
+		Name:        v.ValidatorName(),
+		Description: "password which is not equal to desired number of previous passwords (default " + strconv.Itoa(v.count) + ")",
+		Params: map[string]interface{}{
+			"type":    "integer",
+			"minimum": 1,
+		},
+	}
+}
+
+// ValidateField validates that password is not equal to previous passwords. Valid if string is empty.
+func (v *HistoryValidator) ValidateField(ctx context.Context, fl validator.FieldLevel) bool {
+	password, ok := passwordOf(fl.Field())
+	if !ok {
+		return false
+	}
+
+	if password == "" {
+		return true
+	}
+
+	count := v.count
+	if param := fl.Param(); param != "" {
+		value, err := strconv.Atoi(param)
+		if err != nil {
+			panic(err.Error())
+		}
+		count = value
+	}
+
+	if count < 1 {
+		return true
+	}
+
+	matches, err := v.store.MatchesPrevious(ctx, password, count)
+	if err != nil {
+		v.logger.WithContext(ctx).WithField("FieldValidator", v.ValidatorName()).Warn("password history is not available: " + err.Error())
+		return true
+	}
+
+	return !matches
+}
+
+// Inject is method used to set all dependencies as local variables
+func (v *BreachValidator) Inject(corpus BreachCorpus, logger flamingo.Logger) {
+	v.corpus = corpus
+	v.logger = logger
+}
+
+// ValidatorName defines tag name of breach validator
+func (v *BreachValidator) ValidatorName() string {
+	return "notbreached"
+}
+
+// DescribeRule returns description of breach rule
+func (v *BreachValidator) DescribeRule() domain.RuleDescription {
+	return domain.RuleDescription{
+		Name:        v.ValidatorName(),
+		Description: "password which is not found in corpus of breached passwords",
+	}
+}
+
+// ValidateField validates that password is not found in breach corpus. Valid if string is empty.
+func (v *BreachValidator) ValidateField(ctx context.Context, fl validator.FieldLevel) bool {
+	password, ok := passwordOf(fl.Field())
+	if !ok {
+		return false
+	}
+
+	if password == "" {
+		return true
+	}
+
+	breached, err := v.corpus.IsBreached(ctx, password)
+	if err != nil {
+		v.logger.WithContext(ctx).WithField("FieldValidator", v.ValidatorName()).Warn("breach corpus is not available: " + err.Error())
+		return true
+	}
+
+	return !breached
+}
+
+// passwordOf returns password of the field. It returns false if field is not a string.
+func passwordOf(field reflect.Value) (string, bool) {
+	if field.Kind() != reflect.String {
+		return "", false
+	}
+
+	return field.String(), true
+}
@@ -0,0 +1,247 @@
+package password
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	HistoryValidatorTestSuite struct {
+		suite.Suite
+
+		validator *HistoryValidator
+		store     *passwordTestHistoryStore
+
+		context context.Context
+	}
+
+	BreachValidatorTestSuite struct {
+		suite.Suite
+
+		validator *BreachValidator
+		corpus    *passwordTestBreachCorpus
+
+		context context.Context
+	}
+
+	passwordTestHistoryStore struct {
+		previous []string
+		err      error
+		counts   []int
+	}
+
+	passwordTestBreachCorpus struct {
+		breached map[string]bool
+		err      error
+	}
+)
+
+func (s *passwordTestHistoryStore) MatchesPrevious(_ context.Context, password string, count int) (bool, error) {
+	s.counts = append(s.counts, count)
+	if s.err != nil {
+		return false, s.err
+	}
+
+	for i, previous := range s.previous {
+		if i < count && previous == password {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (c *passwordTestBreachCorpus) IsBreached(_ context.Context, password string) (bool, error) {
+	return c.breached[password], c.err
+}
+
+func TestHistoryValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &HistoryValidatorTestSuite{})
+}
+
+func TestBreachValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &BreachValidatorTestSuite{})
+}
+
+func (t *HistoryValidatorTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *HistoryValidatorTestSuite) SetupTest() {
+	t.store = &passwordTestHistoryStore{
+		previous: []string{"current", "previous", "ancient"},
+	}
+
+	t.validator = &HistoryValidator{}
+	t.validator.Inject(t.store, &flamingo.NullLogger{}, &struct {
+		Count int `inject:"config:form.password.history.count"`
+	}{
+		Count: 2,
+	})
+}
+
+func (t *HistoryValidatorTestSuite) TestValidatorName() {
+	t.Equal("passwordhistory", t.validator.ValidatorName())
+}
+
+func (t *HistoryValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "passwordhistory",
+		Description: "password which is not equal to desired number of previous passwords (default 2)",
+		Params: map[string]interface{}{
+			"type":    "integer",
+			"minimum": 1,
+		},
+	}, t.validator.DescribeRule())
+}
+
+func (t *HistoryValidatorTestSuite) TestValidateField() {
+	testCases := []struct {
+		Password string
+		Param    string
+		Result   bool
+	}{
+		{
+			Password: "new",
+			Param:    "",
+			Result:   true,
+		},
+		{
+			Password: "previous",
+			Param:    "",
+			Result:   false,
+		},
+		{
+			Password: "ancient",
+			Param:    "",
+			Result:   true,
+		},
+		{
+			Password: "ancient",
+			Param:    "3",
+			Result:   false,
+		},
+		{
+			Password: "current",
+			Param:    "0",
+			Result:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Field").Return(reflect.ValueOf(testCase.Password)).Once()
+		fieldLevel.On("Param").Return(testCase.Param).Once()
+		t.Equal(testCase.Result, t.validator.ValidateField(t.context, fieldLevel), testCase)
+		fieldLevel.AssertExpectations(t.T())
+	}
+
+	t.Equal([]int{2, 2, 2, 3}, t.store.counts)
+}
+
+func (t *HistoryValidatorTestSuite) TestValidateField_Empty() {
+	fieldLevel := &mocks.FieldLevel{}
+	fieldLevel.On("Field").Return(reflect.ValueOf("")).Once()
+	t.True(t.validator.ValidateField(t.context, fieldLevel))
+	t.Empty(t.store.counts)
+	fieldLevel.AssertExpectations(t.T())
+
+	fieldLevel = &mocks.FieldLevel{}
+	fieldLevel.On("Field").Return(reflect.ValueOf(10)).Once()
+	t.False(t.validator.ValidateField(t.context, fieldLevel))
+	fieldLevel.AssertExpectations(t.T())
+}
+
+func (t *HistoryValidatorTestSuite) TestValidateField_StoreError() {
+	t.store.err = errors.New("store is down")
+
+	fieldLevel := &mocks.FieldLevel{}
+	fieldLevel.On("Field").Return(reflect.ValueOf("previous")).Once()
+	fieldLevel.On("Param").Return("").Once()
+	t.True(t.validator.ValidateField(t.context, fieldLevel))
+	fieldLevel.AssertExpectations(t.T())
+}
+
+func (t *HistoryValidatorTestSuite) TestValidateField_InvalidParam() {
+	fieldLevel := &mocks.FieldLevel{}
+	fieldLevel.On("Field").Return(reflect.ValueOf("previous")).Once()
+	fieldLevel.On("Param").Return("many").Once()
+
+	t.Panics(func() {
+		t.validator.ValidateField(t.context, fieldLevel)
+	})
+}
+
+func (t *BreachValidatorTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *BreachValidatorTestSuite) SetupTest() {
+	t.corpus = &passwordTestBreachCorpus{
+		breached: map[string]bool{
+			"password123": true,
+		},
+	}
+
+	t.validator = &BreachValidator{}
+	t.validator.Inject(t.corpus, &flamingo.NullLogger{})
+}
+
+func (t *BreachValidatorTestSuite) TestValidatorName() {
+	t.Equal("notbreached", t.validator.ValidatorName())
+}
+
+func (t *BreachValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "notbreached",
+		Description: "password which is not found in corpus of breached passwords",
+	}, t.validator.DescribeRule())
+}
+
+func (t *BreachValidatorTestSuite) TestValidateField() {
+	testCases := []struct {
+		Password interface{}
+		Result   bool
+	}{
+		{
+			Password: "",
+			Result:   true,
+		},
+		{
+			Password: "correct horse battery staple",
+			Result:   true,
+		},
+		{
+			Password: "password123",
+			Result:   false,
+		},
+		{
+			Password: 10,
+			Result:   false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Field").Return(reflect.ValueOf(testCase.Password)).Once()
+		t.Equal(testCase.Result, t.validator.ValidateField(t.context, fieldLevel), testCase.Password)
+		fieldLevel.AssertExpectations(t.T())
+	}
+}
+
+func (t *BreachValidatorTestSuite) TestValidateField_CorpusError() {
+	t.corpus.err = errors.New("service is down")
+
+	fieldLevel := &mocks.FieldLevel{}
+	fieldLevel.On("Field").Return(reflect.ValueOf("password123")).Once()
+	t.True(t.validator.ValidateField(t.context, fieldLevel))
+	fieldLevel.AssertExpectations(t.T())
+}
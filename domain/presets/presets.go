@@ -0,0 +1,105 @@
+package presets
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// LoginFormData defines form data of login form preset
+	LoginFormData struct {
+		Email      string `form:"email" validate:"required,email" conform:"trim,lower"`
+		Password   string `form:"password" validate:"required"`
+		RememberMe bool   `form:"rememberMe"`
+	}
+
+	// RegistrationFormData defines form data of registration form preset
+	RegistrationFormData struct {
+		FirstName            string `form:"firstName" validate:"required,max=100" conform:"trim"`
+		LastName             string `form:"lastName" validate:"required,max=100" conform:"trim"`
+		Email                string `form:"email" validate:"required,email" conform:"trim,lower"`
+		Password             string `form:"password" validate:"required,min=8,max=128"`
+		PasswordConfirmation string `form:"passwordConfirmation" confirmfield:"Password"`
+	}
+
+	// PasswordResetRequestFormData defines form data of password reset request form preset,
+	// where user asks for password reset link
+	PasswordResetRequestFormData struct {
+		Email string `form:"email" validate:"required,email" conform:"trim,lower"`
+	}
+
+	// PasswordResetFormData defines form data of password reset form preset,
+	// where user sets new password by using token from password reset link
+	PasswordResetFormData struct {
+		Token                string `form:"token" validate:"required" conform:"trim"`
+		Password             string `form:"password" validate:"required,min=8,max=128"`
+		PasswordConfirmation string `form:"passwordConfirmation" confirmfield:"Password"`
+	}
+
+	// ContactFormData defines form data of contact form preset
+	ContactFormData struct {
+		Name    string `form:"name" validate:"required,max=100" conform:"trim"`
+		Email   string `form:"email" validate:"required,email" conform:"trim,lower"`
+		Subject string `form:"subject" validate:"max=200" conform:"trim"`
+		Message string `form:"message" validate:"required,max=5000" conform:"trim"`
+	}
+
+	// LoginFormService defines form service of login form preset, bound as "formService.login"
+	LoginFormService struct{}
+
+	// RegistrationFormService defines form service of registration form preset, bound as "formService.registration"
+	RegistrationFormService struct{}
+
+	// PasswordResetRequestFormService defines form service of password reset request form preset,
+	// bound as "formService.passwordResetRequest"
+	PasswordResetRequestFormService struct{}
+
+	// PasswordResetFormService defines form service of password reset form preset, bound as "formService.passwordReset"
+	PasswordResetFormService struct{}
+
+	// ContactFormService defines form service of contact form preset, bound as "formService.contact"
+	ContactFormService struct{}
+)
+
+var (
+	_ domain.FormDataProvider = &LoginFormService{}
+	_ domain.FormDataProvider = &RegistrationFormService{}
+	_ domain.FormDataProvider = &PasswordResetRequestFormService{}
+	_ domain.FormDataProvider = &PasswordResetFormService{}
+	_ domain.FormDataProvider = &ContactFormService{}
+)
+
+// GetFormData provides empty LoginFormData
+func (s *LoginFormService) GetFormData(context.Context, *web.Request) (interface{}, error) {
+	return LoginFormData{}, nil
+}
+
+// GetFormData provides empty RegistrationFormData
+func (s *RegistrationFormService) GetFormData(context.Context, *web.Request) (interface{}, error) {
+	return RegistrationFormData{}, nil
+}
+
+// GetFormData provides empty PasswordResetRequestFormData
+func (s *PasswordResetRequestFormService) GetFormData(context.Context, *web.Request) (interface{}, error) {
+	return PasswordResetRequestFormData{}, nil
+}
+
+// GetFormData provides PasswordResetFormData with token from the password reset link, if it's present in the query
+func (s *PasswordResetFormService) GetFormData(_ context.Context, req *web.Request) (interface{}, error) {
+	formData := PasswordResetFormData{}
+
+	if req != nil {
+		if token, err := req.Query1("token"); err == nil {
+			formData.Token = token
+		}
+	}
+
+	return formData, nil
+}
+
+// GetFormData provides empty ContactFormData
+func (s *ContactFormService) GetFormData(context.Context, *web.Request) (interface{}, error) {
+	return ContactFormData{}, nil
+}
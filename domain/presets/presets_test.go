@@ -0,0 +1,60 @@
+package presets
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+type (
+	PresetsTestSuite struct {
+		suite.Suite
+
+		context context.Context
+	}
+)
+
+func TestPresetsTestSuite(t *testing.T) {
+	suite.Run(t, &PresetsTestSuite{})
+}
+
+func (t *PresetsTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *PresetsTestSuite) TestGetFormData() {
+	request := web.CreateRequest(&http.Request{URL: &url.URL{}}, nil)
+
+	formData, err := (&LoginFormService{}).GetFormData(t.context, request)
+	t.NoError(err)
+	t.Equal(LoginFormData{}, formData)
+
+	formData, err = (&RegistrationFormService{}).GetFormData(t.context, request)
+	t.NoError(err)
+	t.Equal(RegistrationFormData{}, formData)
+
+	formData, err = (&PasswordResetRequestFormService{}).GetFormData(t.context, request)
+	t.NoError(err)
+	t.Equal(PasswordResetRequestFormData{}, formData)
+
+	formData, err = (&PasswordResetFormService{}).GetFormData(t.context, request)
+	t.NoError(err)
+	t.Equal(PasswordResetFormData{}, formData)
+
+	formData, err = (&ContactFormService{}).GetFormData(t.context, request)
+	t.NoError(err)
+	t.Equal(ContactFormData{}, formData)
+}
+
+func (t *PresetsTestSuite) TestGetFormData_PasswordResetToken() {
+	request := web.CreateRequest(&http.Request{URL: &url.URL{RawQuery: "token=abc123"}}, nil)
+
+	formData, err := (&PasswordResetFormService{}).GetFormData(t.context, request)
+	t.NoError(err)
+	t.Equal(PasswordResetFormData{Token: "abc123"}, formData)
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// RedactedValue is value retained or logged instead of values of sensitive form fields
+const RedactedValue = "[REDACTED]"
+
+var (
+	// fieldIndexRegex matches index and key segments of submitted value names (like "[0]" or "[home]")
+	fieldIndexRegex = regexp.MustCompile(`\[[^\]]*\]`)
+)
+
+// IsSensitiveField defines if submitted values of field must not be retained or logged, because field is sensitive
+// field of form data (like payment card data, passwords or encrypted fields) or its name contains "password".
+// Index and key segments of field name (like "[0]") are ignored.
+func (f Form) IsSensitiveField(name string) bool {
+	if f.SensitiveFields[fieldIndexRegex.ReplaceAllString(name, "")] {
+		return true
+	}
+
+	return strings.Contains(strings.ToLower(name), "password")
+}
+
+// RedactValues creates copy of submitted values, which can be safely retained or logged. Values of sensitive fields
+// of the form and of fields which names contain any of lower case redacted names are replaced by RedactedValue,
+// and card numbers in all other values are masked. Form may be nil, if values don't belong to any form.
+func RedactValues(values url.Values, form *Form, redactedNames []string) url.Values {
+	redacted := make(url.Values, len(values))
+
+	for name, fieldValues := range values {
+		sensitive := (form != nil && form.IsSensitiveField(name)) || isRedactedName(name, redactedNames)
+
+		redacted[name] = make([]string, len(fieldValues))
+		for i := range fieldValues {
+			if sensitive {
+				redacted[name][i] = RedactedValue
+			} else {
+				redacted[name][i] = MaskCardNumbers(fieldValues[i])
+			}
+		}
+	}
+
+	return redacted
+}
+
+// isRedactedName checks if field name contains any of lower case redacted names
+func isRedactedName(name string, redactedNames []string) bool {
+	name = strings.ToLower(name)
+
+	for _, redactedName := range redactedNames {
+		if strings.Contains(name, redactedName) {
+			return true
+		}
+	}
+
+	return false
+}
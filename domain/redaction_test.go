@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	RedactionTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestRedactionTestSuite(t *testing.T) {
+	suite.Run(t, &RedactionTestSuite{})
+}
+
+func (t *RedactionTestSuite) TestIsSensitiveField() {
+	form := NewForm(true, nil)
+	form.SensitiveFields = map[string]bool{"iban": true, "contacts.phone": true}
+
+	t.True(form.IsSensitiveField("iban"))
+	t.True(form.IsSensitiveField("contacts[0].phone"))
+	t.True(form.IsSensitiveField("account.newPassword"))
+	t.False(form.IsSensitiveField("contacts[0].name"))
+	t.False(NewForm(true, nil).IsSensitiveField("iban"))
+}
+
+func (t *RedactionTestSuite) TestRedactValues() {
+	form := NewForm(true, nil)
+	form.SensitiveFields = map[string]bool{"iban": true}
+
+	values := url.Values{
+		"email":    []string{"user@example.com"},
+		"iban":     []string{"DE89370400440532013000"},
+		"password": []string{"secret", "secret"},
+		"apiToken": []string{"token"},
+		"note":     []string{"card 4111 1111 1111 1111"},
+	}
+
+	t.Equal(url.Values{
+		"email":    []string{"user@example.com"},
+		"iban":     []string{RedactedValue},
+		"password": []string{RedactedValue, RedactedValue},
+		"apiToken": []string{RedactedValue},
+		"note":     []string{"card ************1111"},
+	}, RedactValues(values, &form, []string{"token"}))
+	t.Equal([]string{"DE89370400440532013000"}, values["iban"])
+
+	t.Equal(url.Values{
+		"iban": []string{"DE89370400440532013000"},
+		"note": []string{"card ************1111"},
+	}, RedactValues(url.Values{
+		"iban": []string{"DE89370400440532013000"},
+		"note": []string{"card 4111111111111111"},
+	}, nil, nil))
+}
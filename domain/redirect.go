@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"net/url"
+	"strings"
+)
+
+type (
+	// RedirectMapping defines redirect targets of a form per outcome of form handling, so controllers don't need
+	// to branch on each outcome. Outcomes without target (like not submitted form) are rendered by the controller.
+	RedirectMapping struct {
+		// Success target of valid submission
+		Success *RedirectTarget `json:"success"`
+		// Invalid target of submission with validation errors
+		Invalid *RedirectTarget `json:"invalid"`
+		// Error target of failed form handling
+		Error *RedirectTarget `json:"error"`
+	}
+
+	// RedirectTarget defines Flamingo route, which is target of redirect after form handling
+	RedirectTarget struct {
+		// Route name of the route
+		Route string `json:"route"`
+		// Params parameters of the route. Values in braces (like "{email}") reference form fields by their form names,
+		// and are replaced by values of form data.
+		Params map[string]string `json:"params"`
+	}
+)
+
+// Target returns redirect target mapped to state of handled form, or nil if there is no target for the state
+func (m RedirectMapping) Target(state FormState) *RedirectTarget {
+	switch state {
+	case FormStateValid:
+		return m.Success
+	case FormStateInvalid:
+		return m.Invalid
+	case FormStateFailed:
+		return m.Error
+	}
+
+	return nil
+}
+
+// ResolveParams returns parameters of the route, with references to form fields replaced by encoded values
+// of form data. References to missing form fields are replaced by empty string.
+func (t RedirectTarget) ResolveParams(values url.Values) map[string]string {
+	params := make(map[string]string, len(t.Params))
+	for name, value := range t.Params {
+		if strings.HasPrefix(value, "{") && strings.HasSuffix(value, "}") {
+			value = values.Get(strings.TrimSuffix(strings.TrimPrefix(value, "{"), "}"))
+		}
+		params[name] = value
+	}
+
+	return params
+}
+
+// HasFieldParams defines if parameters of the route reference form fields
+func (t RedirectTarget) HasFieldParams() bool {
+	for _, value := range t.Params {
+		if strings.HasPrefix(value, "{") && strings.HasSuffix(value, "}") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	RedirectTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestRedirectTestSuite(t *testing.T) {
+	suite.Run(t, &RedirectTestSuite{})
+}
+
+func (t *RedirectTestSuite) TestTarget() {
+	mapping := RedirectMapping{
+		Success: &RedirectTarget{Route: "success"},
+		Invalid: &RedirectTarget{Route: "invalid"},
+		Error:   &RedirectTarget{Route: "error"},
+	}
+
+	t.Nil(mapping.Target(FormStateNotSubmitted))
+	t.Equal(&RedirectTarget{Route: "success"}, mapping.Target(FormStateValid))
+	t.Equal(&RedirectTarget{Route: "invalid"}, mapping.Target(FormStateInvalid))
+	t.Equal(&RedirectTarget{Route: "error"}, mapping.Target(FormStateFailed))
+	t.Nil(RedirectMapping{}.Target(FormStateValid))
+}
+
+func (t *RedirectTestSuite) TestResolveParams() {
+	target := RedirectTarget{
+		Route: "order.success",
+		Params: map[string]string{
+			"id":      "{orderId}",
+			"city":    "{address.city}",
+			"missing": "{unknown}",
+			"step":    "done",
+		},
+	}
+
+	t.True(target.HasFieldParams())
+	t.Equal(map[string]string{
+		"id":      "42",
+		"city":    "Berlin",
+		"missing": "",
+		"step":    "done",
+	}, target.ResolveParams(url.Values{
+		"orderId":      []string{"42"},
+		"address.city": []string{"Berlin"},
+	}))
+
+	t.False(RedirectTarget{Params: map[string]string{"step": "done"}}.HasFieldParams())
+	t.Equal(map[string]string{}, RedirectTarget{}.ResolveParams(nil))
+}
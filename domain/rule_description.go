@@ -0,0 +1,44 @@
+package domain
+
+import "strings"
+
+type (
+	// RuleDescription as struct for machine-readable description of validation rule, which is used by exporters of
+	// form contracts (JSON Schema, OpenAPI, TypeScript), so project-specific rules are part of generated contracts
+	RuleDescription struct {
+		// Name validator tag name
+		Name string `json:"name"`
+		// Description human readable description of the rule
+		Description string `json:"description,omitempty"`
+		// Params JSON Schema of rule parameter (like {"type": "integer", "minimum": 0}), nil if rule has no parameter
+		Params map[string]interface{} `json:"params,omitempty"`
+		// MessageKey key of error message, where "{field}" is replaced by name of the field.
+		// If it's empty, default key "formError.{field}.<name>" is used.
+		MessageKey string `json:"messageKey,omitempty"`
+	}
+
+	// RuleDescriber as optional interface for field validators, which describe their validation rule
+	RuleDescriber interface {
+		// DescribeRule returns description of validation rule
+		DescribeRule() RuleDescription
+	}
+
+	// RuleRegistry as interface for registry of validation rule descriptions
+	RuleRegistry interface {
+		// Register adds description of validation rule, replacing already registered description with same name
+		Register(description RuleDescription)
+		// Describe returns description of validation rule by its name
+		Describe(name string) (RuleDescription, bool)
+		// Descriptions returns descriptions of all registered validation rules, sorted by name
+		Descriptions() []RuleDescription
+	}
+)
+
+// MessageKeyForField returns key of error message of the rule for desired field
+func (d RuleDescription) MessageKeyForField(fieldName string) string {
+	if d.MessageKey == "" {
+		return "formError." + fieldName + "." + d.Name
+	}
+
+	return strings.Replace(d.MessageKey, "{field}", fieldName, -1)
+}
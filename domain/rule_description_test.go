@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	RuleDescriptionTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestRuleDescriptionTestSuite(t *testing.T) {
+	suite.Run(t, &RuleDescriptionTestSuite{})
+}
+
+func (t *RuleDescriptionTestSuite) TestMessageKeyForField() {
+	t.Equal("formError.iban.iban", RuleDescription{Name: "iban"}.MessageKeyForField("iban"))
+	t.Equal("error.payment.iban.invalid", RuleDescription{
+		Name:       "iban",
+		MessageKey: "error.payment.{field}.invalid",
+	}.MessageKeyForField("iban"))
+	t.Equal("error.iban", RuleDescription{
+		Name:       "iban",
+		MessageKey: "error.iban",
+	}.MessageKeyForField("account.iban"))
+}
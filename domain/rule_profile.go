@@ -0,0 +1,26 @@
+package domain
+
+import "context"
+
+type (
+	// ruleProfileKey as key of context value, under which name of selected rule profile is stored
+	ruleProfileKey struct{}
+)
+
+// ContextWithRuleProfile returns context with name of rule profile (like market "DE" or "US"), which is selected by
+// controller at handle time. Rule profiles are defined by configuration, and they override or extend validation rules
+// of form fields.
+func ContextWithRuleProfile(ctx context.Context, profile string) context.Context {
+	return context.WithValue(ctx, ruleProfileKey{}, profile)
+}
+
+// RuleProfileFromContext returns name of selected rule profile, or empty string if no profile is selected
+func RuleProfileFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	profile, _ := ctx.Value(ruleProfileKey{}).(string)
+
+	return profile
+}
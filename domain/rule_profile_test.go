@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	RuleProfileTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestRuleProfileTestSuite(t *testing.T) {
+	suite.Run(t, &RuleProfileTestSuite{})
+}
+
+func (t *RuleProfileTestSuite) TestRuleProfileFromContext() {
+	ctx := ContextWithRuleProfile(context.Background(), "DE")
+	t.Equal("DE", RuleProfileFromContext(ctx))
+
+	ctx = ContextWithRuleProfile(ctx, "US")
+	t.Equal("US", RuleProfileFromContext(ctx))
+}
+
+func (t *RuleProfileTestSuite) TestRuleProfileFromContext_WithoutProfile() {
+	t.Equal("", RuleProfileFromContext(context.Background()))
+	t.Equal("", RuleProfileFromContext(nil))
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"net/url"
+
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+type (
+	// SearchForm as struct for storing result of search/filter form handling.
+	// Beside the Form itself, it contains canonical query, which should be used for redirects and links,
+	// so equal searches always share the same URL.
+	SearchForm struct {
+		Form
+		// CanonicalValues submitted query values of known form fields, without values equal to defaults,
+		// empty values and values of invalid fields
+		CanonicalValues url.Values
+	}
+)
+
+// CanonicalQuery returns canonical query string, with parameters sorted by name
+func (f SearchForm) CanonicalQuery() string {
+	return f.CanonicalValues.Encode()
+}
+
+// IsCanonical defines if query string of the request is already canonical, otherwise controller should redirect
+// to URL with canonical query
+func (f SearchForm) IsCanonical(req *web.Request) bool {
+	return req.Request().URL.RawQuery == f.CanonicalQuery()
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+type (
+	SearchFormTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestSearchFormTestSuite(t *testing.T) {
+	suite.Run(t, &SearchFormTestSuite{})
+}
+
+func (t *SearchFormTestSuite) TestCanonicalQuery() {
+	form := SearchForm{
+		CanonicalValues: url.Values{
+			"sort": []string{"price"},
+			"q":    []string{"red shoes"},
+		},
+	}
+
+	t.Equal("q=red+shoes&sort=price", form.CanonicalQuery())
+	t.Equal("", SearchForm{}.CanonicalQuery())
+}
+
+func (t *SearchFormTestSuite) TestIsCanonical() {
+	form := SearchForm{
+		CanonicalValues: url.Values{
+			"sort": []string{"price"},
+			"q":    []string{"shoes"},
+		},
+	}
+
+	t.True(form.IsCanonical(web.CreateRequest(&http.Request{URL: &url.URL{RawQuery: "q=shoes&sort=price"}}, nil)))
+	t.False(form.IsCanonical(web.CreateRequest(&http.Request{URL: &url.URL{RawQuery: "sort=price&q=shoes"}}, nil)))
+	t.False(form.IsCanonical(web.CreateRequest(&http.Request{URL: &url.URL{RawQuery: "q=shoes&sort=price&page=1"}}, nil)))
+}
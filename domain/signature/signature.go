@@ -0,0 +1,340 @@
+package signature
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"image"
+	"image/png"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	validator "gopkg.in/go-playground/validator.v9"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// Signature defines form field of signature pads (like consent or delivery confirmation forms), which is submitted
+	// as PNG or SVG data URI (like "data:image/png;base64,..."). It's validated by Validator for its format, size,
+	// dimensions and emptiness.
+	//
+	// Data struct {
+	//	 Signature signature.Signature `form:"signature" validate:"required,signature"`
+	// }
+	Signature string
+
+	// Validator defines field validator of signatures, which validates that signature is PNG or SVG data URI, which is
+	// not bigger than configured size, has configured dimensions, and is not empty (like submitted blank pad)
+	Validator struct {
+		maxSize   int
+		minWidth  int
+		minHeight int
+		maxWidth  int
+		maxHeight int
+	}
+
+	// svgElement represents single element of SVG document, with attributes needed for validation
+	svgElement struct {
+		Width   string `xml:"width,attr"`
+		Height  string `xml:"height,attr"`
+		ViewBox string `xml:"viewBox,attr"`
+		Path    string `xml:"d,attr"`
+		Points  string `xml:"points,attr"`
+	}
+)
+
+const (
+	// ContentTypePNG defines content type of PNG signatures
+	ContentTypePNG = "image/png"
+	// ContentTypeSVG defines content type of SVG signatures
+	ContentTypeSVG = "image/svg+xml"
+
+	// ReasonFormat defines reason code of signatures, which are not PNG or SVG data URI
+	ReasonFormat = "format"
+	// ReasonSize defines reason code of signatures, which are bigger than maximal size
+	ReasonSize = "size"
+	// ReasonDimensions defines reason code of signatures, which are smaller or bigger than configured dimensions
+	ReasonDimensions = "dimensions"
+	// ReasonEmpty defines reason code of signatures, which contain no strokes
+	ReasonEmpty = "empty"
+)
+
+var (
+	_ domain.FieldValidator  = &Validator{}
+	_ domain.RuleDescriber   = &Validator{}
+	_ domain.FailureReasoner = &Validator{}
+
+	// svgStrokeElements names of SVG elements which are drawn by signature pads
+	svgStrokeElements = map[string]bool{
+		"path":     true,
+		"polyline": true,
+		"polygon":  true,
+		"line":     true,
+		"circle":   true,
+		"ellipse":  true,
+	}
+)
+
+// Decode returns content type and content of signature data URI.
+// It returns error if signature is not PNG or SVG data URI.
+func (s Signature) Decode() (string, []byte, error) {
+	uri := strings.TrimSpace(string(s))
+	if !strings.HasPrefix(uri, "data:") {
+		return "", nil, domain.NewFormError("signature is not data URI")
+	}
+
+	comma := strings.Index(uri, ",")
+	if comma < 0 {
+		return "", nil, domain.NewFormError("signature data URI has no content")
+	}
+
+	params := strings.Split(uri[len("data:"):comma], ";")
+	contentType := strings.ToLower(params[0])
+	if contentType != ContentTypePNG && contentType != ContentTypeSVG {
+		return "", nil, domain.NewFormErrorf("signature content type %q is not supported", contentType)
+	}
+
+	if params[len(params)-1] == "base64" {
+		content, err := base64.StdEncoding.DecodeString(uri[comma+1:])
+		if err != nil {
+			return "", nil, domain.NewFormErrorWithParent(err)
+		}
+		return contentType, content, nil
+	}
+
+	content, err := url.PathUnescape(uri[comma+1:])
+	if err != nil {
+		return "", nil, domain.NewFormErrorWithParent(err)
+	}
+
+	return contentType, []byte(content), nil
+}
+
+// Inject is method used to set all dependencies as local variables
+func (v *Validator) Inject(cfg *struct {
+	MaxSize   int `inject:"config:form.validator.signature.maxSize"`
+	MinWidth  int `inject:"config:form.validator.signature.minWidth"`
+	MinHeight int `inject:"config:form.validator.signature.minHeight"`
+	MaxWidth  int `inject:"config:form.validator.signature.maxWidth"`
+	MaxHeight int `inject:"config:form.validator.signature.maxHeight"`
+}) {
+	v.maxSize = cfg.MaxSize
+	v.minWidth = cfg.MinWidth
+	v.minHeight = cfg.MinHeight
+	v.maxWidth = cfg.MaxWidth
+	v.maxHeight = cfg.MaxHeight
+}
+
+// ValidatorName defines tag name of signature validator
+func (v *Validator) ValidatorName() string {
+	return "signature"
+}
+
+// DescribeRule returns description of signature rule
+func (v *Validator) DescribeRule() domain.RuleDescription {
+	return domain.RuleDescription{
+		Name:        v.ValidatorName(),
+		Description: "non-empty PNG or SVG data URI of signature with at most " + strconv.Itoa(v.maxSize) + " bytes",
+	}
+}
+
+// ValidateField validates signature by configured policy. Valid if string is empty.
+func (v *Validator) ValidateField(_ context.Context, fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	return field.String() == "" || v.reason(Signature(field.String())) == ""
+}
+
+// FailureReason returns reason code of invalid signature: "format", "size", "dimensions" or "empty"
+func (v *Validator) FailureReason(value interface{}, _ string) string {
+	switch typed := value.(type) {
+	case Signature:
+		return v.reason(typed)
+	case string:
+		return v.reason(Signature(typed))
+	}
+
+	return ""
+}
+
+// reason returns reason code of signature, or empty string if signature is valid
+func (v *Validator) reason(signature Signature) string {
+	contentType, content, err := signature.Decode()
+	if err != nil {
+		return ReasonFormat
+	}
+
+	if v.maxSize > 0 && len(content) > v.maxSize {
+		return ReasonSize
+	}
+
+	var width, height int
+	var empty bool
+	if contentType == ContentTypePNG {
+		width, height, empty, err = inspectPNG(content, v.maxWidth, v.maxHeight)
+	} else {
+		width, height, empty, err = inspectSVG(content)
+	}
+
+	switch {
+	case err == errDimensions:
+		return ReasonDimensions
+	case err != nil:
+		return ReasonFormat
+	case width < v.minWidth || height < v.minHeight:
+		return ReasonDimensions
+	case (v.maxWidth > 0 && width > v.maxWidth) || (v.maxHeight > 0 && height > v.maxHeight):
+		return ReasonDimensions
+	case empty:
+		return ReasonEmpty
+	}
+
+	return ""
+}
+
+// errDimensions is returned by inspectPNG if image exceeds maximal dimensions, so it's not decoded
+var errDimensions = domain.NewFormError("signature exceeds maximal dimensions")
+
+// inspectPNG returns dimensions of PNG image, and if all its pixels have the same color.
+// Image is decoded only if it doesn't exceed maximal dimensions.
+func inspectPNG(content []byte, maxWidth int, maxHeight int) (int, int, bool, error) {
+	config, err := png.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	if (maxWidth > 0 && config.Width > maxWidth) || (maxHeight > 0 && config.Height > maxHeight) {
+		return 0, 0, false, errDimensions
+	}
+
+	img, err := png.Decode(bytes.NewReader(content))
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	return config.Width, config.Height, isBlank(img), nil
+}
+
+// isBlank checks if all pixels of image have the same color
+func isBlank(img image.Image) bool {
+	bounds := img.Bounds()
+	if bounds.Empty() {
+		return true
+	}
+
+	r0, g0, b0, a0 := img.At(bounds.Min.X, bounds.Min.Y).RGBA()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, a := img.At(x, y).RGBA()
+			if a == 0 && a0 == 0 {
+				continue
+			}
+			if r != r0 || g != g0 || b != b0 || a != a0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// inspectSVG returns dimensions of SVG document, by width and height of root element or by its view box,
+// and if document contains no strokes
+func inspectSVG(content []byte) (int, int, bool, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+
+	var root *xml.StartElement
+	for root == nil {
+		token, err := decoder.Token()
+		if err != nil {
+			return 0, 0, false, err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			root = &start
+		}
+	}
+
+	if root.Name.Local != "svg" {
+		return 0, 0, false, domain.NewFormError("signature is not SVG document")
+	}
+
+	element := svgElement{}
+	if err := decoder.DecodeElement(&element, root); err != nil {
+		return 0, 0, false, err
+	}
+
+	width, height := svgDimensions(element)
+
+	return width, height, !hasStrokes(content), nil
+}
+
+// svgDimensions returns dimensions of SVG root element, by its width and height, or by its view box
+func svgDimensions(element svgElement) (int, int) {
+	width := svgLength(element.Width)
+	height := svgLength(element.Height)
+	if width > 0 && height > 0 {
+		return width, height
+	}
+
+	fields := strings.FieldsFunc(element.ViewBox, func(r rune) bool {
+		return r == ' ' || r == ','
+	})
+	if len(fields) == 4 {
+		return svgLength(fields[2]), svgLength(fields[3])
+	}
+
+	return width, height
+}
+
+// svgLength returns SVG length in pixels as integer, units like "px" are ignored
+func svgLength(length string) int {
+	length = strings.TrimSuffix(strings.TrimSpace(length), "px")
+	value, err := strconv.ParseFloat(length, 64)
+	if err != nil {
+		return 0
+	}
+
+	return int(value)
+}
+
+// hasStrokes checks if SVG document contains any stroke drawn by signature pad
+func hasStrokes(content []byte) bool {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return false
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || !svgStrokeElements[start.Name.Local] {
+			continue
+		}
+
+		stroke := svgElement{}
+		if err := decoder.DecodeElement(&stroke, &start); err != nil {
+			return false
+		}
+
+		switch start.Name.Local {
+		case "path":
+			if strings.TrimSpace(stroke.Path) != "" {
+				return true
+			}
+		case "polyline", "polygon":
+			if strings.TrimSpace(stroke.Points) != "" {
+				return true
+			}
+		default:
+			return true
+		}
+	}
+}
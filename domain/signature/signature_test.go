@@ -0,0 +1,176 @@
+package signature
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/png"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	SignatureTestSuite struct {
+		suite.Suite
+	}
+
+	ValidatorTestSuite struct {
+		suite.Suite
+
+		validator *Validator
+	}
+)
+
+func TestSignatureTestSuite(t *testing.T) {
+	suite.Run(t, &SignatureTestSuite{})
+}
+
+func TestValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &ValidatorTestSuite{})
+}
+
+func pngSignature(width int, height int, stroke bool) Signature {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	if stroke {
+		for x := 0; x < width; x++ {
+			img.Set(x, height/2, color.Black)
+		}
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := png.Encode(buffer, img); err != nil {
+		panic(err)
+	}
+
+	return Signature("data:image/png;base64," + base64.StdEncoding.EncodeToString(buffer.Bytes()))
+}
+
+func svgSignature(svg string) Signature {
+	return Signature("data:image/svg+xml," + url.PathEscape(svg))
+}
+
+func (t *SignatureTestSuite) TestDecode() {
+	contentType, content, err := Signature("data:image/png;base64,aGVsbG8=").Decode()
+	t.NoError(err)
+	t.Equal(ContentTypePNG, contentType)
+	t.Equal([]byte("hello"), content)
+
+	contentType, content, err = Signature("data:image/svg+xml;charset=utf-8,%3Csvg%2F%3E").Decode()
+	t.NoError(err)
+	t.Equal(ContentTypeSVG, contentType)
+	t.Equal([]byte("<svg/>"), content)
+
+	_, _, err = Signature("image/png;base64,aGVsbG8=").Decode()
+	t.Error(err)
+
+	_, _, err = Signature("data:image/png;base64").Decode()
+	t.Error(err)
+
+	_, _, err = Signature("data:image/jpeg;base64,aGVsbG8=").Decode()
+	t.Error(err)
+
+	_, _, err = Signature("data:image/png;base64,!!!").Decode()
+	t.Error(err)
+}
+
+func (t *ValidatorTestSuite) SetupTest() {
+	t.validator = &Validator{}
+	t.validator.Inject(&struct {
+		MaxSize   int `inject:"config:form.validator.signature.maxSize"`
+		MinWidth  int `inject:"config:form.validator.signature.minWidth"`
+		MinHeight int `inject:"config:form.validator.signature.minHeight"`
+		MaxWidth  int `inject:"config:form.validator.signature.maxWidth"`
+		MaxHeight int `inject:"config:form.validator.signature.maxHeight"`
+	}{
+		MaxSize:   10240,
+		MinWidth:  20,
+		MinHeight: 10,
+		MaxWidth:  200,
+		MaxHeight: 100,
+	})
+}
+
+func (t *ValidatorTestSuite) TestValidatorName() {
+	t.Equal("signature", t.validator.ValidatorName())
+}
+
+func (t *ValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "signature",
+		Description: "non-empty PNG or SVG data URI of signature with at most 10240 bytes",
+	}, t.validator.DescribeRule())
+}
+
+func (t *ValidatorTestSuite) TestValidateField() {
+	testCases := []struct {
+		Signature interface{}
+		Result    bool
+	}{
+		{
+			Signature: Signature(""),
+			Result:    true,
+		},
+		{
+			Signature: pngSignature(100, 50, true),
+			Result:    true,
+		},
+		{
+			Signature: string(pngSignature(100, 50, true)),
+			Result:    true,
+		},
+		{
+			Signature: pngSignature(100, 50, false),
+			Result:    false,
+		},
+		{
+			Signature: svgSignature(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 50"><path d="M 10 10 L 90 40"/></svg>`),
+			Result:    true,
+		},
+		{
+			Signature: Signature("data:image/jpeg;base64,aGVsbG8="),
+			Result:    false,
+		},
+		{
+			Signature: 10,
+			Result:    false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Field").Return(reflect.ValueOf(testCase.Signature)).Once()
+		t.Equal(testCase.Result, t.validator.ValidateField(context.Background(), fieldLevel), testCase.Signature)
+		fieldLevel.AssertExpectations(t.T())
+	}
+}
+
+func (t *ValidatorTestSuite) TestFailureReason() {
+	t.Equal("", t.validator.FailureReason(pngSignature(100, 50, true), ""))
+	t.Equal("", t.validator.FailureReason(string(pngSignature(20, 10, true)), ""))
+	t.Equal(ReasonFormat, t.validator.FailureReason(Signature("data:text/plain,hello"), ""))
+	t.Equal(ReasonFormat, t.validator.FailureReason(Signature("data:image/png;base64,aGVsbG8="), ""))
+	t.Equal(ReasonSize, t.validator.FailureReason(Signature("data:image/svg+xml,"+string(make([]byte, 10241))), ""))
+	t.Equal(ReasonDimensions, t.validator.FailureReason(pngSignature(10, 10, true), ""))
+	t.Equal(ReasonDimensions, t.validator.FailureReason(pngSignature(201, 50, true), ""))
+	t.Equal(ReasonEmpty, t.validator.FailureReason(pngSignature(100, 50, false), ""))
+	t.Equal("", t.validator.FailureReason(10, ""))
+}
+
+func (t *ValidatorTestSuite) TestFailureReason_SVG() {
+	t.Equal("", t.validator.FailureReason(svgSignature(`<svg width="100px" height="50"><polyline points="1,1 5,5"/></svg>`), ""))
+	t.Equal("", t.validator.FailureReason(svgSignature(`<?xml version="1.0"?><svg viewBox="0,0,100,50"><g><circle r="1"/></g></svg>`), ""))
+	t.Equal(ReasonEmpty, t.validator.FailureReason(svgSignature(`<svg viewBox="0 0 100 50"><path d=" "/></svg>`), ""))
+	t.Equal(ReasonEmpty, t.validator.FailureReason(svgSignature(`<svg viewBox="0 0 100 50"></svg>`), ""))
+	t.Equal(ReasonDimensions, t.validator.FailureReason(svgSignature(`<svg viewBox="0 0 500 50"><path d="M 1 1"/></svg>`), ""))
+	t.Equal(ReasonDimensions, t.validator.FailureReason(svgSignature(`<svg><path d="M 1 1"/></svg>`), ""))
+	t.Equal(ReasonFormat, t.validator.FailureReason(svgSignature(`<html><path d="M 1 1"/></html>`), ""))
+	t.Equal(ReasonFormat, t.validator.FailureReason(svgSignature(`<svg viewBox="0 0 100 50"><path`), ""))
+}
@@ -0,0 +1,250 @@
+package survey
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"gopkg.in/go-playground/validator.v9"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// QuestionProvider defines source of survey questions, which are supplied at request time
+	QuestionProvider interface {
+		// GetQuestions returns all questions of the survey for the request
+		GetQuestions(ctx context.Context, req *web.Request) ([]Question, error)
+	}
+
+	// QuestionType defines type of question, which decides how its answer is validated
+	QuestionType string
+
+	// Question defines single survey question
+	Question struct {
+		// ID unique identifier of the question, used as name of form field
+		ID string
+		// Type of the question
+		Type QuestionType
+		// Label of the question, used as default label of validation errors
+		Label string
+		// Options available answers of choice questions
+		Options []Option
+		// Required flag if question must be answered
+		Required bool
+		// Rules additional validation rules of answers, defined in the same way as validation tag (like "min=3,max=50"),
+		// which are applied on each answer as string
+		Rules string
+	}
+
+	// Option defines single available answer of choice question
+	Option struct {
+		// Value submitted for the option
+		Value string
+		// Label of the option
+		Label string
+	}
+
+	// Answers defines form data of the survey: supplied questions and submitted answers by question IDs
+	Answers struct {
+		// Questions all questions of the survey
+		Questions []Question
+		// Values submitted answers by question IDs. Only multiple choice questions can have more than one value.
+		Values map[string][]string
+	}
+
+	// FormService defines form service for surveys, which acts as provider, decoder and validator of Answers.
+	// Questions are supplied by QuestionProvider, so survey forms don't need compile-time structs.
+	//
+	// formHandler := c.formHandlerFactory.CreateFormHandlerWithFormService(survey.NewFormService(c.questionProvider))
+	FormService struct {
+		provider QuestionProvider
+	}
+)
+
+const (
+	// TypeText question answered with free text
+	TypeText QuestionType = "text"
+	// TypeNumber question answered with number
+	TypeNumber QuestionType = "number"
+	// TypeBoolean question answered with yes or no
+	TypeBoolean QuestionType = "boolean"
+	// TypeChoice question answered with single option
+	TypeChoice QuestionType = "choice"
+	// TypeMultipleChoice question answered with any number of options
+	TypeMultipleChoice QuestionType = "multipleChoice"
+)
+
+var _ domain.CompleteFormService = &FormService{}
+
+// NewFormService creates survey form service with questions supplied by provider
+func NewFormService(provider QuestionProvider) *FormService {
+	return &FormService{
+		provider: provider,
+	}
+}
+
+// GetFormData provides Answers with questions supplied by QuestionProvider and without any answer
+func (s *FormService) GetFormData(ctx context.Context, req *web.Request) (interface{}, error) {
+	questions, err := s.provider.GetQuestions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return Answers{
+		Questions: questions,
+		Values:    map[string][]string{},
+	}, nil
+}
+
+// Decode decodes submitted answers of all questions. Empty values and values of unknown questions are ignored.
+func (s *FormService) Decode(_ context.Context, _ *web.Request, values url.Values, formData interface{}) (interface{}, error) {
+	answers, err := toAnswers(formData)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := Answers{
+		Questions: answers.Questions,
+		Values:    make(map[string][]string, len(answers.Questions)),
+	}
+
+	for _, question := range answers.Questions {
+		var submitted []string
+		for _, value := range values[question.ID] {
+			value = strings.TrimSpace(value)
+			if value != "" {
+				submitted = append(submitted, value)
+			}
+		}
+
+		if len(submitted) == 0 {
+			continue
+		}
+
+		if question.Type != TypeMultipleChoice {
+			submitted = submitted[:1]
+		}
+
+		decoded.Values[question.ID] = submitted
+	}
+
+	return decoded, nil
+}
+
+// Validate validates answers of each question by its type, options, required flag and additional validation rules.
+// Field errors are named by question IDs, in the same way as validation errors of default validator.
+func (s *FormService) Validate(_ context.Context, _ *web.Request, validatorProvider domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	answers, err := toAnswers(formData)
+	if err != nil {
+		return nil, err
+	}
+
+	validationInfo := &domain.ValidationInfo{}
+
+	for _, question := range answers.Questions {
+		values := answers.Values[question.ID]
+		if len(values) == 0 {
+			if question.Required {
+				addError(validationInfo, question, "required")
+			}
+			continue
+		}
+
+		for _, value := range values {
+			if tag := validateType(question, value); tag != "" {
+				addError(validationInfo, question, tag)
+				break
+			}
+		}
+
+		if question.Rules == "" {
+			continue
+		}
+
+		if validatorProvider == nil {
+			return nil, domain.NewFormErrorf("there is no ValidatorProvider for validating question %q", question.ID)
+		}
+
+		for _, value := range values {
+			err := validatorProvider.GetValidator().Var(value, question.Rules)
+			if err == nil {
+				continue
+			}
+
+			validationErrors, ok := err.(validator.ValidationErrors)
+			if !ok {
+				return nil, err
+			}
+
+			for _, fieldError := range validationErrors {
+				addError(validationInfo, question, fieldError.Tag())
+			}
+		}
+	}
+
+	return validationInfo, nil
+}
+
+// Get returns answer of question, or first answer if question is multiple choice
+func (a Answers) Get(id string) string {
+	if values := a.Values[id]; len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// GetAll returns all answers of question
+func (a Answers) GetAll(id string) []string {
+	return a.Values[id]
+}
+
+// validateType returns name of violated validation rule, if value is not valid answer for question type
+func validateType(question Question, value string) string {
+	switch question.Type {
+	case TypeNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return "number"
+		}
+	case TypeBoolean:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return "boolean"
+		}
+	case TypeChoice, TypeMultipleChoice:
+		for _, option := range question.Options {
+			if option.Value == value {
+				return ""
+			}
+		}
+		return "option"
+	}
+
+	return ""
+}
+
+// addError adds field error of question
+func addError(validationInfo *domain.ValidationInfo, question Question, tag string) {
+	label := question.Label
+	if label == "" {
+		label = question.ID
+	}
+
+	validationInfo.AddFieldErrorWithParams(question.ID, "formError."+question.ID+"."+tag, label+" "+tag, domain.NewErrorParams(label, tag, "", ""))
+}
+
+// toAnswers converts form data into Answers
+func toAnswers(formData interface{}) (Answers, error) {
+	switch answers := formData.(type) {
+	case Answers:
+		return answers, nil
+	case *Answers:
+		if answers != nil {
+			return *answers, nil
+		}
+	}
+
+	return Answers{}, domain.NewFormErrorf("expected survey answers as form data, got %T", formData)
+}
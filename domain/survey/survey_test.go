@@ -0,0 +1,236 @@
+package survey
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/go-playground/validator.v9"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	FormServiceTestSuite struct {
+		suite.Suite
+
+		service           *FormService
+		provider          *surveyTestProvider
+		validatorProvider *mocks.ValidatorProvider
+
+		context context.Context
+		request *web.Request
+	}
+
+	surveyTestProvider struct {
+		questions []Question
+		err       error
+	}
+)
+
+func (p *surveyTestProvider) GetQuestions(context.Context, *web.Request) ([]Question, error) {
+	return p.questions, p.err
+}
+
+func TestFormServiceTestSuite(t *testing.T) {
+	suite.Run(t, &FormServiceTestSuite{})
+}
+
+func (t *FormServiceTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *FormServiceTestSuite) SetupTest() {
+	t.provider = &surveyTestProvider{
+		questions: []Question{
+			{
+				ID:       "name",
+				Type:     TypeText,
+				Label:    "Name",
+				Required: true,
+				Rules:    "min=3",
+			},
+			{
+				ID:   "age",
+				Type: TypeNumber,
+			},
+			{
+				ID:   "returning",
+				Type: TypeBoolean,
+			},
+			{
+				ID:   "rating",
+				Type: TypeChoice,
+				Options: []Option{
+					{Value: "good", Label: "Good"},
+					{Value: "bad", Label: "Bad"},
+				},
+			},
+			{
+				ID:   "topics",
+				Type: TypeMultipleChoice,
+				Options: []Option{
+					{Value: "price", Label: "Price"},
+					{Value: "quality", Label: "Quality"},
+				},
+			},
+		},
+	}
+	t.service = NewFormService(t.provider)
+	t.validatorProvider = &mocks.ValidatorProvider{}
+
+	t.request = web.CreateRequest(&http.Request{}, nil)
+}
+
+func (t *FormServiceTestSuite) TearDownTest() {
+	t.validatorProvider.AssertExpectations(t.T())
+}
+
+func (t *FormServiceTestSuite) TestGetFormData() {
+	formData, err := t.service.GetFormData(t.context, t.request)
+	t.NoError(err)
+	t.Equal(Answers{
+		Questions: t.provider.questions,
+		Values:    map[string][]string{},
+	}, formData)
+}
+
+func (t *FormServiceTestSuite) TestGetFormData_Error() {
+	t.provider.err = errors.New("error")
+
+	formData, err := t.service.GetFormData(t.context, t.request)
+	t.Equal(errors.New("error"), err)
+	t.Nil(formData)
+}
+
+func (t *FormServiceTestSuite) TestDecode() {
+	formData, err := t.service.GetFormData(t.context, t.request)
+	t.NoError(err)
+
+	decoded, err := t.service.Decode(t.context, t.request, url.Values{
+		"name":    {" Name ", "Other"},
+		"age":     {""},
+		"rating":  {"good"},
+		"topics":  {"price", "quality"},
+		"unknown": {"value"},
+	}, formData)
+	t.NoError(err)
+
+	answers := decoded.(Answers)
+	t.Equal(map[string][]string{
+		"name":   {"Name"},
+		"rating": {"good"},
+		"topics": {"price", "quality"},
+	}, answers.Values)
+	t.Equal("Name", answers.Get("name"))
+	t.Equal("", answers.Get("age"))
+	t.Equal([]string{"price", "quality"}, answers.GetAll("topics"))
+}
+
+func (t *FormServiceTestSuite) TestDecode_WrongFormData() {
+	decoded, err := t.service.Decode(t.context, t.request, url.Values{}, map[string]string{})
+	t.Error(err)
+	t.Nil(decoded)
+}
+
+func (t *FormServiceTestSuite) TestValidate() {
+	t.validatorProvider.On("GetValidator").Return(validator.New())
+
+	validationInfo, err := t.service.Validate(t.context, t.request, t.validatorProvider, Answers{
+		Questions: t.provider.questions,
+		Values: map[string][]string{
+			"name":      {"Na"},
+			"age":       {"young"},
+			"returning": {"maybe"},
+			"rating":    {"average"},
+			"topics":    {"price", "delivery"},
+		},
+	})
+	t.NoError(err)
+	t.Equal(map[string][]domain.Error{
+		"name": {
+			{
+				MessageKey:   "formError.name.min",
+				DefaultLabel: "Name min",
+				Params:       map[string]string{"field": "Name", "rule": "min"},
+			},
+		},
+		"age": {
+			{
+				MessageKey:   "formError.age.number",
+				DefaultLabel: "age number",
+				Params:       map[string]string{"field": "age", "rule": "number"},
+			},
+		},
+		"returning": {
+			{
+				MessageKey:   "formError.returning.boolean",
+				DefaultLabel: "returning boolean",
+				Params:       map[string]string{"field": "returning", "rule": "boolean"},
+			},
+		},
+		"rating": {
+			{
+				MessageKey:   "formError.rating.option",
+				DefaultLabel: "rating option",
+				Params:       map[string]string{"field": "rating", "rule": "option"},
+			},
+		},
+		"topics": {
+			{
+				MessageKey:   "formError.topics.option",
+				DefaultLabel: "topics option",
+				Params:       map[string]string{"field": "topics", "rule": "option"},
+			},
+		},
+	}, validationInfo.GetErrorsForAllFields())
+}
+
+func (t *FormServiceTestSuite) TestValidate_Required() {
+	validationInfo, err := t.service.Validate(t.context, t.request, t.validatorProvider, Answers{
+		Questions: t.provider.questions,
+	})
+	t.NoError(err)
+	t.Equal(map[string][]domain.Error{
+		"name": {
+			{
+				MessageKey:   "formError.name.required",
+				DefaultLabel: "Name required",
+				Params:       map[string]string{"field": "Name", "rule": "required"},
+			},
+		},
+	}, validationInfo.GetErrorsForAllFields())
+}
+
+func (t *FormServiceTestSuite) TestValidate_Valid() {
+	t.validatorProvider.On("GetValidator").Return(validator.New())
+
+	validationInfo, err := t.service.Validate(t.context, t.request, t.validatorProvider, &Answers{
+		Questions: t.provider.questions,
+		Values: map[string][]string{
+			"name":      {"Name"},
+			"age":       {"42"},
+			"returning": {"true"},
+			"rating":    {"good"},
+			"topics":    {"price", "quality"},
+		},
+	})
+	t.NoError(err)
+	t.True(validationInfo.IsValid())
+}
+
+func (t *FormServiceTestSuite) TestValidate_WithoutValidatorProvider() {
+	validationInfo, err := t.service.Validate(t.context, t.request, nil, Answers{
+		Questions: t.provider.questions,
+		Values: map[string][]string{
+			"name": {"Name"},
+		},
+	})
+	t.Error(err)
+	t.Nil(validationInfo)
+}
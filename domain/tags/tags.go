@@ -0,0 +1,168 @@
+package tags
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	validator "gopkg.in/go-playground/validator.v9"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// List defines form field of tags (like keywords of article), which is submitted as single comma or space
+	// separated input (like "news, Go  #release"). Tags are normalized while they are decoded: they are lower cased,
+	// leading "#" is removed and duplicates are dropped, so order of first occurrences is kept.
+	//
+	// Data struct {
+	//	 Keywords tags.List `form:"keywords" validate:"required,tags"`
+	// }
+	List []string
+
+	// Validator defines field validator of tag lists, which validates number of tags, and length and allowed
+	// characters of each tag, depending on configured policy. Maximal number of tags can be defined by parameter.
+	//
+	// Data struct {
+	//	 Keywords tags.List `validate:"tags=5"`
+	// }
+	//
+	Validator struct {
+		maxCount  int
+		maxLength int
+		charset   *regexp.Regexp
+	}
+)
+
+const (
+	// ReasonTooMany defines reason code of lists, which contain more than maximal number of tags
+	ReasonTooMany = "tooMany"
+	// ReasonTooLong defines reason code of lists, which contain tag longer than maximal length
+	ReasonTooLong = "tooLong"
+	// ReasonCharset defines reason code of lists, which contain tag with characters out of allowed charset
+	ReasonCharset = "charset"
+)
+
+var (
+	_ domain.FieldValidator  = &Validator{}
+	_ domain.RuleDescriber   = &Validator{}
+	_ domain.FailureReasoner = &Validator{}
+)
+
+// Parse returns normalized list of tags from comma or space separated inputs
+func Parse(inputs ...string) List {
+	list := List{}
+	seen := map[string]bool{}
+
+	for _, input := range inputs {
+		for _, tag := range strings.FieldsFunc(input, func(r rune) bool {
+			return r == ',' || unicode.IsSpace(r)
+		}) {
+			tag = strings.ToLower(strings.TrimLeft(tag, "#"))
+			if tag == "" || seen[tag] {
+				continue
+			}
+
+			seen[tag] = true
+			list = append(list, tag)
+		}
+	}
+
+	return list
+}
+
+// String returns tags as comma separated input
+func (l List) String() string {
+	return strings.Join(l, ", ")
+}
+
+// Inject is method used to set all dependencies as local variables
+func (v *Validator) Inject(cfg *struct {
+	MaxCount  int    `inject:"config:form.validator.tags.maxCount"`
+	MaxLength int    `inject:"config:form.validator.tags.maxLength"`
+	Charset   string `inject:"config:form.validator.tags.charset"`
+}) {
+	v.maxCount = cfg.MaxCount
+	v.maxLength = cfg.MaxLength
+	v.charset = regexp.MustCompile("^[" + cfg.Charset + "]*$")
+}
+
+// ValidatorName defines tag name of tags validator
+func (v *Validator) ValidatorName() string {
+	return "tags"
+}
+
+// DescribeRule returns description of tags rule
+func (v *Validator) DescribeRule() domain.RuleDescription {
+	return domain.RuleDescription{
+		Name:        v.ValidatorName(),
+		Description: "list of at most desired number of tags (default " + strconv.Itoa(v.maxCount) + "), each of them with at most " + strconv.Itoa(v.maxLength) + " characters, matching " + v.charset.String(),
+		Params: map[string]interface{}{
+			"type":    "integer",
+			"minimum": 1,
+		},
+	}
+}
+
+// ValidateField validates list of tags by configured policy. Valid if list is empty.
+func (v *Validator) ValidateField(_ context.Context, fl validator.FieldLevel) bool {
+	list, ok := listOf(fl.Field().Interface())
+	if !ok {
+		return false
+	}
+
+	return v.reason(list, fl.Param()) == ""
+}
+
+// FailureReason returns reason code of invalid list: "tooMany", "tooLong" or "charset"
+func (v *Validator) FailureReason(value interface{}, param string) string {
+	list, ok := listOf(value)
+	if !ok {
+		return ""
+	}
+
+	return v.reason(list, param)
+}
+
+// reason returns reason code of list, or empty string if list is valid
+func (v *Validator) reason(list List, param string) string {
+	maxCount := v.maxCount
+	if param != "" {
+		value, err := strconv.Atoi(param)
+		if err != nil {
+			panic(err.Error())
+		}
+		maxCount = value
+	}
+
+	if maxCount > 0 && len(list) > maxCount {
+		return ReasonTooMany
+	}
+
+	for _, tag := range list {
+		if v.maxLength > 0 && utf8.RuneCountInString(tag) > v.maxLength {
+			return ReasonTooLong
+		}
+
+		if !v.charset.MatchString(tag) {
+			return ReasonCharset
+		}
+	}
+
+	return ""
+}
+
+// listOf returns list of tags of field value, which can be []string as well
+func listOf(value interface{}) (List, bool) {
+	switch list := value.(type) {
+	case List:
+		return list, true
+	case []string:
+		return list, true
+	}
+
+	return nil, false
+}
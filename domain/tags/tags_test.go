@@ -0,0 +1,144 @@
+package tags
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	ListTestSuite struct {
+		suite.Suite
+	}
+
+	ValidatorTestSuite struct {
+		suite.Suite
+
+		validator *Validator
+	}
+)
+
+func TestListTestSuite(t *testing.T) {
+	suite.Run(t, &ListTestSuite{})
+}
+
+func TestValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &ValidatorTestSuite{})
+}
+
+func (t *ListTestSuite) TestParse() {
+	t.Equal(List{}, Parse())
+	t.Equal(List{}, Parse(" , ,# "))
+	t.Equal(List{"news", "go", "release"}, Parse("News, Go  #release", "go,NEWS"))
+	t.Equal(List{"first", "second"}, Parse("first\tsecond\n"))
+}
+
+func (t *ListTestSuite) TestString() {
+	t.Equal("", List{}.String())
+	t.Equal("news, go", List{"news", "go"}.String())
+}
+
+func (t *ValidatorTestSuite) SetupTest() {
+	t.validator = &Validator{}
+	t.validator.Inject(&struct {
+		MaxCount  int    `inject:"config:form.validator.tags.maxCount"`
+		MaxLength int    `inject:"config:form.validator.tags.maxLength"`
+		Charset   string `inject:"config:form.validator.tags.charset"`
+	}{
+		MaxCount:  3,
+		MaxLength: 10,
+		Charset:   "a-z0-9-",
+	})
+}
+
+func (t *ValidatorTestSuite) TestValidatorName() {
+	t.Equal("tags", t.validator.ValidatorName())
+}
+
+func (t *ValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "tags",
+		Description: "list of at most desired number of tags (default 3), each of them with at most 10 characters, matching ^[a-z0-9-]*$",
+		Params: map[string]interface{}{
+			"type":    "integer",
+			"minimum": 1,
+		},
+	}, t.validator.DescribeRule())
+}
+
+func (t *ValidatorTestSuite) TestValidateField() {
+	testCases := []struct {
+		List   interface{}
+		Param  string
+		Result bool
+	}{
+		{
+			List:   List{},
+			Result: true,
+		},
+		{
+			List:   List{"news", "go-lang", "release"},
+			Result: true,
+		},
+		{
+			List:   []string{"news", "go"},
+			Result: true,
+		},
+		{
+			List:   List{"news", "go", "release", "more"},
+			Result: false,
+		},
+		{
+			List:   List{"news", "go", "release", "more"},
+			Param:  "5",
+			Result: true,
+		},
+		{
+			List:   List{"news", "go"},
+			Param:  "1",
+			Result: false,
+		},
+		{
+			List:   List{"international"},
+			Result: false,
+		},
+		{
+			List:   List{"go_lang"},
+			Result: false,
+		},
+		{
+			List:   "news",
+			Result: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Field").Return(reflect.ValueOf(testCase.List)).Once()
+		if _, ok := listOf(testCase.List); ok {
+			fieldLevel.On("Param").Return(testCase.Param).Once()
+		}
+		t.Equal(testCase.Result, t.validator.ValidateField(context.Background(), fieldLevel), testCase.List)
+		fieldLevel.AssertExpectations(t.T())
+	}
+}
+
+func (t *ValidatorTestSuite) TestFailureReason() {
+	t.Equal("", t.validator.FailureReason(List{"news"}, ""))
+	t.Equal(ReasonTooMany, t.validator.FailureReason(List{"a", "b", "c", "d"}, ""))
+	t.Equal(ReasonTooMany, t.validator.FailureReason(List{"a", "b"}, "1"))
+	t.Equal(ReasonTooLong, t.validator.FailureReason(List{"international"}, ""))
+	t.Equal(ReasonCharset, t.validator.FailureReason([]string{"go_lang"}, ""))
+	t.Equal("", t.validator.FailureReason("news", ""))
+}
+
+func (t *ValidatorTestSuite) TestFailureReason_InvalidParam() {
+	t.Panics(func() {
+		t.validator.FailureReason(List{"news"}, "wrong")
+	})
+}
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+type (
+	// TenantResolver is interface for defining tenant (like brand or shop) of the request, which selects
+	// tenant specific variant of form handler created by FormHandlerPresets
+	TenantResolver interface {
+		// ResolveTenant as method for returning name of the tenant of the request, or empty string for default tenant
+		ResolveTenant(ctx context.Context, req *web.Request) string
+	}
+
+	// tenantKey as key of context value, under which name of the tenant is stored
+	tenantKey struct{}
+)
+
+// ContextWithTenant returns context with name of the tenant (like brand or shop) of the request, which is selected
+// by project specific filter or controller. Tenant specific form handlers pass resolved tenant via context,
+// so form services and form extensions can use it too.
+func ContextWithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFromContext returns name of the tenant, or empty string if no tenant is selected
+func TenantFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	tenant, _ := ctx.Value(tenantKey{}).(string)
+
+	return tenant
+}
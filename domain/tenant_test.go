@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	TenantTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestTenantTestSuite(t *testing.T) {
+	suite.Run(t, &TenantTestSuite{})
+}
+
+func (t *TenantTestSuite) TestTenantFromContext() {
+	ctx := ContextWithTenant(context.Background(), "brandA")
+	t.Equal("brandA", TenantFromContext(ctx))
+
+	ctx = ContextWithTenant(ctx, "brandB")
+	t.Equal("brandB", TenantFromContext(ctx))
+}
+
+func (t *TenantTestSuite) TestTenantFromContext_WithoutTenant() {
+	t.Equal("", TenantFromContext(context.Background()))
+	t.Equal("", TenantFromContext(nil))
+}
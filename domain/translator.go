@@ -0,0 +1,59 @@
+package domain
+
+import "context"
+
+type (
+	// Translator is interface for defining translation of error messages. It matches TranslationService of Flamingo's
+	// locale module, so that service can be bound as Translator.
+	Translator interface {
+		// Translate as method for translating message key into message of the locale, with passed translation arguments.
+		// Default label is used if there is no translation of the message key.
+		Translate(key string, defaultLabel string, localeCode string, count int, translationArguments map[string]interface{}) string
+	}
+
+	// translatorKey as key of context value, under which translator and locale code are stored
+	translatorKey struct{}
+
+	// contextTranslator as translator and locale code stored in context
+	contextTranslator struct {
+		translator Translator
+		localeCode string
+	}
+)
+
+// ContextWithTranslator returns context with translator of error messages and code of the locale (like "de_DE").
+// Empty locale code stands for default locale of the translator.
+func ContextWithTranslator(ctx context.Context, translator Translator, localeCode string) context.Context {
+	return context.WithValue(ctx, translatorKey{}, contextTranslator{
+		translator: translator,
+		localeCode: localeCode,
+	})
+}
+
+// TranslatorFromContext returns translator of error messages and code of the locale, or nil translator
+// if there is no translator in the context
+func TranslatorFromContext(ctx context.Context) (Translator, string) {
+	if ctx == nil {
+		return nil, ""
+	}
+
+	stored, _ := ctx.Value(translatorKey{}).(contextTranslator)
+
+	return stored.translator, stored.localeCode
+}
+
+// Translate returns message of the error translated by translator of the context, with parameters of the error
+// passed as translation arguments. It returns default label if there is no translator in the context.
+func (e Error) Translate(ctx context.Context) string {
+	translator, localeCode := TranslatorFromContext(ctx)
+	if translator == nil {
+		return e.DefaultLabel
+	}
+
+	arguments := make(map[string]interface{}, len(e.Params))
+	for name, value := range e.Params {
+		arguments[name] = value
+	}
+
+	return translator.Translate(e.MessageKey, e.DefaultLabel, localeCode, 1, arguments)
+}
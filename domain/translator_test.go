@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	TranslatorTestSuite struct {
+		suite.Suite
+
+		translator *translatorTestTranslator
+	}
+
+	translatorTestTranslator struct {
+		key          string
+		defaultLabel string
+		localeCode   string
+		count        int
+		arguments    map[string]interface{}
+	}
+)
+
+func TestTranslatorTestSuite(t *testing.T) {
+	suite.Run(t, &TranslatorTestSuite{})
+}
+
+func (t *translatorTestTranslator) Translate(key string, defaultLabel string, localeCode string, count int, translationArguments map[string]interface{}) string {
+	t.key = key
+	t.defaultLabel = defaultLabel
+	t.localeCode = localeCode
+	t.count = count
+	t.arguments = translationArguments
+
+	return "translated " + key
+}
+
+func (t *TranslatorTestSuite) SetupTest() {
+	t.translator = &translatorTestTranslator{}
+}
+
+func (t *TranslatorTestSuite) TestTranslatorFromContext() {
+	translator, localeCode := TranslatorFromContext(ContextWithTranslator(context.Background(), t.translator, "de_DE"))
+	t.Exactly(t.translator, translator)
+	t.Equal("de_DE", localeCode)
+}
+
+func (t *TranslatorTestSuite) TestTranslatorFromContext_WithoutTranslator() {
+	translator, localeCode := TranslatorFromContext(context.Background())
+	t.Nil(translator)
+	t.Equal("", localeCode)
+
+	translator, localeCode = TranslatorFromContext(nil)
+	t.Nil(translator)
+	t.Equal("", localeCode)
+}
+
+func (t *TranslatorTestSuite) TestTranslate() {
+	err := Error{
+		MessageKey:   "formError.name.max",
+		DefaultLabel: "Name max",
+		Params:       NewErrorParams("Name", "max", "128", ""),
+	}
+
+	t.Equal("translated formError.name.max", err.Translate(ContextWithTranslator(context.Background(), t.translator, "de_DE")))
+	t.Equal(&translatorTestTranslator{
+		key:          "formError.name.max",
+		defaultLabel: "Name max",
+		localeCode:   "de_DE",
+		count:        1,
+		arguments: map[string]interface{}{
+			"field": "Name",
+			"rule":  "max",
+			"value": "128",
+		},
+	}, t.translator)
+}
+
+func (t *TranslatorTestSuite) TestTranslate_WithoutTranslator() {
+	err := Error{
+		MessageKey:   "formError.name.max",
+		DefaultLabel: "Name max",
+	}
+
+	t.Equal("Name max", err.Translate(context.Background()))
+}
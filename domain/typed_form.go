@@ -0,0 +1,42 @@
+//go:build go1.21
+// +build go1.21
+
+package domain
+
+import "reflect"
+
+type (
+	// TypedForm as struct for storing form processing results, with form data of type T.
+	// Beside the Form itself, it contains form data, so controllers don't need to assert its type.
+	TypedForm[T any] struct {
+		Form
+		// Data the form data struct (forms DTO) of type T
+		Data T
+	}
+)
+
+// NewTypedForm returns typed form with form data of passed form. Form data passed as pointer is dereferenced
+// for value type T, and missing form data stays zero value of T. It returns error if form data is of other type.
+func NewTypedForm[T any](form *Form) (*TypedForm[T], error) {
+	if form == nil {
+		return nil, nil
+	}
+
+	typed := &TypedForm[T]{
+		Form: *form,
+	}
+
+	switch data := form.Data.(type) {
+	case nil:
+	case T:
+		typed.Data = data
+	case *T:
+		if data != nil {
+			typed.Data = *data
+		}
+	default:
+		return nil, NewFormErrorf("form data of type %T can't be used as %s", form.Data, reflect.TypeOf((*T)(nil)).Elem())
+	}
+
+	return typed, nil
+}
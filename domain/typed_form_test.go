@@ -0,0 +1,75 @@
+//go:build go1.21
+// +build go1.21
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	TypedFormTestSuite struct {
+		suite.Suite
+	}
+
+	typedFormTestData struct {
+		Name string
+	}
+)
+
+func TestTypedFormTestSuite(t *testing.T) {
+	suite.Run(t, &TypedFormTestSuite{})
+}
+
+func (t *TypedFormTestSuite) TestNewTypedForm_Value() {
+	form := NewForm(true, nil)
+	form.Data = typedFormTestData{Name: "name"}
+	form.ValidationInfo.AddGeneralError("messageKey", "defaultLabel")
+
+	typed, err := NewTypedForm[typedFormTestData](&form)
+	t.NoError(err)
+	t.Equal(typedFormTestData{Name: "name"}, typed.Data)
+	t.True(typed.IsSubmitted())
+	t.False(typed.IsValid())
+	t.Equal(form, typed.Form)
+}
+
+func (t *TypedFormTestSuite) TestNewTypedForm_Pointer() {
+	form := NewForm(false, nil)
+	form.Data = &typedFormTestData{Name: "name"}
+
+	typed, err := NewTypedForm[typedFormTestData](&form)
+	t.NoError(err)
+	t.Equal(typedFormTestData{Name: "name"}, typed.Data)
+
+	pointer, err := NewTypedForm[*typedFormTestData](&form)
+	t.NoError(err)
+	t.Exactly(form.Data, pointer.Data)
+
+	form.Data = (*typedFormTestData)(nil)
+	typed, err = NewTypedForm[typedFormTestData](&form)
+	t.NoError(err)
+	t.Equal(typedFormTestData{}, typed.Data)
+}
+
+func (t *TypedFormTestSuite) TestNewTypedForm_Empty() {
+	typed, err := NewTypedForm[typedFormTestData](nil)
+	t.NoError(err)
+	t.Nil(typed)
+
+	form := NewForm(false, nil)
+	typed, err = NewTypedForm[typedFormTestData](&form)
+	t.NoError(err)
+	t.Equal(typedFormTestData{}, typed.Data)
+}
+
+func (t *TypedFormTestSuite) TestNewTypedForm_WrongType() {
+	form := NewForm(false, nil)
+	form.Data = map[string]string{}
+
+	typed, err := NewTypedForm[typedFormTestData](&form)
+	t.Error(err)
+	t.Nil(typed)
+}
@@ -2,6 +2,18 @@ package domain
 
 import "encoding/json"
 
+const (
+	// RuleValueTypeLength defines Value of comparison rule which is compared with length of string, slice or map
+	RuleValueTypeLength = "length"
+	// RuleValueTypeNumber defines Value of comparison rule which is compared with numeric value
+	RuleValueTypeNumber = "number"
+	// RuleValueTypeTime defines comparison rule which compares time with current time, so it has no Value
+	RuleValueTypeTime = "time"
+	// RuleValueTypeUnsupported defines comparison rule which can't be exported with typed Value, like min, max and len
+	// of time field or comparison of time with parameter, so clients leave it to server side validation
+	RuleValueTypeUnsupported = "unsupported"
+)
+
 type (
 	// ValidationInfo - represents the complete Validation Informations of your form. It can contain GeneralErrors and form field related errors.
 	ValidationInfo struct {
@@ -9,6 +21,8 @@ type (
 		fieldErrors map[string][]Error
 		// generalErrors list of general form errors, that are not related to any field
 		generalErrors []Error
+		// stopped marks that remaining form data validators of the chain are skipped
+		stopped bool
 	}
 
 	validationInfoEnodeAble struct {
@@ -23,6 +37,25 @@ type (
 		Name string
 		// Value additional parameter provided as condition for validation tag
 		Value string
+		// ValueType type of Value for comparison rules (min, max, len, gt, gte, lt and lte), depending on type of
+		// the field: "length" for strings, slices and maps, "number" for numbers and "time" for times, or "unsupported"
+		// for comparisons of times which can't be described for clients
+		ValueType string `json:",omitempty"`
+		// Meta additional metadata of the rule for clients, like JavaScript compatible form of pattern rules
+		// ("jsPattern" and "jsFlags")
+		Meta map[string]string `json:",omitempty"`
+		// Conditions referenced fields of conditional rules, under which the rule applies: "required_if" applies if all
+		// fields have their values, "required_with" if any field is present, "required_with_all" if all fields are
+		// present, "required_without" if any field is missing and "required_without_all" if all fields are missing
+		Conditions []RuleCondition `json:",omitempty"`
+	}
+
+	// RuleCondition - contains single referenced field of conditional validation rule
+	RuleCondition struct {
+		// Field form name of referenced field
+		Field string
+		// Value of referenced field, which makes "required_if" rule apply. It's empty for rules depending on presence of field
+		Value string `json:",omitempty"`
 	}
 
 	// Error - representation of an Error Message - intended usage is to display errors in the view to the end user
@@ -31,9 +64,32 @@ type (
 		MessageKey string
 		// DefaultLabel - a speaking error label. OFten used to show to end user - in case no translation exists
 		DefaultLabel string
+		// Params - parameters of the error message, like "field" (label of the field), "rule" (name of failed validation
+		// rule), "value" (value of the rule, like "128" for "max=128") and "reason" (reason code of failed rule).
+		// Often passed as arguments to translation func in the template
+		Params map[string]string `json:",omitempty"`
 	}
 )
 
+// NewErrorParams returns parameters of error message of failed validation rule, with label of the field and name
+// of the rule, and with value and reason code of the rule if they are not empty
+func NewErrorParams(field string, rule string, value string, reason string) map[string]string {
+	params := map[string]string{
+		"field": field,
+		"rule":  rule,
+	}
+
+	if value != "" {
+		params["value"] = value
+	}
+
+	if reason != "" {
+		params["reason"] = reason
+	}
+
+	return params
+}
+
 // IsValid method which defines if validation info is related to valid data or not
 func (vi *ValidationInfo) IsValid() bool {
 	return !vi.HasGeneralErrors() && !vi.HasAnyFieldErrors()
@@ -66,24 +122,16 @@ func (vi *ValidationInfo) HasErrorsForField(fieldName string) bool {
 // AppendGeneralErrors method which appends all provided validation errors to general errors, without duplicating existing ones
 func (vi *ValidationInfo) AppendGeneralErrors(errs []Error) {
 	for _, err := range errs {
-		vi.AddGeneralError(err.MessageKey, err.DefaultLabel)
+		vi.addGeneralError(err)
 	}
 }
 
 // AddGeneralError method which adds a general error with the passed MessageKey and DefaultLabel
 func (vi *ValidationInfo) AddGeneralError(messageKey string, defaultLabel string) {
-	keys := vi.getExistingMessageKeys(vi.generalErrors)
-
-	if keys[messageKey] {
-		return
-	}
-
-	err := Error{
+	vi.addGeneralError(Error{
 		MessageKey:   messageKey,
 		DefaultLabel: defaultLabel,
-	}
-
-	vi.generalErrors = append(vi.generalErrors, err)
+	})
 }
 
 // GetGeneralErrors method which returns list of all general validation errors
@@ -95,7 +143,7 @@ func (vi *ValidationInfo) GetGeneralErrors() []Error {
 func (vi *ValidationInfo) AppendFieldErrors(fieldErrors map[string][]Error) {
 	for fieldName, errs := range fieldErrors {
 		for _, err := range errs {
-			vi.AddFieldError(fieldName, err.MessageKey, err.DefaultLabel)
+			vi.addFieldError(fieldName, err)
 		}
 	}
 }
@@ -109,22 +157,17 @@ func (vi *ValidationInfo) RemoveAllFieldError(fieldName string) {
 
 // AddFieldError method which adds a field error with the passed field name, message key and default label
 func (vi *ValidationInfo) AddFieldError(fieldName string, messageKey string, defaultLabel string) {
-	if vi.fieldErrors == nil {
-		vi.fieldErrors = map[string][]Error{}
-	}
-
-	keys := vi.getExistingMessageKeys(vi.fieldErrors[fieldName])
-
-	if keys[messageKey] {
-		return
-	}
+	vi.AddFieldErrorWithParams(fieldName, messageKey, defaultLabel, nil)
+}
 
-	err := Error{
+// AddFieldErrorWithParams method which adds a field error with the passed field name, message key, default label
+// and parameters of the error message
+func (vi *ValidationInfo) AddFieldErrorWithParams(fieldName string, messageKey string, defaultLabel string, params map[string]string) {
+	vi.addFieldError(fieldName, Error{
 		MessageKey:   messageKey,
 		DefaultLabel: defaultLabel,
-	}
-
-	vi.fieldErrors[fieldName] = append(vi.fieldErrors[fieldName], err)
+		Params:       params,
+	})
 }
 
 // GetErrorsForAllFields method which returns list of all field validation errors for all fields
@@ -137,6 +180,33 @@ func (vi *ValidationInfo) GetErrorsForField(fieldName string) []Error {
 	return vi.fieldErrors[fieldName]
 }
 
+// StopValidation method which marks that remaining form data validators of the handler are skipped
+func (vi *ValidationInfo) StopValidation() {
+	vi.stopped = true
+}
+
+// IsValidationStopped method which defines if remaining form data validators of the handler are skipped
+func (vi *ValidationInfo) IsValidationStopped() bool {
+	return vi.stopped
+}
+
+// OverrideDefaultLabels method which replaces default labels of general and field errors by labels of their message keys
+func (vi *ValidationInfo) OverrideDefaultLabels(labels map[string]string) {
+	for i, err := range vi.generalErrors {
+		if label, ok := labels[err.MessageKey]; ok {
+			vi.generalErrors[i].DefaultLabel = label
+		}
+	}
+
+	for _, errs := range vi.fieldErrors {
+		for i, err := range errs {
+			if label, ok := labels[err.MessageKey]; ok {
+				errs[i].DefaultLabel = label
+			}
+		}
+	}
+}
+
 //GetValidationSummary - returns a string with all validation messages - useful for logging or other summarized needs
 func (vi *ValidationInfo) GetValidationSummary() string {
 	result := "invalid form: "
@@ -149,6 +219,32 @@ func (vi *ValidationInfo) GetValidationSummary() string {
 	return result
 }
 
+// addGeneralError method which adds a general error, if there is no general error with the same message key
+func (vi *ValidationInfo) addGeneralError(err Error) {
+	keys := vi.getExistingMessageKeys(vi.generalErrors)
+
+	if keys[err.MessageKey] {
+		return
+	}
+
+	vi.generalErrors = append(vi.generalErrors, err)
+}
+
+// addFieldError method which adds a field error, if there is no error of the field with the same message key
+func (vi *ValidationInfo) addFieldError(fieldName string, err Error) {
+	if vi.fieldErrors == nil {
+		vi.fieldErrors = map[string][]Error{}
+	}
+
+	keys := vi.getExistingMessageKeys(vi.fieldErrors[fieldName])
+
+	if keys[err.MessageKey] {
+		return
+	}
+
+	vi.fieldErrors[fieldName] = append(vi.fieldErrors[fieldName], err)
+}
+
 // getExistingMessageKeys method which returns all message keys used in specific list of validation errors
 func (vi *ValidationInfo) getExistingMessageKeys(errs []Error) map[string]bool {
 	keys := make(map[string]bool, len(errs))
@@ -241,6 +241,64 @@ func (t *ValidationInfoTestSuite) TestAddFieldError() {
 	}, t.validationInfo.GetErrorsForAllFields())
 }
 
+func (t *ValidationInfoTestSuite) TestAddFieldErrorWithParams() {
+	t.validationInfo.AddFieldErrorWithParams("fieldName1", "messageKey1", "defaultLabel1", map[string]string{"rule": "max"})
+	t.validationInfo.AddFieldErrorWithParams("fieldName1", "messageKey1", "defaultLabel2", nil)
+	t.validationInfo.AppendFieldErrors(map[string][]Error{
+		"fieldName2": {
+			{
+				MessageKey:   "messageKey2",
+				DefaultLabel: "defaultLabel2",
+				Params:       map[string]string{"rule": "min"},
+			},
+		},
+	})
+	t.validationInfo.AppendGeneralErrors([]Error{
+		{
+			MessageKey:   "messageKeyG",
+			DefaultLabel: "defaultLabelG",
+			Params:       map[string]string{"rule": "general"},
+		},
+	})
+
+	t.Equal(map[string][]Error{
+		"fieldName1": {
+			{
+				MessageKey:   "messageKey1",
+				DefaultLabel: "defaultLabel1",
+				Params:       map[string]string{"rule": "max"},
+			},
+		},
+		"fieldName2": {
+			{
+				MessageKey:   "messageKey2",
+				DefaultLabel: "defaultLabel2",
+				Params:       map[string]string{"rule": "min"},
+			},
+		},
+	}, t.validationInfo.GetErrorsForAllFields())
+	t.Equal([]Error{
+		{
+			MessageKey:   "messageKeyG",
+			DefaultLabel: "defaultLabelG",
+			Params:       map[string]string{"rule": "general"},
+		},
+	}, t.validationInfo.GetGeneralErrors())
+}
+
+func (t *ValidationInfoTestSuite) TestNewErrorParams() {
+	t.Equal(map[string]string{
+		"field": "Name",
+		"rule":  "required",
+	}, NewErrorParams("Name", "required", "", ""))
+	t.Equal(map[string]string{
+		"field":  "Username",
+		"rule":   "handle",
+		"value":  "3",
+		"reason": "reserved",
+	}, NewErrorParams("Username", "handle", "3", "reserved"))
+}
+
 func (t *ValidationInfoTestSuite) TestAppendFieldErrors() {
 	t.False(t.validationInfo.HasErrorsForField("fieldName1"))
 	t.Empty(t.validationInfo.GetErrorsForField("fieldName1"))
@@ -306,6 +364,50 @@ func (t *ValidationInfoTestSuite) TestAppendFieldErrors() {
 	}, t.validationInfo.GetErrorsForAllFields())
 }
 
+func (t *ValidationInfoTestSuite) TestStopValidation() {
+	t.False(t.validationInfo.IsValidationStopped())
+
+	t.validationInfo.StopValidation()
+
+	t.True(t.validationInfo.IsValidationStopped())
+	t.True(t.validationInfo.IsValid())
+}
+
+func (t *ValidationInfoTestSuite) TestOverrideDefaultLabels() {
+	t.validationInfo.AddFieldError("fieldName1", "messageKey1", "defaultLabel1")
+	t.validationInfo.AddFieldError("fieldName1", "messageKey2", "defaultLabel2")
+	t.validationInfo.AddGeneralError("messageKey1", "defaultLabel1")
+	t.validationInfo.AddGeneralError("messageKey3", "defaultLabel3")
+
+	t.validationInfo.OverrideDefaultLabels(map[string]string{
+		"messageKey1": "brandLabel1",
+		"messageKey4": "brandLabel4",
+	})
+
+	t.Equal(map[string][]Error{
+		"fieldName1": {
+			{
+				MessageKey:   "messageKey1",
+				DefaultLabel: "brandLabel1",
+			},
+			{
+				MessageKey:   "messageKey2",
+				DefaultLabel: "defaultLabel2",
+			},
+		},
+	}, t.validationInfo.GetErrorsForAllFields())
+	t.Equal([]Error{
+		{
+			MessageKey:   "messageKey1",
+			DefaultLabel: "brandLabel1",
+		},
+		{
+			MessageKey:   "messageKey3",
+			DefaultLabel: "defaultLabel3",
+		},
+	}, t.validationInfo.GetGeneralErrors())
+}
+
 func (t *ValidationInfoTestSuite) TestMarshalJson() {
 	t.validationInfo.AddFieldError("key", "error", "error")
 	jsonString, _ := json.Marshal(t.validationInfo)
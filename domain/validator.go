@@ -26,6 +26,27 @@ type (
 		ValidateField(ctx context.Context, fl validator.FieldLevel) bool
 	}
 
+	// RuleMetaResolver as optional interface for field validators, which resolve metadata of their rules per request
+	// (like constraints depending on currency of the form), so exported validation rules describe effective constraints
+	RuleMetaResolver interface {
+		// ResolveRuleMeta returns metadata of the rule of the field, depending on the request and form data
+		ResolveRuleMeta(ctx context.Context, req *web.Request, formData interface{}, fieldName string, rule ValidationRule) map[string]string
+	}
+
+	// FailureReasoner as optional interface for field validators, which explain invalid values by machine-readable
+	// reason code (like "reserved" for handle rule). Reason code is appended to message key of the field error.
+	FailureReasoner interface {
+		// FailureReason returns reason code of invalid value, or empty string if there is no specific reason
+		FailureReason(value interface{}, param string) string
+	}
+
+	// NilFieldValidator as optional interface for field validators, which validate nil fields as well (like conditionally
+	// required fields), instead of treating them as invalid
+	NilFieldValidator interface {
+		// ValidatesNilFields returns true if validator is called for nil fields
+		ValidatesNilFields() bool
+	}
+
 	// StructValidator as interface for defining custom struct validation
 	StructValidator interface {
 		// StructType defines struct type which should be validated
@@ -0,0 +1,85 @@
+package validators
+
+import (
+	"flamingo.me/form/domain"
+)
+
+type (
+	// MinAgeValidator defines minimum age validator registered as "minage", which validates the same as "minimumage",
+	// but compares date of birth with current date in configured timezone, so person becomes of age at midnight
+	// of the birthday in that timezone.
+	//
+	// Data struct {
+	//	 Date string `validate:"minage=18"`
+	// }
+	//
+	MinAgeValidator struct {
+		MinimumAgeValidator
+	}
+
+	// MaxAgeValidator defines maximum age validator registered as "maxage", which validates the same as "maximumage",
+	// but compares date of birth with current date in configured timezone.
+	//
+	// Data struct {
+	//	 Date string `validate:"maxage=150"`
+	// }
+	//
+	MaxAgeValidator struct {
+		MaximumAgeValidator
+	}
+)
+
+var (
+	_ domain.FieldValidator   = &MinAgeValidator{}
+	_ domain.RuleDescriber    = &MinAgeValidator{}
+	_ domain.RuleMetaResolver = &MinAgeValidator{}
+	_ domain.FieldValidator   = &MaxAgeValidator{}
+	_ domain.RuleDescriber    = &MaxAgeValidator{}
+	_ domain.RuleMetaResolver = &MaxAgeValidator{}
+)
+
+// Inject is method used to set all dependencies as local variables. Empty timezone is local timezone.
+func (v *MinAgeValidator) Inject(clock domain.Clock, cfg *struct {
+	DateFormat string `inject:"config:form.validator.dateFormat"`
+	Timezone   string `inject:"config:form.validator.timezone"`
+}) {
+	v.dateFormat = cfg.DateFormat
+	v.location = loadAgeLocation(cfg.Timezone)
+	v.clock = clock
+}
+
+// ValidatorName defines tag name of minimum age validator
+func (v *MinAgeValidator) ValidatorName() string {
+	return "minage"
+}
+
+// DescribeRule returns description of minimum age rule
+func (v *MinAgeValidator) DescribeRule() domain.RuleDescription {
+	description := v.MinimumAgeValidator.DescribeRule()
+	description.Name = v.ValidatorName()
+
+	return description
+}
+
+// Inject is method used to set all dependencies as local variables. Empty timezone is local timezone.
+func (v *MaxAgeValidator) Inject(clock domain.Clock, cfg *struct {
+	DateFormat string `inject:"config:form.validator.dateFormat"`
+	Timezone   string `inject:"config:form.validator.timezone"`
+}) {
+	v.dateFormat = cfg.DateFormat
+	v.location = loadAgeLocation(cfg.Timezone)
+	v.clock = clock
+}
+
+// ValidatorName defines tag name of maximum age validator
+func (v *MaxAgeValidator) ValidatorName() string {
+	return "maxage"
+}
+
+// DescribeRule returns description of maximum age rule
+func (v *MaxAgeValidator) DescribeRule() domain.RuleDescription {
+	description := v.MaximumAgeValidator.DescribeRule()
+	description.Name = v.ValidatorName()
+
+	return description
+}
@@ -0,0 +1,131 @@
+package validators
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+	"flamingo.me/form/formtest"
+)
+
+type (
+	AgeValidatorTestSuite struct {
+		suite.Suite
+
+		clock domain.Clock
+	}
+)
+
+func TestAgeValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &AgeValidatorTestSuite{})
+}
+
+func (t *AgeValidatorTestSuite) SetupTest() {
+	// it's already 29th of February 2024 in Berlin
+	t.clock = formtest.NewFakeClock(time.Date(2024, time.February, 28, 23, 30, 0, 0, time.UTC))
+}
+
+func (t *AgeValidatorTestSuite) minAgeValidator(timezone string) *MinAgeValidator {
+	validator := &MinAgeValidator{}
+	validator.Inject(t.clock, &struct {
+		DateFormat string `inject:"config:form.validator.dateFormat"`
+		Timezone   string `inject:"config:form.validator.timezone"`
+	}{
+		DateFormat: "2006-01-02",
+		Timezone:   timezone,
+	})
+
+	return validator
+}
+
+func (t *AgeValidatorTestSuite) maxAgeValidator(timezone string) *MaxAgeValidator {
+	validator := &MaxAgeValidator{}
+	validator.Inject(t.clock, &struct {
+		DateFormat string `inject:"config:form.validator.dateFormat"`
+		Timezone   string `inject:"config:form.validator.timezone"`
+	}{
+		DateFormat: "2006-01-02",
+		Timezone:   timezone,
+	})
+
+	return validator
+}
+
+func (t *AgeValidatorTestSuite) TestInject() {
+	t.Equal(time.Local, t.minAgeValidator("").location)
+	t.Equal(time.Local, t.maxAgeValidator("").location)
+	t.Equal("Europe/Berlin", t.minAgeValidator("Europe/Berlin").location.String())
+	t.Equal("Europe/Berlin", t.maxAgeValidator("Europe/Berlin").location.String())
+
+	t.Panics(func() {
+		t.minAgeValidator("Unknown/Unknown")
+	})
+	t.Panics(func() {
+		t.maxAgeValidator("Unknown/Unknown")
+	})
+}
+
+func (t *AgeValidatorTestSuite) TestValidatorName() {
+	t.Equal("minage", t.minAgeValidator("").ValidatorName())
+	t.Equal("maxage", t.maxAgeValidator("").ValidatorName())
+}
+
+func (t *AgeValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "minage",
+		Description: "date in format 2006-01-02, at least desired number of years ago",
+		Params: map[string]interface{}{
+			"type":    "integer",
+			"minimum": 0,
+		},
+	}, t.minAgeValidator("").DescribeRule())
+	t.Equal(domain.RuleDescription{
+		Name:        "maxage",
+		Description: "date in format 2006-01-02, not more than desired number of years ago",
+		Params: map[string]interface{}{
+			"type":    "integer",
+			"minimum": 0,
+		},
+	}, t.maxAgeValidator("").DescribeRule())
+}
+
+func (t *AgeValidatorTestSuite) TestValidateField() {
+	// it's already 1st of April 2024 in Berlin
+	t.clock = formtest.NewFakeClock(time.Date(2024, time.March, 31, 23, 30, 0, 0, time.UTC))
+
+	fieldLevel := &mocks.FieldLevel{}
+	fieldLevel.On("Field").Return(reflect.ValueOf("2006-04-01"))
+	fieldLevel.On("Param").Return("18")
+
+	t.True(t.minAgeValidator("Europe/Berlin").ValidateField(nil, fieldLevel))
+	t.False(t.minAgeValidator("UTC").ValidateField(nil, fieldLevel))
+
+	fieldLevel = &mocks.FieldLevel{}
+	fieldLevel.On("Field").Return(reflect.ValueOf("1874-03-31"))
+	fieldLevel.On("Param").Return("150")
+
+	t.False(t.maxAgeValidator("Europe/Berlin").ValidateField(nil, fieldLevel))
+	t.True(t.maxAgeValidator("UTC").ValidateField(nil, fieldLevel))
+}
+
+func (t *AgeValidatorTestSuite) TestResolveRuleMeta() {
+	t.Equal(map[string]string{
+		"maxDate":  "2006-02-28",
+		"timezone": "Europe/Berlin",
+	}, t.minAgeValidator("Europe/Berlin").ResolveRuleMeta(context.Background(), nil, nil, "dateOfBirth", domain.ValidationRule{
+		Name:  "minage",
+		Value: "18",
+	}))
+	t.Equal(map[string]string{
+		"minDate":  "1874-02-28",
+		"timezone": "Europe/Berlin",
+	}, t.maxAgeValidator("Europe/Berlin").ResolveRuleMeta(context.Background(), nil, nil, "dateOfBirth", domain.ValidationRule{
+		Name:  "maxage",
+		Value: "150",
+	}))
+}
@@ -0,0 +1,276 @@
+package validators
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+type (
+	// AmountValidator defines validator of decimal amounts, which validates decimal places and range of the amount
+	// depending on its currency. Currency is taken from another field of the same struct, from session value
+	// ("session:key"), or configured default currency is used. Constraints per currency are defined by configuration,
+	// and currencies without configuration are validated only by their ISO 4217 decimal places.
+	//
+	// Data struct {
+	//	 Amount   string `validate:"amount=Currency"`
+	//	 Currency string
+	//	 Donation string `validate:"amount=session:currency"`
+	// }
+	//
+	AmountValidator struct {
+		defaultCurrency string
+		currencies      map[string]AmountConstraints
+	}
+
+	// AmountConstraints defines constraints of amounts in single currency
+	AmountConstraints struct {
+		// Decimals maximal number of decimal places, ISO 4217 decimal places of the currency if not defined
+		Decimals *int `json:"decimals"`
+		// Min minimal amount, not limited if not defined
+		Min *float64 `json:"min"`
+		// Max maximal amount, not limited if not defined
+		Max *float64 `json:"max"`
+	}
+)
+
+const (
+	// AmountValidatorName defines tag name of amount validator
+	AmountValidatorName = "amount"
+
+	// amountSessionPrefix prefix of parameter, which takes currency from session value
+	amountSessionPrefix = "session:"
+)
+
+var (
+	_ domain.FieldValidator   = &AmountValidator{}
+	_ domain.RuleDescriber    = &AmountValidator{}
+	_ domain.RuleMetaResolver = &AmountValidator{}
+
+	// amountRegex defines valid format of decimal amounts
+	amountRegex = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+	// currencyDecimals defines ISO 4217 decimal places of currencies, which don't have 2 decimal places
+	currencyDecimals = map[string]int{
+		"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+		"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+		"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (v *AmountValidator) Inject(cfg *struct {
+	DefaultCurrency string     `inject:"config:form.validator.amount.defaultCurrency"`
+	Currencies      config.Map `inject:"config:form.validator.amount.currencies"`
+}) {
+	v.defaultCurrency = strings.ToUpper(cfg.DefaultCurrency)
+
+	currencies := map[string]AmountConstraints{}
+	if err := cfg.Currencies.MapInto(&currencies); err != nil {
+		panic(err.Error())
+	}
+
+	v.currencies = make(map[string]AmountConstraints, len(currencies))
+	for currency, constraints := range currencies {
+		v.currencies[strings.ToUpper(currency)] = constraints
+	}
+}
+
+// ValidatorName defines tag name of amount validator
+func (v *AmountValidator) ValidatorName() string {
+	return AmountValidatorName
+}
+
+// DescribeRule returns description of amount rule
+func (v *AmountValidator) DescribeRule() domain.RuleDescription {
+	return domain.RuleDescription{
+		Name:        v.ValidatorName(),
+		Description: "decimal amount, with decimal places and range depending on currency taken from the field named by parameter, or from session value (\"session:key\")",
+		Params: map[string]interface{}{
+			"type": "string",
+		},
+	}
+}
+
+// ValidateField validates decimal amount by constraints of its currency. Valid if amount is empty string,
+// or it has allowed number of decimal places and it's in allowed range.
+func (v *AmountValidator) ValidateField(ctx context.Context, fl validator.FieldLevel) bool {
+	amount, ok := amountValue(fl.Field())
+	if !ok {
+		return false
+	}
+
+	if amount == "" {
+		return true
+	}
+
+	if !amountRegex.MatchString(amount) {
+		return false
+	}
+
+	currency := v.currency(fl.Param(), func() *web.Request {
+		if ctx == nil {
+			return nil
+		}
+		return web.RequestFromContext(ctx)
+	}, fl.Parent)
+	decimals, minAmount, maxAmount := v.constraints(currency)
+
+	if index := strings.Index(amount, "."); index >= 0 && len(amount)-index-1 > decimals {
+		return false
+	}
+
+	value, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return false
+	}
+
+	if minAmount != nil && value.Cmp(new(big.Rat).SetFloat64(*minAmount)) < 0 {
+		return false
+	}
+
+	if maxAmount != nil && value.Cmp(new(big.Rat).SetFloat64(*maxAmount)) > 0 {
+		return false
+	}
+
+	return true
+}
+
+// ResolveRuleMeta returns effective constraints of amount rule for the request, as "currency", "decimals",
+// "min" and "max" metadata. Currency is taken from provided form data, or from session.
+func (v *AmountValidator) ResolveRuleMeta(ctx context.Context, req *web.Request, formData interface{}, fieldName string, rule domain.ValidationRule) map[string]string {
+	currency := v.currency(rule.Value, func() *web.Request {
+		return req
+	}, func() reflect.Value {
+		return parentStruct(reflect.ValueOf(formData), strings.Split(fieldName, "."))
+	})
+	decimals, minAmount, maxAmount := v.constraints(currency)
+
+	meta := map[string]string{
+		"decimals": strconv.Itoa(decimals),
+	}
+
+	if currency != "" {
+		meta["currency"] = currency
+	}
+
+	if minAmount != nil {
+		meta["min"] = strconv.FormatFloat(*minAmount, 'f', -1, 64)
+	}
+
+	if maxAmount != nil {
+		meta["max"] = strconv.FormatFloat(*maxAmount, 'f', -1, 64)
+	}
+
+	return meta
+}
+
+// currency resolves currency of the amount from session value or field of parent struct named by parameter.
+// Request and parent struct are only looked up if they are needed. It returns default currency if currency
+// can't be resolved.
+func (v *AmountValidator) currency(param string, request func() *web.Request, parentStruct func() reflect.Value) string {
+	currency := ""
+
+	if strings.HasPrefix(param, amountSessionPrefix) {
+		if req := request(); req != nil && req.Session() != nil {
+			if value, ok := req.Session().Load(strings.TrimPrefix(param, amountSessionPrefix)); ok {
+				currency, _ = value.(string)
+			}
+		}
+	} else if param != "" {
+		parent := parentStruct()
+		for parent.IsValid() && parent.Kind() == reflect.Ptr {
+			parent = parent.Elem()
+		}
+
+		if parent.IsValid() && parent.Kind() == reflect.Struct {
+			if field := parent.FieldByName(param); field.IsValid() && field.Kind() == reflect.String {
+				currency = field.String()
+			}
+		}
+	}
+
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		return v.defaultCurrency
+	}
+
+	return currency
+}
+
+// constraints returns decimal places and range of amounts in the currency
+func (v *AmountValidator) constraints(currency string) (int, *float64, *float64) {
+	decimals, ok := currencyDecimals[currency]
+	if !ok {
+		decimals = 2
+	}
+
+	constraints := v.currencies[currency]
+	if constraints.Decimals != nil {
+		decimals = *constraints.Decimals
+	}
+
+	return decimals, constraints.Min, constraints.Max
+}
+
+// amountValue returns amount of the field as decimal string. It returns false for fields which can't contain amount.
+func amountValue(field reflect.Value) (string, bool) {
+	switch field.Kind() {
+	case reflect.String:
+		return strings.TrimSpace(field.String()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(field.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(field.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(field.Float(), 'f', -1, 64), true
+	}
+
+	return "", false
+}
+
+// parentStruct returns struct value which contains field on the path of form names (like "payment.amount").
+// It returns invalid value if path crosses nil pointer or unknown field.
+func parentStruct(valueOf reflect.Value, path []string) reflect.Value {
+	for _, name := range path[:len(path)-1] {
+		for valueOf.IsValid() && valueOf.Kind() == reflect.Ptr {
+			valueOf = valueOf.Elem()
+		}
+
+		if !valueOf.IsValid() || valueOf.Kind() != reflect.Struct {
+			return reflect.Value{}
+		}
+
+		valueOf = fieldByFormName(valueOf, name)
+	}
+
+	return valueOf
+}
+
+// fieldByFormName returns field of struct value by its form name, or by its go name if it has no "form" tag
+func fieldByFormName(valueOf reflect.Value, name string) reflect.Value {
+	typeOf := valueOf.Type()
+	for i := 0; i < typeOf.NumField(); i++ {
+		fieldType := typeOf.Field(i)
+
+		formName := fieldType.Tag.Get("form")
+		if formName == "" {
+			formName = fieldType.Name
+		}
+
+		if formName == name {
+			return valueOf.Field(i)
+		}
+	}
+
+	return reflect.Value{}
+}
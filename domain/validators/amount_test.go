@@ -0,0 +1,249 @@
+package validators
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	AmountValidatorTestSuite struct {
+		suite.Suite
+
+		validator *AmountValidator
+	}
+
+	amountTestPayment struct {
+		Amount   string `form:"amount"`
+		Currency string `form:"currency"`
+	}
+
+	amountTestData struct {
+		Payment  *amountTestPayment `form:"payment"`
+		Donation string             `form:"donation"`
+	}
+)
+
+func TestAmountValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &AmountValidatorTestSuite{})
+}
+
+func (t *AmountValidatorTestSuite) SetupTest() {
+	t.validator = &AmountValidator{}
+	t.validator.Inject(&struct {
+		DefaultCurrency string     `inject:"config:form.validator.amount.defaultCurrency"`
+		Currencies      config.Map `inject:"config:form.validator.amount.currencies"`
+	}{
+		DefaultCurrency: "eur",
+		Currencies: config.Map{
+			"eur": config.Map{
+				"min": 1.0,
+				"max": 100.0,
+			},
+			"JPY": config.Map{
+				"max": 10000.0,
+			},
+			"CHF": config.Map{
+				"decimals": 1.0,
+			},
+		},
+	})
+}
+
+func (t *AmountValidatorTestSuite) TestValidatorName() {
+	t.Equal("amount", t.validator.ValidatorName())
+}
+
+func (t *AmountValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "amount",
+		Description: "decimal amount, with decimal places and range depending on currency taken from the field named by parameter, or from session value (\"session:key\")",
+		Params: map[string]interface{}{
+			"type": "string",
+		},
+	}, t.validator.DescribeRule())
+}
+
+func (t *AmountValidatorTestSuite) TestValidateField_CurrencyField() {
+	testCases := []struct {
+		Amount   string
+		Currency string
+		Result   bool
+	}{
+		{
+			Amount:   "",
+			Currency: "EUR",
+			Result:   true,
+		},
+		{
+			Amount:   "wrong",
+			Currency: "EUR",
+			Result:   false,
+		},
+		{
+			Amount:   "1.",
+			Currency: "EUR",
+			Result:   false,
+		},
+		{
+			Amount:   "10.50",
+			Currency: "EUR",
+			Result:   true,
+		},
+		{
+			Amount:   "10.505",
+			Currency: "EUR",
+			Result:   false,
+		},
+		{
+			Amount:   "0.99",
+			Currency: "EUR",
+			Result:   false,
+		},
+		{
+			Amount:   "100.01",
+			Currency: "EUR",
+			Result:   false,
+		},
+		{
+			Amount:   "100",
+			Currency: "eur",
+			Result:   true,
+		},
+		{
+			Amount:   "9999",
+			Currency: "JPY",
+			Result:   true,
+		},
+		{
+			Amount:   "99.5",
+			Currency: "JPY",
+			Result:   false,
+		},
+		{
+			Amount:   "10001",
+			Currency: "JPY",
+			Result:   false,
+		},
+		{
+			Amount:   "0.125",
+			Currency: "KWD",
+			Result:   true,
+		},
+		{
+			Amount:   "0.25",
+			Currency: "CHF",
+			Result:   false,
+		},
+		{
+			Amount:   "0.5",
+			Currency: "",
+			Result:   false,
+		},
+		{
+			Amount:   "-1000000.25",
+			Currency: "USD",
+			Result:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		payment := amountTestPayment{
+			Amount:   testCase.Amount,
+			Currency: testCase.Currency,
+		}
+
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Field").Return(reflect.ValueOf(payment.Amount)).Once()
+		fieldLevel.On("Param").Return("Currency").Once()
+		fieldLevel.On("Parent").Return(reflect.ValueOf(payment)).Maybe()
+		t.Equal(testCase.Result, t.validator.ValidateField(nil, fieldLevel), testCase)
+		fieldLevel.AssertExpectations(t.T())
+	}
+}
+
+func (t *AmountValidatorTestSuite) TestValidateField_Session() {
+	session := web.EmptySession()
+	session.Store("currency", "JPY")
+	ctx := web.ContextWithRequest(context.Background(), web.CreateRequest(nil, session))
+
+	fieldLevel := &mocks.FieldLevel{}
+	fieldLevel.On("Field").Return(reflect.ValueOf("5000")).Once()
+	fieldLevel.On("Param").Return("session:currency").Once()
+	t.True(t.validator.ValidateField(ctx, fieldLevel))
+	fieldLevel.AssertExpectations(t.T())
+
+	fieldLevel = &mocks.FieldLevel{}
+	fieldLevel.On("Field").Return(reflect.ValueOf("5000")).Once()
+	fieldLevel.On("Param").Return("session:unknown").Once()
+	t.False(t.validator.ValidateField(ctx, fieldLevel))
+	fieldLevel.AssertExpectations(t.T())
+}
+
+func (t *AmountValidatorTestSuite) TestValidateField_NotAmount() {
+	fieldLevel := &mocks.FieldLevel{}
+	fieldLevel.On("Field").Return(reflect.ValueOf(true)).Once()
+	t.False(t.validator.ValidateField(nil, fieldLevel))
+	fieldLevel.AssertExpectations(t.T())
+
+	fieldLevel = &mocks.FieldLevel{}
+	fieldLevel.On("Field").Return(reflect.ValueOf(50)).Once()
+	fieldLevel.On("Param").Return("").Once()
+	t.True(t.validator.ValidateField(nil, fieldLevel))
+	fieldLevel.AssertExpectations(t.T())
+}
+
+func (t *AmountValidatorTestSuite) TestResolveRuleMeta() {
+	formData := &amountTestData{
+		Payment: &amountTestPayment{
+			Currency: "jpy",
+		},
+	}
+
+	t.Equal(map[string]string{
+		"currency": "JPY",
+		"decimals": "0",
+		"max":      "10000",
+	}, t.validator.ResolveRuleMeta(context.Background(), nil, formData, "payment.amount", domain.ValidationRule{
+		Name:  "amount",
+		Value: "Currency",
+	}))
+
+	t.Equal(map[string]string{
+		"currency": "EUR",
+		"decimals": "2",
+		"min":      "1",
+		"max":      "100",
+	}, t.validator.ResolveRuleMeta(context.Background(), nil, &amountTestData{}, "payment.amount", domain.ValidationRule{
+		Name:  "amount",
+		Value: "Currency",
+	}))
+
+	session := web.EmptySession()
+	session.Store("currency", "KWD")
+
+	t.Equal(map[string]string{
+		"currency": "KWD",
+		"decimals": "3",
+	}, t.validator.ResolveRuleMeta(context.Background(), web.CreateRequest(nil, session), formData, "donation", domain.ValidationRule{
+		Name:  "amount",
+		Value: "session:currency",
+	}))
+}
+
+func (t *AmountValidatorTestSuite) TestResolveRuleMeta_WithoutDefaultCurrency() {
+	validator := &AmountValidator{}
+
+	t.Equal(map[string]string{
+		"decimals": "2",
+	}, validator.ResolveRuleMeta(context.Background(), nil, nil, "donation", domain.ValidationRule{
+		Name: "amount",
+	}))
+}
@@ -0,0 +1,84 @@
+package validators
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"flamingo.me/form/domain"
+
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+type (
+	// AvailableValidator defines availability validator, which delegates to injected availability checker named
+	// by first parameter. Other parameters, separated by spaces, are passed to the checker.
+	//
+	// Data struct {
+	//	 DeliveryDate string `validate:"available=weekday mon tue wed thu fri"`
+	//	 DeliverySlot string `validate:"available=deliverySlot north"`
+	// }
+	//
+	AvailableValidator struct {
+		checkers map[string]domain.AvailabilityChecker
+	}
+)
+
+var (
+	_ domain.FieldValidator = &AvailableValidator{}
+	_ domain.RuleDescriber  = &AvailableValidator{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (v *AvailableValidator) Inject(checkers map[string]domain.AvailabilityChecker) {
+	v.checkers = checkers
+}
+
+// ValidatorName defines tag name of availability validator
+func (v *AvailableValidator) ValidatorName() string {
+	return "available"
+}
+
+// DescribeRule returns description of availability rule, with names of all injected availability checkers
+func (v *AvailableValidator) DescribeRule() domain.RuleDescription {
+	names := make([]string, 0, len(v.checkers))
+	for name := range v.checkers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return domain.RuleDescription{
+		Name:        v.ValidatorName(),
+		Description: "value available by checker named by parameter, followed by parameters of the checker separated by spaces (" + strings.Join(names, ", ") + ")",
+		Params: map[string]interface{}{
+			"type": "string",
+		},
+	}
+}
+
+// ValidateField validates value by availability checker. Valid if value is empty string or zero time,
+// or if checker reports it as available. It panics if there is no checker with desired name.
+func (v *AvailableValidator) ValidateField(ctx context.Context, fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() == reflect.String && strings.TrimSpace(field.String()) == "" {
+		return true
+	}
+
+	if date, ok := field.Interface().(time.Time); ok && date.IsZero() {
+		return true
+	}
+
+	params := strings.Fields(fl.Param())
+	if len(params) == 0 {
+		panic("missing name of availability checker")
+	}
+
+	checker, ok := v.checkers[params[0]]
+	if !ok {
+		panic("unknown availability checker " + params[0])
+	}
+
+	return checker.IsAvailable(ctx, field.Interface(), params[1:])
+}
@@ -0,0 +1,125 @@
+package validators
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	AvailableValidatorTestSuite struct {
+		suite.Suite
+
+		validator *AvailableValidator
+
+		slotChecker *mocks.AvailabilityChecker
+	}
+)
+
+func TestAvailableValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &AvailableValidatorTestSuite{})
+}
+
+func (t *AvailableValidatorTestSuite) SetupTest() {
+	t.slotChecker = &mocks.AvailabilityChecker{}
+
+	t.validator = &AvailableValidator{}
+	t.validator.Inject(map[string]domain.AvailabilityChecker{
+		"deliverySlot": t.slotChecker,
+		"weekday":      &WeekdayChecker{dateFormat: "2006-01-02"},
+	})
+}
+
+func (t *AvailableValidatorTestSuite) TearDownTest() {
+	t.slotChecker.AssertExpectations(t.T())
+	t.slotChecker = nil
+}
+
+func (t *AvailableValidatorTestSuite) TestValidatorName() {
+	t.Equal("available", t.validator.ValidatorName())
+}
+
+func (t *AvailableValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "available",
+		Description: "value available by checker named by parameter, followed by parameters of the checker separated by spaces (deliverySlot, weekday)",
+		Params: map[string]interface{}{
+			"type": "string",
+		},
+	}, t.validator.DescribeRule())
+}
+
+func (t *AvailableValidatorTestSuite) TestValidateField() {
+	ctx := context.Background()
+
+	t.slotChecker.On("IsAvailable", ctx, "2024-03-01 10:00", []string{"north"}).Return(true).Once()
+	t.slotChecker.On("IsAvailable", ctx, "2024-03-01 12:00", []string{"north"}).Return(false).Once()
+	t.slotChecker.On("IsAvailable", ctx, "2024-03-01 12:00", []string{}).Return(true).Once()
+
+	testCases := []struct {
+		Value  interface{}
+		Param  string
+		Result bool
+	}{
+		{
+			Value:  "2024-03-01 10:00",
+			Param:  "deliverySlot north",
+			Result: true,
+		},
+		{
+			Value:  "2024-03-01 12:00",
+			Param:  "deliverySlot  north",
+			Result: false,
+		},
+		{
+			Value:  "2024-03-01 12:00",
+			Param:  "deliverySlot",
+			Result: true,
+		},
+		{
+			Value:  "2024-03-01",
+			Param:  "weekday mon tue wed thu fri",
+			Result: true,
+		},
+		{
+			Value:  time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC),
+			Param:  "weekday mon tue wed thu fri",
+			Result: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Field").Return(reflect.ValueOf(testCase.Value)).Once()
+		fieldLevel.On("Param").Return(testCase.Param).Once()
+		t.Equal(testCase.Result, t.validator.ValidateField(ctx, fieldLevel), testCase.Value)
+		fieldLevel.AssertExpectations(t.T())
+	}
+}
+
+func (t *AvailableValidatorTestSuite) TestValidateField_Empty() {
+	for _, value := range []interface{}{"", " ", time.Time{}} {
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Field").Return(reflect.ValueOf(value)).Once()
+		t.True(t.validator.ValidateField(context.Background(), fieldLevel))
+		fieldLevel.AssertExpectations(t.T())
+	}
+}
+
+func (t *AvailableValidatorTestSuite) TestValidateField_UnknownChecker() {
+	for _, param := range []string{"", "holiday DE"} {
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Field").Return(reflect.ValueOf("2024-03-01")).Once()
+		fieldLevel.On("Param").Return(param).Once()
+
+		t.Panics(func() {
+			t.validator.ValidateField(context.Background(), fieldLevel)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package validators
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// birthDate returns date of birth of the field in the timezone, without time of day. Field is string in date format
+// or time.Time value. It returns false if field is empty, in wrong date format or not a date.
+func birthDate(field reflect.Value, dateFormat string, location *time.Location) (time.Time, bool) {
+	var date time.Time
+
+	switch converted := field.Interface().(type) {
+	case string:
+		if len(strings.TrimSpace(converted)) == 0 {
+			return time.Time{}, false
+		}
+
+		parsed, err := time.ParseInLocation(dateFormat, converted, location)
+		if err != nil {
+			return time.Time{}, false
+		}
+		date = parsed
+	case time.Time:
+		if converted.IsZero() {
+			return time.Time{}, false
+		}
+		date = converted.In(location)
+	default:
+		return time.Time{}, false
+	}
+
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, location), true
+}
+
+// birthDateYearsAgo returns the same day as current date in the timezone, desired years ago. On 29th of February,
+// it's 28th of February of years without that day.
+func birthDateYearsAgo(now time.Time, years int, location *time.Location) time.Time {
+	now = now.In(location)
+
+	date := time.Date(now.Year()-years, now.Month(), now.Day(), 0, 0, 0, 0, location)
+	if date.Month() != now.Month() {
+		date = time.Date(now.Year()-years, now.Month()+1, 0, 0, 0, 0, 0, location)
+	}
+
+	return date
+}
+
+// loadAgeLocation loads timezone of age validators by its name. Empty name is local timezone, same as "Local".
+// It panics on unknown timezone.
+func loadAgeLocation(timezone string) *time.Location {
+	if timezone == "" {
+		return time.Local
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return location
+}
+
+// ageLocation returns timezone of age validators, which is local timezone if none is configured
+func ageLocation(location *time.Location) *time.Location {
+	if location == nil {
+		return time.Local
+	}
+
+	return location
+}
+
+// parseYears parses number of years of age rule parameter. It panics on invalid parameter, same as built-in rules.
+func parseYears(param string) int {
+	if param == "" {
+		return 0
+	}
+
+	years, err := strconv.Atoi(param)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return years
+}
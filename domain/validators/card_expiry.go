@@ -0,0 +1,81 @@
+package validators
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"flamingo.me/form/domain"
+
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+type (
+	// CardExpiryValidator defines validator of card expiry dates in format "MM/YY" or "MM/YYYY",
+	// which validates if card is not expired
+	//
+	// Data struct {
+	//	 CardExpiry string `validate:"cardexpiry"`
+	// }
+	//
+	CardExpiryValidator struct {
+		clock domain.Clock
+	}
+)
+
+var (
+	_ domain.FieldValidator = &CardExpiryValidator{}
+	_ domain.RuleDescriber  = &CardExpiryValidator{}
+
+	// cardExpiryRegex defines valid formats of card expiry date
+	cardExpiryRegex = regexp.MustCompile(`^(0[1-9]|1[0-2]) ?/ ?([0-9]{2}|[0-9]{4})$`)
+)
+
+// Inject is method used to set all dependencies as local variables
+func (v *CardExpiryValidator) Inject(clock domain.Clock) {
+	v.clock = clock
+}
+
+// ValidatorName defines tag name of card expiry validator
+func (v *CardExpiryValidator) ValidatorName() string {
+	return "cardexpiry"
+}
+
+// DescribeRule returns description of card expiry rule
+func (v *CardExpiryValidator) DescribeRule() domain.RuleDescription {
+	return domain.RuleDescription{
+		Name:        v.ValidatorName(),
+		Description: "card expiry date in format MM/YY or MM/YYYY, which is not in the past",
+	}
+}
+
+// ValidateField validates string for card expiry date. Valid if string is empty or card expires
+// in the current month or later.
+func (v *CardExpiryValidator) ValidateField(_ context.Context, fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	expiry := strings.TrimSpace(field.String())
+	if expiry == "" {
+		return true
+	}
+
+	matches := cardExpiryRegex.FindStringSubmatch(expiry)
+	if matches == nil {
+		return false
+	}
+
+	month, _ := strconv.Atoi(matches[1])
+	year, _ := strconv.Atoi(matches[2])
+	if len(matches[2]) == 2 {
+		year += 2000
+	}
+
+	now := domain.CurrentTime(v.clock)
+
+	return year > now.Year() || (year == now.Year() && month >= int(now.Month()))
+}
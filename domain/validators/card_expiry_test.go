@@ -0,0 +1,96 @@
+package validators
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+	"flamingo.me/form/formtest"
+)
+
+type (
+	CardExpiryValidatorTestSuite struct {
+		suite.Suite
+
+		validator *CardExpiryValidator
+	}
+)
+
+func TestCardExpiryValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &CardExpiryValidatorTestSuite{})
+}
+
+func (t *CardExpiryValidatorTestSuite) SetupTest() {
+	t.validator = &CardExpiryValidator{}
+	t.validator.Inject(formtest.NewFakeClock(time.Date(2020, time.June, 15, 12, 0, 0, 0, time.UTC)))
+}
+
+func (t *CardExpiryValidatorTestSuite) TestValidatorName() {
+	t.Equal("cardexpiry", t.validator.ValidatorName())
+}
+
+func (t *CardExpiryValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "cardexpiry",
+		Description: "card expiry date in format MM/YY or MM/YYYY, which is not in the past",
+	}, t.validator.DescribeRule())
+}
+
+func (t *CardExpiryValidatorTestSuite) TestValidateField() {
+	testCases := []struct {
+		Value  interface{}
+		Result bool
+	}{
+		{
+			Value:  "",
+			Result: true,
+		},
+		{
+			Value:  "06/20",
+			Result: true,
+		},
+		{
+			Value:  "01/21",
+			Result: true,
+		},
+		{
+			Value:  "12 / 2025",
+			Result: true,
+		},
+		{
+			Value:  "05/20",
+			Result: false,
+		},
+		{
+			Value:  "12/2019",
+			Result: false,
+		},
+		{
+			Value:  "13/21",
+			Result: false,
+		},
+		{
+			Value:  "6/21",
+			Result: false,
+		},
+		{
+			Value:  "wrong",
+			Result: false,
+		},
+		{
+			Value:  621,
+			Result: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Field").Return(reflect.ValueOf(testCase.Value)).Once()
+		t.Equal(testCase.Result, t.validator.ValidateField(nil, fieldLevel))
+		fieldLevel.AssertExpectations(t.T())
+	}
+}
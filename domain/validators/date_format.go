@@ -22,7 +22,10 @@ type (
 	}
 )
 
-var _ domain.FieldValidator = &DateFormatValidator{}
+var (
+	_ domain.FieldValidator = &DateFormatValidator{}
+	_ domain.RuleDescriber  = &DateFormatValidator{}
+)
 
 // Inject is method used to set all dependencies as local variables
 func (v *DateFormatValidator) Inject(cfg *struct {
@@ -36,6 +39,14 @@ func (v *DateFormatValidator) ValidatorName() string {
 	return "dateformat"
 }
 
+// DescribeRule returns description of date format rule
+func (v *DateFormatValidator) DescribeRule() domain.RuleDescription {
+	return domain.RuleDescription{
+		Name:        v.ValidatorName(),
+		Description: "date in format " + v.dateFormat,
+	}
+}
+
 // ValidateField validates string for right date format. Valid if string is empty or in right date format.
 func (v *DateFormatValidator) ValidateField(_ context.Context, fl validator.FieldLevel) bool {
 	converted, ok := fl.Field().Interface().(string)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	"flamingo.me/form/domain"
 	"flamingo.me/form/domain/mocks"
 )
 
@@ -34,6 +35,13 @@ func (t *DateFormatValidatorTestSuite) TestValidatorName() {
 	t.Equal("dateformat", t.validator.ValidatorName())
 }
 
+func (t *DateFormatValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "dateformat",
+		Description: "date in format 2006-01-02",
+	}, t.validator.DescribeRule())
+}
+
 func (t *DateFormatValidatorTestSuite) TestValidateField() {
 	testCases := []struct {
 		Date   string
@@ -0,0 +1,57 @@
+package validators
+
+// defaultDisposableDomains defines embedded list of domains of common disposable email services.
+// List is kept sorted, so updates can be reviewed easily.
+var defaultDisposableDomains = []string{
+	"10minutemail.com",
+	"20minutemail.com",
+	"33mail.com",
+	"anonbox.net",
+	"burnermail.io",
+	"discard.email",
+	"dispostable.com",
+	"dropmail.me",
+	"emailondeck.com",
+	"fakeinbox.com",
+	"fakemail.net",
+	"getairmail.com",
+	"getnada.com",
+	"guerrillamail.biz",
+	"guerrillamail.com",
+	"guerrillamail.de",
+	"guerrillamail.info",
+	"guerrillamail.net",
+	"guerrillamail.org",
+	"guerrillamailblock.com",
+	"harakirimail.com",
+	"incognitomail.org",
+	"jetable.org",
+	"mailcatch.com",
+	"maildrop.cc",
+	"mailinator.com",
+	"mailinator.net",
+	"mailnesia.com",
+	"mailsac.com",
+	"mintemail.com",
+	"moakt.com",
+	"mohmal.com",
+	"mytemp.email",
+	"mytrashmail.com",
+	"nada.email",
+	"sharklasers.com",
+	"spam4.me",
+	"spambox.us",
+	"spamgourmet.com",
+	"temp-mail.org",
+	"tempail.com",
+	"tempmail.net",
+	"tempmailo.com",
+	"tempr.email",
+	"throwawaymail.com",
+	"trashmail.com",
+	"trashmail.de",
+	"trashmail.net",
+	"yopmail.com",
+	"yopmail.fr",
+	"yopmail.net",
+}
@@ -0,0 +1,158 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain"
+
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+type (
+	// DisposableDomainProvider defines source of domains of disposable email services (like maintained list of
+	// external service). Providers are injected via injector.BindMulti and email is disposable if any of them
+	// reports its domain.
+	DisposableDomainProvider interface {
+		// IsDisposable returns if domain name (in lower case) belongs to disposable email service
+		IsDisposable(ctx context.Context, domain string) bool
+	}
+
+	// DisposableEmailValidator defines validator of email addresses, which must not belong to disposable email services.
+	// Subdomains of disposable domains are disposable as well.
+	//
+	// Data struct {
+	//	 Email string `validate:"required,email,email_not_disposable"`
+	// }
+	//
+	DisposableEmailValidator struct {
+		providers []DisposableDomainProvider
+	}
+
+	// DisposableDomainList defines provider of disposable domains, which uses embedded list of common disposable email
+	// services extended by configured domains. Domains can be allowed by configuration, and list can be updated
+	// in runtime (like from periodically downloaded list).
+	DisposableDomainList struct {
+		mutex   sync.RWMutex
+		domains map[string]bool
+		allowed map[string]bool
+	}
+)
+
+var (
+	_ domain.FieldValidator    = &DisposableEmailValidator{}
+	_ domain.RuleDescriber     = &DisposableEmailValidator{}
+	_ DisposableDomainProvider = &DisposableDomainList{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (v *DisposableEmailValidator) Inject(providers []DisposableDomainProvider) {
+	v.providers = providers
+}
+
+// ValidatorName defines tag name of disposable email validator
+func (v *DisposableEmailValidator) ValidatorName() string {
+	return "email_not_disposable"
+}
+
+// DescribeRule returns description of disposable email rule
+func (v *DisposableEmailValidator) DescribeRule() domain.RuleDescription {
+	return domain.RuleDescription{
+		Name:        v.ValidatorName(),
+		Description: "email address which doesn't belong to disposable email service",
+	}
+}
+
+// ValidateField validates that email doesn't belong to disposable email service. Valid if string is empty, nil
+// pointer or has no domain, so format is validated by "email" rule only. Field is any string type or pointer to it,
+// it panics for fields of other types, same as built-in rules.
+func (v *DisposableEmailValidator) ValidateField(ctx context.Context, fl validator.FieldLevel) bool {
+	field := fl.Field()
+	for field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return true
+		}
+		field = field.Elem()
+	}
+
+	if field.Kind() != reflect.String {
+		panic(fmt.Sprintf("validation rule %q requires string field, got %v", v.ValidatorName(), field.Type()))
+	}
+
+	email := strings.TrimSpace(field.String())
+	index := strings.LastIndex(email, "@")
+	if index < 0 {
+		return true
+	}
+
+	emailDomain := strings.TrimSuffix(strings.ToLower(email[index+1:]), ".")
+	for emailDomain != "" {
+		for _, provider := range v.providers {
+			if provider.IsDisposable(ctx, emailDomain) {
+				return false
+			}
+		}
+
+		dot := strings.Index(emailDomain, ".")
+		if dot < 0 {
+			break
+		}
+		emailDomain = emailDomain[dot+1:]
+	}
+
+	return true
+}
+
+// Inject is method used to set all dependencies as local variables
+func (l *DisposableDomainList) Inject(cfg *struct {
+	Domains config.Slice `inject:"config:form.validator.disposableEmail.domains"`
+	Allowed config.Slice `inject:"config:form.validator.disposableEmail.allowed"`
+}) {
+	var domains, allowed []string
+	if cfg != nil {
+		if err := cfg.Domains.MapInto(&domains); err != nil {
+			panic(err.Error())
+		}
+		if err := cfg.Allowed.MapInto(&allowed); err != nil {
+			panic(err.Error())
+		}
+	}
+
+	l.allowed = domainSet(allowed)
+	l.Update(append(append([]string{}, defaultDisposableDomains...), domains...))
+}
+
+// Update replaces list of disposable domains. Allowed domains stay allowed.
+func (l *DisposableDomainList) Update(domains []string) {
+	set := domainSet(domains)
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.domains = set
+}
+
+// IsDisposable returns if domain is part of the list and it's not allowed
+func (l *DisposableDomainList) IsDisposable(_ context.Context, name string) bool {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return l.domains[name] && !l.allowed[name]
+}
+
+// domainSet returns set of domains in lower case
+func domainSet(domains []string) map[string]bool {
+	set := make(map[string]bool, len(domains))
+	for _, name := range domains {
+		name = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+		if name != "" {
+			set[name] = true
+		}
+	}
+
+	return set
+}
@@ -0,0 +1,175 @@
+package validators
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	DisposableEmailValidatorTestSuite struct {
+		suite.Suite
+
+		validator *DisposableEmailValidator
+		list      *DisposableDomainList
+	}
+
+	disposableEmailTestProvider struct {
+		domains []string
+	}
+)
+
+func (p *disposableEmailTestProvider) IsDisposable(_ context.Context, name string) bool {
+	p.domains = append(p.domains, name)
+
+	return name == "custom-trash.example"
+}
+
+func TestDisposableEmailValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &DisposableEmailValidatorTestSuite{})
+}
+
+func (t *DisposableEmailValidatorTestSuite) SetupTest() {
+	t.list = &DisposableDomainList{}
+	t.list.Inject(&struct {
+		Domains config.Slice `inject:"config:form.validator.disposableEmail.domains"`
+		Allowed config.Slice `inject:"config:form.validator.disposableEmail.allowed"`
+	}{
+		Domains: config.Slice{"Throwaway.example"},
+		Allowed: config.Slice{"33mail.com"},
+	})
+
+	t.validator = &DisposableEmailValidator{}
+	t.validator.Inject([]DisposableDomainProvider{t.list})
+}
+
+func (t *DisposableEmailValidatorTestSuite) TestDefaultDisposableDomainsSorted() {
+	t.True(sort.StringsAreSorted(defaultDisposableDomains))
+}
+
+func (t *DisposableEmailValidatorTestSuite) TestValidatorName() {
+	t.Equal("email_not_disposable", t.validator.ValidatorName())
+}
+
+func (t *DisposableEmailValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "email_not_disposable",
+		Description: "email address which doesn't belong to disposable email service",
+	}, t.validator.DescribeRule())
+}
+
+func (t *DisposableEmailValidatorTestSuite) TestValidateField() {
+	testCases := []struct {
+		Email  interface{}
+		Result bool
+	}{
+		{
+			Email:  "",
+			Result: true,
+		},
+		{
+			Email:  "wrong",
+			Result: true,
+		},
+		{
+			Email:  "user@example.com",
+			Result: true,
+		},
+		{
+			Email:  "user@mailinator.com",
+			Result: false,
+		},
+		{
+			Email:  " User@YOPMAIL.com. ",
+			Result: false,
+		},
+		{
+			Email:  "user@inbox.guerrillamail.com",
+			Result: false,
+		},
+		{
+			Email:  "user@throwaway.example",
+			Result: false,
+		},
+		{
+			Email:  "user@33mail.com",
+			Result: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Field").Return(reflect.ValueOf(testCase.Email)).Once()
+		t.Equal(testCase.Result, t.validator.ValidateField(context.Background(), fieldLevel), testCase.Email)
+		fieldLevel.AssertExpectations(t.T())
+	}
+}
+
+func (t *DisposableEmailValidatorTestSuite) TestValidateField_Types() {
+	type email string
+
+	disposable := "user@mailinator.com"
+	valid := "user@example.com"
+	var empty *string
+
+	testCases := []struct {
+		Email  interface{}
+		Result bool
+	}{
+		{
+			Email:  &disposable,
+			Result: false,
+		},
+		{
+			Email:  &valid,
+			Result: true,
+		},
+		{
+			Email:  empty,
+			Result: true,
+		},
+		{
+			Email:  email("user@mailinator.com"),
+			Result: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Field").Return(reflect.ValueOf(testCase.Email)).Once()
+		t.Equal(testCase.Result, t.validator.ValidateField(context.Background(), fieldLevel), testCase.Email)
+		fieldLevel.AssertExpectations(t.T())
+	}
+
+	fieldLevel := &mocks.FieldLevel{}
+	fieldLevel.On("Field").Return(reflect.ValueOf(10)).Once()
+	t.PanicsWithValue(`validation rule "email_not_disposable" requires string field, got int`, func() {
+		t.validator.ValidateField(context.Background(), fieldLevel)
+	})
+}
+
+func (t *DisposableEmailValidatorTestSuite) TestValidateField_Providers() {
+	provider := &disposableEmailTestProvider{}
+	t.validator.Inject([]DisposableDomainProvider{t.list, provider})
+
+	fieldLevel := &mocks.FieldLevel{}
+	fieldLevel.On("Field").Return(reflect.ValueOf("user@mx.custom-trash.example")).Once()
+	t.False(t.validator.ValidateField(context.Background(), fieldLevel))
+	t.Equal([]string{"mx.custom-trash.example", "custom-trash.example"}, provider.domains)
+	fieldLevel.AssertExpectations(t.T())
+}
+
+func (t *DisposableEmailValidatorTestSuite) TestUpdate() {
+	t.list.Update([]string{"new-trash.example", "33mail.com"})
+
+	t.True(t.list.IsDisposable(context.Background(), "new-trash.example"))
+	t.False(t.list.IsDisposable(context.Background(), "mailinator.com"))
+	t.False(t.list.IsDisposable(context.Background(), "33mail.com"))
+}
@@ -0,0 +1,87 @@
+package validators
+
+import (
+	"context"
+	"mime"
+	"strconv"
+	"strings"
+
+	validator "gopkg.in/go-playground/validator.v9"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// FileValidator defines struct validator of uploaded files, which validates that file is not bigger than
+	// configured size, and that its content type is one of configured content types. Content types can contain
+	// wildcards of subtypes, like "image/*".
+	FileValidator struct {
+		maxSize      int64
+		contentTypes []string
+	}
+)
+
+var _ domain.StructValidator = &FileValidator{}
+
+// Inject is method used to set all dependencies as local variables
+func (v *FileValidator) Inject(cfg *struct {
+	MaxSize      int          `inject:"config:form.validator.file.maxSize"`
+	ContentTypes config.Slice `inject:"config:form.validator.file.contentTypes"`
+}) {
+	if cfg == nil {
+		return
+	}
+
+	var contentTypes []string
+	if err := cfg.ContentTypes.MapInto(&contentTypes); err != nil {
+		panic(err.Error())
+	}
+
+	v.maxSize = int64(cfg.MaxSize)
+	v.contentTypes = make([]string, 0, len(contentTypes))
+	for _, contentType := range contentTypes {
+		v.contentTypes = append(v.contentTypes, strings.ToLower(strings.TrimSpace(contentType)))
+	}
+}
+
+// StructType defines domain.File as type validated by this validator
+func (v *FileValidator) StructType() interface{} {
+	return domain.File{}
+}
+
+// ValidateStruct validates size and content type of uploaded file
+func (v *FileValidator) ValidateStruct(_ context.Context, sl validator.StructLevel) {
+	file, ok := sl.Current().Interface().(domain.File)
+	if !ok {
+		return
+	}
+
+	if v.maxSize > 0 && file.Size > v.maxSize {
+		sl.ReportError(file.Size, "Size", "Size", "max", strconv.FormatInt(v.maxSize, 10))
+	}
+
+	if len(v.contentTypes) > 0 && !v.allowsContentType(file.ContentType) {
+		sl.ReportError(file.ContentType, "ContentType", "ContentType", "oneof", strings.Join(v.contentTypes, " "))
+	}
+}
+
+// allowsContentType checks if content type matches any of configured content types
+func (v *FileValidator) allowsContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	for _, allowed := range v.contentTypes {
+		if allowed == mediaType {
+			return true
+		}
+
+		if strings.HasSuffix(allowed, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(allowed, "*")) {
+			return true
+		}
+	}
+
+	return false
+}
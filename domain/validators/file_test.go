@@ -0,0 +1,90 @@
+package validators
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	FileValidatorTestSuite struct {
+		suite.Suite
+
+		validator   *FileValidator
+		structLevel *mocks.StructLevel
+
+		context context.Context
+	}
+)
+
+func TestFileValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &FileValidatorTestSuite{})
+}
+
+func (t *FileValidatorTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *FileValidatorTestSuite) SetupTest() {
+	t.validator = &FileValidator{}
+	t.validator.Inject(&struct {
+		MaxSize      int          `inject:"config:form.validator.file.maxSize"`
+		ContentTypes config.Slice `inject:"config:form.validator.file.contentTypes"`
+	}{
+		MaxSize:      1024,
+		ContentTypes: config.Slice{"image/*", " Application/PDF "},
+	})
+
+	t.structLevel = &mocks.StructLevel{}
+}
+
+func (t *FileValidatorTestSuite) TearDownTest() {
+	t.structLevel.AssertExpectations(t.T())
+}
+
+func (t *FileValidatorTestSuite) TestStructType() {
+	t.Equal(domain.File{}, t.validator.StructType())
+}
+
+func (t *FileValidatorTestSuite) TestValidateStruct_Valid() {
+	for _, file := range []domain.File{
+		{Filename: "avatar.png", Size: 1024, ContentType: "image/png"},
+		{Filename: "terms.pdf", Size: 10, ContentType: "application/pdf; name=terms.pdf"},
+	} {
+		structLevel := &mocks.StructLevel{}
+		structLevel.On("Current").Return(reflect.ValueOf(file)).Once()
+
+		t.validator.ValidateStruct(t.context, structLevel)
+		structLevel.AssertExpectations(t.T())
+	}
+}
+
+func (t *FileValidatorTestSuite) TestValidateStruct_Invalid() {
+	t.structLevel.On("Current").Return(reflect.ValueOf(domain.File{Filename: "script.js", Size: 1025, ContentType: "text/javascript"})).Once()
+	t.structLevel.On("ReportError", int64(1025), "Size", "Size", "max", "1024").Once()
+	t.structLevel.On("ReportError", "text/javascript", "ContentType", "ContentType", "oneof", "image/* application/pdf").Once()
+
+	t.validator.ValidateStruct(t.context, t.structLevel)
+}
+
+func (t *FileValidatorTestSuite) TestValidateStruct_Unlimited() {
+	validator := &FileValidator{}
+	validator.Inject(nil)
+
+	t.structLevel.On("Current").Return(reflect.ValueOf(domain.File{Size: 1 << 30, ContentType: "text/plain"})).Once()
+
+	validator.ValidateStruct(t.context, t.structLevel)
+}
+
+func (t *FileValidatorTestSuite) TestAllowsContentType() {
+	t.True(t.validator.allowsContentType("image/jpeg"))
+	t.True(t.validator.allowsContentType("Application/PDF"))
+	t.False(t.validator.allowsContentType("imagex/png"))
+	t.False(t.validator.allowsContentType(""))
+}
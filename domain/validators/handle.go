@@ -0,0 +1,141 @@
+package validators
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain"
+
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+type (
+	// HandleValidator defines validator of user handles (like usernames of profiles), which validates length,
+	// allowed characters and reserved words depending on configured policy. Handles are normalized to lower case,
+	// so field is updated with normalized handle if form data is validated by pointer.
+	//
+	// Data struct {
+	//	 Username string `validate:"required,handle"`
+	// }
+	//
+	HandleValidator struct {
+		minLength int
+		maxLength int
+		charset   *regexp.Regexp
+		reserved  map[string]bool
+	}
+)
+
+const (
+	// HandleReasonTooShort defines reason code of handles, which are shorter than minimal length
+	HandleReasonTooShort = "tooShort"
+	// HandleReasonTooLong defines reason code of handles, which are longer than maximal length
+	HandleReasonTooLong = "tooLong"
+	// HandleReasonCharset defines reason code of handles, which contain characters out of allowed charset
+	HandleReasonCharset = "charset"
+	// HandleReasonReserved defines reason code of handles, which are reserved words
+	HandleReasonReserved = "reserved"
+)
+
+var (
+	_ domain.FieldValidator  = &HandleValidator{}
+	_ domain.RuleDescriber   = &HandleValidator{}
+	_ domain.FailureReasoner = &HandleValidator{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (v *HandleValidator) Inject(cfg *struct {
+	MinLength int          `inject:"config:form.validator.handle.minLength"`
+	MaxLength int          `inject:"config:form.validator.handle.maxLength"`
+	Charset   string       `inject:"config:form.validator.handle.charset"`
+	Reserved  config.Slice `inject:"config:form.validator.handle.reserved"`
+}) {
+	var reserved []string
+	if err := cfg.Reserved.MapInto(&reserved); err != nil {
+		panic(err.Error())
+	}
+
+	v.minLength = cfg.MinLength
+	v.maxLength = cfg.MaxLength
+	v.charset = regexp.MustCompile("^[" + cfg.Charset + "]*$")
+	v.reserved = make(map[string]bool, len(reserved))
+	for _, word := range reserved {
+		v.reserved[normalizeHandle(word)] = true
+	}
+}
+
+// ValidatorName defines tag name of handle validator
+func (v *HandleValidator) ValidatorName() string {
+	return "handle"
+}
+
+// DescribeRule returns description of handle rule
+func (v *HandleValidator) DescribeRule() domain.RuleDescription {
+	return domain.RuleDescription{
+		Name:        v.ValidatorName(),
+		Description: "lower case handle with " + v.lengthDescription() + ", matching " + v.charset.String() + ", which is no reserved word",
+	}
+}
+
+// ValidateField validates handle by configured policy. Valid if string is empty or handle has no failure reason.
+// Handle is normalized in the field, if field can be set.
+func (v *HandleValidator) ValidateField(_ context.Context, fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	handle := normalizeHandle(field.String())
+	if field.CanSet() {
+		field.SetString(handle)
+	}
+
+	return handle == "" || v.reason(handle) == ""
+}
+
+// FailureReason returns reason code of invalid handle: "tooShort", "tooLong", "charset" or "reserved"
+func (v *HandleValidator) FailureReason(value interface{}, _ string) string {
+	handle, ok := value.(string)
+	if !ok {
+		return ""
+	}
+
+	return v.reason(normalizeHandle(handle))
+}
+
+// reason returns reason code of normalized handle, or empty string if handle is valid
+func (v *HandleValidator) reason(handle string) string {
+	length := utf8.RuneCountInString(handle)
+
+	switch {
+	case v.minLength > 0 && length < v.minLength:
+		return HandleReasonTooShort
+	case v.maxLength > 0 && length > v.maxLength:
+		return HandleReasonTooLong
+	case !v.charset.MatchString(handle):
+		return HandleReasonCharset
+	case v.reserved[handle]:
+		return HandleReasonReserved
+	}
+
+	return ""
+}
+
+// lengthDescription returns description of allowed length of handles
+func (v *HandleValidator) lengthDescription() string {
+	if v.maxLength > 0 {
+		return strconv.Itoa(v.minLength) + " to " + strconv.Itoa(v.maxLength) + " characters"
+	}
+
+	return "at least " + strconv.Itoa(v.minLength) + " characters"
+}
+
+// normalizeHandle returns handle without surrounding white spaces in lower case
+func normalizeHandle(handle string) string {
+	return strings.ToLower(strings.TrimSpace(handle))
+}
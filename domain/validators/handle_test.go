@@ -0,0 +1,129 @@
+package validators
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	HandleValidatorTestSuite struct {
+		suite.Suite
+
+		validator *HandleValidator
+	}
+)
+
+func TestHandleValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &HandleValidatorTestSuite{})
+}
+
+func (t *HandleValidatorTestSuite) SetupTest() {
+	t.validator = &HandleValidator{}
+	t.validator.Inject(&struct {
+		MinLength int          `inject:"config:form.validator.handle.minLength"`
+		MaxLength int          `inject:"config:form.validator.handle.maxLength"`
+		Charset   string       `inject:"config:form.validator.handle.charset"`
+		Reserved  config.Slice `inject:"config:form.validator.handle.reserved"`
+	}{
+		MinLength: 3,
+		MaxLength: 10,
+		Charset:   "a-z0-9_",
+		Reserved:  config.Slice{"Admin", "root"},
+	})
+}
+
+func (t *HandleValidatorTestSuite) TestValidatorName() {
+	t.Equal("handle", t.validator.ValidatorName())
+}
+
+func (t *HandleValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "handle",
+		Description: "lower case handle with 3 to 10 characters, matching ^[a-z0-9_]*$, which is no reserved word",
+	}, t.validator.DescribeRule())
+}
+
+func (t *HandleValidatorTestSuite) TestValidateField() {
+	testCases := []struct {
+		Handle string
+		Result bool
+		Reason string
+	}{
+		{
+			Handle: "",
+			Result: true,
+		},
+		{
+			Handle: "john_doe",
+			Result: true,
+		},
+		{
+			Handle: " John_Doe ",
+			Result: true,
+		},
+		{
+			Handle: "jd",
+			Result: false,
+			Reason: "tooShort",
+		},
+		{
+			Handle: "john_doe_1234",
+			Result: false,
+			Reason: "tooLong",
+		},
+		{
+			Handle: "john.doe",
+			Result: false,
+			Reason: "charset",
+		},
+		{
+			Handle: "jöhn",
+			Result: false,
+			Reason: "charset",
+		},
+		{
+			Handle: "ADMIN",
+			Result: false,
+			Reason: "reserved",
+		},
+	}
+
+	for _, testCase := range testCases {
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Field").Return(reflect.ValueOf(testCase.Handle)).Once()
+		t.Equal(testCase.Result, t.validator.ValidateField(nil, fieldLevel), testCase.Handle)
+		fieldLevel.AssertExpectations(t.T())
+
+		if !testCase.Result {
+			t.Equal(testCase.Reason, t.validator.FailureReason(testCase.Handle, ""), testCase.Handle)
+		}
+	}
+}
+
+func (t *HandleValidatorTestSuite) TestValidateField_Normalization() {
+	data := &struct {
+		Username string
+	}{
+		Username: " John_Doe ",
+	}
+
+	fieldLevel := &mocks.FieldLevel{}
+	fieldLevel.On("Field").Return(reflect.ValueOf(data).Elem().Field(0)).Once()
+	t.True(t.validator.ValidateField(nil, fieldLevel))
+	t.Equal("john_doe", data.Username)
+	fieldLevel.AssertExpectations(t.T())
+}
+
+func (t *HandleValidatorTestSuite) TestValidateField_NotString() {
+	fieldLevel := &mocks.FieldLevel{}
+	fieldLevel.On("Field").Return(reflect.ValueOf(10)).Once()
+	t.False(t.validator.ValidateField(nil, fieldLevel))
+	t.Equal("", t.validator.FailureReason(10, ""))
+	fieldLevel.AssertExpectations(t.T())
+}
@@ -0,0 +1,232 @@
+package validators
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// JSPatternFlags defines flags of JavaScript regular expression, which JSPattern is written for
+	JSPatternFlags = "u"
+)
+
+// JSPattern converts RE2 pattern into equivalent JavaScript regular expression, which has to be used with
+// JSPatternFlags. It's not just translated syntax: pattern is parsed and written again, so all differences of
+// both dialects (like named groups, case folding, "." and multi line anchors) are taken into account.
+func JSPattern(pattern string) (string, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	if err := writeJSPattern(&b, re); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// writeJSPattern writes single node of parsed pattern in JavaScript syntax
+func writeJSPattern(b *strings.Builder, re *syntax.Regexp) error {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		b.WriteString("[]")
+	case syntax.OpEmptyMatch:
+		b.WriteString("(?:)")
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 && unicode.SimpleFold(r) != r {
+				writeJSClass(b, foldedRunes(r))
+				continue
+			}
+			writeJSRune(b, r)
+		}
+	case syntax.OpCharClass:
+		writeJSClass(b, re.Rune)
+	case syntax.OpAnyCharNotNL:
+		// "." of JavaScript doesn't match "\r", "\u2028" and "\u2029" either
+		b.WriteString(`[^\n]`)
+	case syntax.OpAnyChar:
+		b.WriteString(`[\s\S]`)
+	case syntax.OpBeginLine:
+		b.WriteString(`(?<=^|\n)`)
+	case syntax.OpEndLine:
+		b.WriteString(`(?=\n|$)`)
+	case syntax.OpBeginText:
+		b.WriteString("^")
+	case syntax.OpEndText:
+		b.WriteString("$")
+	case syntax.OpWordBoundary:
+		b.WriteString(`\b`)
+	case syntax.OpNoWordBoundary:
+		b.WriteString(`\B`)
+	case syntax.OpCapture:
+		b.WriteString("(")
+		if re.Name != "" {
+			b.WriteString("?<" + re.Name + ">")
+		}
+		if err := writeJSPattern(b, re.Sub[0]); err != nil {
+			return err
+		}
+		b.WriteString(")")
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		if err := writeJSOperand(b, re.Sub[0]); err != nil {
+			return err
+		}
+		writeJSRepetition(b, re)
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if sub.Op == syntax.OpAlternate {
+				if err := writeJSGroup(b, sub); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := writeJSPattern(b, sub); err != nil {
+				return err
+			}
+		}
+	case syntax.OpAlternate:
+		for i, sub := range re.Sub {
+			if i > 0 {
+				b.WriteString("|")
+			}
+			if err := writeJSPattern(b, sub); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("pattern operation %v has no JavaScript equivalent", re.Op)
+	}
+
+	return nil
+}
+
+// writeJSOperand writes operand of repetition, grouped if it consists of more than single atom
+func writeJSOperand(b *strings.Builder, re *syntax.Regexp) error {
+	switch re.Op {
+	case syntax.OpLiteral:
+		if len(re.Rune) == 1 {
+			return writeJSPattern(b, re)
+		}
+	case syntax.OpCharClass, syntax.OpAnyChar, syntax.OpAnyCharNotNL, syntax.OpCapture:
+		return writeJSPattern(b, re)
+	}
+
+	return writeJSGroup(b, re)
+}
+
+// writeJSGroup writes node of parsed pattern within non capturing group
+func writeJSGroup(b *strings.Builder, re *syntax.Regexp) error {
+	b.WriteString("(?:")
+	if err := writeJSPattern(b, re); err != nil {
+		return err
+	}
+	b.WriteString(")")
+
+	return nil
+}
+
+// writeJSRepetition writes quantifier of repetition node
+func writeJSRepetition(b *strings.Builder, re *syntax.Regexp) {
+	switch re.Op {
+	case syntax.OpStar:
+		b.WriteString("*")
+	case syntax.OpPlus:
+		b.WriteString("+")
+	case syntax.OpQuest:
+		b.WriteString("?")
+	case syntax.OpRepeat:
+		b.WriteString("{" + strconv.Itoa(re.Min))
+		if re.Max != re.Min {
+			b.WriteString(",")
+			if re.Max >= 0 {
+				b.WriteString(strconv.Itoa(re.Max))
+			}
+		}
+		b.WriteString("}")
+	}
+
+	if re.Flags&syntax.NonGreedy != 0 {
+		b.WriteString("?")
+	}
+}
+
+// writeJSClass writes character class by pairs of range bounds. Classes which contain both the lowest and
+// the highest rune are written as negated classes, by their complement.
+func writeJSClass(b *strings.Builder, ranges []rune) {
+	b.WriteString("[")
+
+	if len(ranges) > 0 && ranges[0] == 0 && ranges[len(ranges)-1] == unicode.MaxRune {
+		b.WriteString("^")
+		complement := make([]rune, 0, len(ranges))
+		for i := 1; i+1 < len(ranges); i += 2 {
+			complement = append(complement, ranges[i]+1, ranges[i+1]-1)
+		}
+		ranges = complement
+	}
+
+	for i := 0; i+1 < len(ranges); i += 2 {
+		writeJSClassRune(b, ranges[i])
+		if ranges[i+1] != ranges[i] {
+			if ranges[i+1] > ranges[i]+1 {
+				b.WriteString("-")
+			}
+			writeJSClassRune(b, ranges[i+1])
+		}
+	}
+
+	b.WriteString("]")
+}
+
+// writeJSRune writes literal rune outside of character class, escaped if it's syntax character or not printable ASCII
+func writeJSRune(b *strings.Builder, r rune) {
+	if strings.ContainsRune(`^$\.*+?()[]{}|/`, r) {
+		b.WriteString(`\` + string(r))
+		return
+	}
+
+	if r >= ' ' && r < unicode.MaxASCII {
+		b.WriteRune(r)
+		return
+	}
+
+	writeJSClassRune(b, r)
+}
+
+// writeJSClassRune writes literal rune within character class, escaped if it's not letter, digit or space of ASCII,
+// so class is valid in both "u" and "v" mode of JavaScript
+func writeJSClassRune(b *strings.Builder, r rune) {
+	if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == ' ') {
+		b.WriteRune(r)
+		return
+	}
+
+	b.WriteString(`\u{` + strconv.FormatInt(int64(r), 16) + `}`)
+}
+
+// foldedRunes returns ranges of character class which matches rune in all cases
+func foldedRunes(r rune) []rune {
+	runes := []rune{r}
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		runes = append(runes, f)
+	}
+
+	// ranges of character class have to be sorted
+	for i := 1; i < len(runes); i++ {
+		for j := i; j > 0 && runes[j] < runes[j-1]; j-- {
+			runes[j], runes[j-1] = runes[j-1], runes[j]
+		}
+	}
+
+	ranges := make([]rune, 0, 2*len(runes))
+	for _, f := range runes {
+		ranges = append(ranges, f, f)
+	}
+
+	return ranges
+}
@@ -0,0 +1,54 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	JSPatternTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestJSPatternTestSuite(t *testing.T) {
+	suite.Run(t, &JSPatternTestSuite{})
+}
+
+func (t *JSPatternTestSuite) TestJSPattern() {
+	testCases := []struct {
+		pattern string
+		result  string
+	}{
+		{pattern: `^[0-9]{5}$`, result: `^[0-9]{5}$`},
+		{pattern: `\A\d+\z`, result: `^[0-9]+$`},
+		{pattern: `(?i)ab+`, result: `[Aa][Bb]+`},
+		{pattern: `(?P<year>\d{4})-(\d\d)`, result: `(?<year>[0-9]{4})-([0-9][0-9])`},
+		{pattern: `a.b`, result: `a[^\n]b`},
+		{pattern: `(?s)a.b`, result: `a[\s\S]b`},
+		{pattern: `(?m)^a$`, result: `(?<=^|\n)a(?=\n|$)`},
+		{pattern: `[^a-z]`, result: `[^a-z]`},
+		{pattern: `[\-\]/]`, result: `[\u{2d}\u{2f}\u{5d}]`},
+		{pattern: `a/b.c`, result: `a\/b[^\n]c`},
+		{pattern: `\Qa.b\E`, result: `a\.b`},
+		{pattern: `[[:upper:]]`, result: `[A-Z]`},
+		{pattern: `x(?:ab|cd){2,}?`, result: `x(?:ab|cd){2,}?`},
+		{pattern: `(?U)a+`, result: `a+?`},
+		{pattern: `foo|ba(r)`, result: `foo|ba(r)`},
+		{pattern: `ü€`, result: `\u{fc}\u{20ac}`},
+		{pattern: `\bword\B`, result: `\bword\B`},
+	}
+
+	for _, testCase := range testCases {
+		result, err := JSPattern(testCase.pattern)
+		t.NoError(err)
+		t.Equal(testCase.result, result, testCase.pattern)
+	}
+}
+
+func (t *JSPatternTestSuite) TestJSPattern_Invalid() {
+	result, err := JSPattern(`(`)
+	t.Error(err)
+	t.Empty(result)
+}
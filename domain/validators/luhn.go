@@ -0,0 +1,55 @@
+package validators
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"flamingo.me/form/domain"
+
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+type (
+	// LuhnValidator defines validator of card numbers, which validates if number passes Luhn check.
+	// Spaces and dashes between digits are ignored.
+	//
+	// Data struct {
+	//	 CardNumber string `validate:"luhn"`
+	// }
+	//
+	LuhnValidator struct{}
+)
+
+var (
+	_ domain.FieldValidator = &LuhnValidator{}
+	_ domain.RuleDescriber  = &LuhnValidator{}
+)
+
+// ValidatorName defines tag name of luhn validator
+func (v *LuhnValidator) ValidatorName() string {
+	return "luhn"
+}
+
+// DescribeRule returns description of luhn rule
+func (v *LuhnValidator) DescribeRule() domain.RuleDescription {
+	return domain.RuleDescription{
+		Name:        v.ValidatorName(),
+		Description: "card number which passes Luhn check, spaces and dashes are ignored",
+	}
+}
+
+// ValidateField validates string for Luhn check. Valid if string is empty or it's number which passes Luhn check.
+func (v *LuhnValidator) ValidateField(_ context.Context, fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	number := strings.NewReplacer(" ", "", "-", "").Replace(field.String())
+	if number == "" {
+		return true
+	}
+
+	return domain.CheckLuhn(number)
+}
@@ -0,0 +1,81 @@
+package validators
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	LuhnValidatorTestSuite struct {
+		suite.Suite
+
+		validator *LuhnValidator
+	}
+)
+
+func TestLuhnValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &LuhnValidatorTestSuite{})
+}
+
+func (t *LuhnValidatorTestSuite) SetupTest() {
+	t.validator = &LuhnValidator{}
+}
+
+func (t *LuhnValidatorTestSuite) TestValidatorName() {
+	t.Equal("luhn", t.validator.ValidatorName())
+}
+
+func (t *LuhnValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "luhn",
+		Description: "card number which passes Luhn check, spaces and dashes are ignored",
+	}, t.validator.DescribeRule())
+}
+
+func (t *LuhnValidatorTestSuite) TestValidateField() {
+	testCases := []struct {
+		Value  interface{}
+		Result bool
+	}{
+		{
+			Value:  "",
+			Result: true,
+		},
+		{
+			Value:  "4111111111111111",
+			Result: true,
+		},
+		{
+			Value:  "4111 1111 1111 1111",
+			Result: true,
+		},
+		{
+			Value:  "3782-822463-10005",
+			Result: true,
+		},
+		{
+			Value:  "4111111111111112",
+			Result: false,
+		},
+		{
+			Value:  "4111a11111111111",
+			Result: false,
+		},
+		{
+			Value:  4111111111111111,
+			Result: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Field").Return(reflect.ValueOf(testCase.Value)).Once()
+		t.Equal(testCase.Result, t.validator.ValidateField(nil, fieldLevel))
+		fieldLevel.AssertExpectations(t.T())
+	}
+}
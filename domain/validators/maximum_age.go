@@ -2,17 +2,18 @@ package validators
 
 import (
 	"context"
-	"strconv"
-	"strings"
 	"time"
 
+	"flamingo.me/flamingo/v3/framework/web"
 	"flamingo.me/form/domain"
 
 	validator "gopkg.in/go-playground/validator.v9"
 )
 
 type (
-	// MaximumAgeValidator defines maximum age validator which validates if passed date is after than desired years ago
+	// MaximumAgeValidator defines maximum age validator which validates if passed date is after than desired years ago.
+	// Date is taken from string in configured date format or from time.Time field, and compared with current date
+	// in local timezone. Rule "maxage" compares it with current date in configured timezone instead.
 	//
 	// Data struct {
 	//	 Date string `validate:"maximumage=150"`
@@ -20,16 +21,23 @@ type (
 	//
 	MaximumAgeValidator struct {
 		dateFormat string
+		location   *time.Location
+		clock      domain.Clock
 	}
 )
 
-var _ domain.FieldValidator = &MaximumAgeValidator{}
+var (
+	_ domain.FieldValidator   = &MaximumAgeValidator{}
+	_ domain.RuleDescriber    = &MaximumAgeValidator{}
+	_ domain.RuleMetaResolver = &MaximumAgeValidator{}
+)
 
 // Inject is method used to set all dependencies as local variables
-func (v *MaximumAgeValidator) Inject(cfg *struct {
+func (v *MaximumAgeValidator) Inject(clock domain.Clock, cfg *struct {
 	DateFormat string `inject:"config:form.validator.dateFormat"`
 }) {
 	v.dateFormat = cfg.DateFormat
+	v.clock = clock
 }
 
 // ValidatorName defines tag name of maximum age validator
@@ -37,35 +45,40 @@ func (v *MaximumAgeValidator) ValidatorName() string {
 	return "maximumage"
 }
 
-// ValidateField validates string in date format for maximum age. Valid if string is empty or in wrong date format or in date range.
-func (v *MaximumAgeValidator) ValidateField(_ context.Context, fl validator.FieldLevel) bool {
-	param := fl.Param()
-	years := 0
-
-	if param != "" {
-		value, err := strconv.ParseInt(param, 10, 64)
-		if err != nil {
-			panic(err.Error())
-		}
-		years = int(value)
+// DescribeRule returns description of maximum age rule
+func (v *MaximumAgeValidator) DescribeRule() domain.RuleDescription {
+	return domain.RuleDescription{
+		Name:        v.ValidatorName(),
+		Description: "date in format " + v.dateFormat + ", not more than desired number of years ago",
+		Params: map[string]interface{}{
+			"type":    "integer",
+			"minimum": 0,
+		},
 	}
+}
+
+// ValidateField validates date for maximum age. Valid if string is empty or in wrong date format,
+// or if date is not before earliest allowed date.
+func (v *MaximumAgeValidator) ValidateField(_ context.Context, fl validator.FieldLevel) bool {
+	years := parseYears(fl.Param())
 
-	converted, ok := fl.Field().Interface().(string)
+	date, ok := birthDate(fl.Field(), v.dateFormat, ageLocation(v.location))
 	if !ok {
 		return true
 	}
 
-	if len(strings.TrimSpace(converted)) == 0 {
-		return true
-	}
+	return !date.Before(v.earliestBirthDate(years))
+}
 
-	date, err := time.Parse(v.dateFormat, converted)
-	if err != nil {
-		return true
+// ResolveRuleMeta returns earliest allowed date of the request date as "minDate" metadata
+func (v *MaximumAgeValidator) ResolveRuleMeta(_ context.Context, _ *web.Request, _ interface{}, _ string, rule domain.ValidationRule) map[string]string {
+	return map[string]string{
+		"minDate":  v.earliestBirthDate(parseYears(rule.Value)).Format(v.dateFormat),
+		"timezone": ageLocation(v.location).String(),
 	}
+}
 
-	now := time.Now()
-	desired := time.Date(now.Year()-years, now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
-
-	return date.After(desired) || date.Equal(desired)
+// earliestBirthDate returns earliest allowed date, which is the same day as current date desired years ago
+func (v *MaximumAgeValidator) earliestBirthDate(years int) time.Time {
+	return birthDateYearsAgo(domain.CurrentTime(v.clock), years, ageLocation(v.location))
 }
@@ -1,13 +1,16 @@
 package validators
 
 import (
+	"context"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 
+	"flamingo.me/form/domain"
 	"flamingo.me/form/domain/mocks"
+	"flamingo.me/form/formtest"
 )
 
 type (
@@ -24,17 +27,41 @@ func TestMaximumAgeValidatorTestSuite(t *testing.T) {
 
 func (t *MaximumAgeValidatorTestSuite) SetupTest() {
 	t.validator = &MaximumAgeValidator{}
-	t.validator.Inject(&struct {
+	t.validator.Inject(nil, &struct {
 		DateFormat string `inject:"config:form.validator.dateFormat"`
 	}{
 		DateFormat: "2006-01-02",
 	})
 }
 
+// berlinValidator returns validator, for which it's already 29th of February 2024 in Berlin
+func (t *MaximumAgeValidatorTestSuite) berlinValidator() *MaximumAgeValidator {
+	validator := &MaximumAgeValidator{}
+	validator.Inject(formtest.NewFakeClock(time.Date(2024, time.February, 28, 23, 30, 0, 0, time.UTC)), &struct {
+		DateFormat string `inject:"config:form.validator.dateFormat"`
+	}{
+		DateFormat: "2006-01-02",
+	})
+	validator.location = loadAgeLocation("Europe/Berlin")
+
+	return validator
+}
+
 func (t *MaximumAgeValidatorTestSuite) TestValidatorName() {
 	t.Equal("maximumage", t.validator.ValidatorName())
 }
 
+func (t *MaximumAgeValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "maximumage",
+		Description: "date in format 2006-01-02, not more than desired number of years ago",
+		Params: map[string]interface{}{
+			"type":    "integer",
+			"minimum": 0,
+		},
+	}, t.validator.DescribeRule())
+}
+
 func (t *MaximumAgeValidatorTestSuite) TestValidateField() {
 	now := time.Now()
 	child := time.Date(now.Year()-7, now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
@@ -83,3 +110,56 @@ func (t *MaximumAgeValidatorTestSuite) TestValidateField() {
 		fieldLevel.AssertExpectations(t.T())
 	}
 }
+
+func (t *MaximumAgeValidatorTestSuite) TestValidateField_Timezone() {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	testCases := []struct {
+		Date   interface{}
+		Result bool
+	}{
+		{
+			Date:   time.Time{},
+			Result: true,
+		},
+		{
+			Date:   "2024-02-29",
+			Result: true,
+		},
+		{
+			Date:   "1874-02-28",
+			Result: true,
+		},
+		{
+			Date:   "1874-02-27",
+			Result: false,
+		},
+		{
+			Date:   time.Date(1874, time.February, 27, 23, 30, 0, 0, time.UTC),
+			Result: true,
+		},
+		{
+			Date:   time.Date(1874, time.February, 27, 23, 30, 0, 0, berlin),
+			Result: false,
+		},
+	}
+
+	validator := t.berlinValidator()
+	for _, testCase := range testCases {
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Field").Return(reflect.ValueOf(testCase.Date)).Once()
+		fieldLevel.On("Param").Return("150").Once()
+		t.Equal(testCase.Result, validator.ValidateField(nil, fieldLevel), testCase.Date)
+		fieldLevel.AssertExpectations(t.T())
+	}
+}
+
+func (t *MaximumAgeValidatorTestSuite) TestResolveRuleMeta() {
+	t.Equal(map[string]string{
+		"minDate":  "1874-02-28",
+		"timezone": "Europe/Berlin",
+	}, t.berlinValidator().ResolveRuleMeta(context.Background(), nil, nil, "dateOfBirth", domain.ValidationRule{
+		Name:  "maximumage",
+		Value: "150",
+	}))
+}
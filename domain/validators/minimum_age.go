@@ -2,17 +2,19 @@ package validators
 
 import (
 	"context"
-	"strconv"
-	"strings"
 	"time"
 
+	"flamingo.me/flamingo/v3/framework/web"
 	"flamingo.me/form/domain"
 
 	validator "gopkg.in/go-playground/validator.v9"
 )
 
 type (
-	// MinimumAgeValidator defines minimum age validator which validates if passed date is before than desired years ago
+	// MinimumAgeValidator defines minimum age validator which validates if passed date is before than desired years ago.
+	// Date is taken from string in configured date format or from time.Time field, and compared with current date
+	// in local timezone, so person becomes of age at midnight of the birthday. Rule "minage" compares it with current
+	// date in configured timezone instead.
 	//
 	// Data struct {
 	//	 Date string `validate:"minimumage=18"`
@@ -20,16 +22,23 @@ type (
 	//
 	MinimumAgeValidator struct {
 		dateFormat string
+		location   *time.Location
+		clock      domain.Clock
 	}
 )
 
-var _ domain.FieldValidator = &MinimumAgeValidator{}
+var (
+	_ domain.FieldValidator   = &MinimumAgeValidator{}
+	_ domain.RuleDescriber    = &MinimumAgeValidator{}
+	_ domain.RuleMetaResolver = &MinimumAgeValidator{}
+)
 
 // Inject is method used to set all dependencies as local variables
-func (v *MinimumAgeValidator) Inject(cfg *struct {
+func (v *MinimumAgeValidator) Inject(clock domain.Clock, cfg *struct {
 	DateFormat string `inject:"config:form.validator.dateFormat"`
 }) {
 	v.dateFormat = cfg.DateFormat
+	v.clock = clock
 }
 
 // ValidatorName defines tag name of minimum age validator
@@ -37,35 +46,40 @@ func (v *MinimumAgeValidator) ValidatorName() string {
 	return "minimumage"
 }
 
-// ValidateField validates string in date format for minimum age. Valid if string is empty or in wrong date format or in date range.
-func (v *MinimumAgeValidator) ValidateField(_ context.Context, fl validator.FieldLevel) bool {
-	param := fl.Param()
-	years := 0
-
-	if param != "" {
-		value, err := strconv.ParseInt(param, 10, 64)
-		if err != nil {
-			panic(err.Error())
-		}
-		years = int(value)
+// DescribeRule returns description of minimum age rule
+func (v *MinimumAgeValidator) DescribeRule() domain.RuleDescription {
+	return domain.RuleDescription{
+		Name:        v.ValidatorName(),
+		Description: "date in format " + v.dateFormat + ", at least desired number of years ago",
+		Params: map[string]interface{}{
+			"type":    "integer",
+			"minimum": 0,
+		},
 	}
+}
+
+// ValidateField validates date for minimum age. Valid if string is empty or in wrong date format,
+// or if date is not after latest allowed date of birth.
+func (v *MinimumAgeValidator) ValidateField(_ context.Context, fl validator.FieldLevel) bool {
+	years := parseYears(fl.Param())
 
-	converted, ok := fl.Field().Interface().(string)
+	date, ok := birthDate(fl.Field(), v.dateFormat, ageLocation(v.location))
 	if !ok {
 		return true
 	}
 
-	if len(strings.TrimSpace(converted)) == 0 {
-		return true
-	}
+	return !date.After(v.latestBirthDate(years))
+}
 
-	date, err := time.Parse(v.dateFormat, converted)
-	if err != nil {
-		return true
+// ResolveRuleMeta returns latest allowed date of birth of the request date as "maxDate" metadata
+func (v *MinimumAgeValidator) ResolveRuleMeta(_ context.Context, _ *web.Request, _ interface{}, _ string, rule domain.ValidationRule) map[string]string {
+	return map[string]string{
+		"maxDate":  v.latestBirthDate(parseYears(rule.Value)).Format(v.dateFormat),
+		"timezone": ageLocation(v.location).String(),
 	}
+}
 
-	now := time.Now()
-	desired := time.Date(now.Year()-years, now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location())
-
-	return date.Before(desired)
+// latestBirthDate returns latest date of birth of persons, who are at least desired years old
+func (v *MinimumAgeValidator) latestBirthDate(years int) time.Time {
+	return birthDateYearsAgo(domain.CurrentTime(v.clock), years, ageLocation(v.location))
 }
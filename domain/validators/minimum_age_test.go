@@ -1,13 +1,16 @@
 package validators
 
 import (
+	"context"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 
+	"flamingo.me/form/domain"
 	"flamingo.me/form/domain/mocks"
+	"flamingo.me/form/formtest"
 )
 
 type (
@@ -24,17 +27,41 @@ func TestMinimumAgeValidatorTestSuite(t *testing.T) {
 
 func (t *MinimumAgeValidatorTestSuite) SetupTest() {
 	t.validator = &MinimumAgeValidator{}
-	t.validator.Inject(&struct {
+	t.validator.Inject(nil, &struct {
 		DateFormat string `inject:"config:form.validator.dateFormat"`
 	}{
 		DateFormat: "2006-01-02",
 	})
 }
 
+// berlinValidator returns validator, for which it's already 29th of February 2024 in Berlin
+func (t *MinimumAgeValidatorTestSuite) berlinValidator() *MinimumAgeValidator {
+	validator := &MinimumAgeValidator{}
+	validator.Inject(formtest.NewFakeClock(time.Date(2024, time.February, 28, 23, 30, 0, 0, time.UTC)), &struct {
+		DateFormat string `inject:"config:form.validator.dateFormat"`
+	}{
+		DateFormat: "2006-01-02",
+	})
+	validator.location = loadAgeLocation("Europe/Berlin")
+
+	return validator
+}
+
 func (t *MinimumAgeValidatorTestSuite) TestValidatorName() {
 	t.Equal("minimumage", t.validator.ValidatorName())
 }
 
+func (t *MinimumAgeValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "minimumage",
+		Description: "date in format 2006-01-02, at least desired number of years ago",
+		Params: map[string]interface{}{
+			"type":    "integer",
+			"minimum": 0,
+		},
+	}, t.validator.DescribeRule())
+}
+
 func (t *MinimumAgeValidatorTestSuite) TestValidateField() {
 	now := time.Now()
 	child := time.Date(now.Year()-7, now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
@@ -84,3 +111,65 @@ func (t *MinimumAgeValidatorTestSuite) TestValidateField() {
 		fieldLevel.AssertExpectations(t.T())
 	}
 }
+
+func (t *MinimumAgeValidatorTestSuite) TestValidateField_Timezone() {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	testCases := []struct {
+		Date   interface{}
+		Result bool
+	}{
+		{
+			Date:   time.Time{},
+			Result: true,
+		},
+		{
+			Date:   10,
+			Result: true,
+		},
+		{
+			Date:   "2006-02-28",
+			Result: true,
+		},
+		{
+			Date:   "2006-03-01",
+			Result: false,
+		},
+		{
+			Date:   time.Date(2006, time.February, 28, 23, 30, 0, 0, berlin),
+			Result: true,
+		},
+		{
+			Date:   time.Date(2006, time.February, 28, 23, 30, 0, 0, time.UTC),
+			Result: false,
+		},
+	}
+
+	validator := t.berlinValidator()
+	for _, testCase := range testCases {
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Field").Return(reflect.ValueOf(testCase.Date)).Once()
+		fieldLevel.On("Param").Return("18").Once()
+		t.Equal(testCase.Result, validator.ValidateField(nil, fieldLevel), testCase.Date)
+		fieldLevel.AssertExpectations(t.T())
+	}
+}
+
+func (t *MinimumAgeValidatorTestSuite) TestValidateField_InvalidParam() {
+	fieldLevel := &mocks.FieldLevel{}
+	fieldLevel.On("Param").Return("adult").Once()
+
+	t.Panics(func() {
+		t.validator.ValidateField(nil, fieldLevel)
+	})
+}
+
+func (t *MinimumAgeValidatorTestSuite) TestResolveRuleMeta() {
+	t.Equal(map[string]string{
+		"maxDate":  "2006-02-28",
+		"timezone": "Europe/Berlin",
+	}, t.berlinValidator().ResolveRuleMeta(context.Background(), nil, nil, "dateOfBirth", domain.ValidationRule{
+		Name:  "minimumage",
+		Value: "18",
+	}))
+}
@@ -0,0 +1,89 @@
+package validators
+
+import (
+	"context"
+	"regexp"
+
+	"flamingo.me/form/domain"
+
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+type (
+	// PatternValidator defines validator of strings by regex pattern passed as parameter of the rule.
+	// Commas and pipes in pattern have to be written as "0x2C" and "0x7C", same as for all other rule parameters.
+	// Pattern is checked when binding plan of form data is compiled, and exported in JavaScript compatible form
+	// within validation rule, so the same pattern can be enforced on client side.
+	//
+	// Data struct {
+	//	 PostCode string `validate:"pattern=^[0-9]{5}$"`
+	// }
+	//
+	PatternValidator struct{}
+)
+
+const (
+	// PatternValidatorName defines tag name of pattern validator
+	PatternValidatorName = "pattern"
+)
+
+var (
+	_ domain.FieldValidator = &PatternValidator{}
+	_ domain.RuleDescriber  = &PatternValidator{}
+
+	// patterns compiled regex patterns, by their source
+	patterns = domain.NewCache("patterns")
+)
+
+// ValidatorName defines tag name of pattern validator
+func (v *PatternValidator) ValidatorName() string {
+	return PatternValidatorName
+}
+
+// DescribeRule returns description of pattern rule
+func (v *PatternValidator) DescribeRule() domain.RuleDescription {
+	return domain.RuleDescription{
+		Name:        v.ValidatorName(),
+		Description: "string matching RE2 pattern",
+		Params: map[string]interface{}{
+			"type":   "string",
+			"format": "regex",
+		},
+	}
+}
+
+// ValidateField validates string if it matches pattern. Valid if string is empty or matches pattern.
+func (v *PatternValidator) ValidateField(_ context.Context, fl validator.FieldLevel) bool {
+	converted, ok := fl.Field().Interface().(string)
+	if !ok {
+		return false
+	}
+
+	if len(converted) == 0 {
+		return true
+	}
+
+	regex, err := CompilePattern(fl.Param())
+	if err != nil {
+		return false
+	}
+
+	return regex.MatchString(converted)
+}
+
+// CompilePattern compiles regex pattern, or returns already compiled one. All patterns are RE2 patterns,
+// so they match in linear time, no matter which input is validated.
+func CompilePattern(pattern string) (*regexp.Regexp, error) {
+	if regex, ok := patterns.Load(pattern); ok {
+		return regex.(*regexp.Regexp), nil
+	}
+
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, _ := patterns.LoadOrStore(pattern, regex)
+
+	return stored.(*regexp.Regexp), nil
+}
@@ -0,0 +1,104 @@
+package validators
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	PatternValidatorTestSuite struct {
+		suite.Suite
+
+		validator *PatternValidator
+	}
+)
+
+func TestPatternValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &PatternValidatorTestSuite{})
+}
+
+func (t *PatternValidatorTestSuite) SetupTest() {
+	t.validator = &PatternValidator{}
+}
+
+func (t *PatternValidatorTestSuite) TestValidatorName() {
+	t.Equal("pattern", t.validator.ValidatorName())
+}
+
+func (t *PatternValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "pattern",
+		Description: "string matching RE2 pattern",
+		Params: map[string]interface{}{
+			"type":   "string",
+			"format": "regex",
+		},
+	}, t.validator.DescribeRule())
+}
+
+func (t *PatternValidatorTestSuite) TestValidateField() {
+	testCases := []struct {
+		Value   interface{}
+		Pattern string
+		Result  bool
+	}{
+		{
+			Value:   "",
+			Pattern: "^[0-9]{5}$",
+			Result:  true,
+		},
+		{
+			Value:   "12345",
+			Pattern: "^[0-9]{5}$",
+			Result:  true,
+		},
+		{
+			Value:   "1234",
+			Pattern: "^[0-9]{5}$",
+			Result:  false,
+		},
+		{
+			Value:   "a,b",
+			Pattern: "^[a-z],[a-z]$",
+			Result:  true,
+		},
+		{
+			Value:   "a",
+			Pattern: "(",
+			Result:  false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Field").Return(reflect.ValueOf(testCase.Value)).Once()
+		fieldLevel.On("Param").Return(testCase.Pattern).Maybe()
+		t.Equal(testCase.Result, t.validator.ValidateField(nil, fieldLevel))
+		fieldLevel.AssertExpectations(t.T())
+	}
+}
+
+func (t *PatternValidatorTestSuite) TestValidateField_NotString() {
+	fieldLevel := &mocks.FieldLevel{}
+	fieldLevel.On("Field").Return(reflect.ValueOf(12345)).Once()
+	t.False(t.validator.ValidateField(nil, fieldLevel))
+	fieldLevel.AssertExpectations(t.T())
+}
+
+func (t *PatternValidatorTestSuite) TestCompilePattern() {
+	first, err := CompilePattern("^[a-z]+$")
+	t.NoError(err)
+	t.True(first.MatchString("abc"))
+
+	second, err := CompilePattern("^[a-z]+$")
+	t.NoError(err)
+	t.Same(first, second)
+
+	_, err = CompilePattern("(?<=a)b")
+	t.Error(err)
+}
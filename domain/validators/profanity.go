@@ -0,0 +1,198 @@
+package validators
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+type (
+	// ProfanityValidator defines validator of free-text fields (like reviews or comments), which must not contain
+	// words of configured word lists. Word list is chosen by locale from parameter, or by language requested
+	// by the client. Text is compared in lower case with leetspeak normalization, so "h3ll0" matches "hello".
+	// In warning mode, matches are only logged and field stays valid.
+	//
+	// Data struct {
+	//	 Comment string `validate:"required,max=2000,noprofanity"`
+	//	 Review  string `validate:"noprofanity=de"`
+	// }
+	//
+	ProfanityValidator struct {
+		words   map[string]map[string]bool
+		warning bool
+		logger  flamingo.Logger
+	}
+)
+
+const (
+	// ProfanityModeError defines mode of profanity validator, which invalidates fields containing profanity
+	ProfanityModeError = "error"
+	// ProfanityModeWarning defines mode of profanity validator, which logs fields containing profanity
+	ProfanityModeWarning = "warning"
+
+	// profanityDefaultLocale defines key of word list, which is used for all locales
+	profanityDefaultLocale = "default"
+)
+
+var (
+	_ domain.FieldValidator = &ProfanityValidator{}
+	_ domain.RuleDescriber  = &ProfanityValidator{}
+
+	// leetspeakReplacer replaces common leetspeak characters with letters
+	leetspeakReplacer = strings.NewReplacer(
+		"0", "o",
+		"1", "i",
+		"3", "e",
+		"4", "a",
+		"5", "s",
+		"7", "t",
+		"@", "a",
+		"$", "s",
+	)
+)
+
+// Inject is method used to set all dependencies as local variables
+func (v *ProfanityValidator) Inject(
+	logger flamingo.Logger,
+	cfg *struct {
+		Words config.Map `inject:"config:form.validator.profanity.words"`
+		Mode  string     `inject:"config:form.validator.profanity.mode"`
+	},
+) {
+	v.logger = logger
+	v.words = map[string]map[string]bool{}
+
+	if cfg == nil {
+		return
+	}
+
+	switch cfg.Mode {
+	case "", ProfanityModeError:
+	case ProfanityModeWarning:
+		v.warning = true
+	default:
+		panic("unknown profanity mode " + cfg.Mode)
+	}
+
+	var words map[string][]string
+	if err := cfg.Words.MapInto(&words); err != nil {
+		panic(err.Error())
+	}
+
+	for locale, list := range words {
+		set := make(map[string]bool, len(list))
+		for _, word := range list {
+			if word = normalizeProfanity(word); word != "" {
+				set[word] = true
+			}
+		}
+		v.words[strings.ToLower(locale)] = set
+	}
+}
+
+// ValidatorName defines tag name of profanity validator
+func (v *ProfanityValidator) ValidatorName() string {
+	return "noprofanity"
+}
+
+// DescribeRule returns description of profanity rule
+func (v *ProfanityValidator) DescribeRule() domain.RuleDescription {
+	return domain.RuleDescription{
+		Name:        v.ValidatorName(),
+		Description: "text which doesn't contain words of word list of the locale (default: requested language)",
+		Params: map[string]interface{}{
+			"type": "string",
+		},
+	}
+}
+
+// ValidateField validates that text doesn't contain profanity. Valid if string is empty.
+func (v *ProfanityValidator) ValidateField(ctx context.Context, fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	text := field.String()
+	if text == "" {
+		return true
+	}
+
+	locale := fl.Param()
+	if locale == "" && ctx != nil {
+		locale = requestLanguage(web.RequestFromContext(ctx))
+	}
+
+	word, found := v.find(locale, text)
+	if !found {
+		return true
+	}
+
+	if v.warning {
+		v.logger.WithContext(ctx).WithField("FieldValidator", v.ValidatorName()).WithField("field", fl.FieldName()).Warn("profanity found: " + word)
+		return true
+	}
+
+	return false
+}
+
+// find returns first word of text, which is part of word list of the locale or of default word list
+func (v *ProfanityValidator) find(locale string, text string) (string, bool) {
+	lists := []map[string]bool{v.words[profanityDefaultLocale]}
+	for _, key := range localeKeys(locale) {
+		lists = append(lists, v.words[key])
+	}
+
+	for _, word := range strings.FieldsFunc(normalizeProfanity(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	}) {
+		for _, list := range lists {
+			if list[word] {
+				return word, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// localeKeys returns keys of word lists of the locale, like "de-at" and "de" for "de-AT"
+func localeKeys(locale string) []string {
+	locale = strings.ToLower(strings.TrimSpace(strings.Replace(locale, "_", "-", -1)))
+	if locale == "" {
+		return nil
+	}
+
+	if index := strings.Index(locale, "-"); index > 0 {
+		return []string{locale, locale[:index]}
+	}
+
+	return []string{locale}
+}
+
+// requestLanguage returns primary language requested by the client
+func requestLanguage(req *web.Request) string {
+	if req == nil {
+		return ""
+	}
+
+	language := req.Request().Header.Get("Accept-Language")
+	if index := strings.IndexAny(language, ",;"); index >= 0 {
+		language = language[:index]
+	}
+
+	return strings.TrimSpace(language)
+}
+
+// normalizeProfanity returns text in lower case with leetspeak characters replaced by letters
+func normalizeProfanity(text string) string {
+	return leetspeakReplacer.Replace(strings.ToLower(strings.TrimSpace(text)))
+}
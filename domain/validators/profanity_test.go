@@ -0,0 +1,152 @@
+package validators
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	ProfanityValidatorTestSuite struct {
+		suite.Suite
+
+		validator *ProfanityValidator
+	}
+)
+
+func TestProfanityValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &ProfanityValidatorTestSuite{})
+}
+
+func (t *ProfanityValidatorTestSuite) SetupTest() {
+	t.validator = t.createValidator(ProfanityModeError)
+}
+
+func (t *ProfanityValidatorTestSuite) createValidator(mode string) *ProfanityValidator {
+	validator := &ProfanityValidator{}
+	validator.Inject(&flamingo.NullLogger{}, &struct {
+		Words config.Map `inject:"config:form.validator.profanity.words"`
+		Mode  string     `inject:"config:form.validator.profanity.mode"`
+	}{
+		Words: config.Map{
+			"default": config.Slice{"Scam"},
+			"en":      config.Slice{"idiot"},
+			"de":      config.Slice{"depp"},
+			"de-AT":   config.Slice{"trottel"},
+		},
+		Mode: mode,
+	})
+
+	return validator
+}
+
+func (t *ProfanityValidatorTestSuite) TestInject_UnknownMode() {
+	t.Panics(func() {
+		t.createValidator("block")
+	})
+}
+
+func (t *ProfanityValidatorTestSuite) TestValidatorName() {
+	t.Equal("noprofanity", t.validator.ValidatorName())
+}
+
+func (t *ProfanityValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "noprofanity",
+		Description: "text which doesn't contain words of word list of the locale (default: requested language)",
+		Params: map[string]interface{}{
+			"type": "string",
+		},
+	}, t.validator.DescribeRule())
+}
+
+func (t *ProfanityValidatorTestSuite) TestValidateField() {
+	testCases := []struct {
+		Text   interface{}
+		Locale string
+		Result bool
+	}{
+		{
+			Text:   "",
+			Result: true,
+		},
+		{
+			Text:   "Great product, fast delivery.",
+			Locale: "en",
+			Result: true,
+		},
+		{
+			Text:   "What an IDIOT!",
+			Locale: "en",
+			Result: false,
+		},
+		{
+			Text:   "what an 1d10t",
+			Locale: "en",
+			Result: false,
+		},
+		{
+			Text:   "what an idiotic idea",
+			Locale: "en",
+			Result: true,
+		},
+		{
+			Text:   "this is a $c@m",
+			Locale: "de",
+			Result: false,
+		},
+		{
+			Text:   "so ein Depp",
+			Locale: "en",
+			Result: true,
+		},
+		{
+			Text:   "so ein Depp",
+			Locale: "de_AT",
+			Result: false,
+		},
+		{
+			Text:   "so ein Trottel",
+			Locale: "de-at",
+			Result: false,
+		},
+		{
+			Text:   "so ein Trottel",
+			Locale: "de",
+			Result: true,
+		},
+		{
+			Text:   10,
+			Result: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Field").Return(reflect.ValueOf(testCase.Text)).Once()
+		if text, ok := testCase.Text.(string); ok && text != "" {
+			fieldLevel.On("Param").Return(testCase.Locale).Once()
+		}
+		t.Equal(testCase.Result, t.validator.ValidateField(context.Background(), fieldLevel), testCase.Text)
+		fieldLevel.AssertExpectations(t.T())
+	}
+}
+
+func (t *ProfanityValidatorTestSuite) TestValidateField_Warning() {
+	validator := t.createValidator(ProfanityModeWarning)
+
+	fieldLevel := &mocks.FieldLevel{}
+	fieldLevel.On("Field").Return(reflect.ValueOf("what an idiot")).Once()
+	fieldLevel.On("Param").Return("en").Once()
+	fieldLevel.On("FieldName").Return("comment").Once()
+
+	t.True(validator.ValidateField(context.Background(), fieldLevel))
+	fieldLevel.AssertExpectations(t.T())
+}
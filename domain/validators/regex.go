@@ -25,7 +25,10 @@ type (
 	}
 )
 
-var _ domain.FieldValidator = &RegexValidator{}
+var (
+	_ domain.FieldValidator = &RegexValidator{}
+	_ domain.RuleDescriber  = &RegexValidator{}
+)
 
 // NewRegexValidator creates new instance of RegexValidator by defining it's tag name and regex pattern
 func NewRegexValidator(name string, regex string) *RegexValidator {
@@ -40,6 +43,14 @@ func (v *RegexValidator) ValidatorName() string {
 	return v.name
 }
 
+// DescribeRule returns description of regex rule
+func (v *RegexValidator) DescribeRule() domain.RuleDescription {
+	return domain.RuleDescription{
+		Name:        v.ValidatorName(),
+		Description: "string matching pattern " + v.regex.String(),
+	}
+}
+
 // ValidateField validates string if match right regex. Valid if string is empty or match defined regex pattern.
 func (v *RegexValidator) ValidateField(_ context.Context, fl validator.FieldLevel) bool {
 	converted, ok := fl.Field().Interface().(string)
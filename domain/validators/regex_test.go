@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	"flamingo.me/form/domain"
 	"flamingo.me/form/domain/mocks"
 )
 
@@ -29,6 +30,13 @@ func (t *RegexValidatorTestSuite) TestValidatorName() {
 	t.Equal("onlynumber", t.validator.ValidatorName())
 }
 
+func (t *RegexValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "onlynumber",
+		Description: "string matching pattern ^[0-9]{1}$",
+	}, t.validator.DescribeRule())
+}
+
 func (t *RegexValidatorTestSuite) TestValidateField() {
 	testCases := []struct {
 		Value  string
@@ -0,0 +1,124 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"flamingo.me/form/domain"
+
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+type (
+	// RequiredIfValidator defines validator of conditionally required fields, which must have value if all referenced
+	// fields of the same struct have values passed as parameter. Parameter consists of space separated pairs of field
+	// names and values, and referenced fields may be fields of sub structs (like "Address.Country").
+	//
+	// Data struct {
+	//	 Country string
+	//	 State   string `validate:"required_if=Country US"`
+	// }
+	//
+	RequiredIfValidator struct{}
+)
+
+const (
+	// RequiredIfValidatorName defines tag name of required_if validator
+	RequiredIfValidatorName = "required_if"
+)
+
+var (
+	_ domain.FieldValidator    = &RequiredIfValidator{}
+	_ domain.RuleDescriber     = &RequiredIfValidator{}
+	_ domain.NilFieldValidator = &RequiredIfValidator{}
+)
+
+// ValidatorName defines tag name of required_if validator
+func (v *RequiredIfValidator) ValidatorName() string {
+	return RequiredIfValidatorName
+}
+
+// DescribeRule returns description of required_if rule
+func (v *RequiredIfValidator) DescribeRule() domain.RuleDescription {
+	return domain.RuleDescription{
+		Name:        v.ValidatorName(),
+		Description: "value required if all referenced fields have given values, as space separated pairs of fields and values",
+	}
+}
+
+// ValidatesNilFields returns true, as nil fields are valid unless conditions of the rule hold
+func (v *RequiredIfValidator) ValidatesNilFields() bool {
+	return true
+}
+
+// ValidateField validates field if it has value, in case that all referenced fields have their values.
+// Valid if any of referenced fields has another value, or if it doesn't exist.
+func (v *RequiredIfValidator) ValidateField(_ context.Context, fl validator.FieldLevel) bool {
+	params := strings.Fields(fl.Param())
+	if len(params)%2 != 0 {
+		return false
+	}
+
+	for i := 0; i < len(params); i += 2 {
+		field, ok := referencedField(fl.Parent(), params[i])
+		if !ok || fieldString(field) != params[i+1] {
+			return true
+		}
+	}
+
+	return hasValue(fl.Field())
+}
+
+// referencedField returns field of the struct by path of field names separated by ".", crossing pointers
+// to sub structs
+func referencedField(valueOf reflect.Value, path string) (reflect.Value, bool) {
+	for _, name := range strings.Split(path, ".") {
+		for valueOf.Kind() == reflect.Ptr || valueOf.Kind() == reflect.Interface {
+			if valueOf.IsNil() {
+				return reflect.Value{}, false
+			}
+			valueOf = valueOf.Elem()
+		}
+
+		if valueOf.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+
+		valueOf = valueOf.FieldByName(name)
+		if !valueOf.IsValid() {
+			return reflect.Value{}, false
+		}
+	}
+
+	for valueOf.Kind() == reflect.Ptr || valueOf.Kind() == reflect.Interface {
+		if valueOf.IsNil() {
+			return reflect.Value{}, false
+		}
+		valueOf = valueOf.Elem()
+	}
+
+	return valueOf, true
+}
+
+// fieldString returns value of the field in the form of rule parameters
+func fieldString(valueOf reflect.Value) string {
+	if valueOf.Kind() == reflect.String {
+		return valueOf.String()
+	}
+
+	return fmt.Sprint(valueOf)
+}
+
+// hasValue checks if field has value, same as "required" rule
+func hasValue(valueOf reflect.Value) bool {
+	switch valueOf.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Ptr, reflect.Interface, reflect.Chan, reflect.Func:
+		return !valueOf.IsNil()
+	case reflect.Invalid:
+		return false
+	}
+
+	return !valueOf.IsZero()
+}
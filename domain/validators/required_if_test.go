@@ -0,0 +1,135 @@
+package validators
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	RequiredIfValidatorTestSuite struct {
+		suite.Suite
+
+		validator *RequiredIfValidator
+	}
+
+	requiredIfTestAddress struct {
+		Country string
+	}
+
+	requiredIfTestData struct {
+		Address   *requiredIfTestAddress
+		Type      string
+		Employees int
+		State     string
+		Tags      []string
+	}
+)
+
+func TestRequiredIfValidatorTestSuite(t *testing.T) {
+	suite.Run(t, &RequiredIfValidatorTestSuite{})
+}
+
+func (t *RequiredIfValidatorTestSuite) SetupTest() {
+	t.validator = &RequiredIfValidator{}
+}
+
+func (t *RequiredIfValidatorTestSuite) TestValidatorName() {
+	t.Equal("required_if", t.validator.ValidatorName())
+}
+
+func (t *RequiredIfValidatorTestSuite) TestDescribeRule() {
+	t.Equal(domain.RuleDescription{
+		Name:        "required_if",
+		Description: "value required if all referenced fields have given values, as space separated pairs of fields and values",
+	}, t.validator.DescribeRule())
+}
+
+func (t *RequiredIfValidatorTestSuite) TestValidatesNilFields() {
+	t.True(t.validator.ValidatesNilFields())
+}
+
+func (t *RequiredIfValidatorTestSuite) TestValidateField() {
+	testCases := []struct {
+		Data   requiredIfTestData
+		Field  string
+		Param  string
+		Result bool
+	}{
+		{
+			Data:   requiredIfTestData{Type: "company"},
+			Field:  "State",
+			Param:  "Type company",
+			Result: false,
+		},
+		{
+			Data:   requiredIfTestData{Type: "company", State: "BE"},
+			Field:  "State",
+			Param:  "Type company",
+			Result: true,
+		},
+		{
+			Data:   requiredIfTestData{Type: "person"},
+			Field:  "State",
+			Param:  "Type company",
+			Result: true,
+		},
+		{
+			Data:   requiredIfTestData{Type: "company", Employees: 10},
+			Field:  "Tags",
+			Param:  "Type company Employees 10",
+			Result: false,
+		},
+		{
+			Data:   requiredIfTestData{Type: "company", Employees: 10, Tags: []string{}},
+			Field:  "Tags",
+			Param:  "Type company Employees 10",
+			Result: true,
+		},
+		{
+			Data:   requiredIfTestData{Type: "company", Employees: 5},
+			Field:  "Tags",
+			Param:  "Type company Employees 10",
+			Result: true,
+		},
+		{
+			Data:   requiredIfTestData{Address: &requiredIfTestAddress{Country: "US"}},
+			Field:  "State",
+			Param:  "Address.Country US",
+			Result: false,
+		},
+		{
+			Data:   requiredIfTestData{},
+			Field:  "State",
+			Param:  "Address.Country US",
+			Result: true,
+		},
+		{
+			Data:   requiredIfTestData{},
+			Field:  "State",
+			Param:  "Unknown value",
+			Result: true,
+		},
+		{
+			Data:   requiredIfTestData{},
+			Field:  "State",
+			Param:  "Type",
+			Result: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		parent := reflect.ValueOf(testCase.Data)
+
+		fieldLevel := &mocks.FieldLevel{}
+		fieldLevel.On("Parent").Return(parent).Maybe()
+		fieldLevel.On("Field").Return(parent.FieldByName(testCase.Field)).Maybe()
+		fieldLevel.On("Param").Return(testCase.Param).Once()
+
+		t.Equal(testCase.Result, t.validator.ValidateField(nil, fieldLevel), testCase.Param)
+	}
+}
@@ -0,0 +1,59 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// WeekdayChecker defines availability checker of dates, which are available on weekdays passed as parameters
+	// ("mon", "tue", "wed", "thu", "fri", "sat", "sun"). Dates are strings in configured date format or time.Time values.
+	//
+	// Data struct {
+	//	 DeliveryDate string `validate:"available=weekday mon tue wed thu fri"`
+	// }
+	//
+	WeekdayChecker struct {
+		dateFormat string
+	}
+)
+
+var _ domain.AvailabilityChecker = &WeekdayChecker{}
+
+// Inject is method used to set all dependencies as local variables
+func (c *WeekdayChecker) Inject(cfg *struct {
+	DateFormat string `inject:"config:form.validator.dateFormat"`
+}) {
+	c.dateFormat = cfg.DateFormat
+}
+
+// IsAvailable checks if date is on one of weekdays passed as parameters. Dates in wrong format are available,
+// so they are reported by "dateformat" rule only.
+func (c *WeekdayChecker) IsAvailable(_ context.Context, value interface{}, params []string) bool {
+	var date time.Time
+
+	switch converted := value.(type) {
+	case string:
+		parsed, err := time.Parse(c.dateFormat, strings.TrimSpace(converted))
+		if err != nil {
+			return true
+		}
+		date = parsed
+	case time.Time:
+		date = converted
+	default:
+		return false
+	}
+
+	weekday := strings.ToLower(date.Weekday().String()[0:3])
+	for _, param := range params {
+		if strings.ToLower(param) == weekday {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,78 @@
+package validators
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	WeekdayCheckerTestSuite struct {
+		suite.Suite
+
+		checker *WeekdayChecker
+	}
+)
+
+func TestWeekdayCheckerTestSuite(t *testing.T) {
+	suite.Run(t, &WeekdayCheckerTestSuite{})
+}
+
+func (t *WeekdayCheckerTestSuite) SetupTest() {
+	t.checker = &WeekdayChecker{}
+	t.checker.Inject(&struct {
+		DateFormat string `inject:"config:form.validator.dateFormat"`
+	}{
+		DateFormat: "2006-01-02",
+	})
+}
+
+func (t *WeekdayCheckerTestSuite) TestIsAvailable() {
+	testCases := []struct {
+		Value  interface{}
+		Params []string
+		Result bool
+	}{
+		{
+			Value:  "2024-03-01",
+			Params: []string{"mon", "tue", "wed", "thu", "fri"},
+			Result: true,
+		},
+		{
+			Value:  "2024-03-02",
+			Params: []string{"mon", "tue", "wed", "thu", "fri"},
+			Result: false,
+		},
+		{
+			Value:  "2024-03-03",
+			Params: []string{"Sat", "Sun"},
+			Result: true,
+		},
+		{
+			Value:  time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC),
+			Params: []string{"mon"},
+			Result: true,
+		},
+		{
+			Value:  "2024-03-04",
+			Params: nil,
+			Result: false,
+		},
+		{
+			Value:  "wrong",
+			Params: []string{"mon"},
+			Result: true,
+		},
+		{
+			Value:  10,
+			Params: []string{"mon"},
+			Result: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Equal(testCase.Result, t.checker.IsAvailable(context.Background(), testCase.Value, testCase.Params), testCase.Value)
+	}
+}
@@ -0,0 +1,94 @@
+package formtest
+
+import (
+	"sync"
+	"time"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// FakeClock defines clock which returns fixed time until it's changed, so time dependent features (like minimal
+	// fill time or expiry of confirmation snapshots) can be tested without sleeping:
+	//
+	// clock := formtest.NewFakeClock(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC))
+	// form, _ := formHandler.HandleUnsubmittedForm(ctx, req)
+	// clock.Advance(10 * time.Second)
+	FakeClock struct {
+		mutex sync.RWMutex
+		now   time.Time
+	}
+
+	// FakeTokenSource defines token source which repeats its pattern of bytes, continuing where previous read stopped,
+	// so generated tokens are known in advance. Token source without pattern reads zero bytes.
+	FakeTokenSource struct {
+		mutex    sync.Mutex
+		pattern  []byte
+		position int
+	}
+)
+
+var (
+	_ domain.Clock       = &FakeClock{}
+	_ domain.TokenSource = &FakeTokenSource{}
+)
+
+// NewFakeClock creates clock with fixed current time
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns current time of the clock
+func (c *FakeClock) Now() time.Time {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.now
+}
+
+// Set changes current time of the clock
+func (c *FakeClock) Set(now time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.now = now
+}
+
+// Advance moves current time of the clock by the duration
+func (c *FakeClock) Advance(duration time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.now = c.now.Add(duration)
+}
+
+// NewFakeTokenSource creates token source which repeats the pattern of bytes
+func NewFakeTokenSource(pattern ...byte) *FakeTokenSource {
+	return &FakeTokenSource{pattern: pattern}
+}
+
+// Read fills bytes with next bytes of the pattern
+func (s *FakeTokenSource) Read(b []byte) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for i := range b {
+		if len(s.pattern) == 0 {
+			b[i] = 0
+			continue
+		}
+
+		b[i] = s.pattern[s.position]
+		s.position = (s.position + 1) % len(s.pattern)
+	}
+
+	return len(b), nil
+}
+
+// Reset starts reading from the beginning of the pattern again, so the same tokens are generated again
+func (s *FakeTokenSource) Reset() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.position = 0
+}
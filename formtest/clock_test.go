@@ -0,0 +1,57 @@
+package formtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	ClockTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestClockTestSuite(t *testing.T) {
+	suite.Run(t, &ClockTestSuite{})
+}
+
+func (t *ClockTestSuite) TestFakeClock() {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(now)
+	t.Equal(now, clock.Now())
+
+	clock.Advance(time.Minute)
+	t.Equal(now.Add(time.Minute), clock.Now())
+
+	clock.Set(now)
+	t.Equal(now, clock.Now())
+}
+
+func (t *ClockTestSuite) TestFakeTokenSource() {
+	tokenSource := NewFakeTokenSource(1, 2, 3)
+
+	first := make([]byte, 4)
+	n, err := tokenSource.Read(first)
+	t.NoError(err)
+	t.Equal(4, n)
+	t.Equal([]byte{1, 2, 3, 1}, first)
+
+	second := make([]byte, 2)
+	_, err = tokenSource.Read(second)
+	t.NoError(err)
+	t.Equal([]byte{2, 3}, second)
+
+	tokenSource.Reset()
+	_, err = tokenSource.Read(second)
+	t.NoError(err)
+	t.Equal([]byte{1, 2}, second)
+}
+
+func (t *ClockTestSuite) TestFakeTokenSource_NoPattern() {
+	token := []byte{1, 2}
+	_, err := NewFakeTokenSource().Read(token)
+	t.NoError(err)
+	t.Equal([]byte{0, 0}, token)
+}
@@ -0,0 +1,299 @@
+package formtest
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/formdata"
+)
+
+type (
+	// Fault defines failure injected into wrapped form component. Component first waits for the delay (or until context
+	// is done), then panics with the panic value, if there is any, and otherwise returns the error instead of calling
+	// wrapped component. Fault without error and panic only delays wrapped component.
+	Fault struct {
+		// Stage limits fault to single stage of the component (like StageDecode), empty stage applies to all stages
+		Stage string
+		// Delay duration to wait for before component is called
+		Delay time.Duration
+		// Panic value component panics with
+		Panic interface{}
+		// Err error returned by component
+		Err error
+	}
+
+	// FaultInjector defines faults injected into form components wrapped by it. Faults are injected and cleared
+	// by names of components at any time, so same form handler can be tested with and without failing components:
+	//
+	// faults := formtest.NewFaultInjector()
+	// builder.SetFormDataDecoder(faults.FormDataDecoder("decoder", decoder))
+	// faults.Inject("decoder", formtest.Fault{Err: errors.New("decoding failed")})
+	FaultInjector struct {
+		mutex  sync.RWMutex
+		faults map[string]Fault
+	}
+
+	// faultyFormDataProvider wraps domain.FormDataProvider with injected faults
+	faultyFormDataProvider struct {
+		injector         *FaultInjector
+		name             string
+		formDataProvider domain.FormDataProvider
+	}
+
+	// faultyFormDataDecoder wraps domain.FormDataDecoder with injected faults
+	faultyFormDataDecoder struct {
+		injector        *FaultInjector
+		name            string
+		formDataDecoder domain.FormDataDecoder
+	}
+
+	// faultyFormDataValidator wraps domain.FormDataValidator with injected faults
+	faultyFormDataValidator struct {
+		injector          *FaultInjector
+		name              string
+		formDataValidator domain.FormDataValidator
+	}
+
+	// faultyFormExtension wraps form extension with injected faults. Provider, decoder and validator which are not
+	// implemented by form extension are replaced by default ones, same as by form handler.
+	faultyFormExtension struct {
+		injector      *FaultInjector
+		name          string
+		formExtension domain.FormExtension
+	}
+)
+
+const (
+	// StageProvide stage of providing form data
+	StageProvide = "provide"
+	// StageDecode stage of decoding submitted values
+	StageDecode = "decode"
+	// StageValidate stage of validating form data
+	StageValidate = "validate"
+	// StageGate stage of asking form extension to veto validity of the form
+	StageGate = "gate"
+	// StageObserve stage of notifying form extension about final state of the form
+	StageObserve = "observe"
+)
+
+var (
+	_ domain.FormDataProvider             = &faultyFormDataProvider{}
+	_ domain.UserSpecificFormDataProvider = &faultyFormDataProvider{}
+	_ domain.FormDataDecoder              = &faultyFormDataDecoder{}
+	_ domain.FormDataValidator            = &faultyFormDataValidator{}
+	_ domain.FormDataProvider             = &faultyFormExtension{}
+	_ domain.FormDataDecoder              = &faultyFormExtension{}
+	_ domain.FormDataValidator            = &faultyFormExtension{}
+	_ domain.FormValidityGate             = &faultyFormExtension{}
+	_ domain.FormResultObserver           = &faultyFormExtension{}
+	_ domain.ReadOnlyFormExtension        = &faultyFormExtension{}
+	_ domain.PrioritizedFormExtension     = &faultyFormExtension{}
+	_ domain.UserSpecificFormDataProvider = &faultyFormExtension{}
+)
+
+// NewFaultInjector creates fault injector without any fault
+func NewFaultInjector() *FaultInjector {
+	return &FaultInjector{
+		faults: map[string]Fault{},
+	}
+}
+
+// Inject injects fault into component with the name, replacing previous fault of the component
+func (i *FaultInjector) Inject(name string, fault Fault) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	i.faults[name] = fault
+}
+
+// Clear removes fault of component with the name
+func (i *FaultInjector) Clear(name string) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	delete(i.faults, name)
+}
+
+// ClearAll removes faults of all components
+func (i *FaultInjector) ClearAll() {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	i.faults = map[string]Fault{}
+}
+
+// FormDataProvider wraps form data provider, so it fails by faults injected under the name
+func (i *FaultInjector) FormDataProvider(name string, formDataProvider domain.FormDataProvider) domain.FormDataProvider {
+	return &faultyFormDataProvider{injector: i, name: name, formDataProvider: formDataProvider}
+}
+
+// FormDataDecoder wraps form data decoder, so it fails by faults injected under the name
+func (i *FaultInjector) FormDataDecoder(name string, formDataDecoder domain.FormDataDecoder) domain.FormDataDecoder {
+	return &faultyFormDataDecoder{injector: i, name: name, formDataDecoder: formDataDecoder}
+}
+
+// FormDataValidator wraps form data validator, so it fails by faults injected under the name
+func (i *FaultInjector) FormDataValidator(name string, formDataValidator domain.FormDataValidator) domain.FormDataValidator {
+	return &faultyFormDataValidator{injector: i, name: name, formDataValidator: formDataValidator}
+}
+
+// FormExtension wraps form extension, so it fails by faults injected under the name. Wrapped form extension
+// implements all optional interfaces of form extensions, and behaves like original one if there is no fault.
+func (i *FaultInjector) FormExtension(name string, formExtension domain.FormExtension) domain.FormExtension {
+	return &faultyFormExtension{injector: i, name: name, formExtension: formExtension}
+}
+
+// apply applies fault of component with the name for the stage, if there is any
+func (i *FaultInjector) apply(ctx context.Context, name string, stage string) error {
+	i.mutex.RLock()
+	fault, ok := i.faults[name]
+	i.mutex.RUnlock()
+
+	if !ok || (fault.Stage != "" && fault.Stage != stage) {
+		return nil
+	}
+
+	if fault.Delay > 0 {
+		timer := time.NewTimer(fault.Delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if fault.Panic != nil {
+		panic(fault.Panic)
+	}
+
+	return fault.Err
+}
+
+// GetFormData provides form data of wrapped provider, unless fault is injected
+func (p *faultyFormDataProvider) GetFormData(ctx context.Context, req *web.Request) (interface{}, error) {
+	if err := p.injector.apply(ctx, p.name, StageProvide); err != nil {
+		return nil, err
+	}
+
+	return p.formDataProvider.GetFormData(ctx, req)
+}
+
+// IsUserSpecific returns true if wrapped provider provides user specific form data
+func (p *faultyFormDataProvider) IsUserSpecific() bool {
+	return isUserSpecific(p.formDataProvider)
+}
+
+// Decode decodes values by wrapped decoder, unless fault is injected
+func (d *faultyFormDataDecoder) Decode(ctx context.Context, req *web.Request, values url.Values, formData interface{}) (interface{}, error) {
+	if err := d.injector.apply(ctx, d.name, StageDecode); err != nil {
+		return nil, err
+	}
+
+	return d.formDataDecoder.Decode(ctx, req, values, formData)
+}
+
+// Validate validates form data by wrapped validator, unless fault is injected
+func (v *faultyFormDataValidator) Validate(ctx context.Context, req *web.Request, validatorProvider domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	if err := v.injector.apply(ctx, v.name, StageValidate); err != nil {
+		return nil, err
+	}
+
+	return v.formDataValidator.Validate(ctx, req, validatorProvider, formData)
+}
+
+// GetFormData provides form data of form extension, unless fault is injected
+func (e *faultyFormExtension) GetFormData(ctx context.Context, req *web.Request) (interface{}, error) {
+	if err := e.injector.apply(ctx, e.name, StageProvide); err != nil {
+		return nil, err
+	}
+
+	if provider, ok := e.formExtension.(domain.FormDataProvider); ok {
+		return provider.GetFormData(ctx, req)
+	}
+
+	return (&formdata.DefaultFormDataProviderImpl{}).GetFormData(ctx, req)
+}
+
+// Decode decodes values into form data of form extension, unless fault is injected
+func (e *faultyFormExtension) Decode(ctx context.Context, req *web.Request, values url.Values, formData interface{}) (interface{}, error) {
+	if err := e.injector.apply(ctx, e.name, StageDecode); err != nil {
+		return nil, err
+	}
+
+	if decoder, ok := e.formExtension.(domain.FormDataDecoder); ok {
+		return decoder.Decode(ctx, req, values, formData)
+	}
+
+	return (&formdata.DefaultFormDataDecoderImpl{}).Decode(ctx, req, values, formData)
+}
+
+// Validate validates form data of form extension, unless fault is injected
+func (e *faultyFormExtension) Validate(ctx context.Context, req *web.Request, validatorProvider domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	if err := e.injector.apply(ctx, e.name, StageValidate); err != nil {
+		return nil, err
+	}
+
+	if validator, ok := e.formExtension.(domain.FormDataValidator); ok {
+		return validator.Validate(ctx, req, validatorProvider, formData)
+	}
+
+	return (&formdata.DefaultFormDataValidatorImpl{}).Validate(ctx, req, validatorProvider, formData)
+}
+
+// GateFormValidity asks form extension if form can be treated as valid, unless fault is injected
+func (e *faultyFormExtension) GateFormValidity(ctx context.Context, req *web.Request, values url.Values, form *domain.Form) (*domain.Error, error) {
+	if err := e.injector.apply(ctx, e.name, StageGate); err != nil {
+		return nil, err
+	}
+
+	if gate, ok := e.formExtension.(domain.FormValidityGate); ok {
+		return gate.GateFormValidity(ctx, req, values, form)
+	}
+
+	return nil, nil
+}
+
+// ObserveFormResult notifies form extension about final state of the form, unless fault is injected
+func (e *faultyFormExtension) ObserveFormResult(ctx context.Context, req *web.Request, values url.Values, form *domain.Form) error {
+	if err := e.injector.apply(ctx, e.name, StageObserve); err != nil {
+		return err
+	}
+
+	if observer, ok := e.formExtension.(domain.FormResultObserver); ok {
+		return observer.ObserveFormResult(ctx, req, values, form)
+	}
+
+	return nil
+}
+
+// IsReadOnly returns true if form extension implements domain.ReadOnlyFormExtension and causes no side effects
+func (e *faultyFormExtension) IsReadOnly() bool {
+	readOnly, ok := e.formExtension.(domain.ReadOnlyFormExtension)
+	return ok && readOnly.IsReadOnly()
+}
+
+// Priority returns priority of form extension, if it implements domain.PrioritizedFormExtension
+func (e *faultyFormExtension) Priority() int {
+	if prioritized, ok := e.formExtension.(domain.PrioritizedFormExtension); ok {
+		return prioritized.Priority()
+	}
+
+	return 0
+}
+
+// IsUserSpecific returns true if form extension provides user specific form data
+func (e *faultyFormExtension) IsUserSpecific() bool {
+	return isUserSpecific(e.formExtension)
+}
+
+// isUserSpecific checks if component implements domain.UserSpecificFormDataProvider and provides user specific form data
+func isUserSpecific(component interface{}) bool {
+	provider, ok := component.(domain.UserSpecificFormDataProvider)
+	return ok && provider.IsUserSpecific()
+}
@@ -0,0 +1,149 @@
+package formtest
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/mocks"
+)
+
+type (
+	FaultsTestSuite struct {
+		suite.Suite
+
+		injector *FaultInjector
+
+		context context.Context
+		request *web.Request
+	}
+
+	faultsTestExtension struct{}
+)
+
+var (
+	_ domain.FormValidityGate         = &faultsTestExtension{}
+	_ domain.PrioritizedFormExtension = &faultsTestExtension{}
+)
+
+func TestFaultsTestSuite(t *testing.T) {
+	suite.Run(t, &FaultsTestSuite{})
+}
+
+func (e *faultsTestExtension) GateFormValidity(context.Context, *web.Request, url.Values, *domain.Form) (*domain.Error, error) {
+	return &domain.Error{MessageKey: "formError.gate"}, nil
+}
+
+func (e *faultsTestExtension) Priority() int {
+	return 10
+}
+
+func (t *FaultsTestSuite) SetupTest() {
+	t.injector = NewFaultInjector()
+
+	t.context = context.Background()
+	t.request = web.CreateRequest(nil, nil)
+}
+
+func (t *FaultsTestSuite) TestFormDataProvider() {
+	provider := &mocks.FormDataProvider{}
+	provider.On("GetFormData", t.context, t.request).Return("data", nil).Twice()
+
+	wrapped := t.injector.FormDataProvider("provider", provider)
+
+	formData, err := wrapped.GetFormData(t.context, t.request)
+	t.NoError(err)
+	t.Equal("data", formData)
+
+	t.injector.Inject("provider", Fault{Err: errors.New("provider failed")})
+	formData, err = wrapped.GetFormData(t.context, t.request)
+	t.EqualError(err, "provider failed")
+	t.Nil(formData)
+
+	t.injector.Clear("provider")
+	formData, err = wrapped.GetFormData(t.context, t.request)
+	t.NoError(err)
+	t.Equal("data", formData)
+
+	provider.AssertExpectations(t.T())
+}
+
+func (t *FaultsTestSuite) TestFormDataDecoder() {
+	values := url.Values{"name": []string{"John"}}
+
+	decoder := &mocks.FormDataDecoder{}
+	decoder.On("Decode", t.context, t.request, values, nil).Return("decoded", nil).Once()
+
+	wrapped := t.injector.FormDataDecoder("decoder", decoder)
+
+	t.injector.Inject("decoder", Fault{Stage: StageValidate, Err: errors.New("validation failed")})
+	formData, err := wrapped.Decode(t.context, t.request, values, nil)
+	t.NoError(err, "fault of another stage is ignored")
+	t.Equal("decoded", formData)
+
+	t.injector.Inject("decoder", Fault{Panic: "decoder panicked"})
+	t.PanicsWithValue("decoder panicked", func() {
+		_, _ = wrapped.Decode(t.context, t.request, values, nil)
+	})
+
+	decoder.AssertExpectations(t.T())
+}
+
+func (t *FaultsTestSuite) TestFormDataValidator() {
+	validator := &mocks.FormDataValidator{}
+	validator.On("Validate", t.context, t.request, nil, "data").Return(&domain.ValidationInfo{}, nil).Once()
+
+	wrapped := t.injector.FormDataValidator("validator", validator)
+
+	t.injector.Inject("validator", Fault{Delay: time.Millisecond})
+	validationInfo, err := wrapped.Validate(t.context, t.request, nil, "data")
+	t.NoError(err)
+	t.Equal(&domain.ValidationInfo{}, validationInfo)
+
+	ctx, cancel := context.WithCancel(t.context)
+	cancel()
+
+	t.injector.Inject("validator", Fault{Delay: time.Hour})
+	validationInfo, err = wrapped.Validate(ctx, t.request, nil, "data")
+	t.Equal(context.Canceled, err)
+	t.Nil(validationInfo)
+
+	validator.AssertExpectations(t.T())
+}
+
+func (t *FaultsTestSuite) TestFormExtension() {
+	wrapped := t.injector.FormExtension("formExtension.test", &faultsTestExtension{})
+
+	formData, err := wrapped.(domain.FormDataProvider).GetFormData(t.context, t.request)
+	t.NoError(err)
+	t.Equal(map[string]string{}, formData)
+
+	formData, err = wrapped.(domain.FormDataDecoder).Decode(t.context, t.request, url.Values{"code": []string{"1"}}, formData)
+	t.NoError(err)
+	t.Equal(map[string]string{"code": "1"}, formData)
+
+	gateError, err := wrapped.(domain.FormValidityGate).GateFormValidity(t.context, t.request, nil, nil)
+	t.NoError(err)
+	t.Equal(&domain.Error{MessageKey: "formError.gate"}, gateError)
+
+	t.injector.Inject("formExtension.test", Fault{Stage: StageGate, Err: errors.New("gate failed")})
+	gateError, err = wrapped.(domain.FormValidityGate).GateFormValidity(t.context, t.request, nil, nil)
+	t.EqualError(err, "gate failed")
+	t.Nil(gateError)
+
+	t.injector.Inject("formExtension.test", Fault{Err: errors.New("observer failed")})
+	t.EqualError(wrapped.(domain.FormResultObserver).ObserveFormResult(t.context, t.request, nil, nil), "observer failed")
+
+	t.injector.ClearAll()
+	t.NoError(wrapped.(domain.FormResultObserver).ObserveFormResult(t.context, t.request, nil, nil))
+
+	t.Equal(10, wrapped.(domain.PrioritizedFormExtension).Priority())
+	t.False(wrapped.(domain.ReadOnlyFormExtension).IsReadOnly())
+	t.False(wrapped.(domain.UserSpecificFormDataProvider).IsUserSpecific())
+}
@@ -0,0 +1,494 @@
+// Package scenario provides harness for black-box tests of the whole form pipeline. Harness boots minimal Flamingo
+// application with the form module, registers test form, and drives it like a browser: form is rendered, filled
+// and submitted, and resulting Form is asserted after each step.
+//
+//	scenario.New(t).
+//		WithFormService(&RegistrationFormService{}, "formExtension.csrfToken").
+//		Render().
+//		AssertNotSubmitted().
+//		Fill("email", "john@example.com").
+//		Submit().
+//		AssertValidAndSubmitted()
+package scenario
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/dingo"
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form"
+	"flamingo.me/form/application"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// Scenario defines steps of black-box test of single form. Application is started by the first request (Render
+	// or Submit), so modules, configuration and test form can't be changed afterwards. All requests of the scenario
+	// share the same session and cookies, so form extensions relying on them (like CSRF token) behave the same way
+	// as in browser. Steps which can't be performed stop the test, and failed assertions are reported as errors.
+	Scenario struct {
+		t             testing.TB
+		modules       []dingo.Module
+		config        config.Map
+		overrides     *overrideModule
+		createHandler func(factory application.FormHandlerFactory) domain.FormHandler
+		path          string
+		injector      *dingo.Injector
+		formHandler   domain.FormHandler
+		session       *web.Session
+		cookies       map[string]*http.Cookie
+		values        url.Values
+		request       *web.Request
+		form          *domain.Form
+		err           error
+	}
+
+	// overrideModule overrides bindings of the form module with instances passed to the scenario
+	overrideModule struct {
+		clock       domain.Clock
+		tokenSource domain.TokenSource
+	}
+
+	// loggerModule binds logger required by the form module, which discards all messages
+	loggerModule struct{}
+)
+
+const (
+	// defaultPath path of all requests of the scenario, unless it's changed by WithPath
+	defaultPath = "/form"
+)
+
+var (
+	_ dingo.Module = &overrideModule{}
+	_ dingo.Module = &loggerModule{}
+)
+
+// New creates scenario with the form module and its default configuration, which uses simple form handler
+// with default form data provider, decoder and validator, until test form is registered
+func New(t testing.TB) *Scenario {
+	return &Scenario{
+		t:         t,
+		config:    config.Map{},
+		overrides: &overrideModule{},
+		createHandler: func(factory application.FormHandlerFactory) domain.FormHandler {
+			return factory.CreateSimpleFormHandler()
+		},
+		path:    defaultPath,
+		session: web.EmptySession(),
+		cookies: map[string]*http.Cookie{},
+		values:  url.Values{},
+	}
+}
+
+// WithModules adds modules to the application, like modules of form services and form extensions under test.
+// Modules are configured after the form module, so they can override its bindings.
+func (s *Scenario) WithModules(modules ...dingo.Module) *Scenario {
+	s.requireNotStarted("WithModules")
+	s.modules = append(s.modules, modules...)
+
+	return s
+}
+
+// WithConfig adds configuration of the application, which is merged with default configuration of the form module
+// (like "form.csrf.rotate")
+func (s *Scenario) WithConfig(cfg config.Map) *Scenario {
+	s.requireNotStarted("WithConfig")
+	for key, value := range cfg {
+		s.config[key] = value
+	}
+
+	return s
+}
+
+// WithClock overrides clock of the form module, like formtest.FakeClock for deterministic fill times and expiry
+func (s *Scenario) WithClock(clock domain.Clock) *Scenario {
+	s.requireNotStarted("WithClock")
+	s.overrides.clock = clock
+
+	return s
+}
+
+// WithTokenSource overrides token source of the form module, like formtest.FakeTokenSource for deterministic tokens
+func (s *Scenario) WithTokenSource(tokenSource domain.TokenSource) *Scenario {
+	s.requireNotStarted("WithTokenSource")
+	s.overrides.tokenSource = tokenSource
+
+	return s
+}
+
+// WithFormService registers test form, which is handled by form service and form extensions passed by their names,
+// same as by application.FormHandlerFactory.CreateFormHandlerWithFormService
+func (s *Scenario) WithFormService(formService domain.FormService, formExtensions ...string) *Scenario {
+	return s.WithFormHandler(func(factory application.FormHandlerFactory) domain.FormHandler {
+		return factory.CreateFormHandlerWithFormService(formService, formExtensions...)
+	})
+}
+
+// WithFormHandler registers test form, which is handled by form handler created by the function, like form handlers
+// built by application.FormHandlerBuilder
+func (s *Scenario) WithFormHandler(createHandler func(factory application.FormHandlerFactory) domain.FormHandler) *Scenario {
+	s.requireNotStarted("WithFormHandler")
+	s.createHandler = createHandler
+
+	return s
+}
+
+// WithPath changes path of all requests of the scenario, like paths of routes exempted from CSRF protection
+func (s *Scenario) WithPath(path string) *Scenario {
+	s.path = path
+
+	return s
+}
+
+// Render renders unsubmitted form by GET request. Hidden fields of form extensions (form extensions data with
+// FieldName and Token, like CSRF and minimal fill time tokens) are filled with their tokens, as they would be
+// rendered by the template, and values filled so far are kept.
+func (s *Scenario) Render() *Scenario {
+	s.t.Helper()
+
+	request := s.newRequest(http.MethodGet, nil)
+	form, err := s.handler().HandleUnsubmittedForm(s.requestContext(request), request)
+	s.finish(request, form, err)
+
+	if form != nil {
+		for _, formExtensionData := range form.FormExtensionsData {
+			if fieldName, token, ok := hiddenField(formExtensionData); ok {
+				s.values.Set(fieldName, token)
+			}
+		}
+	}
+
+	return s
+}
+
+// Fill sets value of the field, replacing previous values of the field
+func (s *Scenario) Fill(fieldName string, values ...string) *Scenario {
+	s.values[fieldName] = append([]string{}, values...)
+
+	return s
+}
+
+// FillValues sets values of all passed fields, replacing their previous values
+func (s *Scenario) FillValues(values url.Values) *Scenario {
+	for fieldName, list := range values {
+		s.Fill(fieldName, list...)
+	}
+
+	return s
+}
+
+// Clear removes value of the field, so it's not submitted
+func (s *Scenario) Clear(fieldName string) *Scenario {
+	s.values.Del(fieldName)
+
+	return s
+}
+
+// Submit submits filled values as url encoded POST request, which is handled by HandleForm of the form handler
+func (s *Scenario) Submit() *Scenario {
+	s.t.Helper()
+
+	request := s.newRequest(http.MethodPost, strings.NewReader(s.values.Encode()))
+	form, err := s.handler().HandleForm(s.requestContext(request), request)
+	s.finish(request, form, err)
+
+	return s
+}
+
+// Form returns form returned by the last request
+func (s *Scenario) Form() *domain.Form {
+	return s.form
+}
+
+// Err returns error returned by form handler for the last request
+func (s *Scenario) Err() error {
+	return s.err
+}
+
+// Request returns the last request, like for request scoped results of form extensions
+func (s *Scenario) Request() *web.Request {
+	return s.request
+}
+
+// Values returns copy of values filled so far
+func (s *Scenario) Values() url.Values {
+	values := make(url.Values, len(s.values))
+	for fieldName, list := range s.values {
+		values[fieldName] = append([]string{}, list...)
+	}
+
+	return values
+}
+
+// Cookie returns cookie stored by the scenario, which is sent with all following requests
+func (s *Scenario) Cookie(name string) (*http.Cookie, bool) {
+	cookie, ok := s.cookies[name]
+
+	return cookie, ok
+}
+
+// Injector returns injector of the application, which is started if it's not running yet
+func (s *Scenario) Injector() *dingo.Injector {
+	s.t.Helper()
+	s.start()
+
+	return s.injector
+}
+
+// AssertNoError asserts that form handler returned no error for the last request
+func (s *Scenario) AssertNoError() *Scenario {
+	s.t.Helper()
+	assert.NoError(s.t, s.err)
+
+	return s
+}
+
+// AssertError asserts that form handler returned error for the last request
+func (s *Scenario) AssertError() *Scenario {
+	s.t.Helper()
+	assert.Error(s.t, s.err)
+
+	return s
+}
+
+// AssertSubmitted asserts that form of the last request is submitted
+func (s *Scenario) AssertSubmitted() *Scenario {
+	s.t.Helper()
+	assert.True(s.t, s.requireForm().IsSubmitted(), "form must be submitted")
+
+	return s
+}
+
+// AssertNotSubmitted asserts that form of the last request is not submitted
+func (s *Scenario) AssertNotSubmitted() *Scenario {
+	s.t.Helper()
+	assert.False(s.t, s.requireForm().IsSubmitted(), "form must not be submitted")
+
+	return s
+}
+
+// AssertValid asserts that form of the last request has no validation errors
+func (s *Scenario) AssertValid() *Scenario {
+	s.t.Helper()
+	form := s.requireForm()
+	assert.True(s.t, form.IsValid(), "form must be valid, but has field errors %v and general errors %v",
+		form.ValidationInfo.GetErrorsForAllFields(), form.GetGeneralErrors())
+
+	return s
+}
+
+// AssertInvalid asserts that form of the last request has validation errors
+func (s *Scenario) AssertInvalid() *Scenario {
+	s.t.Helper()
+	assert.False(s.t, s.requireForm().IsValid(), "form must be invalid")
+
+	return s
+}
+
+// AssertValidAndSubmitted asserts that form handler returned no error, and that form of the last request is submitted
+// and valid
+func (s *Scenario) AssertValidAndSubmitted() *Scenario {
+	s.t.Helper()
+
+	return s.AssertNoError().AssertSubmitted().AssertValid()
+}
+
+// AssertFieldError asserts that field of the form of the last request has error with the message key
+func (s *Scenario) AssertFieldError(fieldName string, messageKey string) *Scenario {
+	s.t.Helper()
+	errs := s.requireForm().GetErrorsForField(fieldName)
+	assert.Contains(s.t, messageKeys(errs), messageKey, "field %q must have error %q", fieldName, messageKey)
+
+	return s
+}
+
+// AssertNoFieldError asserts that field of the form of the last request has no error
+func (s *Scenario) AssertNoFieldError(fieldName string) *Scenario {
+	s.t.Helper()
+	assert.Empty(s.t, s.requireForm().GetErrorsForField(fieldName), "field %q must not have errors", fieldName)
+
+	return s
+}
+
+// AssertGeneralError asserts that form of the last request has general error with the message key
+func (s *Scenario) AssertGeneralError(messageKey string) *Scenario {
+	s.t.Helper()
+	errs := s.requireForm().GetGeneralErrors()
+	assert.Contains(s.t, messageKeys(errs), messageKey, "form must have general error %q", messageKey)
+
+	return s
+}
+
+// AssertData asserts that form of the last request has the expected form data
+func (s *Scenario) AssertData(expected interface{}) *Scenario {
+	s.t.Helper()
+	assert.Equal(s.t, expected, s.requireForm().Data)
+
+	return s
+}
+
+// Assert runs custom assertion of the form of the last request
+func (s *Scenario) Assert(assertion func(t testing.TB, form *domain.Form)) *Scenario {
+	s.t.Helper()
+	assertion(s.t, s.requireForm())
+
+	return s
+}
+
+// Configure overrides clock and token source of the form module, if they are passed to the scenario
+func (m *overrideModule) Configure(injector *dingo.Injector) {
+	if m.clock != nil {
+		injector.Override(new(domain.Clock), "").ToInstance(m.clock)
+	}
+	if m.tokenSource != nil {
+		injector.Override(new(domain.TokenSource), "").ToInstance(m.tokenSource)
+	}
+}
+
+// Configure binds logger which discards all messages
+func (m *loggerModule) Configure(injector *dingo.Injector) {
+	injector.Bind(new(flamingo.Logger)).To(flamingo.NullLogger{})
+}
+
+// handler returns form handler of the test form, application is started if it's not running yet
+func (s *Scenario) handler() domain.FormHandler {
+	s.t.Helper()
+	s.start()
+
+	return s.formHandler
+}
+
+// start boots the application with the form module and creates form handler of the test form
+func (s *Scenario) start() {
+	s.t.Helper()
+	if s.injector != nil {
+		return
+	}
+
+	additionalConfig, err := json.Marshal(s.config)
+	if err != nil {
+		s.t.Fatalf("scenario config can't be encoded: %v", err)
+	}
+
+	modules := append([]dingo.Module{&loggerModule{}, &form.Module{}}, s.modules...)
+	area := config.NewArea("scenario", append(modules, s.overrides))
+	if err := config.Load(area, "", config.AdditionalConfig([]string{string(additionalConfig)})); err != nil {
+		s.t.Fatalf("scenario config can't be loaded: %v", err)
+	}
+
+	injector, err := area.GetInitializedInjector()
+	if err != nil {
+		s.t.Fatalf("scenario application can't be started: %v", err)
+	}
+
+	instance, err := injector.GetInstance(new(application.FormHandlerFactory))
+	if err != nil {
+		s.t.Fatalf("form handler factory can't be resolved: %v", err)
+	}
+
+	formHandler := s.createHandler(instance.(application.FormHandlerFactory))
+	if formHandler == nil {
+		s.t.Fatalf("form handler of the scenario is nil")
+	}
+
+	s.injector = injector
+	s.formHandler = formHandler
+}
+
+// newRequest creates request of the scenario with its cookies and session
+func (s *Scenario) newRequest(method string, body io.Reader) *web.Request {
+	request := httptest.NewRequest(method, s.path, body)
+	if body != nil {
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	for _, cookie := range s.cookies {
+		request.AddCookie(&http.Cookie{Name: cookie.Name, Value: cookie.Value})
+	}
+
+	return web.CreateRequest(request, s.session)
+}
+
+// requestContext returns context of the request, which carries the request as Flamingo's router does
+func (s *Scenario) requestContext(request *web.Request) context.Context {
+	return web.ContextWithRequest(context.Background(), request)
+}
+
+// finish stores result of the request, and cookies scheduled by form extensions for the response
+func (s *Scenario) finish(request *web.Request, form *domain.Form, err error) {
+	s.request = request
+	s.form = form
+	s.err = err
+
+	if cookie, ok := extensions.CSRFCookieFromRequest(request); ok {
+		if cookie.MaxAge < 0 {
+			delete(s.cookies, cookie.Name)
+		} else {
+			s.cookies[cookie.Name] = cookie
+		}
+	}
+}
+
+// requireForm returns form of the last request, and stops the test if there is none
+func (s *Scenario) requireForm() *domain.Form {
+	s.t.Helper()
+	if s.form == nil {
+		s.t.Fatalf("there is no form to assert, last request returned error: %v", s.err)
+	}
+
+	return s.form
+}
+
+// requireNotStarted stops the test if application is already running, as it can't be changed anymore
+func (s *Scenario) requireNotStarted(step string) {
+	s.t.Helper()
+	if s.injector != nil {
+		s.t.Fatalf("%s must be called before the first request of the scenario", step)
+	}
+}
+
+// hiddenField returns field name and token of form extension data, which carries them as exported string fields
+// FieldName and Token
+func hiddenField(formExtensionData interface{}) (string, string, bool) {
+	valueOf := reflect.ValueOf(formExtensionData)
+	for valueOf.Kind() == reflect.Ptr {
+		if valueOf.IsNil() {
+			return "", "", false
+		}
+		valueOf = valueOf.Elem()
+	}
+
+	if valueOf.Kind() != reflect.Struct {
+		return "", "", false
+	}
+
+	fieldName, token := valueOf.FieldByName("FieldName"), valueOf.FieldByName("Token")
+	if fieldName.Kind() != reflect.String || token.Kind() != reflect.String || fieldName.String() == "" {
+		return "", "", false
+	}
+
+	return fieldName.String(), token.String(), true
+}
+
+// messageKeys returns message keys of errors
+func messageKeys(errs []domain.Error) []string {
+	keys := make([]string, 0, len(errs))
+	for _, err := range errs {
+		keys = append(keys, err.MessageKey)
+	}
+
+	return keys
+}
@@ -0,0 +1,146 @@
+package scenario
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/extensions"
+	"flamingo.me/form/formtest"
+)
+
+type (
+	ScenarioTestSuite struct {
+		suite.Suite
+	}
+
+	scenarioTestData struct {
+		Email string
+	}
+
+	scenarioTestService struct{}
+)
+
+var (
+	_ domain.FormDataProvider  = &scenarioTestService{}
+	_ domain.FormDataDecoder   = &scenarioTestService{}
+	_ domain.FormDataValidator = &scenarioTestService{}
+)
+
+const scenarioTestToken = "AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE"
+
+func TestScenarioTestSuite(t *testing.T) {
+	suite.Run(t, &ScenarioTestSuite{})
+}
+
+func (s *scenarioTestService) GetFormData(context.Context, *web.Request) (interface{}, error) {
+	return scenarioTestData{}, nil
+}
+
+func (s *scenarioTestService) Decode(_ context.Context, _ *web.Request, values url.Values, _ interface{}) (interface{}, error) {
+	return scenarioTestData{Email: values.Get("email")}, nil
+}
+
+func (s *scenarioTestService) Validate(_ context.Context, _ *web.Request, _ domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	validationInfo := &domain.ValidationInfo{}
+	if formData.(scenarioTestData).Email == "" {
+		validationInfo.AddFieldError("email", "formError.email.required", "email is required")
+	}
+
+	return validationInfo, nil
+}
+
+func (t *ScenarioTestSuite) newScenario() *Scenario {
+	return New(t.T()).
+		WithTokenSource(formtest.NewFakeTokenSource(1)).
+		WithFormService(&scenarioTestService{}, "formExtension.csrfToken")
+}
+
+func (t *ScenarioTestSuite) TestRenderAndSubmit() {
+	scenario := t.newScenario().
+		Render().
+		AssertNoError().
+		AssertNotSubmitted()
+
+	t.Equal(scenarioTestToken, scenario.Values().Get("csrfToken"))
+
+	cookie, ok := scenario.Cookie("form_csrf")
+	t.True(ok)
+	t.Equal(scenarioTestToken, cookie.Value)
+
+	scenario.
+		Fill("email", "john@example.com").
+		Submit().
+		AssertValidAndSubmitted().
+		AssertNoFieldError("email").
+		AssertData(scenarioTestData{Email: "john@example.com"})
+}
+
+func (t *ScenarioTestSuite) TestSubmit_FieldError() {
+	t.newScenario().
+		Render().
+		Submit().
+		AssertNoError().
+		AssertSubmitted().
+		AssertInvalid().
+		AssertFieldError("email", "formError.email.required")
+}
+
+func (t *ScenarioTestSuite) TestSubmit_WithoutCSRFToken() {
+	t.newScenario().
+		Render().
+		Clear("csrfToken").
+		Fill("email", "john@example.com").
+		Submit().
+		AssertInvalid().
+		AssertGeneralError("formError.csrfToken.invalid")
+}
+
+func (t *ScenarioTestSuite) TestSubmit_WithoutRender() {
+	t.newScenario().
+		FillValues(url.Values{"email": []string{"john@example.com"}, "csrfToken": []string{scenarioTestToken}}).
+		Submit().
+		AssertInvalid().
+		AssertGeneralError("formError.csrfToken.invalid")
+}
+
+func (t *ScenarioTestSuite) TestAssert() {
+	t.newScenario().
+		Render().
+		Assert(func(_ testing.TB, form *domain.Form) {
+			t.Contains(form.FormExtensionsData, "formExtension.csrfToken")
+		})
+}
+
+func (t *ScenarioTestSuite) TestHiddenField() {
+	fieldName, token, ok := hiddenField(extensions.CSRFTokenFormData{FieldName: "csrfToken", Token: "token"})
+	t.True(ok)
+	t.Equal("csrfToken", fieldName)
+	t.Equal("token", token)
+
+	fieldName, token, ok = hiddenField(&extensions.MinFillTimeFormData{FieldName: "renderedAt", Token: "signed"})
+	t.True(ok)
+	t.Equal("renderedAt", fieldName)
+	t.Equal("signed", token)
+
+	_, _, ok = hiddenField(extensions.CSRFTokenFormData{Token: "token"})
+	t.False(ok, "field name is required")
+
+	_, _, ok = hiddenField((*extensions.CSRFTokenFormData)(nil))
+	t.False(ok)
+
+	_, _, ok = hiddenField(map[string]string{"FieldName": "csrfToken"})
+	t.False(ok)
+
+	_, _, ok = hiddenField(struct{ FieldName, Token int }{})
+	t.False(ok)
+}
+
+func (t *ScenarioTestSuite) TestMessageKeys() {
+	t.Equal([]string{}, messageKeys(nil))
+	t.Equal([]string{"formError.a", "formError.b"}, messageKeys([]domain.Error{{MessageKey: "formError.a"}, {MessageKey: "formError.b"}}))
+}
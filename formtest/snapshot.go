@@ -0,0 +1,114 @@
+// Package formtest provides helpers for testing forms handled by domain.FormHandler
+package formtest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// formSnapshot defines serializable state of handled form
+	formSnapshot struct {
+		Submitted       bool
+		Valid           bool
+		Data            interface{}
+		ExtensionsData  map[string]interface{}
+		ValidationRules map[string][]domain.ValidationRule
+		GeneralErrors   []domain.Error
+		FieldErrors     map[string][]domain.Error
+	}
+)
+
+// UpdateGoldenEnv is name of environment variable which enables updating of golden files instead of comparing them
+const UpdateGoldenEnv = "FORMTEST_UPDATE_GOLDEN"
+
+// Snapshot serializes handled form (state, data, validation rules and errors) into indented JSON.
+// Serialization is deterministic, as all maps are sorted by keys and all errors are sorted by message keys,
+// so order in which form extensions are processed doesn't affect it.
+func Snapshot(form *domain.Form) ([]byte, error) {
+	if form == nil {
+		return nil, domain.NewFormError("there is no form for snapshot")
+	}
+
+	validationRules := form.GetValidationRules()
+	if validationRules == nil {
+		validationRules = map[string][]domain.ValidationRule{}
+	}
+
+	fieldErrors := map[string][]domain.Error{}
+	for name, errs := range form.ValidationInfo.GetErrorsForAllFields() {
+		if len(errs) > 0 {
+			fieldErrors[name] = sortErrors(errs)
+		}
+	}
+
+	snapshot, err := json.MarshalIndent(formSnapshot{
+		Submitted:       form.IsSubmitted(),
+		Valid:           form.IsValid(),
+		Data:            form.Data,
+		ExtensionsData:  form.FormExtensionsData,
+		ValidationRules: validationRules,
+		GeneralErrors:   sortErrors(form.GetGeneralErrors()),
+		FieldErrors:     fieldErrors,
+	}, "", "  ")
+	if err != nil {
+		return nil, domain.NewFormErrorWithParent(err)
+	}
+
+	return append(snapshot, '\n'), nil
+}
+
+// AssertGolden compares snapshot of handled form with content of golden file, and reports diff in case of mismatch.
+// If environment variable FORMTEST_UPDATE_GOLDEN is set, golden file is written instead:
+//
+// FORMTEST_UPDATE_GOLDEN=1 go test ./...
+func AssertGolden(t testing.TB, form *domain.Form, path string) bool {
+	t.Helper()
+
+	snapshot, err := Snapshot(form)
+	if err != nil {
+		t.Fatalf("snapshot of form failed: %s", err)
+		return false
+	}
+
+	if os.Getenv(UpdateGoldenEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("creating directory for golden file %s failed: %s", path, err)
+			return false
+		}
+		if err := ioutil.WriteFile(path, snapshot, 0644); err != nil {
+			t.Fatalf("writing golden file %s failed: %s", path, err)
+			return false
+		}
+		return true
+	}
+
+	golden, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading golden file %s failed: %s (run tests with %s=1 to create it)", path, err, UpdateGoldenEnv)
+		return false
+	}
+
+	return assert.Equal(t, string(golden), string(snapshot), "form snapshot doesn't match golden file %s", path)
+}
+
+// sortErrors returns copy of errors sorted by message keys and default labels
+func sortErrors(errs []domain.Error) []domain.Error {
+	sorted := append([]domain.Error{}, errs...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].MessageKey != sorted[j].MessageKey {
+			return sorted[i].MessageKey < sorted[j].MessageKey
+		}
+		return sorted[i].DefaultLabel < sorted[j].DefaultLabel
+	})
+
+	return sorted
+}
@@ -0,0 +1,80 @@
+package formtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	SnapshotTestSuite struct {
+		suite.Suite
+	}
+
+	snapshotTestFormData struct {
+		Email    string `json:"email"`
+		Password string `json:"-"`
+	}
+)
+
+func TestSnapshotTestSuite(t *testing.T) {
+	suite.Run(t, &SnapshotTestSuite{})
+}
+
+func (t *SnapshotTestSuite) createForm(generalErrors ...string) *domain.Form {
+	form := domain.NewForm(true, map[string][]domain.ValidationRule{
+		"email": {
+			{
+				Name: "required",
+			},
+			{
+				Name:  "max",
+				Value: "128",
+			},
+		},
+	})
+	form.Data = snapshotTestFormData{
+		Email:    "user@example.com",
+		Password: "secret",
+	}
+	form.FormExtensionsData = map[string]interface{}{
+		"formExtension.csrfToken": map[string]string{
+			"Token": "token",
+		},
+	}
+	for _, generalError := range generalErrors {
+		form.ValidationInfo.AddGeneralError("formError."+generalError, generalError)
+	}
+	form.ValidationInfo.AddFieldError("email", "formError.email.email", "email")
+
+	return &form
+}
+
+func (t *SnapshotTestSuite) TestSnapshot_Deterministic() {
+	first, err := Snapshot(t.createForm("lockout", "csrf"))
+	t.NoError(err)
+
+	second, err := Snapshot(t.createForm("csrf", "lockout"))
+	t.NoError(err)
+
+	t.Equal(string(first), string(second))
+}
+
+func (t *SnapshotTestSuite) TestSnapshot_Error() {
+	snapshot, err := Snapshot(nil)
+	t.Error(err)
+	t.Nil(snapshot)
+
+	form := domain.NewForm(false, nil)
+	form.Data = make(chan int)
+
+	snapshot, err = Snapshot(&form)
+	t.Error(err)
+	t.Nil(snapshot)
+}
+
+func (t *SnapshotTestSuite) TestAssertGolden() {
+	t.True(AssertGolden(t.T(), t.createForm("lockout", "csrf"), "testdata/snapshot.golden"))
+}
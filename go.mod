@@ -5,9 +5,13 @@ require (
 	flamingo.me/flamingo/v3 v3.2.2
 	github.com/go-playground/form v3.1.4+incompatible
 	github.com/go-playground/universal-translator v0.17.0
+	github.com/gomodule/redigo v2.0.0+incompatible
 	github.com/leebenson/conform v1.2.2
 	github.com/leodido/go-urn v1.1.0 // indirect
+	github.com/spf13/cobra v0.0.6
+	github.com/streadway/amqp v0.0.0-20190404075320-75d898a42a94
 	github.com/stretchr/testify v1.7.0
+	go.opencensus.io v0.22.3
 	gopkg.in/go-playground/assert.v1 v1.2.1 // indirect
 	gopkg.in/go-playground/validator.v9 v9.31.0
 )
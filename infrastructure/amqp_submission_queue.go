@@ -0,0 +1,144 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/streadway/amqp"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// AMQPSubmissionQueue defines queue of deferred submissions in AMQP broker (like RabbitMQ), shared between all
+	// instances. Submissions are published as persistent JSON messages with publisher confirms, so they are queued
+	// only when broker acknowledges them. Connection is opened with first submission and reopened after failures.
+	AMQPSubmissionQueue struct {
+		url        string
+		exchange   string
+		routingKey string
+
+		mutex      sync.Mutex
+		connection *amqp.Connection
+		channel    *amqp.Channel
+		confirms   chan amqp.Confirmation
+	}
+)
+
+var (
+	_ domain.SubmissionQueue  = &AMQPSubmissionQueue{}
+	_ domain.DependencyStatus = &AMQPSubmissionQueue{}
+
+	// ErrSubmissionNotAcknowledged defines error of submission which is rejected by AMQP broker
+	ErrSubmissionNotAcknowledged = errors.New("submission is not acknowledged by amqp broker")
+)
+
+// Inject is method used to set all dependencies as local variables
+func (q *AMQPSubmissionQueue) Inject(cfg *struct {
+	URL        string `inject:"config:form.submissionQueue.amqp.url"`
+	Exchange   string `inject:"config:form.submissionQueue.amqp.exchange"`
+	RoutingKey string `inject:"config:form.submissionQueue.amqp.routingKey"`
+}) {
+	q.url = cfg.URL
+	q.exchange = cfg.Exchange
+	q.routingKey = cfg.RoutingKey
+}
+
+// Enqueue publishes submission to configured exchange, and waits until broker acknowledges it
+func (q *AMQPSubmissionQueue) Enqueue(ctx context.Context, submission domain.QueuedSubmission) error {
+	body, err := json.Marshal(submission)
+	if err != nil {
+		return err
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if err := q.connect(); err != nil {
+		return err
+	}
+
+	err = q.channel.Publish(q.exchange, q.routingKey, false, false, amqp.Publishing{
+		ContentType:   "application/json",
+		DeliveryMode:  amqp.Persistent,
+		MessageId:     submission.Token,
+		CorrelationId: submission.CorrelationID,
+		Type:          submission.Type,
+		Timestamp:     submission.Timestamp,
+		Body:          body,
+	})
+	if err != nil {
+		q.disconnect()
+		return err
+	}
+
+	select {
+	case confirmation, ok := <-q.confirms:
+		if !ok {
+			q.disconnect()
+			return ErrSubmissionNotAcknowledged
+		}
+		if !confirmation.Ack {
+			return ErrSubmissionNotAcknowledged
+		}
+		return nil
+	case <-ctx.Done():
+		// confirmation of abandoned submission would be received by the next one, so channel is reopened
+		q.disconnect()
+		return ctx.Err()
+	}
+}
+
+// Status checks if AMQP broker is available
+func (q *AMQPSubmissionQueue) Status() (bool, string) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if err := q.connect(); err != nil {
+		return false, "amqp: " + err.Error()
+	}
+
+	return true, ""
+}
+
+// connect opens connection and channel in confirm mode, if they are not opened yet
+func (q *AMQPSubmissionQueue) connect() error {
+	if q.channel != nil {
+		return nil
+	}
+
+	connection, err := amqp.Dial(q.url)
+	if err != nil {
+		return err
+	}
+
+	channel, err := connection.Channel()
+	if err != nil {
+		connection.Close()
+		return err
+	}
+
+	if err := channel.Confirm(false); err != nil {
+		connection.Close()
+		return err
+	}
+
+	q.connection = connection
+	q.channel = channel
+	q.confirms = channel.NotifyPublish(make(chan amqp.Confirmation, 1))
+
+	return nil
+}
+
+// disconnect closes connection, so it's reopened with the next submission
+func (q *AMQPSubmissionQueue) disconnect() {
+	if q.connection != nil {
+		q.connection.Close()
+	}
+
+	q.connection = nil
+	q.channel = nil
+	q.confirms = nil
+}
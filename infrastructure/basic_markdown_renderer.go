@@ -0,0 +1,169 @@
+package infrastructure
+
+import (
+	"context"
+	"html"
+	"html/template"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"flamingo.me/form/domain/markdown"
+)
+
+type (
+	// BasicMarkdownRenderer defines default markdown renderer, which supports common subset of markdown: paragraphs,
+	// headings, lists, block quotes, code blocks, emphasis, code spans and links. All text is escaped, and only links
+	// with http, https and mailto schemes or relative links are rendered, so HTML is safe to be embedded into pages.
+	// Projects can provide own implementation of markdown.Renderer, which adapts full markdown library.
+	BasicMarkdownRenderer struct{}
+
+	// markdownBlock defines consecutive lines of markdown rendered as single block
+	markdownBlock struct {
+		kind  string
+		lines []string
+		level int
+	}
+)
+
+var (
+	_ markdown.Renderer = &BasicMarkdownRenderer{}
+
+	// markdownHeadingRegex matches ATX headings like "## Title"
+	markdownHeadingRegex = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*$`)
+	// markdownUnorderedRegex matches items of unordered lists like "- item"
+	markdownUnorderedRegex = regexp.MustCompile(`^[-*+]\s+(.*)$`)
+	// markdownOrderedRegex matches items of ordered lists like "1. item"
+	markdownOrderedRegex = regexp.MustCompile(`^\d{1,9}[.)]\s+(.*)$`)
+	// markdownLinkRegex matches inline links like "[text](url)"
+	markdownLinkRegex = regexp.MustCompile(`^\[([^\]]*)\]\(([^)\s]*)\)`)
+	// markdownSafeURLRegex matches URLs which can be rendered as links
+	markdownSafeURLRegex = regexp.MustCompile(`^(?i:https?://|mailto:|/|#)`)
+)
+
+// Render returns HTML of markdown text
+func (r *BasicMarkdownRenderer) Render(_ context.Context, text markdown.Text) (template.HTML, error) {
+	return template.HTML(renderMarkdownBlocks(strings.Split(markdown.Sanitize(string(text)), "\n"))), nil
+}
+
+// renderMarkdownBlocks renders lines of markdown as HTML blocks
+func renderMarkdownBlocks(lines []string) string {
+	var builder strings.Builder
+
+	for _, block := range markdownBlocks(lines) {
+		switch block.kind {
+		case "code":
+			builder.WriteString("<pre><code>" + html.EscapeString(strings.Join(block.lines, "\n")) + "</code></pre>\n")
+		case "heading":
+			tag := "h" + strconv.Itoa(block.level)
+			builder.WriteString("<" + tag + ">" + renderMarkdownInline(block.lines[0]) + "</" + tag + ">\n")
+		case "quote":
+			builder.WriteString("<blockquote>\n" + renderMarkdownBlocks(block.lines) + "</blockquote>\n")
+		case "ul", "ol":
+			builder.WriteString("<" + block.kind + ">\n")
+			for _, item := range block.lines {
+				builder.WriteString("<li>" + renderMarkdownInline(item) + "</li>\n")
+			}
+			builder.WriteString("</" + block.kind + ">\n")
+		default:
+			builder.WriteString("<p>" + renderMarkdownInline(strings.Join(block.lines, "\n")) + "</p>\n")
+		}
+	}
+
+	return builder.String()
+}
+
+// markdownBlocks splits lines of markdown into blocks
+func markdownBlocks(lines []string) []markdownBlock {
+	var blocks []markdownBlock
+	var current *markdownBlock
+
+	appendLine := func(kind string, line string) {
+		if current == nil || current.kind != kind {
+			blocks = append(blocks, markdownBlock{kind: kind})
+			current = &blocks[len(blocks)-1]
+		}
+		current.lines = append(current.lines, line)
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+
+		switch {
+		case line == "":
+			current = nil
+		case strings.HasPrefix(line, "```"):
+			blocks = append(blocks, markdownBlock{kind: "code"})
+			code := &blocks[len(blocks)-1]
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```"); i++ {
+				code.lines = append(code.lines, lines[i])
+			}
+			current = nil
+		case markdownHeadingRegex.MatchString(line):
+			match := markdownHeadingRegex.FindStringSubmatch(line)
+			blocks = append(blocks, markdownBlock{kind: "heading", lines: []string{match[2]}, level: len(match[1])})
+			current = nil
+		case strings.HasPrefix(line, ">"):
+			appendLine("quote", strings.TrimPrefix(strings.TrimPrefix(line, ">"), " "))
+		case markdownUnorderedRegex.MatchString(line):
+			appendLine("ul", markdownUnorderedRegex.FindStringSubmatch(line)[1])
+		case markdownOrderedRegex.MatchString(line):
+			appendLine("ol", markdownOrderedRegex.FindStringSubmatch(line)[1])
+		default:
+			appendLine("paragraph", line)
+		}
+	}
+
+	return blocks
+}
+
+// renderMarkdownInline renders inline markdown of text as escaped HTML
+func renderMarkdownInline(text string) string {
+	var builder strings.Builder
+
+	for len(text) > 0 {
+		switch {
+		case text[0] == '\\' && len(text) > 1 && strings.ContainsRune("\\`*_[]()#+-.!>", rune(text[1])):
+			builder.WriteString(html.EscapeString(text[1:2]))
+			text = text[2:]
+			continue
+		case text[0] == '`':
+			if end := strings.Index(text[1:], "`"); end >= 0 {
+				builder.WriteString("<code>" + html.EscapeString(text[1:end+1]) + "</code>")
+				text = text[end+2:]
+				continue
+			}
+		case strings.HasPrefix(text, "**"):
+			if end := strings.Index(text[2:], "**"); end > 0 {
+				builder.WriteString("<strong>" + renderMarkdownInline(text[2:end+2]) + "</strong>")
+				text = text[end+4:]
+				continue
+			}
+			// unclosed strong emphasis is kept as it is, so it's not mistaken for two emphases
+			builder.WriteString("**")
+			text = text[2:]
+			continue
+		case text[0] == '*':
+			if end := strings.Index(text[1:], "*"); end > 0 {
+				builder.WriteString("<em>" + renderMarkdownInline(text[1:end+1]) + "</em>")
+				text = text[end+2:]
+				continue
+			}
+		case text[0] == '[':
+			if match := markdownLinkRegex.FindStringSubmatch(text); match != nil {
+				if markdownSafeURLRegex.MatchString(match[2]) {
+					builder.WriteString(`<a href="` + html.EscapeString(match[2]) + `" rel="nofollow">` + renderMarkdownInline(match[1]) + "</a>")
+				} else {
+					builder.WriteString(renderMarkdownInline(match[1]))
+				}
+				text = text[len(match[0]):]
+				continue
+			}
+		}
+
+		builder.WriteString(html.EscapeString(text[:1]))
+		text = text[1:]
+	}
+
+	return builder.String()
+}
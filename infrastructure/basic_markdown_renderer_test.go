@@ -0,0 +1,81 @@
+package infrastructure
+
+import (
+	"context"
+	"html/template"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain/markdown"
+)
+
+type (
+	BasicMarkdownRendererTestSuite struct {
+		suite.Suite
+
+		renderer *BasicMarkdownRenderer
+	}
+)
+
+func TestBasicMarkdownRendererTestSuite(t *testing.T) {
+	suite.Run(t, &BasicMarkdownRendererTestSuite{})
+}
+
+func (t *BasicMarkdownRendererTestSuite) SetupTest() {
+	t.renderer = &BasicMarkdownRenderer{}
+}
+
+func (t *BasicMarkdownRendererTestSuite) TestRender() {
+	testCases := []struct {
+		Text     markdown.Text
+		Rendered template.HTML
+	}{
+		{
+			Text:     "",
+			Rendered: "",
+		},
+		{
+			Text:     "first line\nsecond line\n\nnext paragraph",
+			Rendered: "<p>first line\nsecond line</p>\n<p>next paragraph</p>\n",
+		},
+		{
+			Text:     "## Title ##\ntext",
+			Rendered: "<h2>Title</h2>\n<p>text</p>\n",
+		},
+		{
+			Text:     "- first\n* second\n\n1. one\n2) two",
+			Rendered: "<ul>\n<li>first</li>\n<li>second</li>\n</ul>\n<ol>\n<li>one</li>\n<li>two</li>\n</ol>\n",
+		},
+		{
+			Text:     "> quoted\n> **text**",
+			Rendered: "<blockquote>\n<p>quoted\n<strong>text</strong></p>\n</blockquote>\n",
+		},
+		{
+			Text:     "```\nif a < b {\n```",
+			Rendered: "<pre><code>if a &lt; b {</code></pre>\n",
+		},
+		{
+			Text:     "**bold**, *italic*, `a < b` and \\*stars\\*",
+			Rendered: "<p><strong>bold</strong>, <em>italic</em>, <code>a &lt; b</code> and *stars*</p>\n",
+		},
+		{
+			Text:     "[site](https://example.com?a=1&b=2) and [evil](javascript:alert(1)",
+			Rendered: "<p><a href=\"https://example.com?a=1&amp;b=2\" rel=\"nofollow\">site</a> and evil</p>\n",
+		},
+		{
+			Text:     "<b onclick=\"alert(1)\">raw</b> & \"quotes\"",
+			Rendered: "<p>raw &amp; &#34;quotes&#34;</p>\n",
+		},
+		{
+			Text:     "unclosed **bold and *italic",
+			Rendered: "<p>unclosed **bold and *italic</p>\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		rendered, err := t.renderer.Render(context.Background(), testCase.Text)
+		t.NoError(err)
+		t.Equal(testCase.Rendered, rendered, testCase.Text)
+	}
+}
@@ -0,0 +1,19 @@
+package infrastructure
+
+import (
+	"crypto/rand"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// CryptoTokenSource defines default token source, which reads cryptographically secure random bytes
+	CryptoTokenSource struct{}
+)
+
+var _ domain.TokenSource = &CryptoTokenSource{}
+
+// Read fills bytes with cryptographically secure random data
+func (s *CryptoTokenSource) Read(b []byte) (int, error) {
+	return rand.Read(b)
+}
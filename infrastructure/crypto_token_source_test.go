@@ -0,0 +1,30 @@
+package infrastructure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	CryptoTokenSourceTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestCryptoTokenSourceTestSuite(t *testing.T) {
+	suite.Run(t, &CryptoTokenSourceTestSuite{})
+}
+
+func (t *CryptoTokenSourceTestSuite) TestRead() {
+	first := make([]byte, 16)
+	second := make([]byte, 16)
+
+	n, err := (&CryptoTokenSource{}).Read(first)
+	t.NoError(err)
+	t.Equal(16, n)
+
+	_, err = (&CryptoTokenSource{}).Read(second)
+	t.NoError(err)
+	t.NotEqual(first, second)
+}
@@ -0,0 +1,16 @@
+package infrastructure
+
+import "flamingo.me/form/domain"
+
+type (
+	// DefaultLabelTranslator defines default translator of error messages, which provides default labels of messages.
+	// Projects using Flamingo's locale module should bind its TranslationService as domain.Translator instead.
+	DefaultLabelTranslator struct{}
+)
+
+var _ domain.Translator = &DefaultLabelTranslator{}
+
+// Translate returns default label
+func (t *DefaultLabelTranslator) Translate(_ string, defaultLabel string, _ string, _ int, _ map[string]interface{}) string {
+	return defaultLabel
+}
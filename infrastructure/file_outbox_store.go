@@ -0,0 +1,62 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// FileOutboxStore defines storage of outbox events as JSON files in local directory. Files are named by timestamp
+	// and ID of the event, so consumers can process them in order of submissions, and remove them afterwards.
+	FileOutboxStore struct {
+		directory string
+	}
+)
+
+var (
+	_ extensions.OutboxStore = &FileOutboxStore{}
+
+	// outboxEventIDRegex defines valid event IDs, so they can't point outside of directory
+	outboxEventIDRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+)
+
+// Inject is method used to set all dependencies as local variables
+func (s *FileOutboxStore) Inject(cfg *struct {
+	Directory string `inject:"config:form.outbox.file.directory"`
+}) {
+	s.directory = cfg.Directory
+	if s.directory == "" {
+		s.directory = filepath.Join(os.TempDir(), "form-outbox")
+	}
+}
+
+// StoreEvent writes event into file named by its timestamp and ID.
+// File is written under temporary name first, so consumers never see partially written events.
+func (s *FileOutboxStore) StoreEvent(_ context.Context, event extensions.OutboxEvent) error {
+	if !outboxEventIDRegex.MatchString(event.ID) {
+		return fmt.Errorf("invalid outbox event ID %q", event.ID)
+	}
+
+	content, err := json.MarshalIndent(event, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(s.directory, 0700); err != nil {
+		return err
+	}
+
+	path := filepath.Join(s.directory, fmt.Sprintf("%020d-%s.json", event.Timestamp.UnixNano(), event.ID))
+	if err := ioutil.WriteFile(path+".tmp", content, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(path+".tmp", path)
+}
@@ -0,0 +1,92 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	FileOutboxStoreTestSuite struct {
+		suite.Suite
+
+		store     *FileOutboxStore
+		directory string
+
+		context context.Context
+	}
+)
+
+func TestFileOutboxStoreTestSuite(t *testing.T) {
+	suite.Run(t, &FileOutboxStoreTestSuite{})
+}
+
+func (t *FileOutboxStoreTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *FileOutboxStoreTestSuite) SetupTest() {
+	directory, err := ioutil.TempDir("", "form-outbox")
+	t.Require().NoError(err)
+	t.directory = directory
+
+	t.store = &FileOutboxStore{}
+	t.store.Inject(&struct {
+		Directory string `inject:"config:form.outbox.file.directory"`
+	}{
+		Directory: filepath.Join(directory, "events"),
+	})
+}
+
+func (t *FileOutboxStoreTestSuite) TearDownTest() {
+	os.RemoveAll(t.directory)
+}
+
+func (t *FileOutboxStoreTestSuite) TestStoreEvent() {
+	event := extensions.OutboxEvent{
+		ID:        "abc123",
+		Type:      "presets.RegistrationFormData",
+		Timestamp: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		Path:      "/register",
+		Data:      json.RawMessage(`{"email":"user@example.com"}`),
+	}
+
+	t.NoError(t.store.StoreEvent(t.context, event))
+
+	files, err := ioutil.ReadDir(filepath.Join(t.directory, "events"))
+	t.Require().NoError(err)
+	t.Require().Len(files, 1)
+	t.Equal("00001577880000000000-abc123.json", files[0].Name())
+
+	content, err := ioutil.ReadFile(filepath.Join(t.directory, "events", files[0].Name()))
+	t.Require().NoError(err)
+
+	result := extensions.OutboxEvent{}
+	t.NoError(json.Unmarshal(content, &result))
+	t.Equal("abc123", result.ID)
+	t.Equal("presets.RegistrationFormData", result.Type)
+	t.True(event.Timestamp.Equal(result.Timestamp))
+	t.Equal("/register", result.Path)
+	t.JSONEq(`{"email":"user@example.com"}`, string(result.Data))
+}
+
+func (t *FileOutboxStoreTestSuite) TestStoreEvent_InvalidID() {
+	t.Error(t.store.StoreEvent(t.context, extensions.OutboxEvent{ID: "../secret"}))
+}
+
+func (t *FileOutboxStoreTestSuite) TestInject_DefaultDirectory() {
+	store := &FileOutboxStore{}
+	store.Inject(&struct {
+		Directory string `inject:"config:form.outbox.file.directory"`
+	}{})
+
+	t.Equal(filepath.Join(os.TempDir(), "form-outbox"), store.directory)
+}
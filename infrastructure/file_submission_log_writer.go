@@ -0,0 +1,53 @@
+package infrastructure
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// FileSubmissionLogWriter defines append-only JSONL file of submission log records in local directory. File is
+	// opened for every record, so it can be rotated (like by logrotate) without restarting the application.
+	FileSubmissionLogWriter struct {
+		path  string
+		mutex sync.Mutex
+	}
+)
+
+var _ extensions.SubmissionLogWriter = &FileSubmissionLogWriter{}
+
+// Inject is method used to set all dependencies as local variables
+func (w *FileSubmissionLogWriter) Inject(cfg *struct {
+	Path string `inject:"config:form.submissionLog.file.path"`
+}) {
+	w.path = cfg.Path
+	if w.path == "" {
+		w.path = filepath.Join(os.TempDir(), "form-submissions.jsonl")
+	}
+}
+
+// AppendRecord appends record at the end of the file, records of concurrent submissions are not interleaved
+func (w *FileSubmissionLogWriter) AppendRecord(_ context.Context, record []byte) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(w.path), 0700); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(w.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(record); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
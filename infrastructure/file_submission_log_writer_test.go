@@ -0,0 +1,82 @@
+package infrastructure
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	FileSubmissionLogWriterTestSuite struct {
+		suite.Suite
+
+		writer    *FileSubmissionLogWriter
+		directory string
+
+		context context.Context
+	}
+)
+
+func TestFileSubmissionLogWriterTestSuite(t *testing.T) {
+	suite.Run(t, &FileSubmissionLogWriterTestSuite{})
+}
+
+func (t *FileSubmissionLogWriterTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *FileSubmissionLogWriterTestSuite) SetupTest() {
+	directory, err := ioutil.TempDir("", "form-submission-log")
+	t.Require().NoError(err)
+	t.directory = directory
+
+	t.writer = &FileSubmissionLogWriter{}
+	t.writer.Inject(&struct {
+		Path string `inject:"config:form.submissionLog.file.path"`
+	}{
+		Path: filepath.Join(directory, "logs", "submissions.jsonl"),
+	})
+}
+
+func (t *FileSubmissionLogWriterTestSuite) TearDownTest() {
+	os.RemoveAll(t.directory)
+}
+
+func (t *FileSubmissionLogWriterTestSuite) TestAppendRecord() {
+	t.NoError(t.writer.AppendRecord(t.context, []byte(`{"id":"first"}`+"\n")))
+	t.NoError(t.writer.AppendRecord(t.context, []byte(`{"id":"second"}`+"\n")))
+
+	content, err := ioutil.ReadFile(filepath.Join(t.directory, "logs", "submissions.jsonl"))
+	t.NoError(err)
+	t.Equal(`{"id":"first"}`+"\n"+`{"id":"second"}`+"\n", string(content))
+}
+
+func (t *FileSubmissionLogWriterTestSuite) TestAppendRecord_Concurrent() {
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			t.NoError(t.writer.AppendRecord(t.context, []byte(`{"id":"record"}`+"\n")))
+		}()
+	}
+	wg.Wait()
+
+	content, err := ioutil.ReadFile(filepath.Join(t.directory, "logs", "submissions.jsonl"))
+	t.NoError(err)
+	t.Len(content, 20*len(`{"id":"record"}`+"\n"))
+}
+
+func (t *FileSubmissionLogWriterTestSuite) TestInject_DefaultPath() {
+	writer := &FileSubmissionLogWriter{}
+	writer.Inject(&struct {
+		Path string `inject:"config:form.submissionLog.file.path"`
+	}{})
+
+	t.Equal(filepath.Join(os.TempDir(), "form-submissions.jsonl"), writer.path)
+}
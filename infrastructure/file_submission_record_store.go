@@ -0,0 +1,179 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// FileSubmissionRecordStore defines storage of recorded submissions as JSON files in local directory.
+	// It's meant for development and debugging, so recordings are not shared between instances.
+	FileSubmissionRecordStore struct {
+		directory string
+	}
+)
+
+var (
+	_ extensions.SubmissionRecordStore = &FileSubmissionRecordStore{}
+	_ extensions.DataSubjectStore      = &FileSubmissionRecordStore{}
+
+	// submissionRecordingIDRegex defines valid recording IDs, so they can't point outside of directory
+	submissionRecordingIDRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+)
+
+// Inject is method used to set all dependencies as local variables
+func (s *FileSubmissionRecordStore) Inject(cfg *struct {
+	Directory string `inject:"config:form.submissionRecorder.file.directory"`
+}) {
+	s.directory = cfg.Directory
+	if s.directory == "" {
+		s.directory = filepath.Join(os.TempDir(), "form-submissions")
+	}
+}
+
+// StoreSubmission writes recorded submission into file named by its ID
+func (s *FileSubmissionRecordStore) StoreSubmission(_ context.Context, recording extensions.SubmissionRecording) error {
+	path, err := s.path(recording.ID)
+	if err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(recording, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(s.directory, 0700); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, content, 0600)
+}
+
+// LoadSubmission reads recorded submission from file named by its ID
+func (s *FileSubmissionRecordStore) LoadSubmission(_ context.Context, id string) (*extensions.SubmissionRecording, error) {
+	path, err := s.path(id)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	recording := &extensions.SubmissionRecording{}
+	if err := json.Unmarshal(content, recording); err != nil {
+		return nil, err
+	}
+
+	return recording, nil
+}
+
+// FindSubjectData returns all recorded submissions, which contain the subject as one of submitted values
+func (s *FileSubmissionRecordStore) FindSubjectData(ctx context.Context, subject string) ([]extensions.SubjectData, error) {
+	recordings, err := s.subjectRecordings(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]extensions.SubjectData, 0, len(recordings))
+	for _, recording := range recordings {
+		data, err := json.Marshal(recording)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, extensions.SubjectData{
+			Store:     "recordings",
+			ID:        recording.ID,
+			Timestamp: recording.Timestamp,
+			Data:      data,
+		})
+	}
+
+	return result, nil
+}
+
+// EraseSubjectData removes all recorded submissions, which contain the subject as one of submitted values
+func (s *FileSubmissionRecordStore) EraseSubjectData(ctx context.Context, subject string) (int, error) {
+	recordings, err := s.subjectRecordings(ctx, subject)
+	if err != nil {
+		return 0, err
+	}
+
+	erased := 0
+	for _, recording := range recordings {
+		path, err := s.path(recording.ID)
+		if err != nil {
+			return erased, err
+		}
+
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return erased, err
+		}
+		erased++
+	}
+
+	return erased, nil
+}
+
+// subjectRecordings loads all recordings, which contain the subject as one of submitted values
+func (s *FileSubmissionRecordStore) subjectRecordings(ctx context.Context, subject string) ([]*extensions.SubmissionRecording, error) {
+	files, err := ioutil.ReadDir(s.directory)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var recordings []*extensions.SubmissionRecording
+	for _, file := range files {
+		id := strings.TrimSuffix(file.Name(), ".json")
+		if file.IsDir() || id == file.Name() || !submissionRecordingIDRegex.MatchString(id) {
+			continue
+		}
+
+		recording, err := s.LoadSubmission(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if containsSubject(recording.Values, subject) {
+			recordings = append(recordings, recording)
+		}
+	}
+
+	return recordings, nil
+}
+
+// containsSubject checks if any of values matches the subject, case insensitively
+func containsSubject(values map[string][]string, subject string) bool {
+	for _, fieldValues := range values {
+		for _, value := range fieldValues {
+			if strings.EqualFold(strings.TrimSpace(value), subject) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// path returns path of recording file
+func (s *FileSubmissionRecordStore) path(id string) (string, error) {
+	if !submissionRecordingIDRegex.MatchString(id) {
+		return "", fmt.Errorf("invalid submission recording ID %q", id)
+	}
+
+	return filepath.Join(s.directory, id+".json"), nil
+}
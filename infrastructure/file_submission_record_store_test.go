@@ -0,0 +1,144 @@
+package infrastructure
+
+import (
+	"context"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	FileSubmissionRecordStoreTestSuite struct {
+		suite.Suite
+
+		store     *FileSubmissionRecordStore
+		directory string
+
+		context context.Context
+	}
+)
+
+func TestFileSubmissionRecordStoreTestSuite(t *testing.T) {
+	suite.Run(t, &FileSubmissionRecordStoreTestSuite{})
+}
+
+func (t *FileSubmissionRecordStoreTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *FileSubmissionRecordStoreTestSuite) SetupTest() {
+	directory, err := ioutil.TempDir("", "form-submissions")
+	t.Require().NoError(err)
+	t.directory = directory
+
+	t.store = &FileSubmissionRecordStore{}
+	t.store.Inject(&struct {
+		Directory string `inject:"config:form.submissionRecorder.file.directory"`
+	}{
+		Directory: filepath.Join(directory, "recordings"),
+	})
+}
+
+func (t *FileSubmissionRecordStoreTestSuite) TearDownTest() {
+	os.RemoveAll(t.directory)
+}
+
+func (t *FileSubmissionRecordStoreTestSuite) TestStoreSubmission() {
+	recording := extensions.SubmissionRecording{
+		ID:        "abc123",
+		Timestamp: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		Method:    "POST",
+		Path:      "/register",
+		Values: url.Values{
+			"email":    []string{"user"},
+			"password": []string{extensions.RedactedValue},
+		},
+		GeneralErrors: []domain.Error{
+			{
+				MessageKey:   "formError.general",
+				DefaultLabel: "general",
+			},
+		},
+		FieldErrors: map[string][]domain.Error{
+			"email": {
+				{
+					MessageKey:   "formError.email.email",
+					DefaultLabel: "email",
+				},
+			},
+		},
+	}
+
+	t.NoError(t.store.StoreSubmission(t.context, recording))
+	t.FileExists(filepath.Join(t.directory, "recordings", "abc123.json"))
+
+	result, err := t.store.LoadSubmission(t.context, "abc123")
+	t.NoError(err)
+	t.Equal(&recording, result)
+}
+
+func (t *FileSubmissionRecordStoreTestSuite) TestLoadSubmission_Error() {
+	result, err := t.store.LoadSubmission(t.context, "missing")
+	t.Error(err)
+	t.Nil(result)
+
+	result, err = t.store.LoadSubmission(t.context, "../secret")
+	t.Error(err)
+	t.Nil(result)
+
+	t.Error(t.store.StoreSubmission(t.context, extensions.SubmissionRecording{ID: "../secret"}))
+}
+
+func (t *FileSubmissionRecordStoreTestSuite) TestInject_DefaultDirectory() {
+	store := &FileSubmissionRecordStore{}
+	store.Inject(&struct {
+		Directory string `inject:"config:form.submissionRecorder.file.directory"`
+	}{})
+
+	t.Equal(filepath.Join(os.TempDir(), "form-submissions"), store.directory)
+}
+
+func (t *FileSubmissionRecordStoreTestSuite) TestSubjectData() {
+	t.NoError(t.store.StoreSubmission(t.context, extensions.SubmissionRecording{
+		ID:        "abc",
+		Timestamp: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		Values:    url.Values{"email": []string{" User@Example.com"}},
+	}))
+	t.NoError(t.store.StoreSubmission(t.context, extensions.SubmissionRecording{
+		ID:     "def",
+		Values: url.Values{"email": []string{"other@example.com"}},
+	}))
+	t.NoError(ioutil.WriteFile(filepath.Join(t.directory, "recordings", "notes.txt"), []byte("user@example.com"), 0600))
+
+	result, err := t.store.FindSubjectData(t.context, "user@example.com")
+	t.NoError(err)
+	t.Require().Len(result, 1)
+	t.Equal("recordings", result[0].Store)
+	t.Equal("abc", result[0].ID)
+	t.Equal(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC), result[0].Timestamp)
+	t.Contains(string(result[0].Data), "User@Example.com")
+
+	erased, err := t.store.EraseSubjectData(t.context, "user@example.com")
+	t.NoError(err)
+	t.Equal(1, erased)
+	t.NoFileExists(filepath.Join(t.directory, "recordings", "abc.json"))
+	t.FileExists(filepath.Join(t.directory, "recordings", "def.json"))
+}
+
+func (t *FileSubmissionRecordStoreTestSuite) TestSubjectData_MissingDirectory() {
+	result, err := t.store.FindSubjectData(t.context, "user@example.com")
+	t.NoError(err)
+	t.Empty(result)
+
+	erased, err := t.store.EraseSubjectData(t.context, "user@example.com")
+	t.NoError(err)
+	t.Zero(erased)
+}
@@ -0,0 +1,199 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// HTTPWebhookNotifier defines webhook notifier which POSTs notifications to all configured webhook URLs.
+	// Payload is notification encoded as JSON, or rendered via configured template. If secret is defined, payload
+	// is signed with HMAC-SHA256, so webhooks can verify its origin. Failed requests (network errors, status 429
+	// and 5xx) are retried with exponential backoff.
+	HTTPWebhookNotifier struct {
+		client      *http.Client
+		urls        []string
+		secret      []byte
+		template    *template.Template
+		contentType string
+		maxAttempts int
+		backoff     time.Duration
+		wait        func(ctx context.Context, duration time.Duration) error
+	}
+)
+
+const (
+	// WebhookIDHeader is header which contains ID of webhook notification
+	WebhookIDHeader = "X-Form-Webhook-ID"
+	// WebhookSignatureHeader is header which contains HMAC-SHA256 signature of webhook payload, as "sha256=<hex>"
+	WebhookSignatureHeader = "X-Form-Webhook-Signature"
+)
+
+var _ extensions.WebhookNotifier = &HTTPWebhookNotifier{}
+
+// Inject is method used to set all dependencies as local variables
+func (n *HTTPWebhookNotifier) Inject(cfg *struct {
+	URLs        config.Slice `inject:"config:form.webhook.urls"`
+	Secret      string       `inject:"config:form.webhook.secret"`
+	Template    string       `inject:"config:form.webhook.template"`
+	ContentType string       `inject:"config:form.webhook.contentType"`
+	Timeout     string       `inject:"config:form.webhook.timeout"`
+	MaxAttempts int          `inject:"config:form.webhook.retry.maxAttempts"`
+	Backoff     string       `inject:"config:form.webhook.retry.backoff"`
+}) {
+	var urls []string
+	if err := cfg.URLs.MapInto(&urls); err != nil {
+		panic(err.Error())
+	}
+	n.urls = urls
+
+	timeout, err := time.ParseDuration(cfg.Timeout)
+	if err != nil {
+		panic(err.Error())
+	}
+	n.client = &http.Client{Timeout: timeout}
+
+	backoff, err := time.ParseDuration(cfg.Backoff)
+	if err != nil {
+		panic(err.Error())
+	}
+	n.backoff = backoff
+
+	if cfg.Template != "" {
+		n.template = template.Must(template.New("webhook").Funcs(template.FuncMap{
+			"json": webhookJSON,
+		}).Parse(cfg.Template))
+	}
+
+	n.secret = []byte(cfg.Secret)
+	n.contentType = cfg.ContentType
+	n.maxAttempts = cfg.MaxAttempts
+	n.wait = waitWithContext
+}
+
+// Notify delivers notification to all configured webhooks. Every webhook is tried, even if delivery to
+// one of them fails.
+func (n *HTTPWebhookNotifier) Notify(ctx context.Context, notification extensions.WebhookNotification) error {
+	payload, err := n.payload(notification)
+	if err != nil {
+		return err
+	}
+
+	var failures []string
+	for _, url := range n.urls {
+		if err := n.deliver(ctx, url, notification.ID, payload); err != nil {
+			failures = append(failures, err.Error())
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("webhook notification failed: %s", strings.Join(failures, "; "))
+	}
+
+	return nil
+}
+
+// payload creates body of webhook request
+func (n *HTTPWebhookNotifier) payload(notification extensions.WebhookNotification) ([]byte, error) {
+	if n.template == nil {
+		return json.Marshal(notification)
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := n.template.Execute(buffer, notification); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// deliver sends payload to single webhook, with retries of failed requests
+func (n *HTTPWebhookNotifier) deliver(ctx context.Context, url string, id string, payload []byte) error {
+	for attempt := 1; ; attempt++ {
+		retry, err := n.send(ctx, url, id, payload)
+		if err == nil {
+			return nil
+		}
+
+		if !retry || attempt >= n.maxAttempts {
+			return err
+		}
+
+		if err := n.wait(ctx, n.backoff<<uint(attempt-1)); err != nil {
+			return err
+		}
+	}
+}
+
+// send sends single webhook request, and reports if failed request can be retried
+func (n *HTTPWebhookNotifier) send(ctx context.Context, url string, id string, payload []byte) (bool, error) {
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return false, err
+	}
+	request = request.WithContext(ctx)
+	request.Header.Set("Content-Type", n.contentType)
+	request.Header.Set(WebhookIDHeader, id)
+	if len(n.secret) > 0 {
+		request.Header.Set(WebhookSignatureHeader, "sha256="+n.sign(payload))
+	}
+
+	response, err := n.client.Do(request)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		message, _ := ioutil.ReadAll(io.LimitReader(response.Body, 1024))
+		retry := response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= http.StatusInternalServerError
+		return retry, fmt.Errorf("webhook %s failed with status %d: %s", url, response.StatusCode, bytes.TrimSpace(message))
+	}
+
+	return false, nil
+}
+
+// sign returns hex encoded HMAC-SHA256 signature of payload
+func (n *HTTPWebhookNotifier) sign(payload []byte) string {
+	mac := hmac.New(sha256.New, n.secret)
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookJSON encodes value as JSON, so templates can embed form data
+func webhookJSON(value interface{}) (string, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+// waitWithContext waits for duration, or until context is done
+func waitWithContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,149 @@
+package infrastructure
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	HTTPWebhookNotifierTestSuite struct {
+		suite.Suite
+
+		notifier *HTTPWebhookNotifier
+		server   *httptest.Server
+
+		statuses []int
+		requests []*http.Request
+		bodies   []string
+		waits    []time.Duration
+	}
+
+	httpWebhookNotifierTestData struct {
+		Email string `json:"email"`
+	}
+)
+
+func TestHTTPWebhookNotifierTestSuite(t *testing.T) {
+	suite.Run(t, &HTTPWebhookNotifierTestSuite{})
+}
+
+func (t *HTTPWebhookNotifierTestSuite) SetupTest() {
+	t.statuses = nil
+	t.requests = nil
+	t.bodies = nil
+	t.waits = nil
+	t.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		t.requests = append(t.requests, r)
+		t.bodies = append(t.bodies, string(body))
+
+		status := http.StatusNoContent
+		if len(t.statuses) > 0 {
+			status, t.statuses = t.statuses[0], t.statuses[1:]
+		}
+		w.WriteHeader(status)
+	}))
+
+	t.notifier = t.createNotifier("", "")
+}
+
+func (t *HTTPWebhookNotifierTestSuite) TearDownTest() {
+	t.server.Close()
+}
+
+func (t *HTTPWebhookNotifierTestSuite) createNotifier(secret string, template string) *HTTPWebhookNotifier {
+	notifier := &HTTPWebhookNotifier{}
+	notifier.Inject(&struct {
+		URLs        config.Slice `inject:"config:form.webhook.urls"`
+		Secret      string       `inject:"config:form.webhook.secret"`
+		Template    string       `inject:"config:form.webhook.template"`
+		ContentType string       `inject:"config:form.webhook.contentType"`
+		Timeout     string       `inject:"config:form.webhook.timeout"`
+		MaxAttempts int          `inject:"config:form.webhook.retry.maxAttempts"`
+		Backoff     string       `inject:"config:form.webhook.retry.backoff"`
+	}{
+		URLs:        config.Slice{t.server.URL + "/hook"},
+		Secret:      secret,
+		Template:    template,
+		ContentType: "application/json",
+		Timeout:     "1s",
+		MaxAttempts: 3,
+		Backoff:     "100ms",
+	})
+	notifier.wait = func(_ context.Context, duration time.Duration) error {
+		t.waits = append(t.waits, duration)
+		return nil
+	}
+
+	return notifier
+}
+
+func (t *HTTPWebhookNotifierTestSuite) notification() extensions.WebhookNotification {
+	return extensions.WebhookNotification{
+		ID:        "abc",
+		Type:      "presets.ContactFormData",
+		Timestamp: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		Path:      "/contact",
+		Data:      httpWebhookNotifierTestData{Email: "user@example.com"},
+	}
+}
+
+func (t *HTTPWebhookNotifierTestSuite) TestNotify() {
+	t.NoError(t.notifier.Notify(context.Background(), t.notification()))
+	t.Require().Len(t.requests, 1)
+	t.Equal(http.MethodPost, t.requests[0].Method)
+	t.Equal("/hook", t.requests[0].URL.Path)
+	t.Equal("application/json", t.requests[0].Header.Get("Content-Type"))
+	t.Equal("abc", t.requests[0].Header.Get(WebhookIDHeader))
+	t.Empty(t.requests[0].Header.Get(WebhookSignatureHeader))
+	t.JSONEq(`{"id":"abc","type":"presets.ContactFormData","timestamp":"2020-01-01T12:00:00Z","path":"/contact","data":{"email":"user@example.com"}}`, t.bodies[0])
+}
+
+func (t *HTTPWebhookNotifierTestSuite) TestNotify_Template() {
+	t.notifier = t.createNotifier("", `{"text":"New contact {{ .Data.Email }}","data":{{ json .Data }}}`)
+
+	t.NoError(t.notifier.Notify(context.Background(), t.notification()))
+	t.Require().Len(t.bodies, 1)
+	t.JSONEq(`{"text":"New contact user@example.com","data":{"email":"user@example.com"}}`, t.bodies[0])
+}
+
+func (t *HTTPWebhookNotifierTestSuite) TestNotify_Signature() {
+	t.notifier = t.createNotifier("secret", `payload`)
+
+	t.NoError(t.notifier.Notify(context.Background(), t.notification()))
+	t.Require().Len(t.requests, 1)
+	t.Equal("sha256=b82fcb791acec57859b989b430a826488ce2e479fdf92326bd0a2e8375a42ba4", t.requests[0].Header.Get(WebhookSignatureHeader))
+}
+
+func (t *HTTPWebhookNotifierTestSuite) TestNotify_Retry() {
+	t.statuses = []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}
+
+	t.NoError(t.notifier.Notify(context.Background(), t.notification()))
+	t.Len(t.requests, 3)
+	t.Equal([]time.Duration{100 * time.Millisecond, 200 * time.Millisecond}, t.waits)
+}
+
+func (t *HTTPWebhookNotifierTestSuite) TestNotify_RetryExhausted() {
+	t.statuses = []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway}
+
+	t.Error(t.notifier.Notify(context.Background(), t.notification()))
+	t.Len(t.requests, 3)
+	t.Len(t.waits, 2)
+}
+
+func (t *HTTPWebhookNotifierTestSuite) TestNotify_NoRetry() {
+	t.statuses = []int{http.StatusBadRequest}
+
+	t.Error(t.notifier.Notify(context.Background(), t.notification()))
+	t.Len(t.requests, 1)
+	t.Empty(t.waits)
+}
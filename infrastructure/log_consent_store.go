@@ -0,0 +1,38 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// LogConsentStore defines default consent storage, which only writes captured consents into the log.
+	// Projects should provide own implementation of extensions.ConsentStore, which persists consents.
+	LogConsentStore struct {
+		logger flamingo.Logger
+	}
+)
+
+var _ extensions.ConsentStore = &LogConsentStore{}
+
+// Inject is method used to set all dependencies as local variables
+func (s *LogConsentStore) Inject(logger flamingo.Logger) {
+	s.logger = logger
+}
+
+// StoreConsents writes every captured consent into the log
+func (s *LogConsentStore) StoreConsents(ctx context.Context, _ *web.Request, consents []extensions.Consent) error {
+	for _, consent := range consents {
+		s.logger.WithContext(ctx).WithField("ConsentStore", consent.Name).Info(fmt.Sprintf(
+			"consent %q version %q granted: %t, locale %q, timestamp %s",
+			consent.Name, consent.Version, consent.Granted, consent.Locale, consent.Timestamp.Format(time.RFC3339),
+		))
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// LogContactSink defines default contact message delivery, which only writes messages into the log
+	LogContactSink struct {
+		logger flamingo.Logger
+	}
+)
+
+var _ extensions.ContactSink = &LogContactSink{}
+
+// Inject is method used to set all dependencies as local variables
+func (s *LogContactSink) Inject(logger flamingo.Logger) {
+	s.logger = logger
+}
+
+// Deliver writes contact message into the log
+func (s *LogContactSink) Deliver(ctx context.Context, message extensions.ContactMessage) error {
+	s.logger.WithContext(ctx).WithField("ContactSink", "log").Info(fmt.Sprintf(
+		"contact message from %q <%s> with subject %q, locale %q, timestamp %s: %s",
+		message.Name, message.Email, message.Subject, message.Locale, message.Timestamp.Format(time.RFC3339), message.Message,
+	))
+
+	return nil
+}
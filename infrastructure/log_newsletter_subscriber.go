@@ -0,0 +1,35 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// LogNewsletterSubscriber defines default newsletter subscriber, which only writes subscriptions into the log.
+	// Use one of provided email service provider adapters, or own implementation of extensions.NewsletterSubscriber.
+	LogNewsletterSubscriber struct {
+		logger flamingo.Logger
+	}
+)
+
+var _ extensions.NewsletterSubscriber = &LogNewsletterSubscriber{}
+
+// Inject is method used to set all dependencies as local variables
+func (s *LogNewsletterSubscriber) Inject(logger flamingo.Logger) {
+	s.logger = logger
+}
+
+// Subscribe writes subscription into the log
+func (s *LogNewsletterSubscriber) Subscribe(ctx context.Context, subscription extensions.NewsletterSubscription) error {
+	s.logger.WithContext(ctx).WithField("NewsletterSubscriber", "log").Info(fmt.Sprintf(
+		"newsletter subscription of %q, locale %q, timestamp %s",
+		subscription.Email, subscription.Locale, subscription.Timestamp.Format(time.RFC3339),
+	))
+
+	return nil
+}
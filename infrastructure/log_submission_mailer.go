@@ -0,0 +1,34 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// LogSubmissionMailer defines default submission mail delivery, which only writes mails into the log
+	LogSubmissionMailer struct {
+		logger flamingo.Logger
+	}
+)
+
+var _ extensions.SubmissionMailer = &LogSubmissionMailer{}
+
+// Inject is method used to set all dependencies as local variables
+func (m *LogSubmissionMailer) Inject(logger flamingo.Logger) {
+	m.logger = logger
+}
+
+// Send writes submission mail into the log
+func (m *LogSubmissionMailer) Send(ctx context.Context, mail extensions.SubmissionMail) error {
+	m.logger.WithContext(ctx).WithField("SubmissionMailer", "log").Info(fmt.Sprintf(
+		"submission mail with subject %q, timestamp %s: %s",
+		mail.Subject, mail.Timestamp.Format(time.RFC3339), mail.Body,
+	))
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// MailContactSink defines contact message delivery, which sends messages as plain text mail via SMTP server.
+	// Sender of the message is set as "Reply-To", so recipients can answer directly.
+	MailContactSink struct {
+		address  string
+		auth     smtp.Auth
+		from     string
+		to       []string
+		sendMail func(address string, auth smtp.Auth, from string, to []string, message []byte) error
+	}
+)
+
+var _ extensions.ContactSink = &MailContactSink{}
+
+// Inject is method used to set all dependencies as local variables
+func (s *MailContactSink) Inject(cfg *struct {
+	Address  string       `inject:"config:form.contact.mail.address"`
+	Username string       `inject:"config:form.contact.mail.username"`
+	Password string       `inject:"config:form.contact.mail.password"`
+	From     string       `inject:"config:form.contact.mail.from"`
+	To       config.Slice `inject:"config:form.contact.mail.to"`
+}) {
+	s.address = cfg.Address
+	s.from = cfg.From
+	s.sendMail = smtp.SendMail
+
+	if cfg.Username != "" {
+		host, _, err := net.SplitHostPort(cfg.Address)
+		if err != nil {
+			panic(err.Error())
+		}
+		s.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, host)
+	}
+
+	var to []string
+	if err := cfg.To.MapInto(&to); err != nil {
+		panic(err.Error())
+	}
+	s.to = to
+}
+
+// Deliver sends contact message to all configured recipients
+func (s *MailContactSink) Deliver(_ context.Context, message extensions.ContactMessage) error {
+	return s.sendMail(s.address, s.auth, s.from, s.to, s.compose(message))
+}
+
+// compose creates mail from contact message. Submitted values are never used as raw headers,
+// so they can't inject additional headers or recipients.
+func (s *MailContactSink) compose(message extensions.ContactMessage) []byte {
+	buffer := &bytes.Buffer{}
+
+	fmt.Fprintf(buffer, "From: %s\r\n", s.from)
+	fmt.Fprintf(buffer, "To: %s\r\n", strings.Join(s.to, ", "))
+	if address, err := mail.ParseAddress(message.Email); err == nil {
+		fmt.Fprintf(buffer, "Reply-To: %s\r\n", (&mail.Address{Name: singleLine(message.Name), Address: address.Address}).String())
+	}
+	fmt.Fprintf(buffer, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", singleLine(message.Subject)))
+	fmt.Fprintf(buffer, "Date: %s\r\n", message.Timestamp.Format(time.RFC1123Z))
+	buffer.WriteString("MIME-Version: 1.0\r\n")
+	buffer.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buffer.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buffer.WriteString("\r\n")
+
+	fmt.Fprintf(buffer, "Name: %s\r\n", singleLine(message.Name))
+	fmt.Fprintf(buffer, "Email: %s\r\n", singleLine(message.Email))
+	fmt.Fprintf(buffer, "Locale: %s\r\n", singleLine(message.Locale))
+	buffer.WriteString("\r\n")
+	buffer.WriteString(crlfLines(message.Message))
+	buffer.WriteString("\r\n")
+
+	return buffer.Bytes()
+}
+
+// singleLine replaces all line breaks with spaces
+func singleLine(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// crlfLines normalizes all line breaks to CRLF, as required by mail bodies
+func crlfLines(text string) string {
+	return strings.Replace(strings.Replace(text, "\r\n", "\n", -1), "\n", "\r\n", -1)
+}
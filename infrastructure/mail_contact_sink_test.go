@@ -0,0 +1,101 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"net/smtp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	MailContactSinkTestSuite struct {
+		suite.Suite
+
+		sink *MailContactSink
+
+		address string
+		from    string
+		to      []string
+		message string
+		err     error
+	}
+)
+
+func TestMailContactSinkTestSuite(t *testing.T) {
+	suite.Run(t, &MailContactSinkTestSuite{})
+}
+
+func (t *MailContactSinkTestSuite) SetupTest() {
+	t.err = nil
+	t.sink = &MailContactSink{}
+	t.sink.Inject(&struct {
+		Address  string       `inject:"config:form.contact.mail.address"`
+		Username string       `inject:"config:form.contact.mail.username"`
+		Password string       `inject:"config:form.contact.mail.password"`
+		From     string       `inject:"config:form.contact.mail.from"`
+		To       config.Slice `inject:"config:form.contact.mail.to"`
+	}{
+		Address:  "localhost:25",
+		Username: "user",
+		Password: "password",
+		From:     "noreply@example.com",
+		To:       config.Slice{"support@example.com", "sales@example.com"},
+	})
+	t.sink.sendMail = func(address string, _ smtp.Auth, from string, to []string, message []byte) error {
+		t.address = address
+		t.from = from
+		t.to = to
+		t.message = string(message)
+		return t.err
+	}
+}
+
+func (t *MailContactSinkTestSuite) TestDeliver() {
+	t.NotNil(t.sink.auth)
+
+	t.NoError(t.sink.Deliver(context.Background(), extensions.ContactMessage{
+		Name:      "Max\r\nBcc: spam@example.com",
+		Email:     "max@example.com",
+		Subject:   "Frage zu Größen",
+		Message:   "Hello\nWorld",
+		Locale:    "de-DE",
+		Timestamp: time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC),
+	}))
+
+	t.Equal("localhost:25", t.address)
+	t.Equal("noreply@example.com", t.from)
+	t.Equal([]string{"support@example.com", "sales@example.com"}, t.to)
+	t.Equal("From: noreply@example.com\r\n"+
+		"To: support@example.com, sales@example.com\r\n"+
+		"Reply-To: \"Max Bcc: spam@example.com\" <max@example.com>\r\n"+
+		"Subject: =?utf-8?q?Frage_zu_Gr=C3=B6=C3=9Fen?=\r\n"+
+		"Date: Fri, 01 May 2020 12:00:00 +0000\r\n"+
+		"MIME-Version: 1.0\r\n"+
+		"Content-Type: text/plain; charset=utf-8\r\n"+
+		"Content-Transfer-Encoding: 8bit\r\n"+
+		"\r\n"+
+		"Name: Max Bcc: spam@example.com\r\n"+
+		"Email: max@example.com\r\n"+
+		"Locale: de-DE\r\n"+
+		"\r\n"+
+		"Hello\r\nWorld\r\n", t.message)
+}
+
+func (t *MailContactSinkTestSuite) TestDeliver_InvalidEmail() {
+	t.NoError(t.sink.Deliver(context.Background(), extensions.ContactMessage{
+		Email: "not an email",
+	}))
+	t.NotContains(t.message, "Reply-To")
+}
+
+func (t *MailContactSinkTestSuite) TestDeliver_Error() {
+	t.err = errors.New("error")
+
+	t.Equal(errors.New("error"), t.sink.Deliver(context.Background(), extensions.ContactMessage{}))
+}
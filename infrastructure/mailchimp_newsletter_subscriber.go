@@ -0,0 +1,83 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// MailchimpNewsletterSubscriber defines newsletter subscriber which adds members to Mailchimp audience.
+	// Existing members are updated, so repeated subscriptions don't fail.
+	MailchimpNewsletterSubscriber struct {
+		client      *http.Client
+		baseURL     string
+		apiKey      string
+		listID      string
+		doubleOptIn bool
+	}
+
+	// mailchimpMember defines body of Mailchimp member request
+	mailchimpMember struct {
+		EmailAddress string `json:"email_address"`
+		StatusIfNew  string `json:"status_if_new"`
+	}
+)
+
+var _ extensions.NewsletterSubscriber = &MailchimpNewsletterSubscriber{}
+
+// Inject is method used to set all dependencies as local variables
+func (s *MailchimpNewsletterSubscriber) Inject(cfg *struct {
+	APIKey      string `inject:"config:form.newsletter.mailchimp.apiKey"`
+	ListID      string `inject:"config:form.newsletter.mailchimp.listID"`
+	DoubleOptIn bool   `inject:"config:form.newsletter.mailchimp.doubleOptIn"`
+}) {
+	s.client = &http.Client{Timeout: 10 * time.Second}
+	s.apiKey = cfg.APIKey
+	s.listID = cfg.ListID
+	s.doubleOptIn = cfg.DoubleOptIn
+
+	dataCenter := "us1"
+	if index := strings.LastIndex(cfg.APIKey, "-"); index >= 0 {
+		dataCenter = cfg.APIKey[index+1:]
+	}
+	s.baseURL = fmt.Sprintf("https://%s.api.mailchimp.com/3.0", dataCenter)
+}
+
+// Subscribe adds or updates member of configured Mailchimp audience. With double opt-in, new members receive
+// confirmation email and stay pending until they confirm.
+func (s *MailchimpNewsletterSubscriber) Subscribe(ctx context.Context, subscription extensions.NewsletterSubscription) error {
+	status := "subscribed"
+	if s.doubleOptIn {
+		status = "pending"
+	}
+
+	body, err := json.Marshal(mailchimpMember{
+		EmailAddress: subscription.Email,
+		StatusIfNew:  status,
+	})
+	if err != nil {
+		return err
+	}
+
+	hash := md5.Sum([]byte(strings.ToLower(subscription.Email)))
+	endpoint := fmt.Sprintf("%s/lists/%s/members/%s", s.baseURL, s.listID, hex.EncodeToString(hash[:]))
+
+	request, err := http.NewRequest(http.MethodPut, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request = request.WithContext(ctx)
+	request.SetBasicAuth("form", s.apiKey)
+	request.Header.Set("Content-Type", "application/json")
+
+	return doNewsletterRequest(s.client, request, "mailchimp")
+}
@@ -0,0 +1,93 @@
+package infrastructure
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	MailchimpNewsletterSubscriberTestSuite struct {
+		suite.Suite
+
+		subscriber *MailchimpNewsletterSubscriber
+		server     *httptest.Server
+
+		status  int
+		request *http.Request
+		body    string
+	}
+)
+
+func TestMailchimpNewsletterSubscriberTestSuite(t *testing.T) {
+	suite.Run(t, &MailchimpNewsletterSubscriberTestSuite{})
+}
+
+func (t *MailchimpNewsletterSubscriberTestSuite) SetupTest() {
+	t.status = http.StatusOK
+	t.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		t.request = r
+		t.body = string(body)
+		w.WriteHeader(t.status)
+	}))
+
+	t.subscriber = &MailchimpNewsletterSubscriber{}
+	t.subscriber.Inject(&struct {
+		APIKey      string `inject:"config:form.newsletter.mailchimp.apiKey"`
+		ListID      string `inject:"config:form.newsletter.mailchimp.listID"`
+		DoubleOptIn bool   `inject:"config:form.newsletter.mailchimp.doubleOptIn"`
+	}{
+		APIKey:      "secret-us20",
+		ListID:      "list",
+		DoubleOptIn: true,
+	})
+}
+
+func (t *MailchimpNewsletterSubscriberTestSuite) TearDownTest() {
+	t.server.Close()
+}
+
+func (t *MailchimpNewsletterSubscriberTestSuite) TestInject() {
+	t.Equal("https://us20.api.mailchimp.com/3.0", t.subscriber.baseURL)
+}
+
+func (t *MailchimpNewsletterSubscriberTestSuite) TestSubscribe() {
+	t.subscriber.baseURL = t.server.URL
+
+	t.NoError(t.subscriber.Subscribe(context.Background(), extensions.NewsletterSubscription{
+		Email: "User@Example.com",
+	}))
+	t.Equal(http.MethodPut, t.request.Method)
+	t.Equal("/lists/list/members/b58996c504c5638798eb6b511e6f49af", t.request.URL.Path)
+	t.JSONEq(`{"email_address":"User@Example.com","status_if_new":"pending"}`, t.body)
+
+	_, password, ok := t.request.BasicAuth()
+	t.True(ok)
+	t.Equal("secret-us20", password)
+}
+
+func (t *MailchimpNewsletterSubscriberTestSuite) TestSubscribe_WithoutDoubleOptIn() {
+	t.subscriber.baseURL = t.server.URL
+	t.subscriber.doubleOptIn = false
+
+	t.NoError(t.subscriber.Subscribe(context.Background(), extensions.NewsletterSubscription{
+		Email: "user@example.com",
+	}))
+	t.JSONEq(`{"email_address":"user@example.com","status_if_new":"subscribed"}`, t.body)
+}
+
+func (t *MailchimpNewsletterSubscriberTestSuite) TestSubscribe_Error() {
+	t.subscriber.baseURL = t.server.URL
+	t.status = http.StatusBadRequest
+
+	t.Error(t.subscriber.Subscribe(context.Background(), extensions.NewsletterSubscription{
+		Email: "user@example.com",
+	}))
+}
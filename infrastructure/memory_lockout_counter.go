@@ -0,0 +1,96 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// MemoryLockoutCounter defines in memory storage of failed attempts counters.
+	// Counters are not shared between instances, so it's suited only for single instance deployments.
+	MemoryLockoutCounter struct {
+		mutex       sync.Mutex
+		counters    map[string]memoryLockoutCounterEntry
+		lastCleanup time.Time
+		clock       domain.Clock
+	}
+
+	memoryLockoutCounterEntry struct {
+		count   int
+		expires time.Time
+	}
+)
+
+const memoryLockoutCounterCleanupInterval = time.Minute
+
+var _ extensions.LockoutCounter = &MemoryLockoutCounter{}
+
+// Inject is method used to set all dependencies as local variables
+func (c *MemoryLockoutCounter) Inject(clock domain.Clock) {
+	c.clock = clock
+}
+
+// Count returns number of failed attempts for the key
+func (c *MemoryLockoutCounter) Count(_ context.Context, key string) (int, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.counters[key]
+	if !ok || !domain.CurrentTime(c.clock).Before(entry.expires) {
+		return 0, nil
+	}
+
+	return entry.count, nil
+}
+
+// Increment increases number of failed attempts for the key, and starts new window if previous one is expired
+func (c *MemoryLockoutCounter) Increment(_ context.Context, key string, window time.Duration) (int, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := domain.CurrentTime(c.clock)
+	c.cleanup(now)
+
+	entry, ok := c.counters[key]
+	if !ok || !now.Before(entry.expires) {
+		entry = memoryLockoutCounterEntry{
+			expires: now.Add(window),
+		}
+	}
+	entry.count++
+	c.counters[key] = entry
+
+	return entry.count, nil
+}
+
+// Reset removes counter for the key
+func (c *MemoryLockoutCounter) Reset(_ context.Context, key string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.counters, key)
+
+	return nil
+}
+
+// cleanup removes expired counters, at most once per cleanup interval
+func (c *MemoryLockoutCounter) cleanup(now time.Time) {
+	if c.counters == nil {
+		c.counters = map[string]memoryLockoutCounterEntry{}
+	}
+
+	if now.Sub(c.lastCleanup) < memoryLockoutCounterCleanupInterval {
+		return
+	}
+	c.lastCleanup = now
+
+	for key, entry := range c.counters {
+		if !now.Before(entry.expires) {
+			delete(c.counters, key)
+		}
+	}
+}
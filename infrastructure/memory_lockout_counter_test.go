@@ -0,0 +1,102 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/formtest"
+)
+
+type (
+	MemoryLockoutCounterTestSuite struct {
+		suite.Suite
+
+		counter *MemoryLockoutCounter
+		clock   *formtest.FakeClock
+
+		context context.Context
+	}
+)
+
+func TestMemoryLockoutCounterTestSuite(t *testing.T) {
+	suite.Run(t, &MemoryLockoutCounterTestSuite{})
+}
+
+func (t *MemoryLockoutCounterTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *MemoryLockoutCounterTestSuite) SetupTest() {
+	t.clock = formtest.NewFakeClock(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC))
+	t.counter = &MemoryLockoutCounter{}
+	t.counter.Inject(t.clock)
+}
+
+func (t *MemoryLockoutCounterTestSuite) TestCount_Empty() {
+	count, err := t.counter.Count(t.context, "key")
+	t.NoError(err)
+	t.Equal(0, count)
+}
+
+func (t *MemoryLockoutCounterTestSuite) TestIncrement() {
+	count, err := t.counter.Increment(t.context, "key", time.Minute)
+	t.NoError(err)
+	t.Equal(1, count)
+
+	t.clock.Advance(30 * time.Second)
+
+	count, err = t.counter.Increment(t.context, "key", time.Minute)
+	t.NoError(err)
+	t.Equal(2, count)
+
+	count, err = t.counter.Count(t.context, "key")
+	t.NoError(err)
+	t.Equal(2, count)
+
+	count, err = t.counter.Count(t.context, "other")
+	t.NoError(err)
+	t.Equal(0, count)
+}
+
+func (t *MemoryLockoutCounterTestSuite) TestIncrement_Expired() {
+	_, err := t.counter.Increment(t.context, "key", time.Minute)
+	t.NoError(err)
+	_, err = t.counter.Increment(t.context, "key", time.Minute)
+	t.NoError(err)
+
+	t.clock.Advance(time.Minute)
+
+	count, err := t.counter.Count(t.context, "key")
+	t.NoError(err)
+	t.Equal(0, count)
+
+	count, err = t.counter.Increment(t.context, "key", time.Minute)
+	t.NoError(err)
+	t.Equal(1, count)
+}
+
+func (t *MemoryLockoutCounterTestSuite) TestIncrement_Cleanup() {
+	_, err := t.counter.Increment(t.context, "first", time.Second)
+	t.NoError(err)
+
+	t.clock.Advance(2 * time.Minute)
+
+	_, err = t.counter.Increment(t.context, "second", time.Second)
+	t.NoError(err)
+
+	t.Len(t.counter.counters, 1)
+}
+
+func (t *MemoryLockoutCounterTestSuite) TestReset() {
+	_, err := t.counter.Increment(t.context, "key", time.Minute)
+	t.NoError(err)
+
+	t.NoError(t.counter.Reset(t.context, "key"))
+
+	count, err := t.counter.Count(t.context, "key")
+	t.NoError(err)
+	t.Equal(0, count)
+}
@@ -0,0 +1,74 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// MemorySubmissionLocker defines in memory storage of submission locks.
+	// Locks are not shared between instances, so it's suited only for single instance deployments.
+	MemorySubmissionLocker struct {
+		mutex       sync.Mutex
+		locks       map[string]memorySubmissionLock
+		clock       domain.Clock
+		tokenSource domain.TokenSource
+	}
+
+	memorySubmissionLock struct {
+		token   string
+		expires time.Time
+	}
+)
+
+var _ extensions.SubmissionLocker = &MemorySubmissionLocker{}
+
+// Inject is method used to set all dependencies as local variables
+func (l *MemorySubmissionLocker) Inject(clock domain.Clock, tokenSource domain.TokenSource) {
+	l.clock = clock
+	l.tokenSource = tokenSource
+}
+
+// Lock acquires lock for the key, if there is no lock or existing lock is expired
+func (l *MemorySubmissionLocker) Lock(_ context.Context, key string, ttl time.Duration) (string, bool, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.locks == nil {
+		l.locks = map[string]memorySubmissionLock{}
+	}
+
+	now := domain.CurrentTime(l.clock)
+
+	if lock, ok := l.locks[key]; ok && now.Before(lock.expires) {
+		return "", false, nil
+	}
+
+	token, err := generateLockToken(l.tokenSource)
+	if err != nil {
+		return "", false, err
+	}
+
+	l.locks[key] = memorySubmissionLock{
+		token:   token,
+		expires: now.Add(ttl),
+	}
+
+	return token, true, nil
+}
+
+// Unlock releases lock for the key, if it's held by the holder of the token
+func (l *MemorySubmissionLocker) Unlock(_ context.Context, key string, token string) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if lock, ok := l.locks[key]; ok && lock.token == token {
+		delete(l.locks, key)
+	}
+
+	return nil
+}
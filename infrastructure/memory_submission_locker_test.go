@@ -0,0 +1,83 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/formtest"
+)
+
+type (
+	MemorySubmissionLockerTestSuite struct {
+		suite.Suite
+
+		locker *MemorySubmissionLocker
+		clock  *formtest.FakeClock
+
+		context context.Context
+	}
+)
+
+func TestMemorySubmissionLockerTestSuite(t *testing.T) {
+	suite.Run(t, &MemorySubmissionLockerTestSuite{})
+}
+
+func (t *MemorySubmissionLockerTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *MemorySubmissionLockerTestSuite) SetupTest() {
+	t.clock = formtest.NewFakeClock(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC))
+	t.locker = &MemorySubmissionLocker{}
+	t.locker.Inject(t.clock, formtest.NewFakeTokenSource(1, 2, 3))
+}
+
+func (t *MemorySubmissionLockerTestSuite) TestLock() {
+	token, acquired, err := t.locker.Lock(t.context, "key", time.Minute)
+	t.NoError(err)
+	t.True(acquired)
+	t.Equal("01020301020301020301020301020301", token)
+
+	_, acquired, err = t.locker.Lock(t.context, "key", time.Minute)
+	t.NoError(err)
+	t.False(acquired)
+
+	_, acquired, err = t.locker.Lock(t.context, "other", time.Minute)
+	t.NoError(err)
+	t.True(acquired)
+}
+
+func (t *MemorySubmissionLockerTestSuite) TestLock_Expired() {
+	first, acquired, err := t.locker.Lock(t.context, "key", time.Minute)
+	t.NoError(err)
+	t.True(acquired)
+
+	t.clock.Advance(time.Minute)
+
+	second, acquired, err := t.locker.Lock(t.context, "key", time.Minute)
+	t.NoError(err)
+	t.True(acquired)
+	t.NotEqual(first, second)
+}
+
+func (t *MemorySubmissionLockerTestSuite) TestUnlock() {
+	token, _, err := t.locker.Lock(t.context, "key", time.Minute)
+	t.NoError(err)
+
+	t.NoError(t.locker.Unlock(t.context, "key", "other"))
+
+	_, acquired, err := t.locker.Lock(t.context, "key", time.Minute)
+	t.NoError(err)
+	t.False(acquired)
+
+	t.NoError(t.locker.Unlock(t.context, "key", token))
+
+	_, acquired, err = t.locker.Lock(t.context, "key", time.Minute)
+	t.NoError(err)
+	t.True(acquired)
+
+	t.NoError(t.locker.Unlock(t.context, "unknown", token))
+}
@@ -0,0 +1,45 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// MemorySubmissionQueue defines in-memory queue of deferred submissions, consumed by workers of the same instance.
+	// It's meant for development and small setups, so queued submissions are lost with restart of the instance.
+	MemorySubmissionQueue struct {
+		submissions chan domain.QueuedSubmission
+	}
+)
+
+var (
+	_ domain.SubmissionQueue = &MemorySubmissionQueue{}
+
+	// ErrSubmissionQueueFull defines error of full in-memory submission queue
+	ErrSubmissionQueueFull = errors.New("submission queue is full")
+)
+
+// Inject is method used to set all dependencies as local variables
+func (q *MemorySubmissionQueue) Inject(cfg *struct {
+	Capacity int `inject:"config:form.submissionQueue.memory.capacity"`
+}) {
+	q.submissions = make(chan domain.QueuedSubmission, cfg.Capacity)
+}
+
+// Enqueue pushes submission to the queue, without waiting for consumers if queue is full
+func (q *MemorySubmissionQueue) Enqueue(_ context.Context, submission domain.QueuedSubmission) error {
+	select {
+	case q.submissions <- submission:
+		return nil
+	default:
+		return ErrSubmissionQueueFull
+	}
+}
+
+// Submissions returns channel of queued submissions, which is read by consumers
+func (q *MemorySubmissionQueue) Submissions() <-chan domain.QueuedSubmission {
+	return q.submissions
+}
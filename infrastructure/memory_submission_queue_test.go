@@ -0,0 +1,49 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	MemorySubmissionQueueTestSuite struct {
+		suite.Suite
+
+		queue *MemorySubmissionQueue
+
+		context context.Context
+	}
+)
+
+func TestMemorySubmissionQueueTestSuite(t *testing.T) {
+	suite.Run(t, &MemorySubmissionQueueTestSuite{})
+}
+
+func (t *MemorySubmissionQueueTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *MemorySubmissionQueueTestSuite) SetupTest() {
+	t.queue = &MemorySubmissionQueue{}
+	t.queue.Inject(&struct {
+		Capacity int `inject:"config:form.submissionQueue.memory.capacity"`
+	}{
+		Capacity: 2,
+	})
+}
+
+func (t *MemorySubmissionQueueTestSuite) TestEnqueue() {
+	t.NoError(t.queue.Enqueue(t.context, domain.QueuedSubmission{Token: "first"}))
+	t.NoError(t.queue.Enqueue(t.context, domain.QueuedSubmission{Token: "second"}))
+	t.Equal(ErrSubmissionQueueFull, t.queue.Enqueue(t.context, domain.QueuedSubmission{Token: "third"}))
+
+	t.Equal(domain.QueuedSubmission{Token: "first"}, <-t.queue.Submissions())
+	t.Equal(domain.QueuedSubmission{Token: "second"}, <-t.queue.Submissions())
+
+	t.NoError(t.queue.Enqueue(t.context, domain.QueuedSubmission{Token: "third"}))
+	t.Equal(domain.QueuedSubmission{Token: "third"}, <-t.queue.Submissions())
+}
@@ -0,0 +1,20 @@
+package infrastructure
+
+import (
+	"context"
+
+	"flamingo.me/form/domain/card"
+)
+
+type (
+	// NoCardTokenizer defines default card tokenizer, which provides no tokens, so cards are left as they are.
+	// Projects should provide own implementation of card.Tokenizer, which adapts payment service provider.
+	NoCardTokenizer struct{}
+)
+
+var _ card.Tokenizer = &NoCardTokenizer{}
+
+// Tokenize returns empty token
+func (t *NoCardTokenizer) Tokenize(context.Context, card.Card) (string, error) {
+	return "", nil
+}
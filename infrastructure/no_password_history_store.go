@@ -0,0 +1,21 @@
+package infrastructure
+
+import (
+	"context"
+
+	"flamingo.me/form/domain/password"
+)
+
+type (
+	// NoPasswordHistoryStore defines default password history store, which knows no previous passwords.
+	// Projects should provide own implementation of password.HistoryStore, which compares passwords with
+	// hashes of previous passwords of the user.
+	NoPasswordHistoryStore struct{}
+)
+
+var _ password.HistoryStore = &NoPasswordHistoryStore{}
+
+// MatchesPrevious reports that password doesn't match any previous password
+func (s *NoPasswordHistoryStore) MatchesPrevious(context.Context, string, int) (bool, error) {
+	return false, nil
+}
@@ -0,0 +1,32 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// NoSubmissionStore defines default submission store, which is bound if SQL database of the submission store
+	// is not configured. It stores nothing and reports error for each stored submission, so misconfigured form
+	// extension doesn't lose submissions silently, and it's not dependency of the health check.
+	NoSubmissionStore struct{}
+)
+
+var (
+	_ extensions.SubmissionStore = &NoSubmissionStore{}
+
+	// errSubmissionStoreNotConfigured is returned for each stored submission
+	errSubmissionStoreNotConfigured = errors.New("submission store is not configured, form.submissionStore.sql.driver and form.submissionStore.sql.dsn are required")
+)
+
+// StoreSubmission reports that submission can't be stored
+func (s *NoSubmissionStore) StoreSubmission(context.Context, extensions.StoredSubmission) error {
+	return errSubmissionStoreNotConfigured
+}
+
+// LoadSubmission reports that there is no stored submission
+func (s *NoSubmissionStore) LoadSubmission(context.Context, string) (*extensions.StoredSubmission, error) {
+	return nil, extensions.ErrSubmissionNotFound
+}
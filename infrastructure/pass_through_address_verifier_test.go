@@ -0,0 +1,41 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain/address"
+)
+
+type (
+	PassThroughAddressVerifierTestSuite struct {
+		suite.Suite
+
+		verifier *PassThroughAddressVerifier
+	}
+)
+
+func TestPassThroughAddressVerifierTestSuite(t *testing.T) {
+	suite.Run(t, &PassThroughAddressVerifierTestSuite{})
+}
+
+func (t *PassThroughAddressVerifierTestSuite) SetupTest() {
+	t.verifier = &PassThroughAddressVerifier{}
+}
+
+func (t *PassThroughAddressVerifierTestSuite) TestVerifyAddress() {
+	addr := address.Address{
+		Street:  "Main Street",
+		City:    "Springfield",
+		Country: "US",
+	}
+
+	verification, err := t.verifier.VerifyAddress(context.Background(), addr)
+	t.NoError(err)
+	t.Equal(&address.Verification{
+		Verified: true,
+		Address:  addr,
+	}, verification)
+}
@@ -0,0 +1,80 @@
+package infrastructure
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"flamingo.me/form/domain/password"
+)
+
+type (
+	// PwnedPasswordsBreachCorpus defines breach corpus which uses range API of Have I Been Pwned "Pwned Passwords".
+	// It follows k-anonymity model: only first five characters of SHA-1 hash of the password are sent to the service,
+	// and suffixes of all hashes with that prefix are compared locally, so neither password nor its hash leave
+	// the application. Responses are padded, so their size doesn't reveal the prefix.
+	PwnedPasswordsBreachCorpus struct {
+		client   *http.Client
+		rangeURL string
+		minCount int
+	}
+)
+
+var _ password.BreachCorpus = &PwnedPasswordsBreachCorpus{}
+
+// Inject is method used to set all dependencies as local variables
+func (c *PwnedPasswordsBreachCorpus) Inject(cfg *struct {
+	RangeURL string `inject:"config:form.password.breach.rangeURL"`
+	MinCount int    `inject:"config:form.password.breach.minCount"`
+}) {
+	c.client = &http.Client{Timeout: 5 * time.Second}
+	c.rangeURL = strings.TrimSuffix(cfg.RangeURL, "/")
+	c.minCount = cfg.MinCount
+}
+
+// IsBreached returns if password is found in Pwned Passwords at least configured number of times
+func (c *PwnedPasswordsBreachCorpus) IsBreached(ctx context.Context, password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:5], hash[5:]
+
+	request, err := http.NewRequest(http.MethodGet, c.rangeURL+"/"+prefix, nil)
+	if err != nil {
+		return false, err
+	}
+	request = request.WithContext(ctx)
+	request.Header.Set("Add-Padding", "true")
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("pwned passwords range request failed with status %d", response.StatusCode)
+	}
+
+	scanner := bufio.NewScanner(response.Body)
+	for scanner.Scan() {
+		parts := strings.SplitN(strings.TrimSpace(scanner.Text()), ":", 2)
+		if len(parts) != 2 || !strings.EqualFold(parts[0], suffix) {
+			continue
+		}
+
+		count, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return false, err
+		}
+
+		return count > 0 && count >= c.minCount, nil
+	}
+
+	return false, scanner.Err()
+}
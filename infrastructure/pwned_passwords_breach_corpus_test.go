@@ -0,0 +1,84 @@
+package infrastructure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	PwnedPasswordsBreachCorpusTestSuite struct {
+		suite.Suite
+
+		corpus *PwnedPasswordsBreachCorpus
+		server *httptest.Server
+
+		status   int
+		response string
+		path     string
+		padding  string
+	}
+)
+
+func TestPwnedPasswordsBreachCorpusTestSuite(t *testing.T) {
+	suite.Run(t, &PwnedPasswordsBreachCorpusTestSuite{})
+}
+
+func (t *PwnedPasswordsBreachCorpusTestSuite) SetupTest() {
+	t.status = http.StatusOK
+	t.response = "0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n" +
+		"C6008F9CAB4083784CBD1874F76618D2A97:2254650\r\n" +
+		"D0B910E2B3A5A1E1E4A2A8369E1E0E4A9E1:0\r\n"
+	t.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.path = r.URL.Path
+		t.padding = r.Header.Get("Add-Padding")
+		w.WriteHeader(t.status)
+		_, _ = w.Write([]byte(t.response))
+	}))
+
+	t.corpus = &PwnedPasswordsBreachCorpus{}
+	t.corpus.Inject(&struct {
+		RangeURL string `inject:"config:form.password.breach.rangeURL"`
+		MinCount int    `inject:"config:form.password.breach.minCount"`
+	}{
+		RangeURL: t.server.URL + "/range/",
+		MinCount: 1,
+	})
+}
+
+func (t *PwnedPasswordsBreachCorpusTestSuite) TearDownTest() {
+	t.server.Close()
+}
+
+func (t *PwnedPasswordsBreachCorpusTestSuite) TestIsBreached() {
+	breached, err := t.corpus.IsBreached(context.Background(), "password123")
+	t.NoError(err)
+	t.True(breached)
+	t.Equal("/range/CBFDA", t.path)
+	t.Equal("true", t.padding)
+}
+
+func (t *PwnedPasswordsBreachCorpusTestSuite) TestIsBreached_NotFound() {
+	breached, err := t.corpus.IsBreached(context.Background(), "correct horse battery staple")
+	t.NoError(err)
+	t.False(breached)
+}
+
+func (t *PwnedPasswordsBreachCorpusTestSuite) TestIsBreached_MinCount() {
+	t.corpus.minCount = 10000000
+
+	breached, err := t.corpus.IsBreached(context.Background(), "password123")
+	t.NoError(err)
+	t.False(breached)
+}
+
+func (t *PwnedPasswordsBreachCorpusTestSuite) TestIsBreached_Error() {
+	t.status = http.StatusServiceUnavailable
+
+	breached, err := t.corpus.IsBreached(context.Background(), "password123")
+	t.Error(err)
+	t.False(breached)
+}
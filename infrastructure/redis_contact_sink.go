@@ -0,0 +1,63 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// RedisContactSink defines contact message delivery, which pushes messages as JSON into redis list,
+	// so they can be consumed by queue workers
+	RedisContactSink struct {
+		pool *redis.Pool
+		key  string
+	}
+)
+
+var (
+	_ extensions.ContactSink  = &RedisContactSink{}
+	_ domain.DependencyStatus = &RedisContactSink{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (s *RedisContactSink) Inject(cfg *struct {
+	Address  string `inject:"config:form.contact.redis.address"`
+	Password string `inject:"config:form.contact.redis.password"`
+	Database int    `inject:"config:form.contact.redis.database"`
+	Key      string `inject:"config:form.contact.redis.key"`
+}) {
+	s.key = cfg.Key
+	s.pool = &redis.Pool{
+		MaxIdle:     3,
+		IdleTimeout: 240 * time.Second,
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", cfg.Address, redis.DialPassword(cfg.Password), redis.DialDatabase(cfg.Database))
+		},
+	}
+}
+
+// Deliver pushes contact message to the end of the list
+func (s *RedisContactSink) Deliver(_ context.Context, message extensions.ContactMessage) error {
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	_, err = conn.Do("RPUSH", s.key, payload)
+
+	return err
+}
+
+// Status checks if redis is available
+func (s *RedisContactSink) Status() (bool, string) {
+	return redisStatus(s.pool)
+}
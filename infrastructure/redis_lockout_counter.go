@@ -0,0 +1,101 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// RedisLockoutCounter defines redis storage of failed attempts counters, shared between all instances
+	RedisLockoutCounter struct {
+		pool      *redis.Pool
+		keyPrefix string
+	}
+)
+
+var (
+	_ extensions.LockoutCounter = &RedisLockoutCounter{}
+	_ domain.DependencyStatus   = &RedisLockoutCounter{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (c *RedisLockoutCounter) Inject(cfg *struct {
+	Address   string `inject:"config:form.lockout.redis.address"`
+	Password  string `inject:"config:form.lockout.redis.password"`
+	Database  int    `inject:"config:form.lockout.redis.database"`
+	KeyPrefix string `inject:"config:form.lockout.redis.keyPrefix"`
+}) {
+	c.keyPrefix = cfg.KeyPrefix
+	c.pool = &redis.Pool{
+		MaxIdle:     3,
+		IdleTimeout: 240 * time.Second,
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", cfg.Address, redis.DialPassword(cfg.Password), redis.DialDatabase(cfg.Database))
+		},
+	}
+}
+
+// Count returns number of failed attempts for the key
+func (c *RedisLockoutCounter) Count(_ context.Context, key string) (int, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	count, err := redis.Int(conn.Do("GET", c.keyPrefix+key))
+	if err == redis.ErrNil {
+		return 0, nil
+	}
+
+	return count, err
+}
+
+// Increment increases number of failed attempts for the key. Expiration is set with the first failed attempt.
+func (c *RedisLockoutCounter) Increment(_ context.Context, key string, window time.Duration) (int, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	count, err := redis.Int(conn.Do("INCR", c.keyPrefix+key))
+	if err != nil {
+		return 0, err
+	}
+
+	if count == 1 {
+		_, err = conn.Do("PEXPIRE", c.keyPrefix+key, window.Milliseconds())
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return count, nil
+}
+
+// Reset removes counter for the key
+func (c *RedisLockoutCounter) Reset(_ context.Context, key string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("DEL", c.keyPrefix+key)
+
+	return err
+}
+
+// Status checks if redis is available
+func (c *RedisLockoutCounter) Status() (bool, string) {
+	return redisStatus(c.pool)
+}
+
+// redisStatus checks availability of redis by sending PING
+func redisStatus(pool *redis.Pool) (bool, string) {
+	conn := pool.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("PING"); err != nil {
+		return false, "redis: " + err.Error()
+	}
+
+	return true, ""
+}
@@ -0,0 +1,92 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// RedisSubmissionLocker defines redis storage of submission locks, shared between all instances
+	RedisSubmissionLocker struct {
+		pool        *redis.Pool
+		keyPrefix   string
+		tokenSource domain.TokenSource
+	}
+)
+
+// redisUnlockScript deletes the lock only if it's still held by the holder of the token
+const redisUnlockScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) else return 0 end`
+
+var (
+	_ extensions.SubmissionLocker = &RedisSubmissionLocker{}
+	_ domain.DependencyStatus     = &RedisSubmissionLocker{}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (l *RedisSubmissionLocker) Inject(tokenSource domain.TokenSource, cfg *struct {
+	Address   string `inject:"config:form.submissionLock.redis.address"`
+	Password  string `inject:"config:form.submissionLock.redis.password"`
+	Database  int    `inject:"config:form.submissionLock.redis.database"`
+	KeyPrefix string `inject:"config:form.submissionLock.redis.keyPrefix"`
+}) {
+	l.tokenSource = tokenSource
+	l.keyPrefix = cfg.KeyPrefix
+	l.pool = &redis.Pool{
+		MaxIdle:     3,
+		IdleTimeout: 240 * time.Second,
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", cfg.Address, redis.DialPassword(cfg.Password), redis.DialDatabase(cfg.Database))
+		},
+	}
+}
+
+// Lock acquires lock for the key by using SET with NX option, so only one holder can acquire it
+func (l *RedisSubmissionLocker) Lock(_ context.Context, key string, ttl time.Duration) (string, bool, error) {
+	token, err := generateLockToken(l.tokenSource)
+	if err != nil {
+		return "", false, err
+	}
+
+	conn := l.pool.Get()
+	defer conn.Close()
+
+	_, err = redis.String(conn.Do("SET", l.keyPrefix+key, token, "NX", "PX", ttl.Milliseconds()))
+	if err == redis.ErrNil {
+		return "", false, nil
+	} else if err != nil {
+		return "", false, err
+	}
+
+	return token, true, nil
+}
+
+// Unlock releases lock for the key, if it's held by the holder of the token
+func (l *RedisSubmissionLocker) Unlock(_ context.Context, key string, token string) error {
+	conn := l.pool.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("EVAL", redisUnlockScript, 1, l.keyPrefix+key, token)
+
+	return err
+}
+
+// Status checks if redis is available
+func (l *RedisSubmissionLocker) Status() (bool, string) {
+	return redisStatus(l.pool)
+}
+
+// generateLockToken generates random token of token source, which identifies lock holder
+func generateLockToken(tokenSource domain.TokenSource) (string, error) {
+	token := make([]byte, 16)
+	if err := domain.ReadToken(tokenSource, token); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(token), nil
+}
@@ -0,0 +1,89 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// SendinblueNewsletterSubscriber defines newsletter subscriber which adds contacts to Sendinblue lists.
+	// Existing contacts are updated, so repeated subscriptions don't fail.
+	SendinblueNewsletterSubscriber struct {
+		client  *http.Client
+		baseURL string
+		apiKey  string
+		listIDs []int
+	}
+
+	// sendinblueContact defines body of Sendinblue contact request
+	sendinblueContact struct {
+		Email         string `json:"email"`
+		ListIDs       []int  `json:"listIds,omitempty"`
+		UpdateEnabled bool   `json:"updateEnabled"`
+	}
+)
+
+var _ extensions.NewsletterSubscriber = &SendinblueNewsletterSubscriber{}
+
+// Inject is method used to set all dependencies as local variables
+func (s *SendinblueNewsletterSubscriber) Inject(cfg *struct {
+	APIKey  string       `inject:"config:form.newsletter.sendinblue.apiKey"`
+	ListIDs config.Slice `inject:"config:form.newsletter.sendinblue.listIDs"`
+}) {
+	s.client = &http.Client{Timeout: 10 * time.Second}
+	s.baseURL = "https://api.sendinblue.com/v3"
+	s.apiKey = cfg.APIKey
+
+	var listIDs []int
+	if err := cfg.ListIDs.MapInto(&listIDs); err != nil {
+		panic(err.Error())
+	}
+	s.listIDs = listIDs
+}
+
+// Subscribe creates or updates contact in configured Sendinblue lists
+func (s *SendinblueNewsletterSubscriber) Subscribe(ctx context.Context, subscription extensions.NewsletterSubscription) error {
+	body, err := json.Marshal(sendinblueContact{
+		Email:         subscription.Email,
+		ListIDs:       s.listIDs,
+		UpdateEnabled: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, s.baseURL+"/contacts", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request = request.WithContext(ctx)
+	request.Header.Set("api-key", s.apiKey)
+	request.Header.Set("Content-Type", "application/json")
+
+	return doNewsletterRequest(s.client, request, "sendinblue")
+}
+
+// doNewsletterRequest sends request to email service provider and converts unsuccessful responses into errors
+func doNewsletterRequest(client *http.Client, request *http.Request, provider string) error {
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		message, _ := ioutil.ReadAll(io.LimitReader(response.Body, 1024))
+		return fmt.Errorf("%s subscription failed with status %d: %s", provider, response.StatusCode, bytes.TrimSpace(message))
+	}
+
+	return nil
+}
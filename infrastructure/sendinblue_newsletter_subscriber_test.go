@@ -0,0 +1,74 @@
+package infrastructure
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	SendinblueNewsletterSubscriberTestSuite struct {
+		suite.Suite
+
+		subscriber *SendinblueNewsletterSubscriber
+		server     *httptest.Server
+
+		status  int
+		request *http.Request
+		body    string
+	}
+)
+
+func TestSendinblueNewsletterSubscriberTestSuite(t *testing.T) {
+	suite.Run(t, &SendinblueNewsletterSubscriberTestSuite{})
+}
+
+func (t *SendinblueNewsletterSubscriberTestSuite) SetupTest() {
+	t.status = http.StatusCreated
+	t.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		t.request = r
+		t.body = string(body)
+		w.WriteHeader(t.status)
+	}))
+
+	t.subscriber = &SendinblueNewsletterSubscriber{}
+	t.subscriber.Inject(&struct {
+		APIKey  string       `inject:"config:form.newsletter.sendinblue.apiKey"`
+		ListIDs config.Slice `inject:"config:form.newsletter.sendinblue.listIDs"`
+	}{
+		APIKey:  "secret",
+		ListIDs: config.Slice{float64(2), float64(7)},
+	})
+	t.subscriber.baseURL = t.server.URL
+}
+
+func (t *SendinblueNewsletterSubscriberTestSuite) TearDownTest() {
+	t.server.Close()
+}
+
+func (t *SendinblueNewsletterSubscriberTestSuite) TestSubscribe() {
+	t.NoError(t.subscriber.Subscribe(context.Background(), extensions.NewsletterSubscription{
+		Email: "user@example.com",
+	}))
+	t.Equal(http.MethodPost, t.request.Method)
+	t.Equal("/contacts", t.request.URL.Path)
+	t.Equal("secret", t.request.Header.Get("api-key"))
+	t.JSONEq(`{"email":"user@example.com","listIds":[2,7],"updateEnabled":true}`, t.body)
+}
+
+func (t *SendinblueNewsletterSubscriberTestSuite) TestSubscribe_Error() {
+	t.status = http.StatusUnauthorized
+
+	err := t.subscriber.Subscribe(context.Background(), extensions.NewsletterSubscription{
+		Email: "user@example.com",
+	})
+	t.Error(err)
+}
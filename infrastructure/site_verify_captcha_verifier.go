@@ -0,0 +1,94 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// SiteVerifyCaptchaVerifier defines captcha verifier which uses "siteverify" API, shared by
+	// Google reCAPTCHA, hCaptcha and Cloudflare Turnstile. Service is selected by configured provider,
+	// or by configured verification URL, which takes precedence.
+	SiteVerifyCaptchaVerifier struct {
+		client    *http.Client
+		verifyURL string
+		secret    string
+		minScore  float64
+	}
+
+	// siteVerifyResponse defines response of "siteverify" API
+	siteVerifyResponse struct {
+		Success bool     `json:"success"`
+		Score   *float64 `json:"score"`
+	}
+)
+
+var _ extensions.CaptchaVerifier = &SiteVerifyCaptchaVerifier{}
+
+// Inject is method used to set all dependencies as local variables
+func (v *SiteVerifyCaptchaVerifier) Inject(cfg *struct {
+	Provider  string  `inject:"config:form.captcha.provider"`
+	VerifyURL string  `inject:"config:form.captcha.verifyURL"`
+	Secret    string  `inject:"config:form.captcha.secret"`
+	MinScore  float64 `inject:"config:form.captcha.minScore"`
+}) {
+	provider, err := extensions.GetCaptchaProvider(cfg.Provider)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	v.client = &http.Client{Timeout: 10 * time.Second}
+	v.verifyURL = provider.VerifyURL
+	if cfg.VerifyURL != "" {
+		v.verifyURL = cfg.VerifyURL
+	}
+	v.secret = cfg.Secret
+	v.minScore = cfg.MinScore
+}
+
+// VerifyCaptcha verifies captcha response. If service returns score (like reCAPTCHA v3),
+// response is valid only if score reaches configured minimum score.
+func (v *SiteVerifyCaptchaVerifier) VerifyCaptcha(ctx context.Context, response string, remoteIP string) (bool, error) {
+	values := url.Values{
+		"secret":   {v.secret},
+		"response": {response},
+	}
+	if remoteIP != "" {
+		values.Set("remoteip", remoteIP)
+	}
+
+	request, err := http.NewRequest(http.MethodPost, v.verifyURL, strings.NewReader(values.Encode()))
+	if err != nil {
+		return false, err
+	}
+	request = request.WithContext(ctx)
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	httpResponse, err := v.client.Do(request)
+	if err != nil {
+		return false, err
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("captcha verification failed with status %d", httpResponse.StatusCode)
+	}
+
+	result := siteVerifyResponse{}
+	if err := json.NewDecoder(httpResponse.Body).Decode(&result); err != nil {
+		return false, err
+	}
+
+	if result.Score != nil && *result.Score < v.minScore {
+		return false, nil
+	}
+
+	return result.Success, nil
+}
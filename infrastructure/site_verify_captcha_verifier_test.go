@@ -0,0 +1,125 @@
+package infrastructure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	SiteVerifyCaptchaVerifierTestSuite struct {
+		suite.Suite
+
+		verifier *SiteVerifyCaptchaVerifier
+		server   *httptest.Server
+
+		status   int
+		response string
+		values   url.Values
+	}
+)
+
+func TestSiteVerifyCaptchaVerifierTestSuite(t *testing.T) {
+	suite.Run(t, &SiteVerifyCaptchaVerifierTestSuite{})
+}
+
+func (t *SiteVerifyCaptchaVerifierTestSuite) SetupTest() {
+	t.status = http.StatusOK
+	t.response = `{"success": true}`
+	t.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		t.values = r.PostForm
+		w.WriteHeader(t.status)
+		_, _ = w.Write([]byte(t.response))
+	}))
+
+	t.verifier = &SiteVerifyCaptchaVerifier{}
+	t.verifier.Inject(&struct {
+		Provider  string  `inject:"config:form.captcha.provider"`
+		VerifyURL string  `inject:"config:form.captcha.verifyURL"`
+		Secret    string  `inject:"config:form.captcha.secret"`
+		MinScore  float64 `inject:"config:form.captcha.minScore"`
+	}{
+		Provider:  "recaptcha",
+		VerifyURL: t.server.URL,
+		Secret:    "secret",
+		MinScore:  0.5,
+	})
+}
+
+func (t *SiteVerifyCaptchaVerifierTestSuite) TearDownTest() {
+	t.server.Close()
+}
+
+func (t *SiteVerifyCaptchaVerifierTestSuite) TestInject_Provider() {
+	verifier := &SiteVerifyCaptchaVerifier{}
+	verifier.Inject(&struct {
+		Provider  string  `inject:"config:form.captcha.provider"`
+		VerifyURL string  `inject:"config:form.captcha.verifyURL"`
+		Secret    string  `inject:"config:form.captcha.secret"`
+		MinScore  float64 `inject:"config:form.captcha.minScore"`
+	}{
+		Provider: "hcaptcha",
+	})
+	t.Equal("https://api.hcaptcha.com/siteverify", verifier.verifyURL)
+
+	t.Panics(func() {
+		verifier.Inject(&struct {
+			Provider  string  `inject:"config:form.captcha.provider"`
+			VerifyURL string  `inject:"config:form.captcha.verifyURL"`
+			Secret    string  `inject:"config:form.captcha.secret"`
+			MinScore  float64 `inject:"config:form.captcha.minScore"`
+		}{
+			Provider: "unknown",
+		})
+	})
+}
+
+func (t *SiteVerifyCaptchaVerifierTestSuite) TestVerifyCaptcha() {
+	valid, err := t.verifier.VerifyCaptcha(context.Background(), "response", "10.0.0.1")
+	t.NoError(err)
+	t.True(valid)
+	t.Equal(url.Values{
+		"secret":   {"secret"},
+		"response": {"response"},
+		"remoteip": {"10.0.0.1"},
+	}, t.values)
+}
+
+func (t *SiteVerifyCaptchaVerifierTestSuite) TestVerifyCaptcha_Invalid() {
+	t.response = `{"success": false, "error-codes": ["invalid-input-response"]}`
+
+	valid, err := t.verifier.VerifyCaptcha(context.Background(), "response", "")
+	t.NoError(err)
+	t.False(valid)
+	t.Equal(url.Values{
+		"secret":   {"secret"},
+		"response": {"response"},
+	}, t.values)
+}
+
+func (t *SiteVerifyCaptchaVerifierTestSuite) TestVerifyCaptcha_Score() {
+	t.response = `{"success": true, "score": 0.3}`
+
+	valid, err := t.verifier.VerifyCaptcha(context.Background(), "response", "")
+	t.NoError(err)
+	t.False(valid)
+
+	t.response = `{"success": true, "score": 0.9}`
+
+	valid, err = t.verifier.VerifyCaptcha(context.Background(), "response", "")
+	t.NoError(err)
+	t.True(valid)
+}
+
+func (t *SiteVerifyCaptchaVerifierTestSuite) TestVerifyCaptcha_Error() {
+	t.status = http.StatusInternalServerError
+
+	valid, err := t.verifier.VerifyCaptcha(context.Background(), "response", "")
+	t.Error(err)
+	t.False(valid)
+}
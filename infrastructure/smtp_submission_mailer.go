@@ -0,0 +1,79 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// SMTPSubmissionMailer defines submission mail delivery, which sends mails as plain text via SMTP server
+	SMTPSubmissionMailer struct {
+		address  string
+		auth     smtp.Auth
+		from     string
+		to       []string
+		sendMail func(address string, auth smtp.Auth, from string, to []string, message []byte) error
+	}
+)
+
+var _ extensions.SubmissionMailer = &SMTPSubmissionMailer{}
+
+// Inject is method used to set all dependencies as local variables
+func (m *SMTPSubmissionMailer) Inject(cfg *struct {
+	Address  string       `inject:"config:form.submissionMail.smtp.address"`
+	Username string       `inject:"config:form.submissionMail.smtp.username"`
+	Password string       `inject:"config:form.submissionMail.smtp.password"`
+	From     string       `inject:"config:form.submissionMail.smtp.from"`
+	To       config.Slice `inject:"config:form.submissionMail.smtp.to"`
+}) {
+	m.address = cfg.Address
+	m.from = cfg.From
+	m.sendMail = smtp.SendMail
+
+	if cfg.Username != "" {
+		host, _, err := net.SplitHostPort(cfg.Address)
+		if err != nil {
+			panic(err.Error())
+		}
+		m.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, host)
+	}
+
+	var to []string
+	if err := cfg.To.MapInto(&to); err != nil {
+		panic(err.Error())
+	}
+	m.to = to
+}
+
+// Send sends submission mail to all configured recipients
+func (m *SMTPSubmissionMailer) Send(_ context.Context, mail extensions.SubmissionMail) error {
+	return m.sendMail(m.address, m.auth, m.from, m.to, m.compose(mail))
+}
+
+// compose creates mail from submission mail. Rendered subject is reduced to single line,
+// so submitted values can't inject additional headers.
+func (m *SMTPSubmissionMailer) compose(mail extensions.SubmissionMail) []byte {
+	buffer := &bytes.Buffer{}
+
+	fmt.Fprintf(buffer, "From: %s\r\n", m.from)
+	fmt.Fprintf(buffer, "To: %s\r\n", strings.Join(m.to, ", "))
+	fmt.Fprintf(buffer, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", singleLine(mail.Subject)))
+	fmt.Fprintf(buffer, "Date: %s\r\n", mail.Timestamp.Format(time.RFC1123Z))
+	buffer.WriteString("MIME-Version: 1.0\r\n")
+	buffer.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buffer.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buffer.WriteString("\r\n")
+	buffer.WriteString(crlfLines(mail.Body))
+	buffer.WriteString("\r\n")
+
+	return buffer.Bytes()
+}
@@ -0,0 +1,84 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"net/smtp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	SMTPSubmissionMailerTestSuite struct {
+		suite.Suite
+
+		mailer *SMTPSubmissionMailer
+
+		address string
+		from    string
+		to      []string
+		message string
+		err     error
+	}
+)
+
+func TestSMTPSubmissionMailerTestSuite(t *testing.T) {
+	suite.Run(t, &SMTPSubmissionMailerTestSuite{})
+}
+
+func (t *SMTPSubmissionMailerTestSuite) SetupTest() {
+	t.err = nil
+	t.mailer = &SMTPSubmissionMailer{}
+	t.mailer.Inject(&struct {
+		Address  string       `inject:"config:form.submissionMail.smtp.address"`
+		Username string       `inject:"config:form.submissionMail.smtp.username"`
+		Password string       `inject:"config:form.submissionMail.smtp.password"`
+		From     string       `inject:"config:form.submissionMail.smtp.from"`
+		To       config.Slice `inject:"config:form.submissionMail.smtp.to"`
+	}{
+		Address: "localhost:25",
+		From:    "noreply@example.com",
+		To:      config.Slice{"forms@example.com"},
+	})
+	t.mailer.sendMail = func(address string, _ smtp.Auth, from string, to []string, message []byte) error {
+		t.address = address
+		t.from = from
+		t.to = to
+		t.message = string(message)
+		return t.err
+	}
+}
+
+func (t *SMTPSubmissionMailerTestSuite) TestSend() {
+	t.Nil(t.mailer.auth)
+
+	t.NoError(t.mailer.Send(context.Background(), extensions.SubmissionMail{
+		Subject:   "Neue Anfrage\r\nBcc: spam@example.com",
+		Body:      "email: user@example.com\ntopics: shoes",
+		Timestamp: time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC),
+	}))
+
+	t.Equal("localhost:25", t.address)
+	t.Equal("noreply@example.com", t.from)
+	t.Equal([]string{"forms@example.com"}, t.to)
+	t.Equal("From: noreply@example.com\r\n"+
+		"To: forms@example.com\r\n"+
+		"Subject: Neue Anfrage Bcc: spam@example.com\r\n"+
+		"Date: Fri, 01 May 2020 12:00:00 +0000\r\n"+
+		"MIME-Version: 1.0\r\n"+
+		"Content-Type: text/plain; charset=utf-8\r\n"+
+		"Content-Transfer-Encoding: 8bit\r\n"+
+		"\r\n"+
+		"email: user@example.com\r\ntopics: shoes\r\n", t.message)
+}
+
+func (t *SMTPSubmissionMailerTestSuite) TestSend_Error() {
+	t.err = errors.New("error")
+
+	t.Equal(errors.New("error"), t.mailer.Send(context.Background(), extensions.SubmissionMail{}))
+}
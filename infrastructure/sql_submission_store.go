@@ -0,0 +1,260 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// SQLSubmissionStore defines storage of valid submissions in SQL database table, via database/sql.
+	// Driver of the database must be registered by the application (like by importing "github.com/lib/pq"),
+	// and table must exist with columns id, type, submitted_at, path, subject and data. Connection is opened lazily,
+	// so application starts even if database is not available.
+	SQLSubmissionStore struct {
+		driver      string
+		dsn         string
+		table       string
+		placeholder string
+		mutex       sync.Mutex
+		db          *sql.DB
+	}
+)
+
+var (
+	_ extensions.RetainableSubmissionStore = &SQLSubmissionStore{}
+	_ extensions.DataSubjectStore          = &SQLSubmissionStore{}
+
+	// sqlTableRegex defines valid table names, so configured table can't inject SQL
+	sqlTableRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*(\.[a-zA-Z_][a-zA-Z0-9_]*)?$`)
+)
+
+// Inject is method used to set all dependencies as local variables
+func (s *SQLSubmissionStore) Inject(cfg *struct {
+	Driver      string `inject:"config:form.submissionStore.sql.driver"`
+	DSN         string `inject:"config:form.submissionStore.sql.dsn"`
+	Table       string `inject:"config:form.submissionStore.sql.table"`
+	Placeholder string `inject:"config:form.submissionStore.sql.placeholder"`
+}) {
+	if !sqlTableRegex.MatchString(cfg.Table) {
+		panic(fmt.Sprintf("invalid submission store table %q", cfg.Table))
+	}
+
+	if cfg.Placeholder != "?" && cfg.Placeholder != "$" {
+		panic(fmt.Sprintf("invalid submission store placeholder %q, expected \"?\" or \"$\"", cfg.Placeholder))
+	}
+
+	s.driver = cfg.Driver
+	s.dsn = cfg.DSN
+	s.table = cfg.Table
+	s.placeholder = cfg.Placeholder
+}
+
+// StoreSubmission inserts valid submission into configured table
+func (s *SQLSubmissionStore) StoreSubmission(ctx context.Context, submission extensions.StoredSubmission) error {
+	db, err := s.open()
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(
+		ctx,
+		fmt.Sprintf(
+			"INSERT INTO %s (id, type, submitted_at, path, subject, data) VALUES (%s, %s, %s, %s, %s, %s)",
+			s.table, s.bind(1), s.bind(2), s.bind(3), s.bind(4), s.bind(5), s.bind(6),
+		),
+		submission.ID, submission.Type, submission.Timestamp.UTC(), submission.Path, submission.Subject, string(submission.Data),
+	)
+
+	return err
+}
+
+// LoadSubmission selects stored submission by its ID
+func (s *SQLSubmissionStore) LoadSubmission(ctx context.Context, id string) (*extensions.StoredSubmission, error) {
+	db, err := s.open()
+	if err != nil {
+		return nil, err
+	}
+
+	var data string
+	submission := &extensions.StoredSubmission{ID: id}
+	err = db.QueryRowContext(
+		ctx,
+		fmt.Sprintf("SELECT type, submitted_at, path, subject, data FROM %s WHERE id = %s", s.table, s.bind(1)),
+		id,
+	).Scan(&submission.Type, &submission.Timestamp, &submission.Path, &submission.Subject, &data)
+	if err == sql.ErrNoRows {
+		return nil, extensions.ErrSubmissionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	submission.Data = []byte(data)
+
+	return submission, nil
+}
+
+// FindSubmissions selects stored submissions of form data type, which are stored before given time
+func (s *SQLSubmissionStore) FindSubmissions(ctx context.Context, submissionType string, before time.Time) ([]extensions.StoredSubmission, error) {
+	db, err := s.open()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.query(
+		ctx, db,
+		fmt.Sprintf("SELECT id, type, submitted_at, path, subject, data FROM %s WHERE type = %s AND submitted_at < %s", s.table, s.bind(1), s.bind(2)),
+		submissionType, before.UTC(),
+	)
+}
+
+// UpdateSubmission replaces data of stored submission
+func (s *SQLSubmissionStore) UpdateSubmission(ctx context.Context, submission extensions.StoredSubmission) error {
+	db, err := s.open()
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(
+		ctx,
+		fmt.Sprintf("UPDATE %s SET data = %s WHERE id = %s", s.table, s.bind(1), s.bind(2)),
+		string(submission.Data), submission.ID,
+	)
+
+	return err
+}
+
+// DeleteSubmission removes stored submission by its ID
+func (s *SQLSubmissionStore) DeleteSubmission(ctx context.Context, id string) error {
+	db, err := s.open()
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = %s", s.table, s.bind(1)), id)
+
+	return err
+}
+
+// FindSubjectData selects all stored submissions of the subject
+func (s *SQLSubmissionStore) FindSubjectData(ctx context.Context, subject string) ([]extensions.SubjectData, error) {
+	db, err := s.open()
+	if err != nil {
+		return nil, err
+	}
+
+	submissions, err := s.query(
+		ctx, db,
+		fmt.Sprintf("SELECT id, type, submitted_at, path, subject, data FROM %s WHERE subject = %s", s.table, s.bind(1)),
+		subject,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]extensions.SubjectData, 0, len(submissions))
+	for _, submission := range submissions {
+		data, err := json.Marshal(submission)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, extensions.SubjectData{
+			Store:     "submissions",
+			ID:        submission.ID,
+			Timestamp: submission.Timestamp,
+			Data:      data,
+		})
+	}
+
+	return result, nil
+}
+
+// EraseSubjectData deletes all stored submissions of the subject
+func (s *SQLSubmissionStore) EraseSubjectData(ctx context.Context, subject string) (int, error) {
+	db, err := s.open()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := db.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE subject = %s", s.table, s.bind(1)), subject)
+	if err != nil {
+		return 0, err
+	}
+
+	erased, err := result.RowsAffected()
+
+	return int(erased), err
+}
+
+// query selects stored submissions
+func (s *SQLSubmissionStore) query(ctx context.Context, db *sql.DB, query string, args ...interface{}) ([]extensions.StoredSubmission, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var submissions []extensions.StoredSubmission
+	for rows.Next() {
+		var data string
+		submission := extensions.StoredSubmission{}
+		if err := rows.Scan(&submission.ID, &submission.Type, &submission.Timestamp, &submission.Path, &submission.Subject, &data); err != nil {
+			return nil, err
+		}
+		submission.Data = []byte(data)
+		submissions = append(submissions, submission)
+	}
+
+	return submissions, rows.Err()
+}
+
+// Status reports availability of the database
+func (s *SQLSubmissionStore) Status() (bool, string) {
+	db, err := s.open()
+	if err != nil {
+		return false, err.Error()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := db.PingContext(ctx); err != nil {
+		return false, err.Error()
+	}
+
+	return true, ""
+}
+
+// open returns database handle, which is opened on first usage and shared afterwards
+func (s *SQLSubmissionStore) open() (*sql.DB, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.db != nil {
+		return s.db, nil
+	}
+
+	db, err := sql.Open(s.driver, s.dsn)
+	if err != nil {
+		return nil, err
+	}
+	s.db = db
+
+	return db, nil
+}
+
+// bind returns placeholder of n-th query parameter in syntax of configured driver
+func (s *SQLSubmissionStore) bind(n int) string {
+	if s.placeholder == "$" {
+		return fmt.Sprintf("$%d", n)
+	}
+
+	return "?"
+}
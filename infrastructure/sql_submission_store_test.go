@@ -0,0 +1,234 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	SQLSubmissionStoreTestSuite struct {
+		suite.Suite
+
+		context context.Context
+	}
+
+	// sqlTestDriver records statements executed by SQLSubmissionStore, like sqlmock does, with DSN as name of
+	// the connection. Ping fails for DSN "down".
+	sqlTestDriver struct {
+		mutex      sync.Mutex
+		statements map[string][]sqlTestStatement
+	}
+
+	sqlTestStatement struct {
+		query string
+		args  []driver.Value
+	}
+
+	sqlTestConn struct {
+		driver *sqlTestDriver
+		dsn    string
+	}
+
+	sqlTestStmt struct {
+		conn  *sqlTestConn
+		query string
+	}
+
+	sqlTestRows struct{}
+)
+
+const sqlTestDriverName = "formtest"
+
+var (
+	_ driver.Driver = &sqlTestDriver{}
+	_ driver.Pinger = &sqlTestConn{}
+
+	sqlTestRecorder = &sqlTestDriver{statements: map[string][]sqlTestStatement{}}
+)
+
+func init() {
+	sql.Register(sqlTestDriverName, sqlTestRecorder)
+}
+
+func TestSQLSubmissionStoreTestSuite(t *testing.T) {
+	suite.Run(t, &SQLSubmissionStoreTestSuite{})
+}
+
+func (d *sqlTestDriver) Open(dsn string) (driver.Conn, error) {
+	return &sqlTestConn{driver: d, dsn: dsn}, nil
+}
+
+func (d *sqlTestDriver) record(dsn string, query string, args []driver.Value) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.statements[dsn] = append(d.statements[dsn], sqlTestStatement{query: query, args: args})
+}
+
+func (d *sqlTestDriver) recorded(dsn string) []sqlTestStatement {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return d.statements[dsn]
+}
+
+func (c *sqlTestConn) Prepare(query string) (driver.Stmt, error) {
+	return &sqlTestStmt{conn: c, query: query}, nil
+}
+
+func (c *sqlTestConn) Close() error {
+	return nil
+}
+
+func (c *sqlTestConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+func (c *sqlTestConn) Ping(context.Context) error {
+	if c.dsn == "down" {
+		return errors.New("connection refused")
+	}
+
+	return nil
+}
+
+func (s *sqlTestStmt) Close() error {
+	return nil
+}
+
+func (s *sqlTestStmt) NumInput() int {
+	return -1
+}
+
+func (s *sqlTestStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.conn.driver.record(s.conn.dsn, s.query, args)
+
+	return driver.RowsAffected(1), nil
+}
+
+func (s *sqlTestStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.conn.driver.record(s.conn.dsn, s.query, args)
+
+	return &sqlTestRows{}, nil
+}
+
+func (r *sqlTestRows) Columns() []string {
+	return []string{"id", "type", "submitted_at", "path", "subject", "data"}
+}
+
+func (r *sqlTestRows) Close() error {
+	return nil
+}
+
+func (r *sqlTestRows) Next([]driver.Value) error {
+	return io.EOF
+}
+
+func (t *SQLSubmissionStoreTestSuite) SetupSuite() {
+	t.context = context.Background()
+}
+
+func (t *SQLSubmissionStoreTestSuite) newStore(dsn string, table string, placeholder string) *SQLSubmissionStore {
+	store := &SQLSubmissionStore{}
+	store.Inject(&struct {
+		Driver      string `inject:"config:form.submissionStore.sql.driver"`
+		DSN         string `inject:"config:form.submissionStore.sql.dsn"`
+		Table       string `inject:"config:form.submissionStore.sql.table"`
+		Placeholder string `inject:"config:form.submissionStore.sql.placeholder"`
+	}{
+		Driver:      sqlTestDriverName,
+		DSN:         dsn,
+		Table:       table,
+		Placeholder: placeholder,
+	})
+
+	return store
+}
+
+func (t *SQLSubmissionStoreTestSuite) TestInject_Table() {
+	t.NotPanics(func() {
+		t.newStore("table", "form_submissions", "?")
+	})
+	t.NotPanics(func() {
+		t.newStore("table", "forms.submissions", "?")
+	})
+
+	for _, table := range []string{"", "1submissions", "submissions; DROP TABLE users", "a.b.c", "submissions--"} {
+		t.Panics(func() {
+			t.newStore("table", table, "?")
+		}, table)
+	}
+}
+
+func (t *SQLSubmissionStoreTestSuite) TestInject_Placeholder() {
+	t.Panics(func() {
+		t.newStore("placeholder", "form_submissions", ":")
+	})
+	t.Panics(func() {
+		t.newStore("placeholder", "form_submissions", "")
+	})
+}
+
+func (t *SQLSubmissionStoreTestSuite) TestBind() {
+	t.Equal("?", t.newStore("bind", "form_submissions", "?").bind(2))
+	t.Equal("$2", t.newStore("bind", "form_submissions", "$").bind(2))
+}
+
+func (t *SQLSubmissionStoreTestSuite) TestStoreSubmission() {
+	submittedAt := time.Date(2020, 1, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600))
+	submission := extensions.StoredSubmission{
+		ID:        "abc123",
+		Type:      "presets.ContactFormData",
+		Timestamp: submittedAt,
+		Path:      "/contact",
+		Subject:   "user@example.com",
+		Data:      json.RawMessage(`{"email":"user@example.com"}`),
+	}
+
+	t.NoError(t.newStore("store-question-mark", "form_submissions", "?").StoreSubmission(t.context, submission))
+	t.NoError(t.newStore("store-dollar", "forms.submissions", "$").StoreSubmission(t.context, submission))
+
+	args := []driver.Value{"abc123", "presets.ContactFormData", submittedAt.UTC(), "/contact", "user@example.com", `{"email":"user@example.com"}`}
+	t.Equal([]sqlTestStatement{{
+		query: "INSERT INTO form_submissions (id, type, submitted_at, path, subject, data) VALUES (?, ?, ?, ?, ?, ?)",
+		args:  args,
+	}}, sqlTestRecorder.recorded("store-question-mark"))
+	t.Equal([]sqlTestStatement{{
+		query: "INSERT INTO forms.submissions (id, type, submitted_at, path, subject, data) VALUES ($1, $2, $3, $4, $5, $6)",
+		args:  args,
+	}}, sqlTestRecorder.recorded("store-dollar"))
+}
+
+func (t *SQLSubmissionStoreTestSuite) TestFindSubmissions() {
+	before := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	submissions, err := t.newStore("find", "form_submissions", "$").FindSubmissions(t.context, "presets.ContactFormData", before)
+	t.NoError(err)
+	t.Empty(submissions)
+
+	t.Equal([]sqlTestStatement{{
+		query: "SELECT id, type, submitted_at, path, subject, data FROM form_submissions WHERE type = $1 AND submitted_at < $2",
+		args:  []driver.Value{"presets.ContactFormData", before},
+	}}, sqlTestRecorder.recorded("find"))
+}
+
+func (t *SQLSubmissionStoreTestSuite) TestStatus() {
+	alive, details := t.newStore("up", "form_submissions", "?").Status()
+	t.True(alive)
+	t.Empty(details)
+
+	alive, details = t.newStore("down", "form_submissions", "?").Status()
+	t.False(alive)
+	t.Equal("connection refused", details)
+}
@@ -0,0 +1,19 @@
+package infrastructure
+
+import (
+	"time"
+
+	"flamingo.me/form/domain"
+)
+
+type (
+	// SystemClock defines default clock, which returns current time of the system
+	SystemClock struct{}
+)
+
+var _ domain.Clock = &SystemClock{}
+
+// Now returns current time of the system
+func (c *SystemClock) Now() time.Time {
+	return time.Now()
+}
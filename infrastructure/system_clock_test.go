@@ -0,0 +1,26 @@
+package infrastructure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	SystemClockTestSuite struct {
+		suite.Suite
+	}
+)
+
+func TestSystemClockTestSuite(t *testing.T) {
+	suite.Run(t, &SystemClockTestSuite{})
+}
+
+func (t *SystemClockTestSuite) TestNow() {
+	before := time.Now()
+	now := (&SystemClock{}).Now()
+
+	t.False(now.Before(before))
+	t.False(now.After(time.Now()))
+}
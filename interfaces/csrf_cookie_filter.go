@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"context"
+	"net/http"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// CSRFCookieFilter attaches CSRF cookie, scheduled by extensions.CSRFTokenExtension during form handling, to the response
+	CSRFCookieFilter struct{}
+)
+
+var _ web.Filter = &CSRFCookieFilter{}
+
+// Filter sets CSRF cookie header after the request is processed by the rest of the filter chain
+func (f *CSRFCookieFilter) Filter(ctx context.Context, req *web.Request, w http.ResponseWriter, chain *web.FilterChain) web.Result {
+	result := chain.Next(ctx, req, w)
+
+	if cookie, ok := extensions.CSRFCookieFromRequest(req); ok {
+		http.SetCookie(w, cookie)
+	}
+
+	return result
+}
@@ -0,0 +1,97 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// FormResponder responds to handled forms by redirects to routes, which are mapped to outcomes of form handling
+	// per form by configuration, so controllers don't need to branch on each outcome. Cache directive of rendered
+	// responses is derived from cache hint of the form.
+	//
+	// func (c *RegistrationController) Action(ctx context.Context, req *web.Request) web.Result {
+	//	result := c.formHandler.HandleFormResult(ctx, req)
+	//
+	//	return c.formResponder.Respond(ctx, "registration", result, func(result domain.FormResult) web.Result {
+	//		response := c.responder.Render("registration", result.Form())
+	//		response.CacheDirective = c.formResponder.CacheDirective(result.Form())
+	//
+	//		return response
+	//	})
+	// }
+	FormResponder struct {
+		responder *web.Responder
+		encoder   domain.DefaultFormDataEncoder
+		redirects map[string]domain.RedirectMapping
+		maxAge    time.Duration
+	}
+)
+
+// Inject is method used to set all dependencies as local variables. It panics if redirects are not configured
+// as redirect mappings by form names, or if maximal age of cached forms is not valid duration.
+func (r *FormResponder) Inject(responder *web.Responder, encoder domain.DefaultFormDataEncoder, cfg *struct {
+	Redirects config.Map `inject:"config:form.redirects"`
+	MaxAge    string     `inject:"config:form.cacheHints.maxAge"`
+}) {
+	r.responder = responder
+	r.encoder = encoder
+
+	if cfg != nil {
+		if err := cfg.Redirects.MapInto(&r.redirects); err != nil {
+			panic(err.Error())
+		}
+
+		if cfg.MaxAge != "" {
+			maxAge, err := time.ParseDuration(cfg.MaxAge)
+			if err != nil {
+				panic(err.Error())
+			}
+			r.maxAge = maxAge
+		}
+	}
+}
+
+// CacheDirective returns cache directive of response which renders the form. Response of cacheable unsubmitted form
+// is reusable by shared caches for configured maximal age, any other response is not reusable at all.
+func (r *FormResponder) CacheDirective(form *domain.Form) *web.CacheDirective {
+	if form == nil || !form.IsCacheable() || r.maxAge <= 0 {
+		return (&web.CacheDirectiveBuilder{IsReusable: false}).Build()
+	}
+
+	return (&web.CacheDirectiveBuilder{
+		IsReusable:              true,
+		AllowIntermediateCaches: true,
+		MaxCacheLifetime:        int(r.maxAge.Seconds()),
+	}).Build()
+}
+
+// Respond returns redirect to route mapped to outcome of handled form of the name. Route parameters, which reference
+// form fields, are resolved from form data encoded by default form data encoder. Outcomes without mapped route are
+// rendered by render function, except failed form handling, which is responded by server error.
+func (r *FormResponder) Respond(ctx context.Context, name string, result domain.FormResult, render func(result domain.FormResult) web.Result) web.Result {
+	target := r.redirects[name].Target(result.State())
+	if target == nil {
+		if result.IsFailed() {
+			return r.responder.ServerError(result.Err())
+		}
+
+		return render(result)
+	}
+
+	params := target.ResolveParams(nil)
+	if target.HasFieldParams() && result.Form() != nil {
+		values, err := r.encoder.Encode(ctx, result.Data())
+		if err != nil {
+			return r.responder.ServerError(err)
+		}
+
+		params = target.ResolveParams(values)
+	}
+
+	return r.responder.RouteRedirect(target.Route, params)
+}
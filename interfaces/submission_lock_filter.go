@@ -0,0 +1,38 @@
+package interfaces
+
+import (
+	"context"
+	"net/http"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// SubmissionLockFilter releases submission lock, acquired by extensions.SubmissionLockExtension during form handling,
+	// after the request is processed
+	SubmissionLockFilter struct {
+		locker extensions.SubmissionLocker
+		logger flamingo.Logger
+	}
+)
+
+var _ web.Filter = &SubmissionLockFilter{}
+
+// Inject is method used to set all dependencies as local variables
+func (f *SubmissionLockFilter) Inject(locker extensions.SubmissionLocker, logger flamingo.Logger) {
+	f.locker = locker
+	f.logger = logger
+}
+
+// Filter releases submission lock after the request is processed by the rest of the filter chain
+func (f *SubmissionLockFilter) Filter(ctx context.Context, req *web.Request, w http.ResponseWriter, chain *web.FilterChain) web.Result {
+	result := chain.Next(ctx, req, w)
+
+	if err := extensions.ReleaseSubmissionLock(ctx, req, f.locker); err != nil {
+		f.logger.WithField("FormExtension", "submissionLock").Error(err.Error())
+	}
+
+	return result
+}
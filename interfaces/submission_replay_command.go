@@ -0,0 +1,145 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/application"
+	"flamingo.me/form/domain"
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// SubmissionReplayCommand provides CLI command which replays submission, recorded by extensions.SubmissionRecorderExtension,
+	// through the current form processing pipeline and compares resulting errors with recorded ones
+	SubmissionReplayCommand struct {
+		formHandlerFactory application.FormHandlerFactory
+		store              extensions.SubmissionRecordStore
+	}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (c *SubmissionReplayCommand) Inject(formHandlerFactory application.FormHandlerFactory, store extensions.SubmissionRecordStore) {
+	c.formHandlerFactory = formHandlerFactory
+	c.store = store
+}
+
+// Command creates cobra command "form-replay"
+func (c *SubmissionReplayCommand) Command() *cobra.Command {
+	var formService string
+	var formExtensions []string
+
+	cmd := &cobra.Command{
+		Use:   "form-replay [recording ID]",
+		Short: "Replay recorded form submission through the current form pipeline",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.replay(context.Background(), cmd.OutOrStdout(), args[0], formService, formExtensions)
+		},
+	}
+
+	cmd.Flags().StringVar(&formService, "service", "", "name of form service injected via dingo injector")
+	cmd.Flags().StringSliceVar(&formExtensions, "extension", nil, "name of form extension injected via dingo injector")
+
+	return cmd
+}
+
+// replay handles recorded submission with form handler built from named form service and extensions
+func (c *SubmissionReplayCommand) replay(ctx context.Context, out io.Writer, id string, formService string, formExtensions []string) error {
+	recording, err := c.store.LoadSubmission(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	builder := c.formHandlerFactory.GetFormHandlerBuilder()
+	if formService != "" {
+		if err := builder.SetNamedFormService(formService); err != nil {
+			return err
+		}
+	}
+	for _, name := range formExtensions {
+		if err := builder.AddNamedFormExtension(name); err != nil {
+			return err
+		}
+	}
+	formHandler := builder.Build()
+
+	var form *domain.Form
+	req := replayRequest(recording)
+	if recording.Method == http.MethodGet {
+		form, err = formHandler.HandleSubmittedGETForm(ctx, req)
+	} else {
+		form, err = formHandler.HandleSubmittedForm(ctx, req)
+	}
+	if err != nil {
+		return err
+	}
+
+	recorded := formatReplayErrors(recording.GeneralErrors, recording.FieldErrors)
+	replayed := formatReplayErrors(form.ValidationInfo.GetGeneralErrors(), form.ValidationInfo.GetErrorsForAllFields())
+
+	result := "unchanged"
+	if recorded != replayed {
+		result = "changed"
+	}
+
+	_, err = fmt.Fprintf(out, "submission %s %s %s\nrecorded errors:\n%sreplayed errors:\n%sresult: %s\n",
+		recording.ID, recording.Method, recording.Path, recorded, replayed, result)
+
+	return err
+}
+
+// replayRequest creates request with recorded values
+func replayRequest(recording *extensions.SubmissionRecording) *web.Request {
+	values := recording.Values
+	if values == nil {
+		values = url.Values{}
+	}
+
+	request := &http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{Path: recording.Path},
+		Header: http.Header{},
+		Form:   values,
+	}
+
+	if recording.Method == http.MethodGet {
+		request.Method = http.MethodGet
+		request.URL.RawQuery = values.Encode()
+	} else {
+		request.PostForm = values
+	}
+
+	return web.CreateRequest(request, web.EmptySession())
+}
+
+// formatReplayErrors formats errors as sorted lines, so recorded and replayed errors can be compared
+func formatReplayErrors(generalErrors []domain.Error, fieldErrors map[string][]domain.Error) string {
+	var lines []string
+
+	for _, err := range generalErrors {
+		lines = append(lines, fmt.Sprintf("  general: %s", err.MessageKey))
+	}
+
+	for fieldName, errs := range fieldErrors {
+		for _, err := range errs {
+			lines = append(lines, fmt.Sprintf("  field %s: %s", fieldName, err.MessageKey))
+		}
+	}
+
+	if len(lines) == 0 {
+		return "  none\n"
+	}
+
+	sort.Strings(lines)
+
+	return strings.Join(lines, "\n") + "\n"
+}
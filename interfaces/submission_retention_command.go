@@ -0,0 +1,42 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"flamingo.me/form/domain/extensions"
+)
+
+type (
+	// SubmissionRetentionCommand provides CLI command which applies retention policies to submissions stored by
+	// extensions.SubmissionStoreExtension, so it can be scheduled (like via cron job)
+	SubmissionRetentionCommand struct {
+		retention *extensions.SubmissionRetention
+	}
+)
+
+// Inject is method used to set all dependencies as local variables
+func (c *SubmissionRetentionCommand) Inject(retention *extensions.SubmissionRetention) {
+	c.retention = retention
+}
+
+// Command creates cobra command "form-retention"
+func (c *SubmissionRetentionCommand) Command() *cobra.Command {
+	return &cobra.Command{
+		Use:   "form-retention",
+		Short: "Delete or anonymize stored form submissions by configured retention policies",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			result, err := c.retention.Run(context.Background())
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "deleted submissions: %d\nanonymized submissions: %d\n", result.Deleted, result.Anonymized)
+
+			return err
+		},
+	}
+}
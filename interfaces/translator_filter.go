@@ -0,0 +1,29 @@
+package interfaces
+
+import (
+	"context"
+	"net/http"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain"
+)
+
+type (
+	// TranslatorFilter stores translator of error messages into context of the request, so field errors of forms
+	// can be translated via domain.Error.Translate
+	TranslatorFilter struct {
+		translator domain.Translator
+	}
+)
+
+var _ web.Filter = &TranslatorFilter{}
+
+// Inject is method used to set all dependencies as local variables
+func (f *TranslatorFilter) Inject(translator domain.Translator) {
+	f.translator = translator
+}
+
+// Filter passes context with translator and default locale to the rest of the filter chain
+func (f *TranslatorFilter) Filter(ctx context.Context, req *web.Request, w http.ResponseWriter, chain *web.FilterChain) web.Result {
+	return chain.Next(domain.ContextWithTranslator(ctx, f.translator, ""), req, w)
+}
@@ -0,0 +1,124 @@
+package interfaces
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/opencensus"
+	"flamingo.me/flamingo/v3/framework/web"
+	"flamingo.me/form/domain/formdata"
+)
+
+type (
+	// UploadLimitFilter limits count of multipart requests (like file uploads) processed concurrently by the instance.
+	// Requests exceeding the limit wait for free slot up to queue timeout, and are rejected with 503 and Retry-After
+	// header afterwards, so upload stampedes don't exhaust memory and temporary storage of the instance.
+	UploadLimitFilter struct {
+		slots        chan struct{}
+		queueTimeout time.Duration
+		retryAfter   string
+		logger       flamingo.Logger
+	}
+)
+
+var (
+	_ web.Filter = &UploadLimitFilter{}
+
+	// rejectedUploads counts multipart requests rejected because of saturated upload limit
+	rejectedUploads = stats.Int64("flamingo-form/uploads/rejected", "Count of multipart requests rejected because of upload limit", stats.UnitDimensionless)
+)
+
+func init() {
+	if err := opencensus.View("flamingo-form/uploads/rejected", rejectedUploads, view.Count()); err != nil {
+		panic(err)
+	}
+}
+
+// Inject is method used to set all dependencies as local variables. It panics if queue timeout or retry delay
+// is not valid duration.
+func (f *UploadLimitFilter) Inject(logger flamingo.Logger, cfg *struct {
+	MaxConcurrent int    `inject:"config:form.uploads.maxConcurrent"`
+	QueueTimeout  string `inject:"config:form.uploads.queueTimeout"`
+	RetryAfter    string `inject:"config:form.uploads.retryAfter"`
+}) {
+	f.logger = logger
+
+	if cfg == nil {
+		return
+	}
+
+	var err error
+	if f.queueTimeout, err = time.ParseDuration(cfg.QueueTimeout); err != nil {
+		panic(err.Error())
+	}
+
+	retryAfter, err := time.ParseDuration(cfg.RetryAfter)
+	if err != nil {
+		panic(err.Error())
+	}
+	// Retry-After header is defined in whole seconds, so shorter delays are rounded up
+	f.retryAfter = strconv.Itoa(int(math.Ceil(retryAfter.Seconds())))
+
+	if cfg.MaxConcurrent > 0 {
+		f.slots = make(chan struct{}, cfg.MaxConcurrent)
+	}
+}
+
+// Filter processes multipart request by the rest of the filter chain, if there is free slot of upload limit,
+// or rejects it otherwise. Other requests are not limited.
+func (f *UploadLimitFilter) Filter(ctx context.Context, req *web.Request, w http.ResponseWriter, chain *web.FilterChain) web.Result {
+	if f.slots == nil || !formdata.IsMultipartContentType(req.Request().Header.Get("Content-Type")) {
+		return chain.Next(ctx, req, w)
+	}
+
+	if !f.acquire(ctx) {
+		stats.Record(ctx, rejectedUploads.M(1))
+		f.logger.WithField("FormHandler", "uploadLimit").Warn("multipart request rejected, upload limit is saturated")
+
+		return &web.Response{
+			Status: http.StatusServiceUnavailable,
+			Header: http.Header{"Retry-After": []string{f.retryAfter}},
+		}
+	}
+	defer f.release()
+
+	return chain.Next(ctx, req, w)
+}
+
+// acquire takes free slot of upload limit, by waiting for it up to queue timeout. It returns false if there is no
+// free slot within the timeout, or if request is canceled while waiting.
+func (f *UploadLimitFilter) acquire(ctx context.Context) bool {
+	select {
+	case f.slots <- struct{}{}:
+		return true
+	default:
+	}
+
+	if f.queueTimeout <= 0 {
+		return false
+	}
+
+	timer := time.NewTimer(f.queueTimeout)
+	defer timer.Stop()
+
+	select {
+	case f.slots <- struct{}{}:
+		return true
+	case <-timer.C:
+		return false
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// release frees slot of upload limit taken by acquire
+func (f *UploadLimitFilter) release() {
+	<-f.slots
+}
@@ -0,0 +1,37 @@
+package interfaces
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/form/application"
+)
+
+type (
+	// WarmupSubscriber warms up all form handlers built at boot when server is started, so the first requests
+	// don't compile binding plans of large forms and don't prime caches
+	WarmupSubscriber struct {
+		formHandlerFactory application.FormHandlerFactory
+		logger             flamingo.Logger
+	}
+)
+
+var _ flamingo.EventSubscriber = &WarmupSubscriber{}
+
+// Inject is method used to set all dependencies as local variables
+func (s *WarmupSubscriber) Inject(formHandlerFactory application.FormHandlerFactory, logger flamingo.Logger) {
+	s.formHandlerFactory = formHandlerFactory
+	s.logger = logger
+}
+
+// Notify warms up form handlers on server start. Failed warm-up is only logged, since form handlers are still
+// prepared by their first requests.
+func (s *WarmupSubscriber) Notify(ctx context.Context, event flamingo.Event) {
+	if _, ok := event.(*flamingo.ServerStartEvent); !ok {
+		return
+	}
+
+	if err := s.formHandlerFactory.Warmup(ctx); err != nil {
+		s.logger.WithField("FormHandler", "warmup").Error(err.Error())
+	}
+}